@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"go-ai/internal/coinai"
+	"os"
+)
+
+func writeExports(cfg config, result *coinai.PipelineResult) error {
+	if cfg.PredictionsOut != "" {
+		rows, err := result.PredictionRows()
+		if err != nil {
+			return err
+		}
+		err = writeExportFile(cfg.PredictionsOut, cfg.ExportFormat, func(f *os.File, format coinai.ExportFormat) error {
+			return coinai.WritePredictions(f, format, coinai.FeatureNames(), rows)
+		})
+		if err != nil {
+			return fmt.Errorf("predictions: %w", err)
+		}
+	}
+
+	if cfg.TradesOut != "" {
+		rows := result.TradeRows()
+		err := writeExportFile(cfg.TradesOut, cfg.ExportFormat, func(f *os.File, format coinai.ExportFormat) error {
+			return coinai.WriteTrades(f, format, rows)
+		})
+		if err != nil {
+			return fmt.Errorf("trades: %w", err)
+		}
+	}
+	return nil
+}
+
+func writeExportFile(path, formatFlag string, write func(*os.File, coinai.ExportFormat) error) error {
+	format, err := coinai.ParseExportFormat(formatFlag, path)
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f, format); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -27,12 +27,18 @@ type config struct {
 	Epochs         int
 	LearningRate   float64
 	L2             float64
+	Seed           uint64
 	LongThreshold  float64
 	ShortThreshold float64
 	FeeBPS         float64
 	Timeout        time.Duration
 	JSONOutput     bool
 	ModelOut       string
+	ManifestOut    string
+	SnapshotOut    string
+	PredictionsOut string
+	TradesOut      string
+	ExportFormat   string
 }
 
 type trainReport struct {
@@ -50,21 +56,22 @@ type trainReport struct {
 	Backtest            coinai.BacktestResult `json:"backtest"`
 	NextPredictedReturn float64               `json:"next_predicted_return"`
 	Signal              coinai.Signal         `json:"signal"`
+	LineageHash         string                `json:"lineage_hash"`
 	GeneratedAt         time.Time             `json:"generated_at"`
 }
 
-type savedModel struct {
-	Market       string                `json:"market"`
-	DataSource   string                `json:"data_source"`
-	Symbol       string                `json:"symbol"`
-	Interval     string                `json:"interval"`
-	FeatureNames []string              `json:"feature_names"`
-	Scaler       coinai.StandardScaler `json:"scaler"`
-	Model        coinai.LinearModel    `json:"model"`
-	TrainedAt    time.Time             `json:"trained_at"`
-}
-
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "monitor":
+			runMonitor(os.Args[2:])
+			return
+		case "replay":
+			runReplay(os.Args[2:])
+			return
+		}
+	}
+
 	cfg := parseFlags()
 	if err := validateConfig(cfg); err != nil {
 		log.Fatalf("invalid config: %v", err)
@@ -78,90 +85,48 @@ func main() {
 		log.Fatalf("fetch candles: %v", err)
 	}
 
-	samples, err := coinai.BuildDataset(candles)
+	pipelineCfg := pipelineConfig(cfg)
+	result, err := coinai.RunPipeline(candles, pipelineCfg)
 	if err != nil {
-		log.Fatalf("build dataset: %v", err)
+		log.Fatalf("run pipeline: %v", err)
 	}
 
-	trainSamples, testSamples, err := coinai.SplitSequential(samples, cfg.TrainRatio)
-	if err != nil {
-		log.Fatalf("split dataset: %v", err)
-	}
-
-	trainX, trainY := samplesToXY(trainSamples)
-	testX, testY := samplesToXY(testSamples)
-
-	scaler := coinai.NewStandardScaler(len(trainX[0]))
-	if err := scaler.Fit(trainX); err != nil {
-		log.Fatalf("fit scaler: %v", err)
-	}
-	trainXNorm, err := scaler.TransformBatch(trainX)
-	if err != nil {
-		log.Fatalf("normalize train data: %v", err)
-	}
-	testXNorm, err := scaler.TransformBatch(testX)
-	if err != nil {
-		log.Fatalf("normalize test data: %v", err)
-	}
-
-	model := coinai.NewLinearModel(len(trainXNorm[0]))
-	stats, err := model.Train(trainXNorm, trainY, coinai.TrainConfig{
-		Epochs:       cfg.Epochs,
-		LearningRate: cfg.LearningRate,
-		L2:           cfg.L2,
-	})
-	if err != nil {
-		log.Fatalf("train model: %v", err)
-	}
-
-	preds := model.PredictBatch(testXNorm)
-	testMSE := coinai.MeanSquaredError(preds, testY)
-	acc := coinai.DirectionalAccuracy(preds, testY)
-
-	backtest, err := coinai.Backtest(preds, testY, coinai.BacktestConfig{
-		LongThreshold:  cfg.LongThreshold,
-		ShortThreshold: cfg.ShortThreshold,
-		FeeRate:        cfg.FeeBPS / 10000,
-	})
-	if err != nil {
-		log.Fatalf("backtest: %v", err)
-	}
-
-	latestFeatures, err := coinai.BuildLatestFeatures(candles)
-	if err != nil {
-		log.Fatalf("latest features: %v", err)
-	}
-	latestNorm, err := scaler.Transform(latestFeatures)
-	if err != nil {
-		log.Fatalf("normalize latest feature: %v", err)
-	}
-	nextPred := model.Predict(latestNorm)
-	signal := coinai.SignalFromPrediction(nextPred, cfg.LongThreshold, cfg.ShortThreshold)
-
 	report := trainReport{
 		Market:              normalizeMarket(cfg.Market),
 		DataSource:          dataSource,
 		Symbol:              cfg.Symbol,
 		Interval:            cfg.Interval,
-		Candles:             len(candles),
-		TrainSamples:        len(trainSamples),
-		TestSamples:         len(testSamples),
+		Candles:             result.Candles,
+		TrainSamples:        len(result.TrainSamples),
+		TestSamples:         len(result.TestSamples),
 		FeatureNames:        coinai.FeatureNames(),
-		TrainLoss:           stats.FinalLoss,
-		TestMSE:             testMSE,
-		TestDirectionalAcc:  acc,
-		Backtest:            backtest,
-		NextPredictedReturn: nextPred,
-		Signal:              signal,
+		TrainLoss:           result.TrainLoss,
+		TestMSE:             result.TestMSE,
+		TestDirectionalAcc:  result.TestDirectionalAcc,
+		Backtest:            result.Backtest,
+		NextPredictedReturn: result.NextPredictedReturn,
+		Signal:              result.Signal,
+		LineageHash:         result.LineageHash,
 		GeneratedAt:         time.Now().UTC(),
 	}
 
 	if cfg.ModelOut != "" {
-		if err := saveModel(cfg, dataSource, scaler, model); err != nil {
+		saved := result.SavedModel(normalizeMarket(cfg.Market), dataSource, cfg.Symbol, cfg.Interval)
+		if err := saved.WriteFile(cfg.ModelOut); err != nil {
 			log.Fatalf("save model: %v", err)
 		}
 	}
 
+	if err := writeExports(cfg, result); err != nil {
+		log.Fatalf("export: %v", err)
+	}
+
+	if cfg.ManifestOut != "" {
+		if err := writeManifest(cfg, dataSource, candles, pipelineCfg, result); err != nil {
+			log.Fatalf("save manifest: %v", err)
+		}
+	}
+
 	if cfg.JSONOutput {
 		output, err := json.MarshalIndent(report, "", "  ")
 		if err != nil {
@@ -172,6 +137,15 @@ func main() {
 	}
 
 	printReport(report, cfg.ModelOut)
+	if cfg.ManifestOut != "" {
+		fmt.Printf("Manifest saved to: %s\n", cfg.ManifestOut)
+	}
+	if cfg.PredictionsOut != "" {
+		fmt.Printf("Predictions saved to: %s\n", cfg.PredictionsOut)
+	}
+	if cfg.TradesOut != "" {
+		fmt.Printf("Trades saved to: %s\n", cfg.TradesOut)
+	}
 }
 
 func parseFlags() config {
@@ -186,17 +160,40 @@ func parseFlags() config {
 	flag.IntVar(&cfg.Epochs, "epochs", 800, "training epochs")
 	flag.Float64Var(&cfg.LearningRate, "lr", 0.03, "learning rate")
 	flag.Float64Var(&cfg.L2, "l2", 0.001, "L2 regularization")
-	flag.Float64Var(&cfg.LongThreshold, "long-threshold", 0.0015, "predicted return threshold for BUY")
-	flag.Float64Var(&cfg.ShortThreshold, "short-threshold", -0.0015, "predicted return threshold for SELL")
+	flag.Uint64Var(&cfg.Seed, "seed", 0, "weight initialisation seed (0 = zero init)")
+	flag.Float64Var(&cfg.LongThreshold, "long-threshold", coinai.DefaultLongThreshold, "predicted return threshold for BUY")
+	flag.Float64Var(&cfg.ShortThreshold, "short-threshold", coinai.DefaultShortThreshold, "predicted return threshold for SELL")
 	flag.Float64Var(&cfg.FeeBPS, "fee-bps", 4, "transaction fee in basis points")
 	flag.DurationVar(&cfg.Timeout, "timeout", 20*time.Second, "network timeout")
 	flag.BoolVar(&cfg.JSONOutput, "json", false, "print output as JSON")
 	flag.StringVar(&cfg.ModelOut, "model-out", "", "optional file path to save trained model JSON")
+	flag.StringVar(&cfg.ManifestOut, "manifest-out", "", "optional file path to save a reproducible run manifest")
+	flag.StringVar(&cfg.SnapshotOut, "snapshot-out", "", "candle snapshot path for the manifest (default: <manifest>.candles.json)")
+	flag.StringVar(&cfg.PredictionsOut, "predictions-out", "", "optional file path to export test-set predictions")
+	flag.StringVar(&cfg.TradesOut, "trades-out", "", "optional file path to export backtest trades")
+	flag.StringVar(&cfg.ExportFormat, "export-format", "", "export format: csv | jsonl (default: from file extension)")
 
 	flag.Parse()
 	return cfg
 }
 
+func pipelineConfig(cfg config) coinai.PipelineConfig {
+	return coinai.PipelineConfig{
+		TrainRatio: cfg.TrainRatio,
+		Train: coinai.TrainConfig{
+			Epochs:       cfg.Epochs,
+			LearningRate: cfg.LearningRate,
+			L2:           cfg.L2,
+			Seed:         cfg.Seed,
+		},
+		Backtest: coinai.BacktestConfig{
+			LongThreshold:  cfg.LongThreshold,
+			ShortThreshold: cfg.ShortThreshold,
+			FeeRate:        cfg.FeeBPS / 10000,
+		},
+	}
+}
+
 func validateConfig(cfg config) error {
 	market := normalizeMarket(cfg.Market)
 	switch {
@@ -219,6 +216,9 @@ func validateConfig(cfg config) error {
 	case cfg.FeeBPS < 0:
 		return fmt.Errorf("fee-bps cannot be negative")
 	}
+	if _, err := coinai.ParseExportFormat(cfg.ExportFormat, ""); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -247,16 +247,6 @@ func normalizeMarket(market string) string {
 	return strings.ToLower(strings.TrimSpace(market))
 }
 
-func samplesToXY(samples []coinai.Sample) ([][]float64, []float64) {
-	x := make([][]float64, 0, len(samples))
-	y := make([]float64, 0, len(samples))
-	for _, sample := range samples {
-		x = append(x, sample.Features)
-		y = append(y, sample.Target)
-	}
-	return x, y
-}
-
 func printReport(report trainReport, modelPath string) {
 	fmt.Printf("Coin AI report [%s | %s %s]\n", report.Market, report.Symbol, report.Interval)
 	fmt.Printf("Data source: %s\n", report.DataSource)
@@ -275,24 +265,3 @@ func printReport(report trainReport, modelPath string) {
 		fmt.Printf("Model saved to: %s\n", modelPath)
 	}
 }
-
-func saveModel(cfg config, dataSource string, scaler *coinai.StandardScaler, model *coinai.LinearModel) error {
-	payload := savedModel{
-		Market:       normalizeMarket(cfg.Market),
-		DataSource:   dataSource,
-		Symbol:       cfg.Symbol,
-		Interval:     cfg.Interval,
-		FeatureNames: coinai.FeatureNames(),
-		Scaler:       *scaler,
-		Model:        *model,
-		TrainedAt:    time.Now().UTC(),
-	}
-	bytes, err := json.MarshalIndent(payload, "", "  ")
-	if err != nil {
-		return fmt.Errorf("marshal model: %w", err)
-	}
-	if err := os.WriteFile(cfg.ModelOut, bytes, 0o644); err != nil {
-		return fmt.Errorf("write model file: %w", err)
-	}
-	return nil
-}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go-ai/internal/coinai"
+	"log"
+	"os"
+	"time"
+)
+
+const exitCodeAlert = 2
+
+type monitorConfig struct {
+	ModelPath      string
+	StatePath      string
+	StockCSV       string
+	Limit          int
+	Window         int
+	MinResolved    int
+	MinAccuracy    float64
+	MaxMSE         float64
+	LongThreshold  float64
+	ShortThreshold float64
+	FeeBPS         float64
+	PSIWarning     float64
+	PSIAlert       float64
+	KSAlert        float64
+	Timeout        time.Duration
+	JSONOutput     bool
+}
+
+type monitorOutput struct {
+	Symbol              string               `json:"symbol"`
+	Interval            string               `json:"interval"`
+	ModelTrainedAt      time.Time            `json:"model_trained_at"`
+	LatestCandle        time.Time            `json:"latest_candle"`
+	NewlyResolved       int                  `json:"newly_resolved"`
+	NextPredictedReturn float64              `json:"next_predicted_return"`
+	Signal              coinai.Signal        `json:"signal"`
+	Report              coinai.MonitorReport `json:"report"`
+	CheckedAt           time.Time            `json:"checked_at"`
+}
+
+func runMonitor(args []string) {
+	cfg := parseMonitorFlags(args)
+	if cfg.ModelPath == "" {
+		log.Fatalf("invalid config: model is required")
+	}
+	if cfg.StatePath == "" {
+		log.Fatalf("invalid config: state is required")
+	}
+	if cfg.LongThreshold <= cfg.ShortThreshold {
+		log.Fatalf("invalid config: long-threshold must be greater than short-threshold")
+	}
+
+	saved, err := coinai.LoadSavedModel(cfg.ModelPath)
+	if err != nil {
+		log.Fatalf("load model: %v", err)
+	}
+	monitor, err := coinai.LoadMonitor(cfg.StatePath)
+	if err != nil {
+		log.Fatalf("load monitor state: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Timeout)
+	defer cancel()
+
+	candles, _, err := loadCandles(ctx, config{
+		Market:   saved.Market,
+		Symbol:   saved.Symbol,
+		Interval: saved.Interval,
+		StockCSV: cfg.StockCSV,
+		Limit:    cfg.Limit,
+		Timeout:  cfg.Timeout,
+	})
+	if err != nil {
+		log.Fatalf("fetch candles: %v", err)
+	}
+	now := time.Now().UTC()
+	candles = coinai.ClosedCandles(candles, now)
+	if len(candles) == 0 {
+		log.Fatalf("no closed candles available")
+	}
+
+	resolved, err := monitor.ResolveFromCandles(candles)
+	if err != nil {
+		log.Fatalf("resolve predictions: %v", err)
+	}
+
+	latestFeatures, err := coinai.BuildLatestFeatures(candles)
+	if err != nil {
+		log.Fatalf("latest features: %v", err)
+	}
+	nextPred, err := saved.Predict(latestFeatures)
+	if err != nil {
+		log.Fatalf("predict: %v", err)
+	}
+	latest := candles[len(candles)-1]
+	monitor.RecordPrediction(latest.CloseTime, latestFeatures, nextPred)
+
+	report, err := monitor.Evaluate(saved.Scaler, saved.FeatureNames, coinai.MonitorConfig{
+		Window:         cfg.Window,
+		MinResolved:    cfg.MinResolved,
+		MinAccuracy:    cfg.MinAccuracy,
+		MaxMSE:         cfg.MaxMSE,
+		LongThreshold:  cfg.LongThreshold,
+		ShortThreshold: cfg.ShortThreshold,
+		FeeRate:        cfg.FeeBPS / 10000,
+		DriftBins:      coinai.DefaultMonitorConfig().DriftBins,
+		PSIWarning:     cfg.PSIWarning,
+		PSIAlert:       cfg.PSIAlert,
+		KSAlert:        cfg.KSAlert,
+	})
+	if err != nil {
+		log.Fatalf("evaluate monitor: %v", err)
+	}
+
+	if err := monitor.WriteFile(cfg.StatePath); err != nil {
+		log.Fatalf("save monitor state: %v", err)
+	}
+
+	out := monitorOutput{
+		Symbol:              saved.Symbol,
+		Interval:            saved.Interval,
+		ModelTrainedAt:      saved.TrainedAt,
+		LatestCandle:        latest.CloseTime,
+		NewlyResolved:       resolved,
+		NextPredictedReturn: nextPred,
+		Signal:              coinai.SignalFromPrediction(nextPred, cfg.LongThreshold, cfg.ShortThreshold),
+		Report:              report,
+		CheckedAt:           now,
+	}
+
+	if cfg.JSONOutput {
+		output, err := json.MarshalIndent(out, "", "  ")
+		if err != nil {
+			log.Fatalf("marshal monitor report: %v", err)
+		}
+		fmt.Println(string(output))
+	} else {
+		printMonitorReport(out)
+	}
+
+	if report.Status == coinai.MonitorStatusAlert {
+		os.Exit(exitCodeAlert)
+	}
+}
+
+func parseMonitorFlags(args []string) monitorConfig {
+	defaults := coinai.DefaultMonitorConfig()
+	cfg := monitorConfig{}
+
+	fs := flag.NewFlagSet("monitor", flag.ExitOnError)
+	fs.StringVar(&cfg.ModelPath, "model", "", "saved model JSON produced by -model-out")
+	fs.StringVar(&cfg.StatePath, "state", "", "monitor state file holding recorded predictions")
+	fs.StringVar(&cfg.StockCSV, "stock-csv", "", "CSV path for stock OHLCV data when the model market is stock")
+	fs.IntVar(&cfg.Limit, "limit", 200, "number of latest candles to fetch")
+	fs.IntVar(&cfg.Window, "window", defaults.Window, "rolling window of predictions used for metrics and drift")
+	fs.IntVar(&cfg.MinResolved, "min-resolved", defaults.MinResolved, "minimum resolved predictions before alerting")
+	fs.Float64Var(&cfg.MinAccuracy, "min-acc", defaults.MinAccuracy, "alert when rolling directional accuracy drops below this value")
+	fs.Float64Var(&cfg.MaxMSE, "max-mse", defaults.MaxMSE, "alert when rolling MSE exceeds this value (0 disables)")
+	fs.Float64Var(&cfg.LongThreshold, "long-threshold", defaults.LongThreshold, "predicted return threshold for BUY")
+	fs.Float64Var(&cfg.ShortThreshold, "short-threshold", defaults.ShortThreshold, "predicted return threshold for SELL")
+	fs.Float64Var(&cfg.FeeBPS, "fee-bps", 4, "transaction fee in basis points")
+	fs.Float64Var(&cfg.PSIWarning, "psi-warn", defaults.PSIWarning, "PSI level that marks a feature as shifting")
+	fs.Float64Var(&cfg.PSIAlert, "psi-alert", defaults.PSIAlert, "PSI level that marks a feature as drifted")
+	fs.Float64Var(&cfg.KSAlert, "ks-alert", defaults.KSAlert, "KS statistic that marks a feature as drifted")
+	fs.DurationVar(&cfg.Timeout, "timeout", 20*time.Second, "network timeout")
+	fs.BoolVar(&cfg.JSONOutput, "json", false, "print output as JSON")
+
+	_ = fs.Parse(args)
+	return cfg
+}
+
+func printMonitorReport(out monitorOutput) {
+	report := out.Report
+	fmt.Printf("Coin AI monitor [%s %s]\n", out.Symbol, out.Interval)
+	fmt.Printf("Model trained at: %s\n", out.ModelTrainedAt.Format(time.RFC3339))
+	fmt.Printf("Latest closed candle: %s\n", out.LatestCandle.Format(time.RFC3339))
+	fmt.Printf("Predictions: %d | resolved in window: %d/%d | newly resolved: %d\n", report.Predictions, report.Resolved, report.Window, out.NewlyResolved)
+	fmt.Printf("Rolling directional accuracy: %.2f%%\n", report.DirectionalAcc*100)
+	fmt.Printf("Rolling MSE: %.8f\n", report.MSE)
+	fmt.Printf("Rolling PnL: %.2f%%\n", report.PnL*100)
+	for _, d := range report.Drift {
+		fmt.Printf("Drift %-14s psi=%.4f ks=%.4f [%s]\n", d.Feature, d.PSI, d.KS, d.Status)
+	}
+	fmt.Printf("Predicted next return: %.4f%%\n", out.NextPredictedReturn*100)
+	fmt.Printf("Signal: %s\n", out.Signal)
+	fmt.Printf("Status: %s\n", report.Status)
+	for _, reason := range report.Reasons {
+		fmt.Printf("  - %s\n", reason)
+	}
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go-ai/internal/coinai"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+type replayConfig struct {
+	ManifestPath string
+	SnapshotPath string
+	Refetch      bool
+	Timeout      time.Duration
+	JSONOutput   bool
+}
+
+type replayOutput struct {
+	Manifest           string              `json:"manifest"`
+	CandleSource       string              `json:"candle_source"`
+	RecordedCode       string              `json:"recorded_code_version"`
+	CurrentCode        string              `json:"current_code_version"`
+	RecordedDataHash   string              `json:"recorded_data_hash"`
+	ReplayedDataHash   string              `json:"replayed_data_hash"`
+	DataMatches        bool                `json:"data_matches"`
+	RecordedLineage    string              `json:"recorded_lineage_hash"`
+	ReplayedLineage    string              `json:"replayed_lineage_hash"`
+	FeatureSpecMatches bool                `json:"feature_spec_matches"`
+	Metrics            []coinai.MetricDiff `json:"metrics"`
+	RecordedSignal     coinai.Signal       `json:"recorded_signal"`
+	ReplayedSignal     coinai.Signal       `json:"replayed_signal"`
+	Reproduced         bool                `json:"reproduced"`
+}
+
+func runReplay(args []string) {
+	cfg := parseReplayFlags(args)
+	if cfg.ManifestPath == "" {
+		log.Fatalf("invalid config: manifest is required")
+	}
+
+	manifest, err := coinai.LoadRunManifest(cfg.ManifestPath)
+	if err != nil {
+		log.Fatalf("load manifest: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Timeout)
+	defer cancel()
+
+	candles, source, err := loadReplayCandles(ctx, cfg, manifest)
+	if err != nil {
+		log.Fatalf("load candles: %v", err)
+	}
+
+	result, err := coinai.RunPipeline(candles, manifest.PipelineConfig())
+	if err != nil {
+		log.Fatalf("run pipeline: %v", err)
+	}
+
+	diffs, identical := coinai.DiffMetrics(manifest.Metrics, result.RunMetrics())
+	out := replayOutput{
+		Manifest:           cfg.ManifestPath,
+		CandleSource:       source,
+		RecordedCode:       manifest.CodeVersion,
+		CurrentCode:        coinai.CodeVersion(),
+		RecordedDataHash:   manifest.Data.ContentHash,
+		ReplayedDataHash:   coinai.CandleHash(candles),
+		RecordedLineage:    manifest.LineageHash,
+		ReplayedLineage:    result.LineageHash,
+		FeatureSpecMatches: manifest.CheckFeatures() == nil,
+		Metrics:            diffs,
+		RecordedSignal:     manifest.Metrics.Signal,
+		ReplayedSignal:     result.Signal,
+	}
+	out.DataMatches = out.RecordedDataHash == out.ReplayedDataHash
+	out.Reproduced = identical && out.DataMatches && out.FeatureSpecMatches
+
+	if cfg.JSONOutput {
+		output, err := json.MarshalIndent(out, "", "  ")
+		if err != nil {
+			log.Fatalf("marshal replay: %v", err)
+		}
+		fmt.Println(string(output))
+	} else {
+		printReplay(out)
+	}
+
+	if !out.Reproduced {
+		os.Exit(exitCodeAlert)
+	}
+}
+
+func parseReplayFlags(args []string) replayConfig {
+	cfg := replayConfig{}
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+
+	fs.StringVar(&cfg.ManifestPath, "manifest", "", "run manifest written with -manifest-out")
+	fs.StringVar(&cfg.SnapshotPath, "snapshot", "", "candle snapshot path (default: the one recorded in the manifest)")
+	fs.BoolVar(&cfg.Refetch, "refetch", false, "ignore the snapshot and reload the exact candle range from the source")
+	fs.DurationVar(&cfg.Timeout, "timeout", 20*time.Second, "network timeout")
+	fs.BoolVar(&cfg.JSONOutput, "json", false, "print output as JSON")
+
+	_ = fs.Parse(args)
+	return cfg
+}
+
+// loadReplayCandles prefers the cached snapshot and falls back to reloading
+// the recorded range from the original source.
+func loadReplayCandles(ctx context.Context, cfg replayConfig, m *coinai.RunManifest) ([]coinai.Candle, string, error) {
+	if !cfg.Refetch {
+		snapshot := cfg.SnapshotPath
+		if snapshot == "" && m.Data.Snapshot != "" {
+			snapshot = m.Data.Snapshot
+			if !filepath.IsAbs(snapshot) {
+				snapshot = filepath.Join(filepath.Dir(cfg.ManifestPath), snapshot)
+			}
+		}
+		if snapshot != "" {
+			candles, err := coinai.LoadCandleSnapshot(snapshot)
+			if err == nil {
+				return candles, "snapshot " + snapshot, nil
+			}
+			if cfg.SnapshotPath != "" {
+				return nil, "", err
+			}
+			log.Printf("snapshot unavailable, reloading from %s: %v", m.Data.DataSource, err)
+		}
+	}
+
+	switch m.Data.DataSource {
+	case "binance":
+		client := coinai.NewBinanceClient(os.Getenv("BINANCE_BASE_URL"), cfg.Timeout)
+		candles, err := client.FetchKlinesRange(ctx, m.Data.Symbol, m.Data.Interval, m.Data.FirstOpenTime, m.Data.LastOpenTime, m.Data.Candles)
+		if err != nil {
+			return nil, "", err
+		}
+		return candles, "binance range", nil
+	case "csv":
+		candles, err := coinai.LoadCandlesFromCSV(strings.TrimSpace(m.Data.StockCSV), m.Data.Limit)
+		if err != nil {
+			return nil, "", err
+		}
+		return candles, "csv " + m.Data.StockCSV, nil
+	default:
+		return nil, "", fmt.Errorf("unsupported data source %q", m.Data.DataSource)
+	}
+}
+
+func writeManifest(cfg config, dataSource string, candles []coinai.Candle, pipelineCfg coinai.PipelineConfig, result *coinai.PipelineResult) error {
+	snapshot := cfg.SnapshotOut
+	if snapshot == "" {
+		snapshot = strings.TrimSuffix(cfg.ManifestOut, filepath.Ext(cfg.ManifestOut)) + ".candles.json"
+	}
+	if err := coinai.WriteCandleSnapshot(snapshot, candles); err != nil {
+		return err
+	}
+	// Store the snapshot relative to the manifest so the pair can be moved together.
+	if rel, err := filepath.Rel(filepath.Dir(cfg.ManifestOut), snapshot); err == nil {
+		snapshot = rel
+	}
+
+	manifest := coinai.NewRunManifest(coinai.ManifestData{
+		Market:     normalizeMarket(cfg.Market),
+		DataSource: dataSource,
+		Symbol:     cfg.Symbol,
+		Interval:   cfg.Interval,
+		Limit:      cfg.Limit,
+		StockCSV:   strings.TrimSpace(cfg.StockCSV),
+		Snapshot:   snapshot,
+	}, candles, pipelineCfg, result)
+	return manifest.WriteFile(cfg.ManifestOut)
+}
+
+func printReplay(out replayOutput) {
+	fmt.Printf("Replay of %s\n", out.Manifest)
+	fmt.Printf("Candles from: %s\n", out.CandleSource)
+	fmt.Printf("Code version: recorded %s | current %s\n", out.RecordedCode, out.CurrentCode)
+	fmt.Printf("Data hash match: %t\n", out.DataMatches)
+	fmt.Printf("Lineage hash match: %t\n", out.RecordedLineage == out.ReplayedLineage)
+	fmt.Printf("Feature spec match: %t\n", out.FeatureSpecMatches)
+	fmt.Println("Metrics:")
+	for _, d := range out.Metrics {
+		mark := "="
+		if !d.Equal {
+			mark = "!"
+		}
+		fmt.Printf("  %s %-24s recorded %.12g | replayed %.12g | delta %.3g\n", mark, d.Name, d.Recorded, d.Replayed, d.Replayed-d.Recorded)
+	}
+	fmt.Printf("Signal: recorded %s | replayed %s\n", out.RecordedSignal, out.ReplayedSignal)
+	if out.Reproduced {
+		fmt.Println("Result: reproduced bit-for-bit")
+	} else {
+		fmt.Println("Result: NOT reproduced")
+	}
+}
@@ -0,0 +1,127 @@
+-- name: CreateAlert :one
+INSERT INTO alerts (id, organization_id, user_id, name, symbol, kline_interval, kind, condition, channels, webhook_url, cooldown_seconds, enabled)
+VALUES (
+    sqlc.arg(id)::UUID,
+    sqlc.arg(organization_id)::UUID,
+    sqlc.arg(user_id)::UUID,
+    sqlc.arg(name)::TEXT,
+    sqlc.arg(symbol)::TEXT,
+    sqlc.arg(kline_interval)::TEXT,
+    sqlc.arg(kind)::TEXT,
+    sqlc.arg(condition)::JSONB,
+    sqlc.arg(channels)::TEXT[],
+    sqlc.narg(webhook_url)::TEXT,
+    sqlc.arg(cooldown_seconds)::INT,
+    sqlc.arg(enabled)::BOOLEAN
+)
+RETURNING id, organization_id, user_id, name, symbol, kline_interval, kind, condition, channels, webhook_url, cooldown_seconds, enabled, last_candle_at, last_signal, last_triggered_at, created_at, updated_at;
+
+-- name: GetAlert :one
+SELECT id, organization_id, user_id, name, symbol, kline_interval, kind, condition, channels, webhook_url, cooldown_seconds, enabled, last_candle_at, last_signal, last_triggered_at, created_at, updated_at
+FROM alerts
+WHERE id = sqlc.arg(id)::UUID
+  AND organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID;
+
+-- name: ListAlerts :many
+SELECT id, organization_id, user_id, name, symbol, kline_interval, kind, condition, channels, webhook_url, cooldown_seconds, enabled, last_candle_at, last_signal, last_triggered_at, created_at, updated_at
+FROM alerts
+WHERE organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID
+ORDER BY created_at;
+
+-- name: ListUserAlerts :many
+SELECT id, organization_id, user_id, name, symbol, kline_interval, kind, condition, channels, webhook_url, cooldown_seconds, enabled, last_candle_at, last_signal, last_triggered_at, created_at, updated_at
+FROM alerts
+WHERE user_id = sqlc.arg(user_id)::UUID
+ORDER BY organization_id, created_at;
+
+-- name: CountOwnerAlerts :one
+SELECT COUNT(*)
+FROM alerts
+WHERE organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID;
+
+-- name: ListEnabledAlerts :many
+SELECT id, organization_id, user_id, name, symbol, kline_interval, kind, condition, channels, webhook_url, cooldown_seconds, enabled, last_candle_at, last_signal, last_triggered_at, created_at, updated_at
+FROM alerts
+WHERE enabled
+ORDER BY symbol, kline_interval, organization_id;
+
+-- name: UpdateAlert :execrows
+UPDATE alerts
+SET name = sqlc.arg(name)::TEXT,
+    channels = sqlc.arg(channels)::TEXT[],
+    webhook_url = sqlc.narg(webhook_url)::TEXT,
+    cooldown_seconds = sqlc.arg(cooldown_seconds)::INT,
+    enabled = sqlc.arg(enabled)::BOOLEAN,
+    last_candle_at = sqlc.narg(last_candle_at)::TIMESTAMPTZ,
+    last_signal = sqlc.narg(last_signal)::TEXT
+WHERE id = sqlc.arg(id)::UUID
+  AND organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID;
+
+-- name: SaveAlertState :exec
+UPDATE alerts
+SET last_candle_at = sqlc.narg(last_candle_at)::TIMESTAMPTZ,
+    last_signal = sqlc.narg(last_signal)::TEXT,
+    last_triggered_at = sqlc.narg(last_triggered_at)::TIMESTAMPTZ
+WHERE id = sqlc.arg(id)::UUID;
+
+-- name: DeleteAlert :execrows
+DELETE FROM alerts
+WHERE id = sqlc.arg(id)::UUID
+  AND organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID;
+
+-- name: DeleteUserAlerts :execrows
+DELETE FROM alerts
+WHERE user_id = sqlc.arg(user_id)::UUID;
+
+-- name: DeleteOrganizationAlerts :execrows
+DELETE FROM alerts
+WHERE organization_id = sqlc.arg(organization_id)::UUID;
+
+-- name: CreateAlertEvent :exec
+INSERT INTO alert_events (id, alert_id, organization_id, user_id, kind, symbol, kline_interval, message, price, details, deliveries, candle_close_time, triggered_at)
+VALUES (
+    sqlc.arg(id)::UUID,
+    sqlc.arg(alert_id)::UUID,
+    sqlc.arg(organization_id)::UUID,
+    sqlc.arg(user_id)::UUID,
+    sqlc.arg(kind)::TEXT,
+    sqlc.arg(symbol)::TEXT,
+    sqlc.arg(kline_interval)::TEXT,
+    sqlc.arg(message)::TEXT,
+    sqlc.arg(price)::DOUBLE PRECISION,
+    sqlc.arg(details)::JSONB,
+    sqlc.arg(deliveries)::JSONB,
+    sqlc.arg(candle_close_time)::TIMESTAMPTZ,
+    sqlc.arg(triggered_at)::TIMESTAMPTZ
+);
+
+-- name: ListAlertEvents :many
+SELECT id, alert_id, organization_id, user_id, kind, symbol, kline_interval, message, price, details, deliveries, candle_close_time, triggered_at
+FROM alert_events
+WHERE alert_id = sqlc.arg(alert_id)::UUID
+ORDER BY triggered_at DESC
+LIMIT sqlc.arg(limit_rows)::INT
+OFFSET sqlc.arg(offset_rows)::INT;
+
+-- name: CountAlertEvents :one
+SELECT COUNT(*)
+FROM alert_events
+WHERE alert_id = sqlc.arg(alert_id)::UUID;
+
+-- name: ListUserAlertEvents :many
+SELECT id, alert_id, organization_id, user_id, kind, symbol, kline_interval, message, price, details, deliveries, candle_close_time, triggered_at
+FROM alert_events
+WHERE user_id = sqlc.arg(user_id)::UUID
+ORDER BY triggered_at DESC
+LIMIT sqlc.arg(limit_rows)::INT
+OFFSET sqlc.arg(offset_rows)::INT;
+
+-- name: CountUserAlertEvents :one
+SELECT COUNT(*)
+FROM alert_events
+WHERE user_id = sqlc.arg(user_id)::UUID;
@@ -0,0 +1,91 @@
+-- name: CreateChild :one
+INSERT INTO childrens (full_name, dob, grade)
+VALUES (
+    sqlc.arg(full_name)::TEXT,
+    sqlc.narg(dob)::DATE,
+    sqlc.narg(grade)::INT
+)
+RETURNING id, full_name, dob, grade, created_at, updated_at;
+
+-- name: GetChild :one
+SELECT id, full_name, dob, grade, created_at, updated_at
+FROM childrens
+WHERE id = sqlc.arg(id)::UUID;
+
+-- name: UpdateChild :execrows
+UPDATE childrens
+SET full_name = sqlc.arg(full_name)::TEXT,
+    dob = sqlc.narg(dob)::DATE,
+    grade = sqlc.narg(grade)::INT
+WHERE id = sqlc.arg(id)::UUID;
+
+-- name: DeleteChild :execrows
+DELETE FROM childrens
+WHERE id = sqlc.arg(id)::UUID;
+
+-- name: ListUserChildren :many
+SELECT c.id, c.full_name, c.dob, c.grade, c.created_at, c.updated_at,
+       uc.relation, uc.is_primary, uc.can_edit_plan, uc.can_manage_rewards, uc.can_view_reports
+FROM user_childrens uc
+JOIN childrens c ON c.id = uc.child_id
+WHERE uc.user_id = sqlc.arg(user_id)::UUID
+ORDER BY c.full_name, c.id;
+
+-- name: CreateChildGuardian :execrows
+INSERT INTO user_childrens (user_id, child_id, relation, is_primary, can_edit_plan, can_manage_rewards, can_view_reports)
+VALUES (
+    sqlc.arg(user_id)::UUID,
+    sqlc.arg(child_id)::UUID,
+    sqlc.arg(relation)::TEXT,
+    sqlc.arg(is_primary)::BOOLEAN,
+    sqlc.arg(can_edit_plan)::BOOLEAN,
+    sqlc.arg(can_manage_rewards)::BOOLEAN,
+    sqlc.arg(can_view_reports)::BOOLEAN
+)
+ON CONFLICT (user_id, child_id) DO NOTHING;
+
+-- name: GetChildGuardian :one
+SELECT user_id, child_id, relation, is_primary, can_edit_plan, can_manage_rewards, can_view_reports, created_at
+FROM user_childrens
+WHERE user_id = sqlc.arg(user_id)::UUID
+  AND child_id = sqlc.arg(child_id)::UUID;
+
+-- name: ListChildGuardians :many
+SELECT uc.user_id, u.email, u.full_name, uc.relation, uc.is_primary,
+       uc.can_edit_plan, uc.can_manage_rewards, uc.can_view_reports, uc.created_at
+FROM user_childrens uc
+JOIN "users" u ON u.id = uc.user_id
+WHERE uc.child_id = sqlc.arg(child_id)::UUID
+ORDER BY uc.is_primary DESC, uc.created_at, uc.user_id;
+
+-- name: UpdateChildGuardian :execrows
+UPDATE user_childrens
+SET relation = sqlc.arg(relation)::TEXT,
+    can_edit_plan = sqlc.arg(can_edit_plan)::BOOLEAN,
+    can_manage_rewards = sqlc.arg(can_manage_rewards)::BOOLEAN,
+    can_view_reports = sqlc.arg(can_view_reports)::BOOLEAN
+WHERE user_id = sqlc.arg(user_id)::UUID
+  AND child_id = sqlc.arg(child_id)::UUID;
+
+-- name: DeleteChildGuardian :execrows
+DELETE FROM user_childrens
+WHERE user_id = sqlc.arg(user_id)::UUID
+  AND child_id = sqlc.arg(child_id)::UUID;
+
+-- name: ClearChildPrimaryGuardian :exec
+UPDATE user_childrens
+SET is_primary = FALSE
+WHERE child_id = sqlc.arg(child_id)::UUID
+  AND is_primary;
+
+-- name: SetChildPrimaryGuardian :execrows
+UPDATE user_childrens
+SET is_primary = TRUE
+WHERE user_id = sqlc.arg(user_id)::UUID
+  AND child_id = sqlc.arg(child_id)::UUID;
+
+-- name: GetUserIDByEmail :one
+SELECT id
+FROM "users"
+WHERE email = sqlc.arg(email)::TEXT
+LIMIT 1;
@@ -0,0 +1,82 @@
+-- name: CreateJob :one
+INSERT INTO jobs (id, organization_id, name, job_type, schedule, payload, enabled, max_retries, next_run_at)
+VALUES (
+    sqlc.arg(id)::UUID,
+    sqlc.arg(organization_id)::UUID,
+    sqlc.arg(name)::TEXT,
+    sqlc.arg(job_type)::TEXT,
+    sqlc.arg(schedule)::TEXT,
+    sqlc.arg(payload)::JSONB,
+    sqlc.arg(enabled)::BOOLEAN,
+    sqlc.arg(max_retries)::INT,
+    sqlc.narg(next_run_at)::TIMESTAMPTZ
+)
+RETURNING id;
+
+-- name: GetJobByID :one
+SELECT id, organization_id, name, job_type, schedule, payload, enabled, max_retries, next_run_at, last_run_at, created_at, updated_at
+FROM jobs
+WHERE id = sqlc.arg(id)::UUID
+  AND organization_id = sqlc.arg(organization_id)::UUID;
+
+-- name: ListJobs :many
+SELECT id, organization_id, name, job_type, schedule, payload, enabled, max_retries, next_run_at, last_run_at, created_at, updated_at
+FROM jobs
+WHERE organization_id = sqlc.arg(organization_id)::UUID
+ORDER BY name;
+
+-- name: ListDueJobs :many
+SELECT id, organization_id, name, job_type, schedule, payload, enabled, max_retries, next_run_at, last_run_at, created_at, updated_at
+FROM jobs
+WHERE enabled
+  AND next_run_at IS NOT NULL
+  AND next_run_at <= sqlc.arg(now)::TIMESTAMPTZ
+ORDER BY next_run_at;
+
+-- name: SetJobEnabled :execrows
+UPDATE jobs
+SET enabled = sqlc.arg(enabled)::BOOLEAN
+WHERE id = sqlc.arg(id)::UUID
+  AND organization_id = sqlc.arg(organization_id)::UUID;
+
+-- name: ClaimJobNextRun :execrows
+UPDATE jobs
+SET next_run_at = sqlc.arg(next_run_at)::TIMESTAMPTZ,
+    last_run_at = NOW()
+WHERE id = sqlc.arg(id)::UUID
+  AND next_run_at = sqlc.arg(expected)::TIMESTAMPTZ;
+
+-- name: CreateJobRun :one
+INSERT INTO job_runs (job_id, attempt, status, instance)
+VALUES (
+    sqlc.arg(job_id)::UUID,
+    sqlc.arg(attempt)::INT,
+    'running',
+    sqlc.arg(instance)::TEXT
+)
+RETURNING id;
+
+-- name: FinishJobRun :exec
+UPDATE job_runs
+SET status = sqlc.arg(status)::TEXT,
+    error = sqlc.narg(error)::TEXT,
+    output = sqlc.narg(output)::JSONB,
+    finished_at = NOW()
+WHERE id = sqlc.arg(id)::UUID;
+
+-- name: ListJobRuns :many
+SELECT id, job_id, attempt, status, instance, error, output, started_at, finished_at
+FROM job_runs
+WHERE job_id = sqlc.arg(job_id)::UUID
+ORDER BY started_at DESC
+LIMIT sqlc.arg(limit_rows)::INT
+OFFSET sqlc.arg(offset_rows)::INT;
+
+-- name: CountJobRuns :one
+SELECT COUNT(*)
+FROM job_runs
+WHERE job_id = sqlc.arg(job_id)::UUID;
+
+-- name: DeleteOrganizationJobs :execrows
+DELETE FROM jobs
+WHERE organization_id = sqlc.arg(organization_id)::UUID;
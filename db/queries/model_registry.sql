@@ -0,0 +1,88 @@
+-- name: CreateModelVersion :one
+INSERT INTO model_versions (id, organization_id, symbol, kline_interval, version, stage, lineage_hash, parent_id, metrics, artifact)
+VALUES (
+    sqlc.arg(id)::UUID,
+    sqlc.arg(organization_id)::UUID,
+    sqlc.arg(symbol)::TEXT,
+    sqlc.arg(kline_interval)::TEXT,
+    (
+        SELECT COALESCE(MAX(mv.version), 0) + 1
+        FROM model_versions mv
+        WHERE mv.organization_id = sqlc.arg(organization_id)::UUID
+          AND mv.symbol = sqlc.arg(symbol)::TEXT
+          AND mv.kline_interval = sqlc.arg(kline_interval)::TEXT
+    ),
+    sqlc.arg(stage)::TEXT,
+    sqlc.arg(lineage_hash)::TEXT,
+    sqlc.narg(parent_id)::UUID,
+    sqlc.arg(metrics)::JSONB,
+    sqlc.arg(artifact)::JSONB
+)
+RETURNING id, organization_id, symbol, kline_interval, version, stage, lineage_hash, parent_id, metrics, artifact, created_at, stage_changed_at, production_at, rolled_back_at;
+
+-- name: GetModelVersionByID :one
+SELECT id, organization_id, symbol, kline_interval, version, stage, lineage_hash, parent_id, metrics, artifact, created_at, stage_changed_at, production_at, rolled_back_at
+FROM model_versions
+WHERE id = sqlc.arg(id)::UUID
+  AND organization_id = sqlc.arg(organization_id)::UUID;
+
+-- name: GetProductionModelVersion :one
+SELECT id, organization_id, symbol, kline_interval, version, stage, lineage_hash, parent_id, metrics, artifact, created_at, stage_changed_at, production_at, rolled_back_at
+FROM model_versions
+WHERE organization_id = sqlc.arg(organization_id)::UUID
+  AND symbol = sqlc.arg(symbol)::TEXT
+  AND kline_interval = sqlc.arg(kline_interval)::TEXT
+  AND stage = 'production';
+
+-- name: GetRollbackModelVersion :one
+SELECT id, organization_id, symbol, kline_interval, version, stage, lineage_hash, parent_id, metrics, artifact, created_at, stage_changed_at, production_at, rolled_back_at
+FROM model_versions
+WHERE organization_id = sqlc.arg(organization_id)::UUID
+  AND symbol = sqlc.arg(symbol)::TEXT
+  AND kline_interval = sqlc.arg(kline_interval)::TEXT
+  AND stage = 'archived'
+  AND production_at IS NOT NULL
+  AND rolled_back_at IS NULL
+ORDER BY production_at DESC
+LIMIT 1;
+
+-- name: ListModelVersions :many
+SELECT id, organization_id, symbol, kline_interval, version, stage, lineage_hash, parent_id, metrics, artifact, created_at, stage_changed_at, production_at, rolled_back_at
+FROM model_versions
+WHERE organization_id = sqlc.arg(organization_id)::UUID
+  AND (sqlc.narg(symbol)::TEXT IS NULL OR symbol = sqlc.narg(symbol)::TEXT)
+  AND (sqlc.narg(kline_interval)::TEXT IS NULL OR kline_interval = sqlc.narg(kline_interval)::TEXT)
+  AND (sqlc.narg(stage)::TEXT IS NULL OR stage = sqlc.narg(stage)::TEXT)
+ORDER BY symbol, kline_interval, version DESC
+LIMIT sqlc.arg(limit_rows)::INT
+OFFSET sqlc.arg(offset_rows)::INT;
+
+-- name: CountModelVersions :one
+SELECT COUNT(*)
+FROM model_versions
+WHERE organization_id = sqlc.arg(organization_id)::UUID
+  AND (sqlc.narg(symbol)::TEXT IS NULL OR symbol = sqlc.narg(symbol)::TEXT)
+  AND (sqlc.narg(kline_interval)::TEXT IS NULL OR kline_interval = sqlc.narg(kline_interval)::TEXT)
+  AND (sqlc.narg(stage)::TEXT IS NULL OR stage = sqlc.narg(stage)::TEXT);
+
+-- name: SetModelVersionStage :execrows
+UPDATE model_versions
+SET stage = sqlc.arg(stage)::TEXT,
+    stage_changed_at = NOW(),
+    production_at = CASE WHEN sqlc.arg(stage)::TEXT = 'production' THEN NOW() ELSE production_at END,
+    rolled_back_at = CASE WHEN sqlc.arg(stage)::TEXT = 'production' THEN NULL ELSE rolled_back_at END
+WHERE id = sqlc.arg(id)::UUID;
+
+-- name: ArchiveProductionModelVersion :execrows
+UPDATE model_versions
+SET stage = 'archived',
+    stage_changed_at = NOW(),
+    rolled_back_at = CASE WHEN sqlc.arg(rollback)::BOOLEAN THEN NOW() ELSE rolled_back_at END
+WHERE organization_id = sqlc.arg(organization_id)::UUID
+  AND symbol = sqlc.arg(symbol)::TEXT
+  AND kline_interval = sqlc.arg(kline_interval)::TEXT
+  AND stage = 'production';
+
+-- name: DeleteOrganizationModelVersions :execrows
+DELETE FROM model_versions
+WHERE organization_id = sqlc.arg(organization_id)::UUID;
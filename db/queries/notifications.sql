@@ -0,0 +1,58 @@
+-- name: CreateNotification :one
+INSERT INTO notifications (id, user_id, organization_id, kind, title, body, data)
+VALUES (
+    sqlc.arg(id)::UUID,
+    sqlc.arg(user_id)::UUID,
+    sqlc.narg(organization_id)::UUID,
+    sqlc.arg(kind)::TEXT,
+    sqlc.arg(title)::TEXT,
+    sqlc.arg(body)::TEXT,
+    sqlc.arg(data)::JSONB
+)
+RETURNING id, user_id, organization_id, kind, title, body, data, read_at, created_at;
+
+-- name: ListNotifications :many
+SELECT id, user_id, organization_id, kind, title, body, data, read_at, created_at
+FROM notifications
+WHERE user_id = sqlc.arg(user_id)::UUID
+  AND (NOT sqlc.arg(unread_only)::BOOLEAN OR read_at IS NULL)
+ORDER BY created_at DESC
+LIMIT sqlc.arg(limit_rows)::INT
+OFFSET sqlc.arg(offset_rows)::INT;
+
+-- name: CountNotifications :one
+SELECT COUNT(*)
+FROM notifications
+WHERE user_id = sqlc.arg(user_id)::UUID
+  AND (NOT sqlc.arg(unread_only)::BOOLEAN OR read_at IS NULL);
+
+-- name: CountUnreadNotifications :one
+SELECT COUNT(*)
+FROM notifications
+WHERE user_id = sqlc.arg(user_id)::UUID
+  AND read_at IS NULL;
+
+-- name: MarkNotificationRead :execrows
+UPDATE notifications
+SET read_at = COALESCE(read_at, NOW())
+WHERE id = sqlc.arg(id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID;
+
+-- name: MarkAllNotificationsRead :execrows
+UPDATE notifications
+SET read_at = NOW()
+WHERE user_id = sqlc.arg(user_id)::UUID
+  AND read_at IS NULL;
+
+-- name: DeleteUserNotifications :execrows
+DELETE FROM notifications
+WHERE user_id = sqlc.arg(user_id)::UUID;
+
+-- name: DeleteOrganizationNotifications :execrows
+DELETE FROM notifications
+WHERE organization_id = sqlc.arg(organization_id)::UUID;
+
+-- name: GetUserEmail :one
+SELECT email
+FROM "users"
+WHERE id = sqlc.arg(id)::UUID;
@@ -0,0 +1,110 @@
+-- name: CreatePortfolio :one
+INSERT INTO portfolios (id, organization_id, user_id, name, currency)
+VALUES (
+    sqlc.arg(id)::UUID,
+    sqlc.arg(organization_id)::UUID,
+    sqlc.arg(user_id)::UUID,
+    sqlc.arg(name)::TEXT,
+    sqlc.arg(currency)::TEXT
+)
+RETURNING id, organization_id, user_id, name, currency, created_at, updated_at;
+
+-- name: GetPortfolio :one
+SELECT id, organization_id, user_id, name, currency, created_at, updated_at
+FROM portfolios
+WHERE id = sqlc.arg(id)::UUID
+  AND organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID;
+
+-- name: LockPortfolio :execrows
+SELECT id
+FROM portfolios
+WHERE id = sqlc.arg(id)::UUID
+FOR UPDATE;
+
+-- name: ListPortfolios :many
+SELECT id, organization_id, user_id, name, currency, created_at, updated_at
+FROM portfolios
+WHERE organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID
+ORDER BY created_at;
+
+-- name: ListUserPortfolios :many
+SELECT id, organization_id, user_id, name, currency, created_at, updated_at
+FROM portfolios
+WHERE user_id = sqlc.arg(user_id)::UUID
+ORDER BY organization_id, created_at;
+
+-- name: CountOwnerPortfolios :one
+SELECT COUNT(*)
+FROM portfolios
+WHERE organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID;
+
+-- name: RenamePortfolio :execrows
+UPDATE portfolios
+SET name = sqlc.arg(name)::TEXT
+WHERE id = sqlc.arg(id)::UUID
+  AND organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID;
+
+-- name: DeletePortfolio :execrows
+DELETE FROM portfolios
+WHERE id = sqlc.arg(id)::UUID
+  AND organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID;
+
+-- name: DeleteUserPortfolios :execrows
+DELETE FROM portfolios
+WHERE user_id = sqlc.arg(user_id)::UUID;
+
+-- name: DeleteOrganizationPortfolios :execrows
+DELETE FROM portfolios
+WHERE organization_id = sqlc.arg(organization_id)::UUID;
+
+-- name: CreatePortfolioTrade :one
+INSERT INTO portfolio_trades (
+    id, portfolio_id, symbol, market, side, quantity, price, fee,
+    executed_at, source, signal, kline_interval, model_version, note
+)
+VALUES (
+    sqlc.arg(id)::UUID,
+    sqlc.arg(portfolio_id)::UUID,
+    sqlc.arg(symbol)::TEXT,
+    sqlc.arg(market)::TEXT,
+    sqlc.arg(side)::TEXT,
+    sqlc.arg(quantity)::NUMERIC,
+    sqlc.arg(price)::NUMERIC,
+    sqlc.arg(fee)::NUMERIC,
+    sqlc.arg(executed_at)::TIMESTAMPTZ,
+    sqlc.arg(source)::TEXT,
+    sqlc.arg(signal)::TEXT,
+    sqlc.arg(kline_interval)::TEXT,
+    sqlc.arg(model_version)::INT,
+    sqlc.arg(note)::TEXT
+)
+RETURNING id, portfolio_id, symbol, market, side, quantity, price, fee, executed_at, source, signal, kline_interval, model_version, note, created_at;
+
+-- name: ListPortfolioTrades :many
+SELECT id, portfolio_id, symbol, market, side, quantity, price, fee, executed_at, source, signal, kline_interval, model_version, note, created_at
+FROM portfolio_trades
+WHERE portfolio_id = sqlc.arg(portfolio_id)::UUID
+ORDER BY executed_at, created_at;
+
+-- name: ListPortfolioTradesPage :many
+SELECT id, portfolio_id, symbol, market, side, quantity, price, fee, executed_at, source, signal, kline_interval, model_version, note, created_at
+FROM portfolio_trades
+WHERE portfolio_id = sqlc.arg(portfolio_id)::UUID
+ORDER BY executed_at DESC, created_at DESC
+LIMIT sqlc.arg(limit_rows)::INT
+OFFSET sqlc.arg(offset_rows)::INT;
+
+-- name: CountPortfolioTrades :one
+SELECT COUNT(*)
+FROM portfolio_trades
+WHERE portfolio_id = sqlc.arg(portfolio_id)::UUID;
+
+-- name: DeletePortfolioTrade :execrows
+DELETE FROM portfolio_trades
+WHERE id = sqlc.arg(id)::UUID
+  AND portfolio_id = sqlc.arg(portfolio_id)::UUID;
@@ -1,12 +1,12 @@
 -- name: GetUserByID :one
-SELECT u.id, u.email, u.full_name, r.role_name, u.is_active, u.created_at, u.updated_at, u.image_url
+SELECT u.id, u.email, u.full_name, r.role_name, u.is_active, u.created_at, u.updated_at, u.image_url, u.email_verified_at, u.password_reset_required, u.totp_enabled_at, u.phone, u.phone_verified_at
 FROM "users" u
 LEFT JOIN "roles" r ON r.id = u.role_id
 WHERE u.id = sqlc.arg(user_id)::UUID
 LIMIT 1;
 
 -- name: GetUserByEmail :one
-SELECT u.id, u.email, u.full_name, r.role_name, u.password_hash, u.is_active, u.created_at, u.updated_at, u.image_url
+SELECT u.id, u.email, u.full_name, r.role_name, u.password_hash, u.is_active, u.created_at, u.updated_at, u.image_url, u.password_reset_required, u.email_verified_at, u.totp_enabled_at, u.phone, u.phone_verified_at
 FROM "users" u
 LEFT JOIN "roles" r ON r.id = u.role_id
 WHERE u.email = sqlc.arg(email)::TEXT
@@ -25,6 +25,7 @@ RETURNING id;
 -- name: UpdateUser :exec
 UPDATE "users"
 SET full_name = sqlc.arg(full_name)::TEXT,
+    email_verified_at = CASE WHEN lower(email) = lower(sqlc.arg(email)::TEXT) THEN email_verified_at END,
     email = sqlc.arg(email)::TEXT,
     password_hash = sqlc.arg(password_hash)::TEXT,
     image_url = sqlc.arg(image_url)::TEXT,
@@ -49,3 +50,542 @@ WHERE id = sqlc.arg(user_id)::UUID;
 UPDATE "users"
 SET password_hash = sqlc.arg(password_hash)::TEXT
 WHERE id = sqlc.arg(user_id)::UUID;
+
+-- name: ListUserPermissions :many
+SELECT p.name
+FROM "users" u
+JOIN "role_permissions" rp ON rp.role_id = u.role_id
+JOIN "permissions" p ON p.id = rp.permission_id
+WHERE u.id = sqlc.arg(user_id)::UUID
+  AND u.is_active
+ORDER BY p.name;
+
+-- name: ListRoles :many
+SELECT id, role_name, created_at, updated_at
+FROM "roles"
+ORDER BY id;
+
+-- name: GetRoleByID :one
+SELECT id, role_name, created_at, updated_at
+FROM "roles"
+WHERE id = sqlc.arg(id)::INT
+LIMIT 1;
+
+-- name: CreateRole :one
+INSERT INTO "roles" (role_name)
+VALUES (sqlc.arg(role_name)::TEXT)
+RETURNING id, role_name, created_at, updated_at;
+
+-- name: DeleteRole :execrows
+DELETE FROM "roles"
+WHERE id = sqlc.arg(id)::INT;
+
+-- name: CountUsersByRole :one
+SELECT COUNT(*)
+FROM "users"
+WHERE role_id = sqlc.arg(role_id)::INT;
+
+-- name: ListRolePermissions :many
+SELECT rp.role_id, p.name
+FROM "role_permissions" rp
+JOIN "permissions" p ON p.id = rp.permission_id
+ORDER BY rp.role_id, p.name;
+
+-- name: ListPermissionsByRole :many
+SELECT p.name
+FROM "role_permissions" rp
+JOIN "permissions" p ON p.id = rp.permission_id
+WHERE rp.role_id = sqlc.arg(role_id)::INT
+ORDER BY p.name;
+
+-- name: ListPermissionsByRoleName :many
+SELECT p.name
+FROM "roles" r
+JOIN "role_permissions" rp ON rp.role_id = r.id
+JOIN "permissions" p ON p.id = rp.permission_id
+WHERE r.role_name = sqlc.arg(role_name)::TEXT
+ORDER BY p.name;
+
+-- name: DeleteRolePermissions :exec
+DELETE FROM "role_permissions"
+WHERE role_id = sqlc.arg(role_id)::INT;
+
+-- name: AddRolePermissions :execrows
+INSERT INTO "role_permissions" (role_id, permission_id)
+SELECT sqlc.arg(role_id)::INT, p.id
+FROM "permissions" p
+WHERE p.name = ANY(sqlc.arg(names)::TEXT[])
+ON CONFLICT DO NOTHING;
+
+-- name: TouchRole :exec
+UPDATE "roles"
+SET updated_at = NOW()
+WHERE id = sqlc.arg(id)::INT;
+
+-- name: ListPermissions :many
+SELECT id, name, description, created_at
+FROM "permissions"
+ORDER BY name;
+
+-- name: CreatePermission :one
+INSERT INTO "permissions" (name, description)
+VALUES (sqlc.arg(name)::TEXT, sqlc.arg(description)::TEXT)
+RETURNING id, name, description, created_at;
+
+-- name: ListUsers :many
+SELECT u.id, u.email, u.full_name, r.role_name, u.is_active, u.password_reset_required, u.image_url, u.created_at, u.updated_at
+FROM "users" u
+LEFT JOIN "roles" r ON r.id = u.role_id
+WHERE (sqlc.narg(search)::TEXT IS NULL
+       OR u.email ILIKE '%' || sqlc.narg(search)::TEXT || '%'
+       OR u.full_name ILIKE '%' || sqlc.narg(search)::TEXT || '%')
+  AND (sqlc.narg(role_name)::TEXT IS NULL OR r.role_name = sqlc.narg(role_name)::TEXT)
+  AND (sqlc.narg(is_active)::BOOLEAN IS NULL OR u.is_active = sqlc.narg(is_active)::BOOLEAN)
+ORDER BY u.created_at DESC, u.id
+LIMIT sqlc.arg(limit_rows)::INT
+OFFSET sqlc.arg(offset_rows)::INT;
+
+-- name: CountUsers :one
+SELECT COUNT(*)
+FROM "users" u
+LEFT JOIN "roles" r ON r.id = u.role_id
+WHERE (sqlc.narg(search)::TEXT IS NULL
+       OR u.email ILIKE '%' || sqlc.narg(search)::TEXT || '%'
+       OR u.full_name ILIKE '%' || sqlc.narg(search)::TEXT || '%')
+  AND (sqlc.narg(role_name)::TEXT IS NULL OR r.role_name = sqlc.narg(role_name)::TEXT)
+  AND (sqlc.narg(is_active)::BOOLEAN IS NULL OR u.is_active = sqlc.narg(is_active)::BOOLEAN);
+
+-- name: GetUserAccount :one
+SELECT u.id, u.email, u.full_name, r.role_name, u.is_active, u.password_reset_required, u.image_url, u.created_at, u.updated_at
+FROM "users" u
+LEFT JOIN "roles" r ON r.id = u.role_id
+WHERE u.id = sqlc.arg(user_id)::UUID
+LIMIT 1;
+
+-- name: SetUserRole :execrows
+UPDATE "users" u
+SET role_id = r.id
+FROM "roles" r
+WHERE r.role_name = sqlc.arg(role_name)::TEXT
+  AND u.id = sqlc.arg(user_id)::UUID;
+
+-- name: SetUserActive :execrows
+UPDATE "users"
+SET is_active = sqlc.arg(is_active)::BOOLEAN
+WHERE id = sqlc.arg(user_id)::UUID;
+
+-- name: SetPasswordResetRequired :execrows
+UPDATE "users"
+SET password_reset_required = sqlc.arg(required)::BOOLEAN
+WHERE id = sqlc.arg(user_id)::UUID;
+
+-- name: CreateUserToken :exec
+INSERT INTO "user_tokens" (user_id, purpose, token_hash, expires_at)
+VALUES (
+    sqlc.arg(user_id)::UUID,
+    sqlc.arg(purpose)::TEXT,
+    sqlc.arg(token_hash)::TEXT,
+    sqlc.arg(expires_at)::TIMESTAMPTZ
+);
+
+-- name: RevokeUserTokens :exec
+UPDATE "user_tokens"
+SET used_at = NOW()
+WHERE user_id = sqlc.arg(user_id)::UUID
+  AND purpose = sqlc.arg(purpose)::TEXT
+  AND used_at IS NULL;
+
+-- name: ConsumeUserToken :one
+UPDATE "user_tokens"
+SET used_at = NOW()
+WHERE token_hash = sqlc.arg(token_hash)::TEXT
+  AND purpose = sqlc.arg(purpose)::TEXT
+  AND used_at IS NULL
+  AND expires_at > NOW()
+RETURNING user_id;
+
+-- name: ResetPasswordByID :exec
+UPDATE "users"
+SET password_hash = sqlc.arg(password_hash)::TEXT,
+    password_reset_required = FALSE
+WHERE id = sqlc.arg(user_id)::UUID;
+
+-- name: MarkEmailVerified :exec
+UPDATE "users"
+SET email_verified_at = COALESCE(email_verified_at, NOW())
+WHERE id = sqlc.arg(user_id)::UUID;
+
+-- name: GetUserTOTP :one
+SELECT totp_secret, totp_enabled_at
+FROM "users"
+WHERE id = sqlc.arg(user_id)::UUID;
+
+-- name: SetUserTOTPSecret :execrows
+UPDATE "users"
+SET totp_secret = sqlc.arg(totp_secret)::TEXT
+WHERE id = sqlc.arg(user_id)::UUID
+  AND totp_enabled_at IS NULL;
+
+-- name: EnableUserTOTP :execrows
+UPDATE "users"
+SET totp_enabled_at = NOW()
+WHERE id = sqlc.arg(user_id)::UUID
+  AND totp_secret IS NOT NULL
+  AND totp_enabled_at IS NULL;
+
+-- name: DisableUserTOTP :exec
+UPDATE "users"
+SET totp_secret = NULL,
+    totp_enabled_at = NULL
+WHERE id = sqlc.arg(user_id)::UUID;
+
+-- name: CreateRecoveryCodes :exec
+INSERT INTO "user_recovery_codes" (user_id, code_hash)
+SELECT sqlc.arg(user_id)::UUID, unnest(sqlc.arg(code_hashes)::TEXT[]);
+
+-- name: DeleteRecoveryCodes :exec
+DELETE FROM "user_recovery_codes"
+WHERE user_id = sqlc.arg(user_id)::UUID;
+
+-- name: ConsumeRecoveryCode :execrows
+UPDATE "user_recovery_codes"
+SET used_at = NOW()
+WHERE user_id = sqlc.arg(user_id)::UUID
+  AND code_hash = sqlc.arg(code_hash)::TEXT
+  AND used_at IS NULL;
+
+-- name: CountRecoveryCodes :one
+SELECT COUNT(*)
+FROM "user_recovery_codes"
+WHERE user_id = sqlc.arg(user_id)::UUID
+  AND used_at IS NULL;
+
+-- name: CreateAPIKey :one
+INSERT INTO "api_keys" (user_id, name, prefix, secret_hash, scopes, allowed_ips, expires_at)
+VALUES (
+    sqlc.arg(user_id)::UUID,
+    sqlc.arg(name)::TEXT,
+    sqlc.arg(prefix)::TEXT,
+    sqlc.arg(secret_hash)::TEXT,
+    sqlc.arg(scopes)::TEXT[],
+    sqlc.arg(allowed_ips)::TEXT[],
+    sqlc.narg(expires_at)::TIMESTAMPTZ
+)
+RETURNING id, user_id, name, prefix, secret_hash, scopes, allowed_ips, expires_at, last_used_at, last_used_ip, revoked_at, created_at;
+
+-- name: ListAPIKeysByUser :many
+SELECT id, user_id, name, prefix, secret_hash, scopes, allowed_ips, expires_at, last_used_at, last_used_ip, revoked_at, created_at
+FROM "api_keys"
+WHERE user_id = sqlc.arg(user_id)::UUID
+  AND revoked_at IS NULL
+ORDER BY created_at DESC;
+
+-- name: CountActiveAPIKeys :one
+SELECT COUNT(*)
+FROM "api_keys"
+WHERE user_id = sqlc.arg(user_id)::UUID
+  AND revoked_at IS NULL
+  AND (expires_at IS NULL OR expires_at > NOW());
+
+-- name: GetAPIKeyByPrefix :one
+SELECT k.id, k.user_id, k.name, k.prefix, k.secret_hash, k.scopes, k.allowed_ips, k.expires_at, k.last_used_at, k.last_used_ip, k.revoked_at, k.created_at,
+       u.is_active, r.role_name
+FROM "api_keys" k
+JOIN "users" u ON u.id = k.user_id
+LEFT JOIN "roles" r ON r.id = u.role_id
+WHERE k.prefix = sqlc.arg(prefix)::TEXT
+LIMIT 1;
+
+-- name: RevokeAPIKey :execrows
+UPDATE "api_keys"
+SET revoked_at = NOW()
+WHERE id = sqlc.arg(id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID
+  AND revoked_at IS NULL;
+
+-- name: TouchAPIKey :exec
+UPDATE "api_keys"
+SET last_used_at = NOW(),
+    last_used_ip = sqlc.arg(ip)::TEXT
+WHERE id = sqlc.arg(id)::UUID
+  AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute');
+
+-- name: GetUserIdentity :one
+SELECT id, user_id, provider, subject, email, created_at, last_login_at
+FROM "user_identities"
+WHERE provider = sqlc.arg(provider)::TEXT
+  AND subject = sqlc.arg(subject)::TEXT
+LIMIT 1;
+
+-- name: CreateUserIdentity :one
+INSERT INTO "user_identities" (user_id, provider, subject, email)
+VALUES (
+    sqlc.arg(user_id)::UUID,
+    sqlc.arg(provider)::TEXT,
+    sqlc.arg(subject)::TEXT,
+    sqlc.narg(email)::TEXT
+)
+RETURNING id, user_id, provider, subject, email, created_at, last_login_at;
+
+-- name: ListUserIdentities :many
+SELECT id, user_id, provider, subject, email, created_at, last_login_at
+FROM "user_identities"
+WHERE user_id = sqlc.arg(user_id)::UUID
+ORDER BY created_at;
+
+-- name: CountUserIdentities :one
+SELECT COUNT(*)
+FROM "user_identities"
+WHERE user_id = sqlc.arg(user_id)::UUID;
+
+-- name: DeleteUserIdentity :execrows
+DELETE FROM "user_identities"
+WHERE id = sqlc.arg(id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID;
+
+-- name: TouchUserIdentity :exec
+UPDATE "user_identities"
+SET last_login_at = NOW(),
+    email = sqlc.narg(email)::TEXT
+WHERE id = sqlc.arg(id)::UUID;
+
+-- name: CreateAuditEvent :exec
+INSERT INTO "audit_events" (actor_id, action, target_type, target_id, ip, user_agent, request_id, metadata)
+VALUES (
+    sqlc.narg(actor_id)::UUID,
+    sqlc.arg(action)::TEXT,
+    sqlc.arg(target_type)::TEXT,
+    sqlc.arg(target_id)::TEXT,
+    sqlc.arg(ip)::TEXT,
+    sqlc.arg(user_agent)::TEXT,
+    sqlc.arg(request_id)::TEXT,
+    sqlc.arg(metadata)::JSONB
+);
+
+-- name: ListAuditEvents :many
+SELECT id, actor_id, action, target_type, target_id, ip, user_agent, request_id, metadata, created_at
+FROM "audit_events"
+WHERE (sqlc.narg(actor_id)::UUID IS NULL OR actor_id = sqlc.narg(actor_id)::UUID)
+  AND (sqlc.narg(action)::TEXT IS NULL OR action = sqlc.narg(action)::TEXT)
+  AND (sqlc.narg(target_type)::TEXT IS NULL OR target_type = sqlc.narg(target_type)::TEXT)
+  AND (sqlc.narg(target_id)::TEXT IS NULL OR target_id = sqlc.narg(target_id)::TEXT)
+  AND (sqlc.narg(ip)::TEXT IS NULL OR ip = sqlc.narg(ip)::TEXT)
+  AND (sqlc.narg(since)::TIMESTAMPTZ IS NULL OR created_at >= sqlc.narg(since)::TIMESTAMPTZ)
+  AND (sqlc.narg(until)::TIMESTAMPTZ IS NULL OR created_at < sqlc.narg(until)::TIMESTAMPTZ)
+ORDER BY created_at DESC, id
+LIMIT sqlc.arg(limit_rows)::INT
+OFFSET sqlc.arg(offset_rows)::INT;
+
+-- name: CountAuditEvents :one
+SELECT COUNT(*)
+FROM "audit_events"
+WHERE (sqlc.narg(actor_id)::UUID IS NULL OR actor_id = sqlc.narg(actor_id)::UUID)
+  AND (sqlc.narg(action)::TEXT IS NULL OR action = sqlc.narg(action)::TEXT)
+  AND (sqlc.narg(target_type)::TEXT IS NULL OR target_type = sqlc.narg(target_type)::TEXT)
+  AND (sqlc.narg(target_id)::TEXT IS NULL OR target_id = sqlc.narg(target_id)::TEXT)
+  AND (sqlc.narg(ip)::TEXT IS NULL OR ip = sqlc.narg(ip)::TEXT)
+  AND (sqlc.narg(since)::TIMESTAMPTZ IS NULL OR created_at >= sqlc.narg(since)::TIMESTAMPTZ)
+  AND (sqlc.narg(until)::TIMESTAMPTZ IS NULL OR created_at < sqlc.narg(until)::TIMESTAMPTZ);
+
+-- name: CreateOrganization :one
+INSERT INTO "organizations" (name, created_by)
+VALUES (
+    sqlc.arg(name)::TEXT,
+    sqlc.narg(created_by)::UUID
+)
+RETURNING id, name, created_by, created_at, updated_at;
+
+-- name: GetOrganization :one
+SELECT id, name, created_by, created_at, updated_at
+FROM "organizations"
+WHERE id = sqlc.arg(id)::UUID;
+
+-- name: RenameOrganization :execrows
+UPDATE "organizations"
+SET name = sqlc.arg(name)::TEXT
+WHERE id = sqlc.arg(id)::UUID;
+
+-- name: AddOrganizationMember :execrows
+INSERT INTO "organization_members" (organization_id, user_id, role)
+VALUES (
+    sqlc.arg(organization_id)::UUID,
+    sqlc.arg(user_id)::UUID,
+    sqlc.arg(role)::TEXT
+)
+ON CONFLICT (organization_id, user_id) DO NOTHING;
+
+-- name: GetOrganizationMemberRole :one
+SELECT role
+FROM "organization_members"
+WHERE organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID;
+
+-- name: ListUserOrganizations :many
+SELECT o.id, o.name, m.role, m.created_at
+FROM "organization_members" m
+JOIN "organizations" o ON o.id = m.organization_id
+WHERE m.user_id = sqlc.arg(user_id)::UUID
+ORDER BY m.created_at, o.id;
+
+-- name: ListOrganizationMembers :many
+SELECT m.user_id, u.email, u.full_name, m.role, m.created_at
+FROM "organization_members" m
+JOIN "users" u ON u.id = m.user_id
+WHERE m.organization_id = sqlc.arg(organization_id)::UUID
+ORDER BY m.created_at, m.user_id;
+
+-- name: UpdateOrganizationMemberRole :execrows
+UPDATE "organization_members"
+SET role = sqlc.arg(role)::TEXT
+WHERE organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID;
+
+-- name: DeleteOrganizationMember :execrows
+DELETE FROM "organization_members"
+WHERE organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID;
+
+-- name: CountOrganizationOwners :one
+SELECT COUNT(*)
+FROM "organization_members"
+WHERE organization_id = sqlc.arg(organization_id)::UUID
+  AND role = 'owner';
+
+-- name: DeletePendingOrganizationInvitations :exec
+DELETE FROM "organization_invitations"
+WHERE organization_id = sqlc.arg(organization_id)::UUID
+  AND email = sqlc.arg(email)::CITEXT
+  AND accepted_at IS NULL;
+
+-- name: CreateOrganizationInvitation :one
+INSERT INTO "organization_invitations" (organization_id, email, role, token_hash, invited_by, expires_at)
+VALUES (
+    sqlc.arg(organization_id)::UUID,
+    sqlc.arg(email)::CITEXT,
+    sqlc.arg(role)::TEXT,
+    sqlc.arg(token_hash)::TEXT,
+    sqlc.narg(invited_by)::UUID,
+    sqlc.arg(expires_at)::TIMESTAMPTZ
+)
+RETURNING id, organization_id, email, role, token_hash, invited_by, expires_at, accepted_at, created_at;
+
+-- name: ListOrganizationInvitations :many
+SELECT id, organization_id, email, role, token_hash, invited_by, expires_at, accepted_at, created_at
+FROM "organization_invitations"
+WHERE organization_id = sqlc.arg(organization_id)::UUID
+  AND accepted_at IS NULL
+  AND expires_at > NOW()
+ORDER BY created_at DESC;
+
+-- name: DeleteOrganizationInvitation :execrows
+DELETE FROM "organization_invitations"
+WHERE id = sqlc.arg(id)::UUID
+  AND organization_id = sqlc.arg(organization_id)::UUID
+  AND accepted_at IS NULL;
+
+-- name: AcceptOrganizationInvitation :one
+UPDATE "organization_invitations"
+SET accepted_at = NOW()
+WHERE token_hash = sqlc.arg(token_hash)::TEXT
+  AND accepted_at IS NULL
+  AND expires_at > NOW()
+RETURNING id, organization_id, email, role, token_hash, invited_by, expires_at, accepted_at, created_at;
+
+-- name: ScheduleUserDeletion :execrows
+UPDATE "users"
+SET deletion_scheduled_at = sqlc.arg(deletion_scheduled_at)::TIMESTAMPTZ
+WHERE id = sqlc.arg(user_id)::UUID;
+
+-- name: CancelUserDeletion :execrows
+UPDATE "users"
+SET deletion_scheduled_at = NULL
+WHERE id = sqlc.arg(user_id)::UUID
+  AND deletion_scheduled_at IS NOT NULL;
+
+-- name: ListUsersDueForDeletion :many
+SELECT id
+FROM "users"
+WHERE deletion_scheduled_at <= sqlc.arg(now)::TIMESTAMPTZ
+ORDER BY deletion_scheduled_at
+LIMIT sqlc.arg(limit_count)::INT;
+
+-- name: LockScheduledUser :one
+SELECT email
+FROM "users"
+WHERE id = sqlc.arg(user_id)::UUID
+  AND deletion_scheduled_at <= sqlc.arg(now)::TIMESTAMPTZ
+FOR NO KEY UPDATE;
+
+-- name: DeleteScheduledUser :execrows
+DELETE FROM "users"
+WHERE id = sqlc.arg(user_id)::UUID
+  AND deletion_scheduled_at <= sqlc.arg(now)::TIMESTAMPTZ;
+
+-- name: EnableAuditAnonymization :exec
+SELECT set_config('audit.anonymize', 'on', true);
+
+-- name: AnonymizeUserAuditEvents :execrows
+UPDATE "audit_events"
+SET ip = '',
+    user_agent = '',
+    metadata = metadata - sqlc.arg(keys)::TEXT[]
+WHERE actor_id = sqlc.arg(user_id)::UUID
+   OR (target_type = 'user' AND target_id = sqlc.arg(user_id)::UUID::TEXT)
+   OR (action = 'auth.login_failed' AND metadata->>'email' = sqlc.narg(email)::TEXT);
+
+-- name: RevokeUserAPIKeys :execrows
+UPDATE "api_keys"
+SET revoked_at = NOW()
+WHERE user_id = sqlc.arg(user_id)::UUID
+  AND revoked_at IS NULL;
+
+-- name: DeleteOrganization :execrows
+DELETE FROM "organizations"
+WHERE id = sqlc.arg(id)::UUID;
+
+-- name: ListSoleMemberOrganizations :many
+SELECT m.organization_id
+FROM "organization_members" m
+WHERE m.user_id = sqlc.arg(user_id)::UUID
+  AND NOT EXISTS (
+      SELECT 1
+      FROM "organization_members" o
+      WHERE o.organization_id = m.organization_id
+        AND o.user_id <> m.user_id
+  )
+ORDER BY m.organization_id;
+
+-- name: ListOrganizationsNeedingOwner :many
+SELECT o.id, o.name, o.created_by, o.created_at, o.updated_at
+FROM "organizations" o
+JOIN "organization_members" m ON m.organization_id = o.id
+WHERE m.user_id = sqlc.arg(user_id)::UUID
+  AND m.role = 'owner'
+  AND NOT EXISTS (
+      SELECT 1
+      FROM "organization_members" x
+      WHERE x.organization_id = o.id
+        AND x.user_id <> m.user_id
+        AND x.role = 'owner'
+  )
+  AND EXISTS (
+      SELECT 1
+      FROM "organization_members" x
+      WHERE x.organization_id = o.id
+        AND x.user_id <> m.user_id
+  )
+ORDER BY o.name, o.id;
+
+-- name: SetUserPhone :execrows
+UPDATE "users"
+SET phone_verified_at = CASE WHEN phone IS NOT DISTINCT FROM sqlc.narg(phone)::TEXT THEN phone_verified_at END,
+    phone = sqlc.narg(phone)::TEXT,
+    updated_at = NOW()
+WHERE id = sqlc.arg(user_id)::UUID;
+
+-- name: MarkPhoneVerified :execrows
+UPDATE "users"
+SET phone_verified_at = NOW(),
+    updated_at = NOW()
+WHERE id = sqlc.arg(user_id)::UUID
+  AND phone = sqlc.arg(phone)::TEXT;
@@ -0,0 +1,98 @@
+-- name: CreateWatchlist :one
+INSERT INTO watchlists (id, organization_id, user_id, name, position)
+VALUES (
+    sqlc.arg(id)::UUID,
+    sqlc.arg(organization_id)::UUID,
+    sqlc.arg(user_id)::UUID,
+    sqlc.arg(name)::TEXT,
+    (
+        SELECT COALESCE(MAX(position) + 1, 0)
+        FROM watchlists
+        WHERE organization_id = sqlc.arg(organization_id)::UUID
+          AND user_id = sqlc.arg(user_id)::UUID
+    )
+)
+RETURNING id, organization_id, user_id, name, position, created_at, updated_at;
+
+-- name: GetWatchlist :one
+SELECT id, organization_id, user_id, name, position, created_at, updated_at
+FROM watchlists
+WHERE id = sqlc.arg(id)::UUID
+  AND organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID;
+
+-- name: ListWatchlists :many
+SELECT id, organization_id, user_id, name, position, created_at, updated_at
+FROM watchlists
+WHERE organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID
+ORDER BY position, created_at;
+
+-- name: ListUserWatchlists :many
+SELECT id, organization_id, user_id, name, position, created_at, updated_at
+FROM watchlists
+WHERE user_id = sqlc.arg(user_id)::UUID
+ORDER BY organization_id, position, created_at;
+
+-- name: RenameWatchlist :execrows
+UPDATE watchlists
+SET name = sqlc.arg(name)::TEXT
+WHERE id = sqlc.arg(id)::UUID
+  AND organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID;
+
+-- name: DeleteWatchlist :execrows
+DELETE FROM watchlists
+WHERE id = sqlc.arg(id)::UUID
+  AND organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID;
+
+-- name: ReorderWatchlists :execrows
+UPDATE watchlists w
+SET position = o.ord - 1
+FROM unnest(sqlc.arg(ids)::UUID[]) WITH ORDINALITY AS o(id, ord)
+WHERE w.id = o.id
+  AND w.organization_id = sqlc.arg(organization_id)::UUID
+  AND w.user_id = sqlc.arg(user_id)::UUID;
+
+-- name: DeleteOrganizationWatchlists :execrows
+DELETE FROM watchlists
+WHERE organization_id = sqlc.arg(organization_id)::UUID;
+
+-- name: DeleteUserWatchlists :execrows
+DELETE FROM watchlists
+WHERE user_id = sqlc.arg(user_id)::UUID;
+
+-- name: ListWatchlistItems :many
+SELECT id, watchlist_id, symbol, market, kline_interval, position, created_at
+FROM watchlist_items
+WHERE watchlist_id = ANY(sqlc.arg(watchlist_ids)::UUID[])
+ORDER BY watchlist_id, position, created_at;
+
+-- name: CreateWatchlistItem :one
+INSERT INTO watchlist_items (id, watchlist_id, symbol, market, kline_interval, position)
+VALUES (
+    sqlc.arg(id)::UUID,
+    sqlc.arg(watchlist_id)::UUID,
+    sqlc.arg(symbol)::TEXT,
+    sqlc.arg(market)::TEXT,
+    sqlc.arg(kline_interval)::TEXT,
+    (
+        SELECT COALESCE(MAX(position) + 1, 0)
+        FROM watchlist_items
+        WHERE watchlist_id = sqlc.arg(watchlist_id)::UUID
+    )
+)
+RETURNING id, watchlist_id, symbol, market, kline_interval, position, created_at;
+
+-- name: DeleteWatchlistItem :execrows
+DELETE FROM watchlist_items
+WHERE id = sqlc.arg(id)::UUID
+  AND watchlist_id = sqlc.arg(watchlist_id)::UUID;
+
+-- name: ReorderWatchlistItems :execrows
+UPDATE watchlist_items i
+SET position = o.ord - 1
+FROM unnest(sqlc.arg(ids)::UUID[]) WITH ORDINALITY AS o(id, ord)
+WHERE i.id = o.id
+  AND i.watchlist_id = sqlc.arg(watchlist_id)::UUID;
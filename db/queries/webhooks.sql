@@ -0,0 +1,163 @@
+-- name: CreateWebhookEndpoint :one
+INSERT INTO webhook_endpoints (id, organization_id, user_id, url, description, events, secret, enabled)
+VALUES (
+    sqlc.arg(id)::UUID,
+    sqlc.arg(organization_id)::UUID,
+    sqlc.arg(user_id)::UUID,
+    sqlc.arg(url)::TEXT,
+    sqlc.arg(description)::TEXT,
+    sqlc.arg(events)::TEXT[],
+    sqlc.arg(secret)::TEXT,
+    sqlc.arg(enabled)::BOOLEAN
+)
+RETURNING id, organization_id, user_id, url, description, events, secret, enabled, created_at, updated_at;
+
+-- name: GetWebhookEndpoint :one
+SELECT id, organization_id, user_id, url, description, events, secret, enabled, created_at, updated_at
+FROM webhook_endpoints
+WHERE id = sqlc.arg(id)::UUID
+  AND organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID;
+
+-- name: GetWebhookEndpointByID :one
+SELECT id, organization_id, user_id, url, description, events, secret, enabled, created_at, updated_at
+FROM webhook_endpoints
+WHERE id = sqlc.arg(id)::UUID;
+
+-- name: ListWebhookEndpoints :many
+SELECT id, organization_id, user_id, url, description, events, secret, enabled, created_at, updated_at
+FROM webhook_endpoints
+WHERE organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID
+ORDER BY created_at;
+
+-- name: ListUserWebhookEndpoints :many
+SELECT id, organization_id, user_id, url, description, events, secret, enabled, created_at, updated_at
+FROM webhook_endpoints
+WHERE user_id = sqlc.arg(user_id)::UUID
+ORDER BY organization_id, created_at;
+
+-- name: CountOwnerWebhookEndpoints :one
+SELECT COUNT(*)
+FROM webhook_endpoints
+WHERE organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID;
+
+-- name: ListSubscribedWebhookEndpoints :many
+SELECT id, organization_id, user_id, url, description, events, secret, enabled, created_at, updated_at
+FROM webhook_endpoints
+WHERE organization_id = sqlc.arg(organization_id)::UUID
+  AND enabled
+  AND (sqlc.arg(event_type)::TEXT = ANY(events) OR '*' = ANY(events))
+  AND (sqlc.narg(user_id)::UUID IS NULL OR user_id = sqlc.narg(user_id)::UUID)
+ORDER BY created_at;
+
+-- name: UpdateWebhookEndpoint :execrows
+UPDATE webhook_endpoints
+SET url = sqlc.arg(url)::TEXT,
+    description = sqlc.arg(description)::TEXT,
+    events = sqlc.arg(events)::TEXT[],
+    secret = sqlc.arg(secret)::TEXT,
+    enabled = sqlc.arg(enabled)::BOOLEAN
+WHERE id = sqlc.arg(id)::UUID
+  AND organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID;
+
+-- name: DeleteWebhookEndpoint :execrows
+DELETE FROM webhook_endpoints
+WHERE id = sqlc.arg(id)::UUID
+  AND organization_id = sqlc.arg(organization_id)::UUID
+  AND user_id = sqlc.arg(user_id)::UUID;
+
+-- name: DeleteUserWebhookEndpoints :execrows
+DELETE FROM webhook_endpoints
+WHERE user_id = sqlc.arg(user_id)::UUID;
+
+-- name: DeleteOrganizationWebhookEndpoints :execrows
+DELETE FROM webhook_endpoints
+WHERE organization_id = sqlc.arg(organization_id)::UUID;
+
+-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_deliveries (id, endpoint_id, organization_id, event_id, event_type, payload, next_attempt_at)
+VALUES (
+    sqlc.arg(id)::UUID,
+    sqlc.arg(endpoint_id)::UUID,
+    sqlc.arg(organization_id)::UUID,
+    sqlc.arg(event_id)::UUID,
+    sqlc.arg(event_type)::TEXT,
+    sqlc.arg(payload)::JSONB,
+    sqlc.arg(next_attempt_at)::TIMESTAMPTZ
+)
+RETURNING id, endpoint_id, organization_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_attempt_at, last_status_code, last_error, delivered_at, created_at;
+
+-- name: GetWebhookDelivery :one
+SELECT id, endpoint_id, organization_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_attempt_at, last_status_code, last_error, delivered_at, created_at
+FROM webhook_deliveries
+WHERE id = sqlc.arg(id)::UUID
+  AND endpoint_id = sqlc.arg(endpoint_id)::UUID;
+
+-- name: ListWebhookDeliveries :many
+SELECT id, endpoint_id, organization_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_attempt_at, last_status_code, last_error, delivered_at, created_at
+FROM webhook_deliveries
+WHERE endpoint_id = sqlc.arg(endpoint_id)::UUID
+  AND (sqlc.arg(status)::TEXT = '' OR status = sqlc.arg(status)::TEXT)
+ORDER BY created_at DESC
+LIMIT sqlc.arg(limit_rows)::INT
+OFFSET sqlc.arg(offset_rows)::INT;
+
+-- name: CountWebhookDeliveries :one
+SELECT COUNT(*)
+FROM webhook_deliveries
+WHERE endpoint_id = sqlc.arg(endpoint_id)::UUID
+  AND (sqlc.arg(status)::TEXT = '' OR status = sqlc.arg(status)::TEXT);
+
+-- name: ClaimDueWebhookDeliveries :many
+UPDATE webhook_deliveries
+SET next_attempt_at = sqlc.arg(lease_until)::TIMESTAMPTZ
+WHERE id IN (
+    SELECT d.id
+    FROM webhook_deliveries d
+    JOIN webhook_endpoints e ON e.id = d.endpoint_id
+    WHERE d.status = 'pending'
+      AND d.next_attempt_at <= sqlc.arg(now)::TIMESTAMPTZ
+      AND e.enabled
+    ORDER BY d.next_attempt_at
+    LIMIT sqlc.arg(limit_rows)::INT
+    FOR UPDATE OF d SKIP LOCKED
+)
+RETURNING id, endpoint_id, organization_id, event_id, event_type, payload, status, attempts, next_attempt_at, last_attempt_at, last_status_code, last_error, delivered_at, created_at;
+
+-- name: UpdateWebhookDeliveryState :exec
+UPDATE webhook_deliveries
+SET status = sqlc.arg(status)::TEXT,
+    attempts = sqlc.arg(attempts)::INT,
+    next_attempt_at = sqlc.arg(next_attempt_at)::TIMESTAMPTZ,
+    last_attempt_at = sqlc.narg(last_attempt_at)::TIMESTAMPTZ,
+    last_status_code = sqlc.narg(last_status_code)::INT,
+    last_error = sqlc.narg(last_error)::TEXT,
+    delivered_at = sqlc.narg(delivered_at)::TIMESTAMPTZ
+WHERE id = sqlc.arg(id)::UUID;
+
+-- name: CreateWebhookDeliveryAttempt :exec
+INSERT INTO webhook_delivery_attempts (id, delivery_id, attempt, status_code, error, response_body, duration_ms, attempted_at)
+VALUES (
+    sqlc.arg(id)::UUID,
+    sqlc.arg(delivery_id)::UUID,
+    sqlc.arg(attempt)::INT,
+    sqlc.narg(status_code)::INT,
+    sqlc.narg(error)::TEXT,
+    sqlc.arg(response_body)::TEXT,
+    sqlc.arg(duration_ms)::INT,
+    sqlc.arg(attempted_at)::TIMESTAMPTZ
+);
+
+-- name: ListWebhookDeliveryAttempts :many
+SELECT id, delivery_id, attempt, status_code, error, response_body, duration_ms, attempted_at
+FROM webhook_delivery_attempts
+WHERE delivery_id = sqlc.arg(delivery_id)::UUID
+ORDER BY attempted_at;
+
+-- name: DeleteFinishedWebhookDeliveries :execrows
+DELETE FROM webhook_deliveries
+WHERE status <> 'pending'
+  AND created_at < sqlc.arg(before)::TIMESTAMPTZ;
@@ -0,0 +1,52 @@
+-- =========================
+-- ALERTS (price and signal rules evaluated against closed candles)
+-- =========================
+CREATE TABLE IF NOT EXISTS alerts (
+    id                UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id   UUID NOT NULL, -- organizations(id) in the users schema
+    user_id           UUID NOT NULL, -- users(id) in the users schema
+    name              TEXT NOT NULL,
+    symbol            TEXT NOT NULL,
+    kline_interval    TEXT NOT NULL,
+    kind              TEXT NOT NULL CHECK (kind IN ('price_cross', 'percent_move', 'signal_change')),
+    condition         JSONB NOT NULL DEFAULT '{}'::JSONB,
+    channels          TEXT[] NOT NULL DEFAULT '{in_app}',
+    webhook_url       TEXT,
+    cooldown_seconds  INT NOT NULL DEFAULT 3600 CHECK (cooldown_seconds >= 0),
+    enabled           BOOLEAN NOT NULL DEFAULT TRUE,
+    last_candle_at    TIMESTAMPTZ,
+    last_signal       TEXT,
+    last_triggered_at TIMESTAMPTZ,
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at        TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_alerts_owner ON alerts(organization_id, user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_alerts_user ON alerts(user_id);
+CREATE INDEX IF NOT EXISTS idx_alerts_enabled ON alerts(symbol, kline_interval) WHERE enabled;
+
+CREATE TRIGGER trg_alerts_updated_at
+BEFORE UPDATE ON alerts
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- =========================
+-- ALERT EVENTS (history, one row per firing)
+-- =========================
+CREATE TABLE IF NOT EXISTS alert_events (
+    id                UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    alert_id          UUID NOT NULL REFERENCES alerts(id) ON DELETE CASCADE,
+    organization_id   UUID NOT NULL,
+    user_id           UUID NOT NULL,
+    kind              TEXT NOT NULL,
+    symbol            TEXT NOT NULL,
+    kline_interval    TEXT NOT NULL,
+    message           TEXT NOT NULL,
+    price             DOUBLE PRECISION NOT NULL,
+    details           JSONB NOT NULL DEFAULT '{}'::JSONB,
+    deliveries        JSONB NOT NULL DEFAULT '[]'::JSONB,
+    candle_close_time TIMESTAMPTZ NOT NULL,
+    triggered_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_alert_events_alert ON alert_events(alert_id, triggered_at DESC);
+CREATE INDEX IF NOT EXISTS idx_alert_events_user ON alert_events(user_id, triggered_at DESC);
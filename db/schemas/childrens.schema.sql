@@ -8,7 +8,11 @@ CREATE TABLE IF NOT EXISTS childrens (
   updated_at  TIMESTAMPTZ NOT NULL DEFAULT now()
 );
 
-CREATE INDEX IF NOT EXISTS idx_children_grade ON children(grade);
+CREATE INDEX IF NOT EXISTS idx_children_grade ON childrens(grade);
+
+CREATE TRIGGER trg_childrens_updated_at
+BEFORE UPDATE ON childrens
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
 
 
 CREATE TABLE IF NOT EXISTS user_childrens (
@@ -32,3 +36,8 @@ CREATE TABLE IF NOT EXISTS user_childrens (
 
 CREATE INDEX IF NOT EXISTS idx_user_children_user  ON user_childrens(user_id);
 CREATE INDEX IF NOT EXISTS idx_user_children_child ON user_childrens(child_id);
+
+-- Exactly one primary guardian per child
+CREATE UNIQUE INDEX IF NOT EXISTS uq_user_children_primary
+  ON user_childrens(child_id)
+  WHERE is_primary;
@@ -0,0 +1,41 @@
+-- =========================
+-- JOBS (cron-like schedules for background work)
+-- =========================
+CREATE TABLE IF NOT EXISTS jobs (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id UUID NOT NULL, -- organizations(id) in the users schema
+    name         TEXT NOT NULL,
+    job_type     TEXT NOT NULL,
+    schedule     TEXT NOT NULL,
+    payload      JSONB NOT NULL DEFAULT '{}'::JSONB,
+    enabled      BOOLEAN NOT NULL DEFAULT TRUE,
+    max_retries  INT NOT NULL DEFAULT 3 CHECK (max_retries >= 0),
+    next_run_at  TIMESTAMPTZ,
+    last_run_at  TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_jobs_name UNIQUE (organization_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_jobs_due ON jobs(next_run_at) WHERE enabled;
+
+CREATE TRIGGER trg_jobs_updated_at
+BEFORE UPDATE ON jobs
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- =========================
+-- JOB RUNS (one row per attempt)
+-- =========================
+CREATE TABLE IF NOT EXISTS job_runs (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    job_id       UUID NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    attempt      INT NOT NULL,
+    status       TEXT NOT NULL CHECK (status IN ('running', 'succeeded', 'failed')),
+    instance     TEXT NOT NULL,
+    error        TEXT,
+    output       JSONB,
+    started_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    finished_at  TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_runs_job ON job_runs(job_id, started_at DESC);
@@ -0,0 +1,50 @@
+-- =========================
+-- MODEL VERSIONS (immutable trained models with a lifecycle stage)
+-- =========================
+CREATE TABLE IF NOT EXISTS model_versions (
+    id                UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id   UUID NOT NULL, -- organizations(id) in the users schema
+    symbol            TEXT NOT NULL,
+    kline_interval    TEXT NOT NULL,
+    version           INT NOT NULL CHECK (version > 0),
+    stage             TEXT NOT NULL DEFAULT 'candidate'
+                      CHECK (stage IN ('candidate', 'staging', 'production', 'archived')),
+    lineage_hash      TEXT NOT NULL,
+    parent_id         UUID REFERENCES model_versions(id) ON DELETE SET NULL,
+    metrics           JSONB NOT NULL,
+    artifact          JSONB NOT NULL,
+    created_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    stage_changed_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    production_at     TIMESTAMPTZ,
+    rolled_back_at    TIMESTAMPTZ,
+    CONSTRAINT uq_model_versions_version UNIQUE (organization_id, symbol, kline_interval, version)
+);
+
+-- At most one production model per organization and symbol/interval
+CREATE UNIQUE INDEX IF NOT EXISTS uq_model_versions_production
+    ON model_versions(organization_id, symbol, kline_interval)
+    WHERE stage = 'production';
+
+CREATE INDEX IF NOT EXISTS idx_model_versions_lookup
+    ON model_versions(organization_id, symbol, kline_interval, version DESC);
+
+-- Everything except the stage bookkeeping is immutable
+CREATE OR REPLACE FUNCTION forbid_model_version_mutation()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.organization_id IS DISTINCT FROM OLD.organization_id
+       OR NEW.symbol IS DISTINCT FROM OLD.symbol
+       OR NEW.kline_interval IS DISTINCT FROM OLD.kline_interval
+       OR NEW.version IS DISTINCT FROM OLD.version
+       OR NEW.lineage_hash IS DISTINCT FROM OLD.lineage_hash
+       OR NEW.metrics IS DISTINCT FROM OLD.metrics
+       OR NEW.artifact IS DISTINCT FROM OLD.artifact THEN
+        RAISE EXCEPTION 'model_versions rows are immutable';
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_model_versions_immutable
+BEFORE UPDATE ON model_versions
+FOR EACH ROW EXECUTE FUNCTION forbid_model_version_mutation();
@@ -0,0 +1,43 @@
+-- =========================
+-- PORTFOLIOS (virtual portfolios, valued from their trade journal)
+-- =========================
+CREATE TABLE IF NOT EXISTS portfolios (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id UUID NOT NULL, -- organizations(id) in the users schema
+    user_id         UUID NOT NULL, -- users(id) in the users schema
+    name            TEXT NOT NULL,
+    currency        TEXT NOT NULL DEFAULT 'USDT',
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_portfolios_name UNIQUE (organization_id, user_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_portfolios_owner ON portfolios(organization_id, user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_portfolios_user ON portfolios(user_id);
+
+CREATE TRIGGER trg_portfolios_updated_at
+BEFORE UPDATE ON portfolios
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- =========================
+-- PORTFOLIO TRADES (the journal; amounts are exact, 8 decimal places)
+-- =========================
+CREATE TABLE IF NOT EXISTS portfolio_trades (
+    id             UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    portfolio_id   UUID NOT NULL REFERENCES portfolios(id) ON DELETE CASCADE,
+    symbol         TEXT NOT NULL,
+    market         TEXT NOT NULL CHECK (market IN ('coin', 'stock')),
+    side           TEXT NOT NULL CHECK (side IN ('buy', 'sell')),
+    quantity       NUMERIC(38, 8) NOT NULL CHECK (quantity > 0),
+    price          NUMERIC(38, 8) NOT NULL CHECK (price > 0),
+    fee            NUMERIC(38, 8) NOT NULL DEFAULT 0 CHECK (fee >= 0),
+    executed_at    TIMESTAMPTZ NOT NULL,
+    source         TEXT NOT NULL DEFAULT 'manual' CHECK (source IN ('manual', 'signal')),
+    signal         TEXT NOT NULL DEFAULT '', -- model signal a 'signal' trade followed
+    kline_interval TEXT NOT NULL DEFAULT '',
+    model_version  INT NOT NULL DEFAULT 0,
+    note           TEXT NOT NULL DEFAULT '',
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_portfolio_trades_replay ON portfolio_trades(portfolio_id, executed_at, created_at);
@@ -19,9 +19,31 @@ CREATE TABLE IF NOT EXISTS roles (
 );
 
 CREATE TRIGGER trg_role_updated_at
-BEFORE UPDATE ON role
+BEFORE UPDATE ON roles
 FOR EACH ROW EXECUTE FUNCTION set_updated_at();
 
+-- =========================
+-- PERMISSIONS ("resource:action", "resource:*" hoặc "*")
+-- =========================
+CREATE TABLE IF NOT EXISTS permissions (
+    id           INT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    name         TEXT NOT NULL UNIQUE,
+    description  TEXT NOT NULL DEFAULT '',
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- =========================
+-- ROLE PERMISSIONS (grants)
+-- =========================
+CREATE TABLE IF NOT EXISTS role_permissions (
+    role_id        INT NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+    permission_id  INT NOT NULL REFERENCES permissions(id) ON DELETE CASCADE,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (role_id, permission_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_role_permissions_permission ON role_permissions(permission_id);
+
 -- =========================
 -- USERS (chú ý: "user" là từ khóa; nếu giữ tên này, luôn để trong dấu ")
 -- =========================
@@ -30,14 +52,228 @@ CREATE TABLE IF NOT EXISTS "users" (
     full_name      TEXT NOT NULL UNIQUE,
     email          CITEXT UNIQUE,
     password_hash  TEXT NOT NULL,
-    role_id        INT REFERENCES role(id) ON UPDATE CASCADE ON DELETE SET NULL,
+    role_id        INT REFERENCES roles(id) ON UPDATE CASCADE ON DELETE SET NULL,
     image_url      TEXT,
-    phone         TEXT,
+    phone          TEXT,
+    phone_verified_at TIMESTAMPTZ,
     is_active      BOOLEAN NOT NULL DEFAULT TRUE,
+    password_reset_required BOOLEAN NOT NULL DEFAULT FALSE,
+    email_verified_at TIMESTAMPTZ,
+    totp_secret    TEXT,
+    totp_enabled_at TIMESTAMPTZ,
+    deletion_scheduled_at TIMESTAMPTZ,
     created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     updated_at     TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );
 
+CREATE INDEX IF NOT EXISTS idx_users_role ON "users"(role_id);
+-- Mỗi số điện thoại đã xác minh chỉ thuộc về một tài khoản
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_phone_verified ON "users"(phone) WHERE phone_verified_at IS NOT NULL;
+-- Tài khoản chờ xoá vĩnh viễn sau thời gian ân hạn
+CREATE INDEX IF NOT EXISTS idx_users_deletion ON "users"(deletion_scheduled_at) WHERE deletion_scheduled_at IS NOT NULL;
+
 CREATE TRIGGER trg_user_updated_at
-BEFORE UPDATE ON "user"
+BEFORE UPDATE ON "users"
 FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- =========================
+-- USER TOKENS (password reset / email verification, chỉ lưu hash)
+-- =========================
+CREATE TABLE IF NOT EXISTS user_tokens (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id      UUID NOT NULL REFERENCES "users"(id) ON DELETE CASCADE,
+    purpose      TEXT NOT NULL CHECK (purpose IN ('password_reset', 'email_verification')),
+    token_hash   TEXT NOT NULL UNIQUE,
+    expires_at   TIMESTAMPTZ NOT NULL,
+    used_at      TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_tokens_user ON user_tokens(user_id, purpose) WHERE used_at IS NULL;
+
+-- =========================
+-- USER RECOVERY CODES (2FA one-time codes, chỉ lưu hash)
+-- =========================
+CREATE TABLE IF NOT EXISTS user_recovery_codes (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id      UUID NOT NULL REFERENCES "users"(id) ON DELETE CASCADE,
+    code_hash    TEXT NOT NULL,
+    used_at      TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, code_hash)
+);
+
+-- =========================
+-- API KEYS (bot/cron access; secret chỉ lưu hash)
+-- =========================
+CREATE TABLE IF NOT EXISTS api_keys (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id      UUID NOT NULL REFERENCES "users"(id) ON DELETE CASCADE,
+    name         TEXT NOT NULL,
+    prefix       TEXT NOT NULL UNIQUE,
+    secret_hash  TEXT NOT NULL,
+    scopes       TEXT[] NOT NULL DEFAULT '{}',
+    allowed_ips  TEXT[] NOT NULL DEFAULT '{}',
+    expires_at   TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    last_used_ip TEXT,
+    revoked_at   TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_user ON api_keys(user_id, created_at DESC) WHERE revoked_at IS NULL;
+
+-- =========================
+-- USER IDENTITIES (social login: provider + subject -> user)
+-- =========================
+CREATE TABLE IF NOT EXISTS user_identities (
+    id            UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id       UUID NOT NULL REFERENCES "users"(id) ON DELETE CASCADE,
+    provider      TEXT NOT NULL,
+    subject       TEXT NOT NULL,
+    email         TEXT,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_login_at TIMESTAMPTZ,
+    UNIQUE (provider, subject),
+    UNIQUE (user_id, provider)
+);
+
+-- =========================
+-- AUDIT EVENTS (append-only; actor/target không có FK để giữ lịch sử khi xoá user)
+-- =========================
+CREATE TABLE IF NOT EXISTS audit_events (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    actor_id     UUID,
+    action       TEXT NOT NULL,
+    target_type  TEXT NOT NULL DEFAULT '',
+    target_id    TEXT NOT NULL DEFAULT '',
+    ip           TEXT NOT NULL DEFAULT '',
+    user_agent   TEXT NOT NULL DEFAULT '',
+    request_id   TEXT NOT NULL DEFAULT '',
+    metadata     JSONB NOT NULL DEFAULT '{}'::JSONB,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_created ON audit_events(created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor ON audit_events(actor_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_target ON audit_events(target_type, target_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_action ON audit_events(action, created_at DESC);
+
+-- Deleting an account clears the IP, user agent and personal metadata of the
+-- user's events. It sets audit.anonymize for its transaction; every other
+-- column must stay as it was.
+CREATE OR REPLACE FUNCTION audit_events_append_only()
+RETURNS trigger LANGUAGE plpgsql AS $$
+BEGIN
+  IF TG_OP = 'UPDATE'
+     AND current_setting('audit.anonymize', true) = 'on'
+     AND NEW.id = OLD.id
+     AND NEW.actor_id IS NOT DISTINCT FROM OLD.actor_id
+     AND NEW.action = OLD.action
+     AND NEW.target_type = OLD.target_type
+     AND NEW.target_id = OLD.target_id
+     AND NEW.request_id = OLD.request_id
+     AND NEW.created_at = OLD.created_at THEN
+    RETURN NEW;
+  END IF;
+  RAISE EXCEPTION 'audit_events is append-only';
+END; $$;
+
+CREATE TRIGGER trg_audit_events_append_only
+BEFORE UPDATE OR DELETE ON audit_events
+FOR EACH ROW EXECUTE FUNCTION audit_events_append_only();
+
+CREATE TRIGGER trg_audit_events_no_truncate
+BEFORE TRUNCATE ON audit_events
+FOR EACH STATEMENT EXECUTE FUNCTION audit_events_append_only();
+
+-- =========================
+-- ORGANIZATIONS (workspaces; models, jobs and uploads belong to one)
+-- =========================
+CREATE TABLE IF NOT EXISTS organizations (
+    id          UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name        TEXT NOT NULL,
+    created_by  UUID REFERENCES "users"(id) ON DELETE SET NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER trg_organization_updated_at
+BEFORE UPDATE ON organizations
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+CREATE TABLE IF NOT EXISTS organization_members (
+    organization_id  UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id          UUID NOT NULL REFERENCES "users"(id) ON DELETE CASCADE,
+    role             TEXT NOT NULL CHECK (role IN ('owner', 'manager', 'staff')),
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (organization_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_members_user ON organization_members(user_id);
+
+-- Lời mời chỉ lưu hash của token
+CREATE TABLE IF NOT EXISTS organization_invitations (
+    id               UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id  UUID NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    email            CITEXT NOT NULL,
+    role             TEXT NOT NULL CHECK (role IN ('owner', 'manager', 'staff')),
+    token_hash       TEXT NOT NULL UNIQUE,
+    invited_by       UUID REFERENCES "users"(id) ON DELETE SET NULL,
+    expires_at       TIMESTAMPTZ NOT NULL,
+    accepted_at      TIMESTAMPTZ,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_organization_invitations_org
+    ON organization_invitations(organization_id, created_at DESC)
+    WHERE accepted_at IS NULL;
+
+-- =========================
+-- SEED: built-in roles, permissions and grants
+-- =========================
+INSERT INTO roles (role_name)
+VALUES ('user'), ('admin'), ('owner'), ('manager'), ('staff')
+ON CONFLICT (role_name) DO NOTHING;
+
+INSERT INTO permissions (name, description)
+VALUES
+    ('*',              'Every permission, including ones added later'),
+    ('models:*',       'Every model permission'),
+    ('models:read',    'List and inspect model versions'),
+    ('models:predict', 'Request predictions from the production model'),
+    ('models:promote', 'Change model stages and roll back production'),
+    ('jobs:*',         'Every job permission'),
+    ('jobs:read',      'List jobs and their runs'),
+    ('jobs:manage',    'Create and update scheduled jobs'),
+    ('users:*',        'Every user management permission'),
+    ('users:read',     'List and view user accounts'),
+    ('users:manage',   'Change user roles and account status'),
+    ('roles:manage',   'Manage roles, permissions and grants'),
+    ('audit:read',     'Query the security audit log'),
+    ('webhooks:manage', 'Register outgoing webhooks and read their delivery log')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM (VALUES
+    ('owner',   '*'),
+    ('admin',   'models:*'),
+    ('admin',   'jobs:*'),
+    ('admin',   'users:*'),
+    ('admin',   'roles:manage'),
+    ('admin',   'audit:read'),
+    ('admin',   'webhooks:manage'),
+    ('manager', 'models:*'),
+    ('manager', 'jobs:read'),
+    ('manager', 'users:read'),
+    ('manager', 'webhooks:manage'),
+    ('staff',   'models:read'),
+    ('staff',   'models:predict'),
+    ('staff',   'jobs:read'),
+    ('user',    'models:read'),
+    ('user',    'models:predict')
+) AS g(role_name, permission_name)
+JOIN roles r ON r.role_name = g.role_name
+JOIN permissions p ON p.name = g.permission_name
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,36 @@
+-- =========================
+-- WATCHLISTS (named lists of market symbols, one set per user and organization)
+-- =========================
+CREATE TABLE IF NOT EXISTS watchlists (
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id UUID NOT NULL, -- organizations(id) in the users schema
+    user_id      UUID NOT NULL, -- users(id) in the users schema
+    name         TEXT NOT NULL,
+    position     INT NOT NULL DEFAULT 0,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_watchlists_name UNIQUE (organization_id, user_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_watchlists_owner ON watchlists(organization_id, user_id, position);
+CREATE INDEX IF NOT EXISTS idx_watchlists_user ON watchlists(user_id);
+
+CREATE TRIGGER trg_watchlists_updated_at
+BEFORE UPDATE ON watchlists
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- =========================
+-- WATCHLIST ITEMS
+-- =========================
+CREATE TABLE IF NOT EXISTS watchlist_items (
+    id             UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    watchlist_id   UUID NOT NULL REFERENCES watchlists(id) ON DELETE CASCADE,
+    symbol         TEXT NOT NULL,
+    market         TEXT NOT NULL CHECK (market IN ('coin', 'stock')),
+    kline_interval TEXT NOT NULL,
+    position       INT NOT NULL DEFAULT 0,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_watchlist_items_symbol UNIQUE (watchlist_id, market, symbol, kline_interval)
+);
+
+CREATE INDEX IF NOT EXISTS idx_watchlist_items_list ON watchlist_items(watchlist_id, position);
@@ -0,0 +1,62 @@
+-- =========================
+-- WEBHOOK ENDPOINTS (user-registered receivers of organization events)
+-- =========================
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+    id              UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id UUID NOT NULL, -- organizations(id) in the users schema
+    user_id         UUID NOT NULL, -- users(id) in the users schema
+    url             TEXT NOT NULL,
+    description     TEXT NOT NULL DEFAULT '',
+    events          TEXT[] NOT NULL DEFAULT '{}',
+    secret          TEXT NOT NULL, -- AES-GCM sealed signing secret
+    enabled         BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_endpoints_owner ON webhook_endpoints(organization_id, user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_webhook_endpoints_user ON webhook_endpoints(user_id);
+
+CREATE TRIGGER trg_webhook_endpoints_updated_at
+BEFORE UPDATE ON webhook_endpoints
+FOR EACH ROW EXECUTE FUNCTION set_updated_at();
+
+-- =========================
+-- WEBHOOK DELIVERIES (queue, one row per event per endpoint)
+-- =========================
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id               UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    endpoint_id      UUID NOT NULL REFERENCES webhook_endpoints(id) ON DELETE CASCADE,
+    organization_id  UUID NOT NULL,
+    event_id         UUID NOT NULL,
+    event_type       TEXT NOT NULL,
+    payload          JSONB NOT NULL,
+    status           TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'succeeded', 'dead')),
+    attempts         INT NOT NULL DEFAULT 0,
+    next_attempt_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_attempt_at  TIMESTAMPTZ,
+    last_status_code INT,
+    last_error       TEXT,
+    delivered_at     TIMESTAMPTZ,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_endpoint ON webhook_deliveries(endpoint_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_finished ON webhook_deliveries(created_at) WHERE status <> 'pending';
+
+-- =========================
+-- WEBHOOK DELIVERY ATTEMPTS (log, one row per HTTP request)
+-- =========================
+CREATE TABLE IF NOT EXISTS webhook_delivery_attempts (
+    id            UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    delivery_id   UUID NOT NULL REFERENCES webhook_deliveries(id) ON DELETE CASCADE,
+    attempt       INT NOT NULL,
+    status_code   INT,
+    error         TEXT,
+    response_body TEXT NOT NULL DEFAULT '',
+    duration_ms   INT NOT NULL DEFAULT 0,
+    attempted_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_attempts_delivery ON webhook_delivery_attempts(delivery_id, attempted_at);
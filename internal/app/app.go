@@ -1,11 +1,19 @@
 package app
 
 import (
+	"context"
 	"go-ai/internal/container"
 	healthhttp "go-ai/internal/health/transport/http"
+	householdhttp "go-ai/internal/household/transport/http"
+	authapp "go-ai/internal/identity/application/auth"
 	identityhttp "go-ai/internal/identity/transport/http"
+	jobshttp "go-ai/internal/jobs/transport/http"
+	markethttp "go-ai/internal/market/transport/http"
 	uploadhttp "go-ai/internal/media/transport/http"
+	registryhttp "go-ai/internal/modelregistry/transport/http"
+	notificationhttp "go-ai/internal/notification/transport/http"
 	"go-ai/internal/platform/config"
+	"go-ai/pkg/jwtkeys"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/labstack/echo/v5"
@@ -13,19 +21,100 @@ import (
 	"github.com/rs/zerolog"
 )
 
-func BuildApp(e *echo.Echo, pool *pgxpool.Pool, redis *redis.Client, cfg *config.Config, log zerolog.Logger) {
+// BackgroundWorker is a long-running component started alongside the HTTP
+// server and stopped during graceful shutdown.
+type BackgroundWorker interface {
+	Start(ctx context.Context)
+	Shutdown(ctx context.Context) error
+}
+
+// Drainer is a worker holding requests open, such as event streams. The
+// HTTP server waits for open requests when shutting down, so it asks
+// drainers to end theirs first.
+type Drainer interface {
+	Drain()
+}
+
+func BuildApp(e *echo.Echo, pool *pgxpool.Pool, redis *redis.Client, tokens jwtkeys.Issuer, passwords *authapp.Passwords, cfg *config.Config, log zerolog.Logger) []BackgroundWorker {
+	var workers []BackgroundWorker
+
 	api := e.Group("/api")
 
 	healthModule := container.InitHealthModule(pool, redis, cfg, log)
 	healthhttp.RegisterHealthRoutes(api, healthModule.Handler)
 
-	identityModule := container.InitIdentityModule(pool, redis, cfg, log)
+	mail := container.InitMailer(cfg, log)
+	texts := container.InitSMS(cfg, log)
+
+	identityModule := container.InitIdentityModule(pool, redis, mail, texts, tokens, passwords, cfg, log)
 	identityhttp.RegisterIdentityRoutes(api, identityModule.Handler, identityModule.Middleware)
+	identityhttp.RegisterSessionRoutes(api, identityModule.SessionHandler, identityModule.Middleware)
+	identityhttp.RegisterAccountRoutes(api, identityModule.AccountHandler, identityModule.Middleware)
+	identityhttp.RegisterMFARoutes(api, identityModule.MFAHandler, identityModule.Middleware)
+	identityhttp.RegisterPhoneRoutes(api, identityModule.PhoneHandler, identityModule.Middleware)
+	identityhttp.RegisterAPIKeyRoutes(api, identityModule.APIKeyHandler, identityModule.Middleware)
+	identityhttp.RegisterOAuthRoutes(api, identityModule.OAuthHandler, identityModule.Middleware)
+	identityhttp.RegisterRbacRoutes(api, identityModule.RbacHandler, identityModule.Middleware, identityModule.RbacService)
+	identityhttp.RegisterUserAdminRoutes(api, identityModule.UserHandler, identityModule.Middleware, identityModule.RbacService)
+	identityhttp.RegisterAuditRoutes(api, identityModule.AuditHandler, identityModule.Middleware, identityModule.RbacService)
+	identityhttp.RegisterOrgRoutes(api, identityModule.OrgHandler, identityModule.Middleware)
+	identityhttp.RegisterJWKSRoutes(e, identityModule.JWKSHandler)
 
 	mediaModule, err := container.InitMediaModule(identityModule.Middleware, cfg, log)
 	if err != nil {
 		log.Error().Err(err).Msg("failed to init media module")
 	} else {
 		uploadhttp.RegisterMediaRoutes(api, mediaModule.Handler, mediaModule.Auth)
+		identityModule.AccountData.AddExporter("uploads", mediaModule.Storage)
+		identityModule.AccountData.AddPurger(mediaModule.Storage)
+	}
+
+	notificationModule := container.InitNotificationModule(pool, mail, cfg, log)
+	notificationhttp.RegisterNotificationRoutes(api, notificationModule.Handler, identityModule.Middleware)
+	notificationhttp.RegisterWebhookRoutes(api, notificationModule.WebhookHandler, identityModule.Middleware, identityModule.RbacService)
+	identityModule.AccountData.AddExporter("notifications", notificationModule.UserData)
+	identityModule.AccountData.AddPurger(notificationModule.UserData)
+	identityModule.AccountData.AddExporter("webhooks", notificationModule.WebhookData)
+	identityModule.AccountData.AddPurger(notificationModule.WebhookData)
+	if cfg.WebhooksEnabled {
+		workers = append(workers, notificationModule.WebhookWorker)
 	}
+
+	modelRegistryModule := container.InitModelRegistryModule(pool, notificationModule.Webhooks, cfg, log)
+	registryhttp.RegisterModelRoutes(api, modelRegistryModule.Handler, identityModule.Middleware, identityModule.RbacService)
+	identityModule.AccountData.AddExporter("models", modelRegistryModule.UserData)
+	identityModule.AccountData.AddPurger(modelRegistryModule.UserData)
+
+	householdModule := container.InitHouseholdModule(pool, log)
+	householdhttp.RegisterChildRoutes(api, householdModule.Handler, identityModule.Middleware, householdModule.Repo)
+	identityModule.AccountData.AddExporter("children", householdModule.UserData)
+	identityModule.AccountData.AddPurger(householdModule.UserData)
+
+	marketModule := container.InitMarketModule(pool, redis, modelRegistryModule.Registry, notificationModule.Dispatcher, notificationModule.Webhooks, cfg, log)
+	markethttp.RegisterWatchlistRoutes(api, marketModule.WatchlistHandler, identityModule.Middleware, identityModule.RbacService)
+	markethttp.RegisterAlertRoutes(api, marketModule.AlertHandler, identityModule.Middleware)
+	markethttp.RegisterPortfolioRoutes(api, marketModule.PortfolioHandler, identityModule.Middleware)
+	identityModule.AccountData.AddExporter("watchlists", marketModule.UserData)
+	identityModule.AccountData.AddPurger(marketModule.UserData)
+	identityModule.AccountData.AddExporter("alerts", marketModule.AlertUserData)
+	identityModule.AccountData.AddPurger(marketModule.AlertUserData)
+	identityModule.AccountData.AddExporter("portfolios", marketModule.PortfolioData)
+	identityModule.AccountData.AddPurger(marketModule.PortfolioData)
+	if cfg.AlertsEnabled {
+		workers = append(workers, marketModule.AlertEvaluator)
+	}
+	if cfg.StreamsEnabled {
+		markethttp.RegisterStreamRoutes(api, marketModule.StreamHandler, identityModule.Middleware)
+		workers = append(workers, marketModule.StreamHub, marketModule.StreamPoller)
+	}
+
+	jobsModule := container.InitJobsModule(pool, redis, modelRegistryModule.Registry, notificationModule.Webhooks, cfg, log)
+	jobshttp.RegisterJobRoutes(api, jobsModule.Handler, identityModule.Middleware, identityModule.RbacService)
+	identityModule.AccountData.AddPurger(jobsModule.UserData)
+	if cfg.JobsEnabled {
+		workers = append(workers, jobsModule.Scheduler)
+	}
+	workers = append(workers, identityModule.AccountPurger)
+
+	return workers
 }
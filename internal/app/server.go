@@ -63,6 +63,9 @@ func NewServer() *echo.Echo {
 func NewServerWithConfig(srvCfg ServerConfig) *echo.Echo {
 	e := echo.New()
 	log := logger.NewLogger()
+	// Run swaps in the TRUSTED_PROXIES extractor; until then forwarding
+	// headers are not believed.
+	e.IPExtractor = echo.ExtractIPDirect()
 
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins: []string{"http://localhost:3000", "http://157.66.218.138:3000"},
@@ -87,11 +90,18 @@ func NewServerWithConfig(srvCfg ServerConfig) *echo.Echo {
 	)
 	e.Use(middleware.RateLimiter(rateLimiterStore))
 
+	// Event streams outlive the request timeout and are not worth logging
+	// the body of.
+	streams := middlewares.StreamSkipper("/api/stream")
+
 	e.Use(middlewares.RequestIDMiddleware(log))
-	e.Use(middleware.ContextTimeout(srvCfg.RequestTimeout))
+	e.Use(middleware.ContextTimeoutWithConfig(middleware.ContextTimeoutConfig{
+		Skipper: streams,
+		Timeout: srvCfg.RequestTimeout,
+	}))
 	e.Use(middleware.Recover())
 	e.Use(middlewares.RequestLoggerMiddleware(log))
-	e.Use(middlewares.ResponseLoggerMiddleware())
+	e.Use(middlewares.ResponseLoggerMiddleware(streams))
 
 	// Swagger UI
 	e.GET("/swagger/*", swagger.Handler(nil))
@@ -115,6 +125,22 @@ func Run(e *echo.Echo) error {
 		Int("rate_limit", cfg.RateLimitRequests).
 		Msg("Configuration loaded")
 
+	ipExtractor, err := middlewares.TrustedProxyIPExtractor(cfg.TrustedProxies)
+	if err != nil {
+		return fmt.Errorf("invalid TRUSTED_PROXIES: %w", err)
+	}
+	e.IPExtractor = ipExtractor
+
+	tokens, err := container.InitTokenIssuer(cfg, log)
+	if err != nil {
+		return fmt.Errorf("init jwt keys failed: %w", err)
+	}
+
+	passwords, err := container.InitPasswords(cfg, log)
+	if err != nil {
+		return fmt.Errorf("init passwords failed: %w", err)
+	}
+
 	dsnPg := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
 		cfg.DBUser, cfg.DBPassword, cfg.DBHost, cfg.DBPort, cfg.DBName,
 	)
@@ -137,7 +163,13 @@ func Run(e *echo.Echo) error {
 		return fmt.Errorf("connect redis failed: %w", err)
 	}
 
-	BuildApp(e, pool, redisClient, cfg, log)
+	workers := BuildApp(e, pool, redisClient, tokens, passwords, cfg, log)
+
+	ctxWorkers, cancelWorkers := context.WithCancel(context.Background())
+	defer cancelWorkers()
+	for _, w := range workers {
+		w.Start(ctxWorkers)
+	}
 
 	chServer := make(chan error, 1)
 	serverAddr := ":" + cfg.ServerPort
@@ -148,6 +180,11 @@ func Run(e *echo.Echo) error {
 		WriteTimeout: time.Duration(cfg.RequestTimeout) * time.Second,
 		IdleTimeout:  120 * time.Second,
 	}
+	for _, w := range workers {
+		if d, ok := w.(Drainer); ok {
+			srv.RegisterOnShutdown(d.Drain)
+		}
+	}
 
 	go func() {
 		log.Info().Str("addr", serverAddr).Msg("Starting HTTP server")
@@ -181,6 +218,14 @@ func Run(e *echo.Echo) error {
 		log.Error().Err(err).Msg("HTTP server shutdown error")
 	}
 
+	// Stop background workers before their connections go away
+	log.Info().Msg("Stopping background workers...")
+	for _, w := range workers {
+		if err := w.Shutdown(ctx); err != nil {
+			log.Error().Err(err).Msg("Background worker shutdown error")
+		}
+	}
+
 	// Clean up database connections
 	log.Info().Msg("Closing database connections...")
 	pool.Close()
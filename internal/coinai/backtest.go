@@ -6,17 +6,27 @@ import (
 )
 
 func Backtest(preds, actuals []float64, cfg BacktestConfig) (BacktestResult, error) {
+	detail, err := BacktestDetailed(preds, actuals, cfg)
+	if err != nil {
+		return BacktestResult{}, err
+	}
+	return detail.Result, nil
+}
+
+// BacktestDetailed runs the same simulation as Backtest and also returns the
+// per-bar positions and the list of round-trip trades.
+func BacktestDetailed(preds, actuals []float64, cfg BacktestConfig) (*BacktestDetail, error) {
 	if len(preds) == 0 {
-		return BacktestResult{}, fmt.Errorf("empty predictions")
+		return nil, fmt.Errorf("empty predictions")
 	}
 	if len(preds) != len(actuals) {
-		return BacktestResult{}, fmt.Errorf("predictions and actuals length mismatch")
+		return nil, fmt.Errorf("predictions and actuals length mismatch")
 	}
 	if cfg.LongThreshold <= cfg.ShortThreshold {
-		return BacktestResult{}, fmt.Errorf("long threshold must be greater than short threshold")
+		return nil, fmt.Errorf("long threshold must be greater than short threshold")
 	}
 	if cfg.FeeRate < 0 {
-		return BacktestResult{}, fmt.Errorf("fee rate cannot be negative")
+		return nil, fmt.Errorf("fee rate cannot be negative")
 	}
 
 	equity := 1.0
@@ -27,6 +37,10 @@ func Backtest(preds, actuals []float64, cfg BacktestConfig) (BacktestResult, err
 	winBars := 0
 	activeBars := 0
 
+	bars := make([]BacktestBar, 0, len(preds))
+	var tradeList []Trade
+	var open *Trade
+
 	periodReturns := make([]float64, 0, len(preds))
 	for i := range preds {
 		targetPosition := 0
@@ -61,8 +75,39 @@ func Backtest(preds, actuals []float64, cfg BacktestConfig) (BacktestResult, err
 			maxDrawdown = drawdown
 		}
 
+		// A position change closes the open trade (paying its exit fee) and
+		// opens a new one (paying its entry fee) on the same bar.
+		if targetPosition != position && open != nil {
+			open.ExitIndex = i
+			open.Return = (1+open.Return)*(1-cfg.FeeRate*float64(absInt(position))) - 1
+			tradeList = append(tradeList, *open)
+			open = nil
+		}
+		if targetPosition != 0 {
+			barReturn := float64(targetPosition) * actuals[i]
+			if open == nil {
+				open = &Trade{Side: targetPosition, EntryIndex: i}
+				barReturn -= cfg.FeeRate * float64(absInt(targetPosition))
+			}
+			open.Bars++
+			open.Return = (1+open.Return)*(1+barReturn) - 1
+		}
+
+		bars = append(bars, BacktestBar{
+			Position: targetPosition,
+			Turnover: turnover,
+			Fee:      fee,
+			Return:   periodReturn,
+			Equity:   equity,
+			Drawdown: drawdown,
+		})
 		position = targetPosition
 	}
+	if open != nil {
+		open.ExitIndex = len(preds) - 1
+		open.Open = true
+		tradeList = append(tradeList, *open)
+	}
 
 	sharpe := sharpeRatio(periodReturns)
 	winRate := 0.0
@@ -70,12 +115,16 @@ func Backtest(preds, actuals []float64, cfg BacktestConfig) (BacktestResult, err
 		winRate = float64(winBars) / float64(activeBars)
 	}
 
-	return BacktestResult{
-		TotalReturn: equity - 1,
-		WinRate:     winRate,
-		MaxDrawdown: maxDrawdown,
-		Sharpe:      sharpe,
-		Trades:      trades,
+	return &BacktestDetail{
+		Result: BacktestResult{
+			TotalReturn: equity - 1,
+			WinRate:     winRate,
+			MaxDrawdown: maxDrawdown,
+			Sharpe:      sharpe,
+			Trades:      trades,
+		},
+		Bars:   bars,
+		Trades: tradeList,
 	}, nil
 }
 
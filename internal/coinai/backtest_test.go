@@ -49,3 +49,56 @@ func TestBacktestAndSignal(t *testing.T) {
 func closeEnough(a, b, eps float64) bool {
 	return math.Abs(a-b) <= eps
 }
+
+func TestBacktestDetailedTrades(t *testing.T) {
+	preds := []float64{0.02, 0.02, -0.03, 0.0, 0.02}
+	actuals := []float64{0.01, 0.02, -0.01, 0.005, 0.01}
+	fee := 0.001
+
+	detail, err := BacktestDetailed(preds, actuals, BacktestConfig{
+		LongThreshold:  0.01,
+		ShortThreshold: -0.01,
+		FeeRate:        fee,
+	})
+	if err != nil {
+		t.Fatalf("BacktestDetailed returned error: %v", err)
+	}
+
+	summary, err := Backtest(preds, actuals, BacktestConfig{LongThreshold: 0.01, ShortThreshold: -0.01, FeeRate: fee})
+	if err != nil {
+		t.Fatalf("Backtest returned error: %v", err)
+	}
+	if detail.Result != summary {
+		t.Fatalf("detailed result %+v != summary %+v", detail.Result, summary)
+	}
+
+	if got, want := len(detail.Bars), len(preds); got != want {
+		t.Fatalf("bars = %d, want %d", got, want)
+	}
+	wantPositions := []int{1, 1, -1, 0, 1}
+	for i, bar := range detail.Bars {
+		if bar.Position != wantPositions[i] {
+			t.Fatalf("bar %d position = %d, want %d", i, bar.Position, wantPositions[i])
+		}
+	}
+	if last := detail.Bars[len(detail.Bars)-1].Equity; !closeEnough(last, summary.TotalReturn+1, 1e-12) {
+		t.Fatalf("final equity = %f, want %f", last, summary.TotalReturn+1)
+	}
+
+	if got, want := len(detail.Trades), 3; got != want {
+		t.Fatalf("trades = %d, want %d", got, want)
+	}
+	long := detail.Trades[0]
+	wantLong := (1+0.01-fee)*(1+0.02)*(1-fee) - 1
+	if long.Side != 1 || long.EntryIndex != 0 || long.ExitIndex != 2 || long.Bars != 2 || !closeEnough(long.Return, wantLong, 1e-12) {
+		t.Fatalf("long trade = %+v, want return %f", long, wantLong)
+	}
+	short := detail.Trades[1]
+	wantShort := (1+0.01-fee)*(1-fee) - 1
+	if short.Side != -1 || short.Bars != 1 || !closeEnough(short.Return, wantShort, 1e-12) {
+		t.Fatalf("short trade = %+v, want return %f", short, wantShort)
+	}
+	if last := detail.Trades[2]; !last.Open || last.EntryIndex != 4 {
+		t.Fatalf("last trade = %+v, want open trade entered at 4", last)
+	}
+}
@@ -13,6 +13,19 @@ import (
 
 const defaultBinanceBaseURL = "https://api.binance.com"
 
+// binanceIntervals are the kline intervals Binance serves.
+var binanceIntervals = map[string]struct{}{
+	"1m": {}, "3m": {}, "5m": {}, "15m": {}, "30m": {},
+	"1h": {}, "2h": {}, "4h": {}, "6h": {}, "8h": {}, "12h": {},
+	"1d": {}, "3d": {}, "1w": {}, "1M": {},
+}
+
+// IsBinanceInterval reports whether Binance serves klines for interval.
+func IsBinanceInterval(interval string) bool {
+	_, ok := binanceIntervals[interval]
+	return ok
+}
+
 type BinanceClient struct {
 	BaseURL    string
 	HTTPClient *http.Client
@@ -45,16 +58,46 @@ func (c *BinanceClient) FetchKlines(ctx context.Context, symbol, interval string
 		return nil, fmt.Errorf("limit must be in range 1..1000")
 	}
 
+	q := url.Values{}
+	q.Set("symbol", symbol)
+	q.Set("interval", interval)
+	q.Set("limit", strconv.Itoa(limit))
+	return c.fetchKlines(ctx, q)
+}
+
+// FetchKlinesRange loads up to limit candles whose open time lies in
+// [start, end]. Unlike FetchKlines the result does not shift over time, so it
+// can reproduce an earlier training window.
+func (c *BinanceClient) FetchKlinesRange(ctx context.Context, symbol, interval string, start, end time.Time, limit int) ([]Candle, error) {
+	if symbol == "" {
+		return nil, fmt.Errorf("symbol is required")
+	}
+	if interval == "" {
+		return nil, fmt.Errorf("interval is required")
+	}
+	if limit <= 0 || limit > 1000 {
+		return nil, fmt.Errorf("limit must be in range 1..1000")
+	}
+	if end.Before(start) {
+		return nil, fmt.Errorf("end time is before start time")
+	}
+
+	q := url.Values{}
+	q.Set("symbol", symbol)
+	q.Set("interval", interval)
+	q.Set("startTime", strconv.FormatInt(start.UnixMilli(), 10))
+	q.Set("endTime", strconv.FormatInt(end.UnixMilli(), 10))
+	q.Set("limit", strconv.Itoa(limit))
+	return c.fetchKlines(ctx, q)
+}
+
+func (c *BinanceClient) fetchKlines(ctx context.Context, params url.Values) ([]Candle, error) {
 	u, err := url.Parse(c.BaseURL)
 	if err != nil {
 		return nil, fmt.Errorf("invalid base URL: %w", err)
 	}
 	u.Path = "/api/v3/klines"
-	q := u.Query()
-	q.Set("symbol", symbol)
-	q.Set("interval", interval)
-	q.Set("limit", strconv.Itoa(limit))
-	u.RawQuery = q.Encode()
+	u.RawQuery = params.Encode()
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
 	if err != nil {
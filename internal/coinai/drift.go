@@ -0,0 +1,80 @@
+package coinai
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const psiFloor = 1e-4
+
+// PopulationStabilityIndex compares observed values against the normal
+// distribution the scaler was fitted on. Bins are equiprobable under that
+// distribution, so every expected bin share is 1/bins.
+func PopulationStabilityIndex(values []float64, mean, std float64, bins int) (float64, error) {
+	if len(values) == 0 {
+		return 0, fmt.Errorf("empty values")
+	}
+	if bins < 2 {
+		return 0, fmt.Errorf("bins must be at least 2")
+	}
+	if std <= 0 {
+		return 0, fmt.Errorf("std must be positive")
+	}
+
+	edges := make([]float64, bins-1)
+	for k := range edges {
+		edges[k] = normalQuantile(float64(k+1) / float64(bins))
+	}
+
+	counts := make([]int, bins)
+	for _, v := range values {
+		z := (v - mean) / std
+		idx := sort.SearchFloat64s(edges, z)
+		counts[idx]++
+	}
+
+	expected := 1 / float64(bins)
+	var psi float64
+	for _, count := range counts {
+		actual := float64(count) / float64(len(values))
+		if actual < psiFloor {
+			actual = psiFloor
+		}
+		psi += (actual - expected) * math.Log(actual/expected)
+	}
+	return psi, nil
+}
+
+// KolmogorovSmirnov returns the one-sample KS statistic of values against
+// a normal distribution with the given mean and standard deviation.
+func KolmogorovSmirnov(values []float64, mean, std float64) (float64, error) {
+	if len(values) == 0 {
+		return 0, fmt.Errorf("empty values")
+	}
+	if std <= 0 {
+		return 0, fmt.Errorf("std must be positive")
+	}
+
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	n := float64(len(sorted))
+	var d float64
+	for i, v := range sorted {
+		cdf := normalCDF((v - mean) / std)
+		lower := cdf - float64(i)/n
+		upper := float64(i+1)/n - cdf
+		d = math.Max(d, math.Max(lower, upper))
+	}
+	return d, nil
+}
+
+func normalCDF(z float64) float64 {
+	return 0.5 * (1 + math.Erf(z/math.Sqrt2))
+}
+
+func normalQuantile(p float64) float64 {
+	return math.Sqrt2 * math.Erfinv(2*p-1)
+}
@@ -0,0 +1,241 @@
+package coinai
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type ExportFormat string
+
+const (
+	ExportCSV   ExportFormat = "csv"
+	ExportJSONL ExportFormat = "jsonl"
+)
+
+type PredictionRow struct {
+	Time           time.Time
+	Features       []float64
+	ScaledFeatures []float64
+	Prediction     float64
+	Actual         float64
+	Signal         Signal
+	Position       int
+	BarReturn      float64
+	Equity         float64
+}
+
+type TradeRow struct {
+	Side       string    `json:"side"`
+	EntryTime  time.Time `json:"entry_time"`
+	ExitTime   time.Time `json:"exit_time"`
+	EntryIndex int       `json:"entry_index"`
+	ExitIndex  int       `json:"exit_index"`
+	Bars       int       `json:"bars"`
+	Return     float64   `json:"return"`
+	Open       bool      `json:"open"`
+}
+
+// ParseExportFormat resolves an explicit format, or infers it from the file
+// extension (.jsonl/.ndjson for JSON lines, anything else for CSV).
+func ParseExportFormat(format, path string) (ExportFormat, error) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "csv":
+		return ExportCSV, nil
+	case "jsonl", "ndjson":
+		return ExportJSONL, nil
+	case "":
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".jsonl", ".ndjson":
+			return ExportJSONL, nil
+		}
+		return ExportCSV, nil
+	}
+	return "", fmt.Errorf("export format must be csv or jsonl")
+}
+
+// PredictionRows joins the test samples with their predictions and the
+// simulated backtest state, one row per test bar.
+func (r *PipelineResult) PredictionRows() ([]PredictionRow, error) {
+	if len(r.TestPredictions) != len(r.TestSamples) || len(r.BacktestBars) != len(r.TestSamples) {
+		return nil, fmt.Errorf("pipeline result is incomplete")
+	}
+
+	rows := make([]PredictionRow, 0, len(r.TestSamples))
+	for i, sample := range r.TestSamples {
+		scaled, err := r.Scaler.Transform(sample.Features)
+		if err != nil {
+			return nil, fmt.Errorf("scale features at %d: %w", i, err)
+		}
+		bar := r.BacktestBars[i]
+		rows = append(rows, PredictionRow{
+			Time:           sample.Time,
+			Features:       sample.Features,
+			ScaledFeatures: scaled,
+			Prediction:     r.TestPredictions[i],
+			Actual:         sample.Target,
+			Signal:         signalFromPosition(bar.Position),
+			Position:       bar.Position,
+			BarReturn:      bar.Return,
+			Equity:         bar.Equity,
+		})
+	}
+	return rows, nil
+}
+
+func (r *PipelineResult) TradeRows() []TradeRow {
+	rows := make([]TradeRow, 0, len(r.Trades))
+	for _, t := range r.Trades {
+		side := "LONG"
+		if t.Side < 0 {
+			side = "SHORT"
+		}
+		rows = append(rows, TradeRow{
+			Side:       side,
+			EntryTime:  r.TestSamples[t.EntryIndex].Time,
+			ExitTime:   r.TestSamples[t.ExitIndex].Time,
+			EntryIndex: t.EntryIndex,
+			ExitIndex:  t.ExitIndex,
+			Bars:       t.Bars,
+			Return:     t.Return,
+			Open:       t.Open,
+		})
+	}
+	return rows
+}
+
+func WritePredictions(w io.Writer, format ExportFormat, featureNames []string, rows []PredictionRow) error {
+	switch format {
+	case ExportCSV:
+		cw := csv.NewWriter(w)
+		header := []string{"time"}
+		header = append(header, featureNames...)
+		for _, name := range featureNames {
+			header = append(header, "scaled_"+name)
+		}
+		header = append(header, "prediction", "actual", "signal", "position", "bar_return", "equity")
+		if err := cw.Write(header); err != nil {
+			return err
+		}
+		for _, row := range rows {
+			record := []string{row.Time.Format(time.RFC3339)}
+			for _, v := range row.Features {
+				record = append(record, formatFloat(v))
+			}
+			for _, v := range row.ScaledFeatures {
+				record = append(record, formatFloat(v))
+			}
+			record = append(record,
+				formatFloat(row.Prediction),
+				formatFloat(row.Actual),
+				string(row.Signal),
+				strconv.Itoa(row.Position),
+				formatFloat(row.BarReturn),
+				formatFloat(row.Equity),
+			)
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	case ExportJSONL:
+		enc := json.NewEncoder(w)
+		for _, row := range rows {
+			if err := enc.Encode(struct {
+				Time           time.Time          `json:"time"`
+				Features       map[string]float64 `json:"features"`
+				ScaledFeatures map[string]float64 `json:"scaled_features"`
+				Prediction     float64            `json:"prediction"`
+				Actual         float64            `json:"actual"`
+				Signal         Signal             `json:"signal"`
+				Position       int                `json:"position"`
+				BarReturn      float64            `json:"bar_return"`
+				Equity         float64            `json:"equity"`
+			}{
+				Time:           row.Time,
+				Features:       namedValues(featureNames, row.Features),
+				ScaledFeatures: namedValues(featureNames, row.ScaledFeatures),
+				Prediction:     row.Prediction,
+				Actual:         row.Actual,
+				Signal:         row.Signal,
+				Position:       row.Position,
+				BarReturn:      row.BarReturn,
+				Equity:         row.Equity,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported export format %q", format)
+}
+
+func WriteTrades(w io.Writer, format ExportFormat, rows []TradeRow) error {
+	switch format {
+	case ExportCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write([]string{"side", "entry_time", "exit_time", "entry_index", "exit_index", "bars", "return", "open"}); err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if err := cw.Write([]string{
+				row.Side,
+				row.EntryTime.Format(time.RFC3339),
+				row.ExitTime.Format(time.RFC3339),
+				strconv.Itoa(row.EntryIndex),
+				strconv.Itoa(row.ExitIndex),
+				strconv.Itoa(row.Bars),
+				formatFloat(row.Return),
+				strconv.FormatBool(row.Open),
+			}); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	case ExportJSONL:
+		enc := json.NewEncoder(w)
+		for _, row := range rows {
+			if err := enc.Encode(row); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported export format %q", format)
+}
+
+func signalFromPosition(position int) Signal {
+	switch {
+	case position > 0:
+		return SignalBuy
+	case position < 0:
+		return SignalSell
+	}
+	return SignalHold
+}
+
+func namedValues(names []string, values []float64) map[string]float64 {
+	out := make(map[string]float64, len(values))
+	for i, v := range values {
+		name := "f" + strconv.Itoa(i)
+		if i < len(names) {
+			name = names[i]
+		}
+		out[name] = v
+	}
+	return out
+}
+
+func formatFloat(v float64) string {
+	if v == 0 {
+		v = 0 // drop the sign of negative zero
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
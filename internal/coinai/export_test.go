@@ -0,0 +1,101 @@
+package coinai
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseExportFormat(t *testing.T) {
+	tests := []struct {
+		format, path string
+		want         ExportFormat
+	}{
+		{"", "out.csv", ExportCSV},
+		{"", "out.jsonl", ExportJSONL},
+		{"", "out.NDJSON", ExportJSONL},
+		{"jsonl", "out.csv", ExportJSONL},
+		{"CSV", "out.jsonl", ExportCSV},
+	}
+	for _, tt := range tests {
+		got, err := ParseExportFormat(tt.format, tt.path)
+		if err != nil || got != tt.want {
+			t.Fatalf("ParseExportFormat(%q, %q) = %q, %v; want %q", tt.format, tt.path, got, err, tt.want)
+		}
+	}
+	if _, err := ParseExportFormat("xlsx", "out.xlsx"); err == nil {
+		t.Fatalf("expected error for unsupported format")
+	}
+}
+
+func TestWritePredictions(t *testing.T) {
+	rows := []PredictionRow{{
+		Time:           time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC),
+		Features:       []float64{0.5, -0.25},
+		ScaledFeatures: []float64{1, -1},
+		Prediction:     0.002,
+		Actual:         -0.001,
+		Signal:         SignalBuy,
+		Position:       1,
+		BarReturn:      -0.001,
+		Equity:         0.999,
+	}}
+	names := []string{"a", "b"}
+
+	var buf bytes.Buffer
+	if err := WritePredictions(&buf, ExportCSV, names, rows); err != nil {
+		t.Fatalf("WritePredictions csv error: %v", err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("read csv: %v", err)
+	}
+	wantHeader := "time,a,b,scaled_a,scaled_b,prediction,actual,signal,position,bar_return,equity"
+	if got := strings.Join(records[0], ","); got != wantHeader {
+		t.Fatalf("header = %s, want %s", got, wantHeader)
+	}
+	wantRow := "2024-01-02T03:00:00Z,0.5,-0.25,1,-1,0.002,-0.001,BUY,1,-0.001,0.999"
+	if got := strings.Join(records[1], ","); got != wantRow {
+		t.Fatalf("row = %s, want %s", got, wantRow)
+	}
+
+	buf.Reset()
+	if err := WritePredictions(&buf, ExportJSONL, names, rows); err != nil {
+		t.Fatalf("WritePredictions jsonl error: %v", err)
+	}
+	var decoded struct {
+		Features map[string]float64 `json:"features"`
+		Signal   Signal             `json:"signal"`
+		Equity   float64            `json:"equity"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("decode jsonl: %v", err)
+	}
+	if decoded.Features["b"] != -0.25 || decoded.Signal != SignalBuy || decoded.Equity != 0.999 {
+		t.Fatalf("decoded = %+v", decoded)
+	}
+}
+
+func TestWriteTradesCSV(t *testing.T) {
+	rows := []TradeRow{{
+		Side:       "SHORT",
+		EntryTime:  time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		ExitTime:   time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+		EntryIndex: 1,
+		ExitIndex:  2,
+		Bars:       1,
+		Return:     0.0125,
+	}}
+	var buf bytes.Buffer
+	if err := WriteTrades(&buf, ExportCSV, rows); err != nil {
+		t.Fatalf("WriteTrades error: %v", err)
+	}
+	want := "side,entry_time,exit_time,entry_index,exit_index,bars,return,open\n" +
+		"SHORT,2024-01-02T00:00:00Z,2024-01-03T00:00:00Z,1,2,1,0.0125,false\n"
+	if buf.String() != want {
+		t.Fatalf("csv = %q, want %q", buf.String(), want)
+	}
+}
@@ -0,0 +1,61 @@
+package coinai
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+)
+
+// LineageHash fingerprints the inputs of a training run: the candles, the
+// feature set and the pipeline config. Two models with the same hash were
+// trained on identical data with identical settings.
+func LineageHash(candles []Candle, cfg PipelineConfig) string {
+	h := sha256.New()
+	writeString := func(s string) {
+		writeUint(h, uint64(len(s)))
+		h.Write([]byte(s))
+	}
+
+	for _, name := range featureNames {
+		writeString(name)
+	}
+	writeFloat(h, cfg.TrainRatio)
+	writeUint(h, uint64(cfg.Train.Epochs))
+	writeFloat(h, cfg.Train.LearningRate)
+	writeFloat(h, cfg.Train.L2)
+	writeUint(h, cfg.Train.Seed)
+
+	writeCandles(h, candles)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// CandleHash fingerprints the candle data alone.
+func CandleHash(candles []Candle) string {
+	h := sha256.New()
+	writeCandles(h, candles)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func writeCandles(w interface{ Write([]byte) (int, error) }, candles []Candle) {
+	writeUint(w, uint64(len(candles)))
+	for _, c := range candles {
+		writeUint(w, uint64(c.OpenTime.UnixMilli()))
+		writeUint(w, uint64(c.CloseTime.UnixMilli()))
+		writeFloat(w, c.Open)
+		writeFloat(w, c.High)
+		writeFloat(w, c.Low)
+		writeFloat(w, c.Close)
+		writeFloat(w, c.Volume)
+	}
+}
+
+func writeUint(w interface{ Write([]byte) (int, error) }, v uint64) {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], v)
+	w.Write(buf[:])
+}
+
+func writeFloat(w interface{ Write([]byte) (int, error) }, v float64) {
+	writeUint(w, math.Float64bits(v))
+}
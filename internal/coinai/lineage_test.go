@@ -0,0 +1,37 @@
+package coinai
+
+import "testing"
+
+func TestLineageHash(t *testing.T) {
+	candles := mockCandles([]float64{100, 101, 102, 101, 103, 104, 105, 104})
+	cfg := PipelineConfig{
+		TrainRatio: 0.7,
+		Train:      TrainConfig{Epochs: 100, LearningRate: 0.03, L2: 0.001},
+	}
+
+	base := LineageHash(candles, cfg)
+	if len(base) != 64 {
+		t.Fatalf("hash length = %d, want 64", len(base))
+	}
+	if again := LineageHash(mockCandles([]float64{100, 101, 102, 101, 103, 104, 105, 104}), cfg); again != base {
+		t.Fatalf("hash is not deterministic: %s != %s", again, base)
+	}
+
+	changedCfg := cfg
+	changedCfg.Train.Epochs = 101
+	if LineageHash(candles, changedCfg) == base {
+		t.Fatalf("hash did not change with training config")
+	}
+
+	changedData := mockCandles([]float64{100, 101, 102, 101, 103, 104, 105, 104.5})
+	if LineageHash(changedData, cfg) == base {
+		t.Fatalf("hash did not change with candle data")
+	}
+
+	// Backtest settings do not affect the trained weights.
+	backtestCfg := cfg
+	backtestCfg.Backtest.FeeRate = 0.001
+	if LineageHash(candles, backtestCfg) != base {
+		t.Fatalf("hash changed with backtest config")
+	}
+}
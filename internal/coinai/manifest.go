@@ -0,0 +1,236 @@
+package coinai
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"time"
+)
+
+const manifestSchemaVersion = 1
+
+// RunManifest records everything needed to reproduce a training run.
+type RunManifest struct {
+	SchemaVersion int              `json:"schema_version"`
+	CreatedAt     time.Time        `json:"created_at"`
+	CodeVersion   string           `json:"code_version"`
+	GoVersion     string           `json:"go_version"`
+	Data          ManifestData     `json:"data"`
+	Config        ManifestConfig   `json:"config"`
+	Features      ManifestFeatures `json:"features"`
+	LineageHash   string           `json:"lineage_hash"`
+	Metrics       RunMetrics       `json:"metrics"`
+}
+
+type ManifestData struct {
+	Market        string    `json:"market"`
+	DataSource    string    `json:"data_source"`
+	Symbol        string    `json:"symbol"`
+	Interval      string    `json:"interval"`
+	Limit         int       `json:"limit"`
+	StockCSV      string    `json:"stock_csv,omitempty"`
+	Candles       int       `json:"candles"`
+	FirstOpenTime time.Time `json:"first_open_time"`
+	LastOpenTime  time.Time `json:"last_open_time"`
+	LastCloseTime time.Time `json:"last_close_time"`
+	ContentHash   string    `json:"content_hash"`
+	Snapshot      string    `json:"snapshot,omitempty"`
+}
+
+type ManifestConfig struct {
+	TrainRatio     float64 `json:"train_ratio"`
+	Epochs         int     `json:"epochs"`
+	LearningRate   float64 `json:"learning_rate"`
+	L2             float64 `json:"l2"`
+	Seed           uint64  `json:"seed"`
+	LongThreshold  float64 `json:"long_threshold"`
+	ShortThreshold float64 `json:"short_threshold"`
+	FeeRate        float64 `json:"fee_rate"`
+}
+
+type ManifestFeatures struct {
+	Names  []string `json:"names"`
+	Window int      `json:"window"`
+}
+
+type RunMetrics struct {
+	TrainLoss           float64        `json:"train_loss"`
+	TestMSE             float64        `json:"test_mse"`
+	TestDirectionalAcc  float64        `json:"test_directional_acc"`
+	Backtest            BacktestResult `json:"backtest"`
+	NextPredictedReturn float64        `json:"next_predicted_return"`
+	Signal              Signal         `json:"signal"`
+}
+
+type MetricDiff struct {
+	Name     string  `json:"name"`
+	Recorded float64 `json:"recorded"`
+	Replayed float64 `json:"replayed"`
+	Equal    bool    `json:"equal"`
+}
+
+// NewRunManifest describes a finished pipeline run. The data fields that only
+// the caller knows (market, source, symbol, ...) are filled in via data.
+func NewRunManifest(data ManifestData, candles []Candle, cfg PipelineConfig, result *PipelineResult) *RunManifest {
+	data.Candles = len(candles)
+	if len(candles) > 0 {
+		data.FirstOpenTime = candles[0].OpenTime
+		data.LastOpenTime = candles[len(candles)-1].OpenTime
+		data.LastCloseTime = candles[len(candles)-1].CloseTime
+	}
+	data.ContentHash = CandleHash(candles)
+
+	return &RunManifest{
+		SchemaVersion: manifestSchemaVersion,
+		CreatedAt:     time.Now().UTC(),
+		CodeVersion:   CodeVersion(),
+		GoVersion:     runtime.Version(),
+		Data:          data,
+		Config: ManifestConfig{
+			TrainRatio:     cfg.TrainRatio,
+			Epochs:         cfg.Train.Epochs,
+			LearningRate:   cfg.Train.LearningRate,
+			L2:             cfg.Train.L2,
+			Seed:           cfg.Train.Seed,
+			LongThreshold:  cfg.Backtest.LongThreshold,
+			ShortThreshold: cfg.Backtest.ShortThreshold,
+			FeeRate:        cfg.Backtest.FeeRate,
+		},
+		Features: ManifestFeatures{
+			Names:  FeatureNames(),
+			Window: featureWindow,
+		},
+		LineageHash: result.LineageHash,
+		Metrics:     result.RunMetrics(),
+	}
+}
+
+func LoadRunManifest(path string) (*RunManifest, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest: %w", err)
+	}
+	var m RunManifest
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, fmt.Errorf("decode manifest: %w", err)
+	}
+	if m.SchemaVersion != manifestSchemaVersion {
+		return nil, fmt.Errorf("unsupported manifest schema version %d", m.SchemaVersion)
+	}
+	return &m, nil
+}
+
+func (m *RunManifest) WriteFile(path string) error {
+	bytes, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal manifest: %w", err)
+	}
+	if err := os.WriteFile(path, bytes, 0o644); err != nil {
+		return fmt.Errorf("write manifest: %w", err)
+	}
+	return nil
+}
+
+// PipelineConfig rebuilds the exact config the run was trained with.
+func (m *RunManifest) PipelineConfig() PipelineConfig {
+	return PipelineConfig{
+		TrainRatio: m.Config.TrainRatio,
+		Train: TrainConfig{
+			Epochs:       m.Config.Epochs,
+			LearningRate: m.Config.LearningRate,
+			L2:           m.Config.L2,
+			Seed:         m.Config.Seed,
+		},
+		Backtest: BacktestConfig{
+			LongThreshold:  m.Config.LongThreshold,
+			ShortThreshold: m.Config.ShortThreshold,
+			FeeRate:        m.Config.FeeRate,
+		},
+	}
+}
+
+// CheckFeatures reports whether the manifest was produced with the feature
+// set compiled into this binary.
+func (m *RunManifest) CheckFeatures() error {
+	current := FeatureNames()
+	if m.Features.Window != featureWindow || len(m.Features.Names) != len(current) {
+		return fmt.Errorf("feature spec changed since the run")
+	}
+	for i, name := range current {
+		if m.Features.Names[i] != name {
+			return fmt.Errorf("feature spec changed since the run: %q != %q", m.Features.Names[i], name)
+		}
+	}
+	return nil
+}
+
+func (r *PipelineResult) RunMetrics() RunMetrics {
+	return RunMetrics{
+		TrainLoss:           r.TrainLoss,
+		TestMSE:             r.TestMSE,
+		TestDirectionalAcc:  r.TestDirectionalAcc,
+		Backtest:            r.Backtest,
+		NextPredictedReturn: r.NextPredictedReturn,
+		Signal:              r.Signal,
+	}
+}
+
+// DiffMetrics compares two runs metric by metric. Values must match exactly
+// for a run to count as reproduced.
+func DiffMetrics(recorded, replayed RunMetrics) ([]MetricDiff, bool) {
+	pairs := []struct {
+		name string
+		a, b float64
+	}{
+		{"train_loss", recorded.TrainLoss, replayed.TrainLoss},
+		{"test_mse", recorded.TestMSE, replayed.TestMSE},
+		{"test_directional_acc", recorded.TestDirectionalAcc, replayed.TestDirectionalAcc},
+		{"backtest_total_return", recorded.Backtest.TotalReturn, replayed.Backtest.TotalReturn},
+		{"backtest_win_rate", recorded.Backtest.WinRate, replayed.Backtest.WinRate},
+		{"backtest_max_drawdown", recorded.Backtest.MaxDrawdown, replayed.Backtest.MaxDrawdown},
+		{"backtest_sharpe", recorded.Backtest.Sharpe, replayed.Backtest.Sharpe},
+		{"backtest_trades", float64(recorded.Backtest.Trades), float64(replayed.Backtest.Trades)},
+		{"next_predicted_return", recorded.NextPredictedReturn, replayed.NextPredictedReturn},
+	}
+
+	identical := recorded.Signal == replayed.Signal
+	diffs := make([]MetricDiff, 0, len(pairs))
+	for _, p := range pairs {
+		equal := p.a == p.b
+		if !equal {
+			identical = false
+		}
+		diffs = append(diffs, MetricDiff{Name: p.name, Recorded: p.a, Replayed: p.b, Equal: equal})
+	}
+	return diffs, identical
+}
+
+// CodeVersion returns the VCS revision stamped into the binary, with a
+// "-dirty" suffix for modified trees, or the module version when unavailable.
+func CodeVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	var revision, modified string
+	for _, s := range info.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			revision = s.Value
+		case "vcs.modified":
+			modified = s.Value
+		}
+	}
+	if revision == "" {
+		if info.Main.Version != "" {
+			return info.Main.Version
+		}
+		return "unknown"
+	}
+	if modified == "true" {
+		revision += "-dirty"
+	}
+	return revision
+}
@@ -0,0 +1,76 @@
+package coinai
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestCandleSnapshotRoundTrip(t *testing.T) {
+	candles := mockCandles([]float64{100.1, 101.37, 99.999999, 102.5, 103.25, 104.125, 105.0625, 104.03125})
+	path := filepath.Join(t.TempDir(), "candles.json")
+
+	if err := WriteCandleSnapshot(path, candles); err != nil {
+		t.Fatalf("WriteCandleSnapshot error: %v", err)
+	}
+	loaded, err := LoadCandleSnapshot(path)
+	if err != nil {
+		t.Fatalf("LoadCandleSnapshot error: %v", err)
+	}
+	if got, want := CandleHash(loaded), CandleHash(candles); got != want {
+		t.Fatalf("snapshot hash = %s, want %s", got, want)
+	}
+}
+
+func TestReplayReproducesRun(t *testing.T) {
+	closes := make([]float64, 0, 120)
+	price := 100.0
+	for i := 0; i < 120; i++ {
+		price *= 1 + 0.01*float64((i*7)%5-2)/2
+		closes = append(closes, price)
+	}
+	candles := mockCandles(closes)
+	cfg := PipelineConfig{
+		TrainRatio: 0.7,
+		Train:      TrainConfig{Epochs: 200, LearningRate: 0.03, L2: 0.001, Seed: 7},
+		Backtest:   BacktestConfig{LongThreshold: 0.0015, ShortThreshold: -0.0015, FeeRate: 0.0004},
+	}
+
+	result, err := RunPipeline(candles, cfg)
+	if err != nil {
+		t.Fatalf("RunPipeline error: %v", err)
+	}
+	manifest := NewRunManifest(ManifestData{Market: "stock", DataSource: "csv", Symbol: "TEST", Interval: "1d"}, candles, cfg, result)
+
+	path := filepath.Join(t.TempDir(), "run.json")
+	if err := manifest.WriteFile(path); err != nil {
+		t.Fatalf("WriteFile error: %v", err)
+	}
+	loaded, err := LoadRunManifest(path)
+	if err != nil {
+		t.Fatalf("LoadRunManifest error: %v", err)
+	}
+	if err := loaded.CheckFeatures(); err != nil {
+		t.Fatalf("CheckFeatures error: %v", err)
+	}
+	if loaded.Data.ContentHash != CandleHash(candles) {
+		t.Fatalf("content hash mismatch")
+	}
+
+	replayed, err := RunPipeline(candles, loaded.PipelineConfig())
+	if err != nil {
+		t.Fatalf("replay RunPipeline error: %v", err)
+	}
+	diffs, identical := DiffMetrics(loaded.Metrics, replayed.RunMetrics())
+	if !identical {
+		t.Fatalf("replay differs: %+v", diffs)
+	}
+	if replayed.LineageHash != loaded.LineageHash {
+		t.Fatalf("lineage hash = %s, want %s", replayed.LineageHash, loaded.LineageHash)
+	}
+
+	changed := loaded.Metrics
+	changed.TestMSE += 1e-12
+	if _, identical := DiffMetrics(loaded.Metrics, changed); identical {
+		t.Fatalf("DiffMetrics did not detect a changed metric")
+	}
+}
@@ -2,6 +2,7 @@ package coinai
 
 import (
 	"fmt"
+	"math/rand/v2"
 )
 
 type LinearModel struct {
@@ -57,6 +58,13 @@ func (m *LinearModel) Train(data [][]float64, targets []float64, cfg TrainConfig
 			return TrainStats{}, fmt.Errorf("inconsistent feature dimensions")
 		}
 	}
+	if cfg.Seed != 0 {
+		rng := rand.New(rand.NewPCG(cfg.Seed, cfg.Seed))
+		for j := range m.Weights {
+			m.Weights[j] = rng.NormFloat64() * 0.01
+		}
+		m.Bias = 0
+	}
 
 	for i := 0; i < cfg.Epochs; i++ {
 		gradW := make([]float64, featureCount)
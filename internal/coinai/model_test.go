@@ -38,3 +38,25 @@ func TestLinearModelTrain(t *testing.T) {
 		t.Fatalf("directional accuracy = %f, want >= 0.95", acc)
 	}
 }
+
+func TestLinearModelTrainSeed(t *testing.T) {
+	trainX := [][]float64{{1, 0}, {0, 1}, {1, 1}, {-1, 0.5}}
+	trainY := []float64{0.2, -0.1, 0.1, -0.3}
+	train := func(seed uint64) *LinearModel {
+		model := NewLinearModel(2)
+		if _, err := model.Train(trainX, trainY, TrainConfig{Epochs: 3, LearningRate: 0.01, Seed: seed}); err != nil {
+			t.Fatalf("Train returned error: %v", err)
+		}
+		return model
+	}
+
+	a, b, c := train(11), train(11), train(12)
+	for i := range a.Weights {
+		if a.Weights[i] != b.Weights[i] {
+			t.Fatalf("same seed produced different weights: %v vs %v", a.Weights, b.Weights)
+		}
+	}
+	if a.Weights[0] == c.Weights[0] && a.Weights[1] == c.Weights[1] {
+		t.Fatalf("different seeds produced identical weights: %v", a.Weights)
+	}
+}
@@ -0,0 +1,310 @@
+package coinai
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+)
+
+type MonitorStatus string
+
+const (
+	MonitorStatusOK      MonitorStatus = "OK"
+	MonitorStatusWarning MonitorStatus = "WARNING"
+	MonitorStatusAlert   MonitorStatus = "ALERT"
+)
+
+type MonitorConfig struct {
+	Window         int
+	MinResolved    int
+	MinAccuracy    float64
+	MaxMSE         float64
+	LongThreshold  float64
+	ShortThreshold float64
+	FeeRate        float64
+	DriftBins      int
+	PSIWarning     float64
+	PSIAlert       float64
+	KSAlert        float64
+}
+
+func DefaultMonitorConfig() MonitorConfig {
+	return MonitorConfig{
+		Window:         100,
+		MinResolved:    20,
+		MinAccuracy:    0.5,
+		LongThreshold:  DefaultLongThreshold,
+		ShortThreshold: DefaultShortThreshold,
+		DriftBins:      10,
+		PSIWarning:     0.1,
+		PSIAlert:       0.25,
+		KSAlert:        0.2,
+	}
+}
+
+type PredictionRecord struct {
+	Time       time.Time `json:"time"`
+	Features   []float64 `json:"features"`
+	Prediction float64   `json:"prediction"`
+	Realised   *float64  `json:"realised,omitempty"`
+}
+
+func (r PredictionRecord) Resolved() bool {
+	return r.Realised != nil
+}
+
+type FeatureDrift struct {
+	Feature string        `json:"feature"`
+	PSI     float64       `json:"psi"`
+	KS      float64       `json:"ks"`
+	Status  MonitorStatus `json:"status"`
+}
+
+type MonitorReport struct {
+	Predictions    int            `json:"predictions"`
+	Resolved       int            `json:"resolved"`
+	Window         int            `json:"window"`
+	DirectionalAcc float64        `json:"directional_acc"`
+	MSE            float64        `json:"mse"`
+	PnL            float64        `json:"pnl"`
+	Drift          []FeatureDrift `json:"drift"`
+	Status         MonitorStatus  `json:"status"`
+	Reasons        []string       `json:"reasons,omitempty"`
+}
+
+type Monitor struct {
+	Records []PredictionRecord `json:"records"`
+}
+
+func LoadMonitor(path string) (*Monitor, error) {
+	if path == "" {
+		return nil, fmt.Errorf("monitor state path is required")
+	}
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &Monitor{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read monitor state: %w", err)
+	}
+
+	var m Monitor
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, fmt.Errorf("decode monitor state: %w", err)
+	}
+	return &m, nil
+}
+
+func (m *Monitor) WriteFile(path string) error {
+	bytes, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal monitor state: %w", err)
+	}
+	if err := os.WriteFile(path, bytes, 0o644); err != nil {
+		return fmt.Errorf("write monitor state: %w", err)
+	}
+	return nil
+}
+
+// RecordPrediction stores a prediction made on the candle that closed at t.
+// It reports false when a prediction for t has already been recorded.
+func (m *Monitor) RecordPrediction(t time.Time, features []float64, prediction float64) bool {
+	for _, r := range m.Records {
+		if r.Time.Equal(t) {
+			return false
+		}
+	}
+
+	stored := make([]float64, len(features))
+	copy(stored, features)
+	m.Records = append(m.Records, PredictionRecord{
+		Time:       t.UTC(),
+		Features:   stored,
+		Prediction: prediction,
+	})
+	sort.SliceStable(m.Records, func(i, j int) bool {
+		return m.Records[i].Time.Before(m.Records[j].Time)
+	})
+	return true
+}
+
+// ResolveFromCandles fills in the realised return of every pending record
+// whose following candle is present in candles, and returns how many
+// records were resolved. Callers must pass closed candles only.
+func (m *Monitor) ResolveFromCandles(candles []Candle) (int, error) {
+	byClose := make(map[int64]int, len(candles))
+	for i, c := range candles {
+		byClose[c.CloseTime.UnixMilli()] = i
+	}
+
+	resolved := 0
+	for idx := range m.Records {
+		record := &m.Records[idx]
+		if record.Resolved() {
+			continue
+		}
+		i, ok := byClose[record.Time.UnixMilli()]
+		if !ok || i+1 >= len(candles) {
+			continue
+		}
+		realised, err := pctChange(candles[i+1].Close, candles[i].Close)
+		if err != nil {
+			return resolved, fmt.Errorf("realised return at %s: %w", record.Time.Format(time.RFC3339), err)
+		}
+		record.Realised = &realised
+		resolved++
+	}
+	return resolved, nil
+}
+
+func (m *Monitor) Evaluate(scaler StandardScaler, featureNames []string, cfg MonitorConfig) (MonitorReport, error) {
+	if cfg.Window <= 0 {
+		return MonitorReport{}, fmt.Errorf("window must be greater than 0")
+	}
+	if cfg.LongThreshold <= cfg.ShortThreshold {
+		return MonitorReport{}, fmt.Errorf("long threshold must be greater than short threshold")
+	}
+
+	report := MonitorReport{
+		Predictions: len(m.Records),
+		Window:      cfg.Window,
+		Status:      MonitorStatusOK,
+	}
+
+	preds := make([]float64, 0, cfg.Window)
+	actuals := make([]float64, 0, cfg.Window)
+	for i := len(m.Records) - 1; i >= 0 && len(preds) < cfg.Window; i-- {
+		r := m.Records[i]
+		if !r.Resolved() {
+			continue
+		}
+		preds = append(preds, r.Prediction)
+		actuals = append(actuals, *r.Realised)
+	}
+	reverseFloats(preds)
+	reverseFloats(actuals)
+	report.Resolved = len(preds)
+
+	if len(preds) > 0 {
+		report.DirectionalAcc = DirectionalAccuracy(preds, actuals)
+		report.MSE = MeanSquaredError(preds, actuals)
+		backtest, err := Backtest(preds, actuals, BacktestConfig{
+			LongThreshold:  cfg.LongThreshold,
+			ShortThreshold: cfg.ShortThreshold,
+			FeeRate:        cfg.FeeRate,
+		})
+		if err != nil {
+			return MonitorReport{}, fmt.Errorf("rolling pnl: %w", err)
+		}
+		report.PnL = backtest.TotalReturn
+	}
+
+	if len(preds) >= cfg.MinResolved && len(preds) > 0 {
+		if cfg.MinAccuracy > 0 && report.DirectionalAcc < cfg.MinAccuracy {
+			report.raise(MonitorStatusAlert, fmt.Sprintf("directional accuracy %.4f below %.4f", report.DirectionalAcc, cfg.MinAccuracy))
+		}
+		if cfg.MaxMSE > 0 && report.MSE > cfg.MaxMSE {
+			report.raise(MonitorStatusAlert, fmt.Sprintf("mse %.8f above %.8f", report.MSE, cfg.MaxMSE))
+		}
+	}
+
+	drift, err := m.featureDrift(scaler, featureNames, cfg)
+	if err != nil {
+		return MonitorReport{}, err
+	}
+	for _, d := range drift {
+		switch d.Status {
+		case MonitorStatusAlert:
+			report.raise(MonitorStatusAlert, fmt.Sprintf("feature %s drifted (psi=%.4f ks=%.4f)", d.Feature, d.PSI, d.KS))
+		case MonitorStatusWarning:
+			report.raise(MonitorStatusWarning, fmt.Sprintf("feature %s shifting (psi=%.4f)", d.Feature, d.PSI))
+		}
+	}
+	report.Drift = drift
+
+	return report, nil
+}
+
+func (m *Monitor) featureDrift(scaler StandardScaler, featureNames []string, cfg MonitorConfig) ([]FeatureDrift, error) {
+	start := len(m.Records) - cfg.Window
+	if start < 0 {
+		start = 0
+	}
+	window := m.Records[start:]
+	if len(window) == 0 || len(window) < cfg.MinResolved {
+		return nil, nil
+	}
+
+	featureCount := len(scaler.Means)
+	columns := make([][]float64, featureCount)
+	for _, r := range window {
+		if len(r.Features) != featureCount {
+			return nil, fmt.Errorf("record at %s has %d features, scaler expects %d", r.Time.Format(time.RFC3339), len(r.Features), featureCount)
+		}
+		for j, v := range r.Features {
+			columns[j] = append(columns[j], v)
+		}
+	}
+
+	bins := cfg.DriftBins
+	if bins < 2 {
+		bins = 10
+	}
+
+	out := make([]FeatureDrift, 0, featureCount)
+	for j, values := range columns {
+		psi, err := PopulationStabilityIndex(values, scaler.Means[j], scaler.Stds[j], bins)
+		if err != nil {
+			return nil, fmt.Errorf("psi feature %d: %w", j, err)
+		}
+		ks, err := KolmogorovSmirnov(values, scaler.Means[j], scaler.Stds[j])
+		if err != nil {
+			return nil, fmt.Errorf("ks feature %d: %w", j, err)
+		}
+
+		name := fmt.Sprintf("feature_%d", j)
+		if j < len(featureNames) {
+			name = featureNames[j]
+		}
+
+		status := MonitorStatusOK
+		switch {
+		case (cfg.PSIAlert > 0 && psi >= cfg.PSIAlert) || (cfg.KSAlert > 0 && ks >= cfg.KSAlert):
+			status = MonitorStatusAlert
+		case cfg.PSIWarning > 0 && psi >= cfg.PSIWarning:
+			status = MonitorStatusWarning
+		}
+
+		out = append(out, FeatureDrift{
+			Feature: name,
+			PSI:     psi,
+			KS:      ks,
+			Status:  status,
+		})
+	}
+	return out, nil
+}
+
+func (r *MonitorReport) raise(status MonitorStatus, reason string) {
+	if status == MonitorStatusAlert || r.Status == MonitorStatusOK {
+		r.Status = status
+	}
+	r.Reasons = append(r.Reasons, reason)
+}
+
+func ClosedCandles(candles []Candle, now time.Time) []Candle {
+	end := len(candles)
+	for end > 0 && candles[end-1].CloseTime.After(now) {
+		end--
+	}
+	return candles[:end]
+}
+
+func reverseFloats(values []float64) {
+	for i, j := 0, len(values)-1; i < j; i, j = i+1, j-1 {
+		values[i], values[j] = values[j], values[i]
+	}
+}
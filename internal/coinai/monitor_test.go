@@ -0,0 +1,148 @@
+package coinai
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestPopulationStabilityIndex(t *testing.T) {
+	stable := normalSamples(500, 0, 1)
+	psi, err := PopulationStabilityIndex(stable, 0, 1, 10)
+	if err != nil {
+		t.Fatalf("PopulationStabilityIndex returned error: %v", err)
+	}
+	if psi > 0.01 {
+		t.Fatalf("psi for matching distribution = %f, want <= 0.01", psi)
+	}
+
+	shifted := normalSamples(500, 1.5, 1)
+	psi, err = PopulationStabilityIndex(shifted, 0, 1, 10)
+	if err != nil {
+		t.Fatalf("PopulationStabilityIndex returned error: %v", err)
+	}
+	if psi < 0.25 {
+		t.Fatalf("psi for shifted distribution = %f, want >= 0.25", psi)
+	}
+}
+
+func TestKolmogorovSmirnov(t *testing.T) {
+	ks, err := KolmogorovSmirnov(normalSamples(500, 0, 1), 0, 1)
+	if err != nil {
+		t.Fatalf("KolmogorovSmirnov returned error: %v", err)
+	}
+	if ks > 0.01 {
+		t.Fatalf("ks for matching distribution = %f, want <= 0.01", ks)
+	}
+
+	ks, err = KolmogorovSmirnov(normalSamples(500, 0, 3), 0, 1)
+	if err != nil {
+		t.Fatalf("KolmogorovSmirnov returned error: %v", err)
+	}
+	if ks < 0.2 {
+		t.Fatalf("ks for wider distribution = %f, want >= 0.2", ks)
+	}
+}
+
+func TestMonitorResolveAndEvaluate(t *testing.T) {
+	candles := mockCandles([]float64{100, 101, 102, 103, 104, 105, 104, 106})
+	scaler := StandardScaler{Means: []float64{0}, Stds: []float64{1}}
+
+	m := &Monitor{}
+	for i := 4; i < len(candles); i++ {
+		pred := 0.01
+		if i == 5 {
+			pred = -0.01
+		}
+		if !m.RecordPrediction(candles[i].CloseTime, []float64{0}, pred) {
+			t.Fatalf("prediction %d was not recorded", i)
+		}
+	}
+	if m.RecordPrediction(candles[4].CloseTime, []float64{0}, 0.5) {
+		t.Fatal("duplicate prediction was recorded")
+	}
+
+	resolved, err := m.ResolveFromCandles(candles)
+	if err != nil {
+		t.Fatalf("ResolveFromCandles returned error: %v", err)
+	}
+	if got, want := resolved, 3; got != want {
+		t.Fatalf("resolved = %d, want %d", got, want)
+	}
+	if m.Records[len(m.Records)-1].Resolved() {
+		t.Fatal("latest prediction should still be pending")
+	}
+
+	cfg := DefaultMonitorConfig()
+	cfg.MinResolved = 1
+	cfg.MinAccuracy = 0.9
+	cfg.PSIAlert = 0
+	cfg.PSIWarning = 0
+	cfg.KSAlert = 0
+	report, err := m.Evaluate(scaler, []string{"f"}, cfg)
+	if err != nil {
+		t.Fatalf("Evaluate returned error: %v", err)
+	}
+
+	// Realised returns: +0.96%, -0.95%, +1.92%; predictions: +, -, +.
+	if got, want := report.DirectionalAcc, 1.0; !closeEnough(got, want, 1e-12) {
+		t.Fatalf("directional accuracy = %f, want %f", got, want)
+	}
+	if got, want := report.Status, MonitorStatusOK; got != want {
+		t.Fatalf("status = %s, want %s", got, want)
+	}
+	if got, want := len(report.Drift), 1; got != want {
+		t.Fatalf("len(drift) = %d, want %d", got, want)
+	}
+}
+
+func TestMonitorAlertsOnDrift(t *testing.T) {
+	m := &Monitor{}
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i, v := range normalSamples(100, 4, 1) {
+		m.RecordPrediction(base.Add(time.Duration(i)*time.Hour), []float64{v}, 0)
+	}
+
+	report, err := m.Evaluate(StandardScaler{Means: []float64{0}, Stds: []float64{1}}, []string{"ret_1"}, DefaultMonitorConfig())
+	if err != nil {
+		t.Fatalf("Evaluate returned error: %v", err)
+	}
+	if got, want := report.Status, MonitorStatusAlert; got != want {
+		t.Fatalf("status = %s, want %s", got, want)
+	}
+	if got, want := report.Drift[0].Status, MonitorStatusAlert; got != want {
+		t.Fatalf("drift status = %s, want %s", got, want)
+	}
+}
+
+func TestMonitorStateRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "monitor.json")
+
+	m, err := LoadMonitor(path)
+	if err != nil {
+		t.Fatalf("LoadMonitor on missing file returned error: %v", err)
+	}
+	m.RecordPrediction(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), []float64{1, 2}, 0.02)
+	if err := m.WriteFile(path); err != nil {
+		t.Fatalf("WriteFile returned error: %v", err)
+	}
+
+	loaded, err := LoadMonitor(path)
+	if err != nil {
+		t.Fatalf("LoadMonitor returned error: %v", err)
+	}
+	if got, want := len(loaded.Records), 1; got != want {
+		t.Fatalf("len(records) = %d, want %d", got, want)
+	}
+	if got, want := loaded.Records[0].Prediction, 0.02; got != want {
+		t.Fatalf("prediction = %f, want %f", got, want)
+	}
+}
+
+func normalSamples(n int, mean, std float64) []float64 {
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = mean + std*normalQuantile((float64(i)+0.5)/float64(n))
+	}
+	return out
+}
@@ -0,0 +1,157 @@
+package coinai
+
+import (
+	"fmt"
+	"time"
+)
+
+type PipelineConfig struct {
+	TrainRatio float64
+	Train      TrainConfig
+	Backtest   BacktestConfig
+}
+
+type PipelineResult struct {
+	Candles             int
+	LineageHash         string
+	TrainSamples        []Sample
+	TestSamples         []Sample
+	Scaler              *StandardScaler
+	Model               *LinearModel
+	TrainLoss           float64
+	TestPredictions     []float64
+	TestMSE             float64
+	TestDirectionalAcc  float64
+	Backtest            BacktestResult
+	BacktestBars        []BacktestBar
+	Trades              []Trade
+	NextPredictedReturn float64
+	Signal              Signal
+}
+
+type ValidationMetrics struct {
+	MSE            float64 `json:"mse"`
+	DirectionalAcc float64 `json:"directional_acc"`
+}
+
+func RunPipeline(candles []Candle, cfg PipelineConfig) (*PipelineResult, error) {
+	samples, err := BuildDataset(candles)
+	if err != nil {
+		return nil, fmt.Errorf("build dataset: %w", err)
+	}
+
+	trainSamples, testSamples, err := SplitSequential(samples, cfg.TrainRatio)
+	if err != nil {
+		return nil, fmt.Errorf("split dataset: %w", err)
+	}
+
+	trainX, trainY := SamplesToXY(trainSamples)
+	testX, testY := SamplesToXY(testSamples)
+
+	scaler := NewStandardScaler(len(trainX[0]))
+	if err := scaler.Fit(trainX); err != nil {
+		return nil, fmt.Errorf("fit scaler: %w", err)
+	}
+	trainXNorm, err := scaler.TransformBatch(trainX)
+	if err != nil {
+		return nil, fmt.Errorf("normalize train data: %w", err)
+	}
+	testXNorm, err := scaler.TransformBatch(testX)
+	if err != nil {
+		return nil, fmt.Errorf("normalize test data: %w", err)
+	}
+
+	model := NewLinearModel(len(trainXNorm[0]))
+	stats, err := model.Train(trainXNorm, trainY, cfg.Train)
+	if err != nil {
+		return nil, fmt.Errorf("train model: %w", err)
+	}
+
+	preds := model.PredictBatch(testXNorm)
+	backtest, err := BacktestDetailed(preds, testY, cfg.Backtest)
+	if err != nil {
+		return nil, fmt.Errorf("backtest: %w", err)
+	}
+
+	latestFeatures, err := BuildLatestFeatures(candles)
+	if err != nil {
+		return nil, fmt.Errorf("latest features: %w", err)
+	}
+	latestNorm, err := scaler.Transform(latestFeatures)
+	if err != nil {
+		return nil, fmt.Errorf("normalize latest feature: %w", err)
+	}
+	nextPred := model.Predict(latestNorm)
+
+	return &PipelineResult{
+		Candles:             len(candles),
+		LineageHash:         LineageHash(candles, cfg),
+		TrainSamples:        trainSamples,
+		TestSamples:         testSamples,
+		Scaler:              scaler,
+		Model:               model,
+		TrainLoss:           stats.FinalLoss,
+		TestPredictions:     preds,
+		TestMSE:             MeanSquaredError(preds, testY),
+		TestDirectionalAcc:  DirectionalAccuracy(preds, testY),
+		Backtest:            backtest.Result,
+		BacktestBars:        backtest.Bars,
+		Trades:              backtest.Trades,
+		NextPredictedReturn: nextPred,
+		Signal:              SignalFromPrediction(nextPred, cfg.Backtest.LongThreshold, cfg.Backtest.ShortThreshold),
+	}, nil
+}
+
+func (r *PipelineResult) SavedModel(market, dataSource, symbol, interval string) *SavedModel {
+	return &SavedModel{
+		LineageHash:  r.LineageHash,
+		Market:       market,
+		DataSource:   dataSource,
+		Symbol:       symbol,
+		Interval:     interval,
+		FeatureNames: FeatureNames(),
+		Scaler:       *r.Scaler,
+		Model:        *r.Model,
+		TrainedAt:    time.Now().UTC(),
+	}
+}
+
+func (r *PipelineResult) ValidationMetrics() ValidationMetrics {
+	return ValidationMetrics{
+		MSE:            r.TestMSE,
+		DirectionalAcc: r.TestDirectionalAcc,
+	}
+}
+
+// Validate scores a saved model on samples built from raw candles, so a
+// candidate and the model it would replace can be compared on the same data.
+func (m *SavedModel) Validate(samples []Sample) (ValidationMetrics, error) {
+	if len(samples) == 0 {
+		return ValidationMetrics{}, fmt.Errorf("empty validation samples")
+	}
+
+	x, y := SamplesToXY(samples)
+	preds := make([]float64, 0, len(x))
+	for _, row := range x {
+		pred, err := m.Predict(row)
+		if err != nil {
+			return ValidationMetrics{}, err
+		}
+		preds = append(preds, pred)
+	}
+
+	return ValidationMetrics{
+		MSE:            MeanSquaredError(preds, y),
+		DirectionalAcc: DirectionalAccuracy(preds, y),
+	}, nil
+}
+
+func SamplesToXY(samples []Sample) ([][]float64, []float64) {
+	x := make([][]float64, 0, len(samples))
+	y := make([]float64, 0, len(samples))
+	for _, sample := range samples {
+		x = append(x, sample.Features)
+		y = append(y, sample.Target)
+	}
+	return x, y
+}
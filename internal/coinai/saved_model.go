@@ -0,0 +1,62 @@
+package coinai
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+type SavedModel struct {
+	Version      int            `json:"version,omitempty"`
+	LineageHash  string         `json:"lineage_hash,omitempty"`
+	Market       string         `json:"market"`
+	DataSource   string         `json:"data_source"`
+	Symbol       string         `json:"symbol"`
+	Interval     string         `json:"interval"`
+	FeatureNames []string       `json:"feature_names"`
+	Scaler       StandardScaler `json:"scaler"`
+	Model        LinearModel    `json:"model"`
+	TrainedAt    time.Time      `json:"trained_at"`
+}
+
+func LoadSavedModel(path string) (*SavedModel, error) {
+	if path == "" {
+		return nil, fmt.Errorf("model path is required")
+	}
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read model file: %w", err)
+	}
+
+	var saved SavedModel
+	if err := json.Unmarshal(raw, &saved); err != nil {
+		return nil, fmt.Errorf("decode model file: %w", err)
+	}
+	if len(saved.Model.Weights) == 0 {
+		return nil, fmt.Errorf("model has no weights")
+	}
+	if len(saved.Scaler.Means) != len(saved.Model.Weights) || len(saved.Scaler.Stds) != len(saved.Model.Weights) {
+		return nil, fmt.Errorf("scaler dimensions do not match model weights")
+	}
+	return &saved, nil
+}
+
+func (m *SavedModel) WriteFile(path string) error {
+	bytes, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal model: %w", err)
+	}
+	if err := os.WriteFile(path, bytes, 0o644); err != nil {
+		return fmt.Errorf("write model file: %w", err)
+	}
+	return nil
+}
+
+func (m *SavedModel) Predict(features []float64) (float64, error) {
+	normalized, err := m.Scaler.Transform(features)
+	if err != nil {
+		return 0, err
+	}
+	return m.Model.Predict(normalized), nil
+}
@@ -0,0 +1,27 @@
+package coinai
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Default signal thresholds on the predicted next-candle return: at or
+// above DefaultLongThreshold is a BUY, at or below DefaultShortThreshold a
+// SELL.
+const (
+	DefaultLongThreshold  = 0.0015
+	DefaultShortThreshold = -0.0015
+)
+
+// CandleFetcher loads the latest candles for a symbol/interval.
+type CandleFetcher interface {
+	FetchKlines(ctx context.Context, symbol, interval string, limit int) ([]Candle, error)
+}
+
+// ModelStore serves the model currently in production for an organization's
+// symbol/interval.
+type ModelStore interface {
+	// Current returns (nil, nil) when no model has been promoted yet.
+	Current(ctx context.Context, orgID uuid.UUID, symbol, interval string) (*SavedModel, error)
+}
@@ -0,0 +1,68 @@
+package coinai
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// snapshotCandle stores times as Unix milliseconds so a snapshot round-trips
+// exactly; Go's JSON float encoding is already lossless.
+type snapshotCandle struct {
+	OpenTime  int64   `json:"open_time"`
+	CloseTime int64   `json:"close_time"`
+	Open      float64 `json:"open"`
+	High      float64 `json:"high"`
+	Low       float64 `json:"low"`
+	Close     float64 `json:"close"`
+	Volume    float64 `json:"volume"`
+}
+
+func WriteCandleSnapshot(path string, candles []Candle) error {
+	rows := make([]snapshotCandle, 0, len(candles))
+	for _, c := range candles {
+		rows = append(rows, snapshotCandle{
+			OpenTime:  c.OpenTime.UnixMilli(),
+			CloseTime: c.CloseTime.UnixMilli(),
+			Open:      c.Open,
+			High:      c.High,
+			Low:       c.Low,
+			Close:     c.Close,
+			Volume:    c.Volume,
+		})
+	}
+	bytes, err := json.Marshal(rows)
+	if err != nil {
+		return fmt.Errorf("marshal snapshot: %w", err)
+	}
+	if err := os.WriteFile(path, bytes, 0o644); err != nil {
+		return fmt.Errorf("write snapshot: %w", err)
+	}
+	return nil
+}
+
+func LoadCandleSnapshot(path string) ([]Candle, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read snapshot: %w", err)
+	}
+	var rows []snapshotCandle
+	if err := json.Unmarshal(raw, &rows); err != nil {
+		return nil, fmt.Errorf("decode snapshot: %w", err)
+	}
+
+	candles := make([]Candle, 0, len(rows))
+	for _, r := range rows {
+		candles = append(candles, Candle{
+			OpenTime:  time.UnixMilli(r.OpenTime).UTC(),
+			CloseTime: time.UnixMilli(r.CloseTime).UTC(),
+			Open:      r.Open,
+			High:      r.High,
+			Low:       r.Low,
+			Close:     r.Close,
+			Volume:    r.Volume,
+		})
+	}
+	return candles, nil
+}
@@ -22,6 +22,9 @@ type TrainConfig struct {
 	Epochs       int
 	LearningRate float64
 	L2           float64
+	// Seed, when non-zero, initialises weights with small pseudo-random
+	// values. The same seed always yields the same starting point.
+	Seed uint64
 }
 
 type TrainStats struct {
@@ -42,6 +45,33 @@ type BacktestResult struct {
 	Trades      int
 }
 
+// BacktestBar is the simulated state after one test bar.
+type BacktestBar struct {
+	Position int
+	Turnover int
+	Fee      float64
+	Return   float64
+	Equity   float64
+	Drawdown float64
+}
+
+// Trade is one contiguous long (Side 1) or short (Side -1) holding.
+// ExitIndex is the bar on which it was closed, or the last bar when Open.
+type Trade struct {
+	Side       int
+	EntryIndex int
+	ExitIndex  int
+	Bars       int
+	Return     float64
+	Open       bool
+}
+
+type BacktestDetail struct {
+	Result BacktestResult
+	Bars   []BacktestBar
+	Trades []Trade
+}
+
 type Signal string
 
 const (
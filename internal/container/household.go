@@ -0,0 +1,41 @@
+package container
+
+import (
+	childapp "go-ai/internal/household/application/child"
+	"go-ai/internal/household/domain/child"
+	"go-ai/internal/household/infrastructure/db"
+	householdhttp "go-ai/internal/household/transport/http"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+type HouseholdModule struct {
+	Handler  *householdhttp.ChildHandler
+	Repo     child.Repository
+	UserData *childapp.UserData
+}
+
+func InitHouseholdModule(pool *pgxpool.Pool, log zerolog.Logger) *HouseholdModule {
+	childRepo := db.NewChildRepo(pool)
+
+	handler := householdhttp.NewChildHandler(
+		childapp.NewCreateChildUseCase(childRepo),
+		childapp.NewListChildrenUseCase(childRepo),
+		childapp.NewGetChildUseCase(childRepo),
+		childapp.NewUpdateChildUseCase(childRepo),
+		childapp.NewDeleteChildUseCase(childRepo),
+		childapp.NewListGuardiansUseCase(childRepo),
+		childapp.NewAddGuardianUseCase(childRepo),
+		childapp.NewUpdateGuardianUseCase(childRepo),
+		childapp.NewRemoveGuardianUseCase(childRepo),
+		childapp.NewSetPrimaryGuardianUseCase(childRepo),
+		log,
+	)
+
+	return &HouseholdModule{
+		Handler:  handler,
+		Repo:     childRepo,
+		UserData: childapp.NewUserData(childRepo),
+	}
+}
@@ -1,13 +1,26 @@
 package container
 
 import (
+	apikeyapp "go-ai/internal/identity/application/apikey"
+	auditapp "go-ai/internal/identity/application/audit"
 	authapp "go-ai/internal/identity/application/auth"
+	orgapp "go-ai/internal/identity/application/org"
+	rbacapp "go-ai/internal/identity/application/rbac"
+	userapp "go-ai/internal/identity/application/user"
+	"go-ai/internal/identity/domain/apikey"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/auth"
 	"go-ai/internal/identity/domain/rbac"
 	"go-ai/internal/identity/infrastructure/cache"
 	"go-ai/internal/identity/infrastructure/db"
 	identityhttp "go-ai/internal/identity/transport/http"
 	middlewares "go-ai/internal/identity/transport/middlewares"
 	"go-ai/internal/platform/config"
+	"go-ai/pkg/jwtkeys"
+	"go-ai/pkg/lock"
+	"go-ai/pkg/mailer"
+	"go-ai/pkg/sms"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/redis/go-redis/v9"
@@ -15,26 +28,49 @@ import (
 )
 
 type IdentityModule struct {
-	Handler     *identityhttp.AuthHandler
-	Middleware  *middlewares.IdentityMiddleware
-	RbacService rbac.Service
+	Handler        *identityhttp.AuthHandler
+	SessionHandler *identityhttp.SessionHandler
+	AccountHandler *identityhttp.AccountHandler
+	MFAHandler     *identityhttp.MFAHandler
+	PhoneHandler   *identityhttp.PhoneHandler
+	APIKeyHandler  *identityhttp.APIKeyHandler
+	OAuthHandler   *identityhttp.OAuthHandler
+	RbacHandler    *identityhttp.RbacHandler
+	UserHandler    *identityhttp.UserHandler
+	AuditHandler   *identityhttp.AuditHandler
+	OrgHandler     *identityhttp.OrgHandler
+	JWKSHandler    *identityhttp.JWKSHandler
+	Middleware     *middlewares.IdentityMiddleware
+	RbacService    rbac.Service
+	AccountData    *authapp.AccountData
+	AccountPurger  *authapp.AccountPurger
 }
 
-func InitIdentityModule(pool *pgxpool.Pool, redis *redis.Client, config *config.Config, log zerolog.Logger) *IdentityModule {
+func InitIdentityModule(pool *pgxpool.Pool, redis *redis.Client, mail mailer.Mailer, texts sms.Sender, tokens jwtkeys.Issuer, passwords *authapp.Passwords, config *config.Config, log zerolog.Logger) *IdentityModule {
+
+	securityLog := log.With().Str("component", "security").Logger()
+	auditRepo := db.NewAuditRepo(pool)
+	auditLog := audit.NewRecorder(auditRepo, securityLog)
 
 	rbacRepo := db.NewRbacRepo(pool)
-	rbacService := rbac.Service{Repo: rbacRepo}
+	rbacCache := cache.NewRbacCache(redis)
+	rbacService := rbac.Service{Repo: rbacRepo, Cache: rbacCache, Audit: auditLog}
 
 	authRepo := db.NewAuthRepo(pool)
 	authCache := cache.NewAuthCache(redis)
+	mfaRepo := db.NewMFARepo(pool)
+	mfaCache := cache.NewMFACache(redis)
+	loginAttempts := cache.NewLoginAttemptCache(redis)
+	loginGuard := authapp.NewLoginGuard(loginAttempts, lockoutPolicy(config), securityLog)
 
-	registerUseCase := authapp.NewRegisterUseCase(authRepo)
-	loginUseCase := authapp.NewLoginUseCase(authRepo, authCache, config)
-	refreshUseCase := authapp.NewRefreshTokenUseCase(authRepo, authCache, config)
+	sendVerificationUseCase := authapp.NewSendVerificationEmailUseCase(authRepo, authRepo, mail, config)
+	registerUseCase := authapp.NewRegisterUseCase(authRepo, passwords, sendVerificationUseCase)
+	loginUseCase := authapp.NewLoginUseCase(authRepo, authCache, mfaCache, loginGuard, passwords, tokens, config, auditLog)
+	refreshUseCase := authapp.NewRefreshTokenUseCase(authRepo, authCache, tokens, config)
 	profileUseCase := authapp.NewGetProfileUseCase(authRepo, authCache)
-	changePasswordUseCase := authapp.NewChangePasswordUseCase(authRepo)
-	updateProfileUseCase := authapp.NewUpdateProfileUseCase(authRepo, authCache)
-	logoutUseCase := authapp.NewLogoutUseCase(authRepo, authCache)
+	changePasswordUseCase := authapp.NewChangePasswordUseCase(authRepo, passwords, authCache, auditLog)
+	updateProfileUseCase := authapp.NewUpdateProfileUseCase(authRepo, authCache, config, auditLog)
+	logoutUseCase := authapp.NewLogoutUseCase(authRepo, authCache, auditLog)
 	handler := identityhttp.NewAuthHandler(
 		registerUseCase,
 		loginUseCase,
@@ -46,11 +82,144 @@ func InitIdentityModule(pool *pgxpool.Pool, redis *redis.Client, config *config.
 		log,
 	)
 
-	middleware := middlewares.NewIdentityMiddleware(authCache, config)
+	sessionHandler := identityhttp.NewSessionHandler(
+		authapp.NewListSessionsUseCase(authCache),
+		authapp.NewRevokeSessionUseCase(authCache, auditLog),
+		authapp.NewLogoutAllUseCase(authCache, auditLog),
+		authapp.NewIssueStreamTicketUseCase(authCache, config),
+		log,
+	)
+
+	mfaHandler := identityhttp.NewMFAHandler(
+		authapp.NewLoginMFAUseCase(authRepo, mfaRepo, authCache, mfaCache, loginGuard, tokens, config, auditLog),
+		authapp.NewMFAStatusUseCase(mfaRepo),
+		authapp.NewEnrollMFAUseCase(authRepo, mfaRepo, config),
+		authapp.NewConfirmMFAUseCase(mfaRepo, mfaCache, config, auditLog),
+		authapp.NewDisableMFAUseCase(authRepo, mfaRepo, mfaCache, passwords, config, auditLog),
+		authapp.NewRegenerateRecoveryCodesUseCase(mfaRepo, mfaCache, config),
+		log,
+	)
+
+	phoneCache := cache.NewPhoneCache(redis)
+	phoneHandler := identityhttp.NewPhoneHandler(
+		authapp.NewSendPhoneCodeUseCase(authRepo, phoneCache, texts, config),
+		authapp.NewVerifyPhoneUseCase(authRepo, phoneCache, authCache, config, auditLog),
+		authapp.NewRemovePhoneUseCase(authRepo, phoneCache, authCache, auditLog),
+		log,
+	)
+
+	identityRepo := db.NewIdentityRepo(pool)
+	oauthCache := cache.NewOAuthCache(redis)
+	providers := InitOAuthProviders(config, log)
+	oauthHandler := identityhttp.NewOAuthHandler(
+		providers,
+		authapp.NewStartOAuthUseCase(providers, oauthCache, config),
+		authapp.NewOAuthLoginUseCase(providers, oauthCache, authRepo, identityRepo, authCache, mfaCache, tokens, config, auditLog),
+		authapp.NewLinkOAuthUseCase(providers, oauthCache, identityRepo, auditLog),
+		authapp.NewListIdentitiesUseCase(identityRepo),
+		authapp.NewUnlinkIdentityUseCase(authRepo, identityRepo, auditLog),
+		log,
+	)
+
+	apiKeyRepo := db.NewAPIKeyRepo(pool)
+	apiKeyHandler := identityhttp.NewAPIKeyHandler(
+		apikeyapp.NewCreateAPIKeyUseCase(apiKeyRepo, rbacService, auditLog),
+		apikeyapp.NewListAPIKeysUseCase(apiKeyRepo),
+		apikeyapp.NewRevokeAPIKeyUseCase(apiKeyRepo, auditLog),
+		log,
+	)
+
+	rbacHandler := identityhttp.NewRbacHandler(
+		rbacapp.NewListRolesUseCase(rbacRepo),
+		rbacapp.NewCreateRoleUseCase(rbacRepo, rbacService),
+		rbacapp.NewDeleteRoleUseCase(rbacRepo),
+		rbacapp.NewSetRolePermissionsUseCase(rbacRepo, rbacService),
+		rbacapp.NewListPermissionsUseCase(rbacRepo),
+		rbacapp.NewCreatePermissionUseCase(rbacRepo),
+		log,
+	)
+
+	userRepo := db.NewUserRepo(pool)
+	userHandler := identityhttp.NewUserHandler(
+		userapp.NewListUsersUseCase(userRepo),
+		userapp.NewGetUserUseCase(userRepo),
+		userapp.NewChangeUserRoleUseCase(userRepo, rbacService, auditLog),
+		userapp.NewSetUserStatusUseCase(userRepo, authCache, rbacService, auditLog),
+		userapp.NewForcePasswordResetUseCase(userRepo, authCache, rbacService, auditLog),
+		userapp.NewUnlockUserUseCase(userRepo, loginAttempts, rbacService, securityLog, auditLog),
+		log,
+	)
+
+	auditHandler := identityhttp.NewAuditHandler(
+		auditapp.NewListAuditEventsUseCase(auditRepo),
+		log,
+	)
+
+	orgRepo := db.NewOrgRepo(pool)
+	orgHandler := identityhttp.NewOrgHandler(
+		orgapp.NewCreateOrganizationUseCase(orgRepo, auditLog),
+		orgapp.NewListOrganizationsUseCase(orgRepo, authCache),
+		orgapp.NewRenameOrganizationUseCase(orgRepo, auditLog),
+		orgapp.NewSwitchOrganizationUseCase(orgRepo, authCache),
+		orgapp.NewListMembersUseCase(orgRepo),
+		orgapp.NewChangeMemberRoleUseCase(orgRepo, auditLog),
+		orgapp.NewRemoveMemberUseCase(orgRepo, auditLog),
+		orgapp.NewInviteMemberUseCase(orgRepo, authRepo, mail, config, auditLog),
+		orgapp.NewListInvitationsUseCase(orgRepo),
+		orgapp.NewRevokeInvitationUseCase(orgRepo, auditLog),
+		orgapp.NewAcceptInvitationUseCase(orgRepo, authRepo, auditLog),
+		log,
+	)
+
+	accountData := authapp.NewAccountData()
+	accountHandler := identityhttp.NewAccountHandler(
+		authapp.NewForgotPasswordUseCase(authRepo, authRepo, cache.NewResetCache(redis), mail, config),
+		authapp.NewResetPasswordUseCase(authRepo, authRepo, passwords, authCache, auditLog),
+		authapp.NewVerifyEmailUseCase(authRepo, authRepo, auditLog),
+		sendVerificationUseCase,
+		authapp.NewDeleteAccountUseCase(authRepo, mfaRepo, mfaCache, passwords, orgRepo, apiKeyRepo, authCache, mail, config, auditLog),
+		authapp.NewExportDataUseCase(authRepo, userRepo, authCache, identityRepo, apiKeyRepo, orgRepo, accountData, auditLog),
+		log,
+	)
+	accountPurger := authapp.NewAccountPurger(
+		authRepo,
+		orgRepo,
+		accountData,
+		lock.New(redis, "account_purge_"),
+		time.Duration(config.AccountPurgeInterval)*time.Second,
+		auditLog,
+		log,
+	)
+
+	middleware := middlewares.NewIdentityMiddleware(authCache, apikey.Service{Repo: apiKeyRepo}, orgRepo, tokens, config)
 
 	return &IdentityModule{
-		Handler:     handler,
-		Middleware:  middleware,
-		RbacService: rbacService,
+		Handler:        handler,
+		SessionHandler: sessionHandler,
+		AccountHandler: accountHandler,
+		MFAHandler:     mfaHandler,
+		PhoneHandler:   phoneHandler,
+		APIKeyHandler:  apiKeyHandler,
+		OAuthHandler:   oauthHandler,
+		RbacHandler:    rbacHandler,
+		UserHandler:    userHandler,
+		AuditHandler:   auditHandler,
+		OrgHandler:     orgHandler,
+		JWKSHandler:    identityhttp.NewJWKSHandler(tokens),
+		Middleware:     middleware,
+		RbacService:    rbacService,
+		AccountData:    accountData,
+		AccountPurger:  accountPurger,
+	}
+}
+
+func lockoutPolicy(cfg *config.Config) auth.LockoutPolicy {
+	return auth.LockoutPolicy{
+		MaxAttempts:   cfg.LoginMaxAttempts,
+		IPMaxAttempts: cfg.LoginIPMaxAttempts,
+		Window:        time.Duration(cfg.LoginAttemptWindow) * time.Second,
+		Lockout:       time.Duration(cfg.LoginLockoutDuration) * time.Second,
+		BaseDelay:     time.Duration(cfg.LoginDelayBase) * time.Millisecond,
+		MaxDelay:      time.Duration(cfg.LoginDelayMax) * time.Millisecond,
 	}
 }
@@ -0,0 +1,68 @@
+package container
+
+import (
+	"go-ai/internal/coinai"
+	jobapp "go-ai/internal/jobs/application/job"
+	"go-ai/internal/jobs/domain/job"
+	"go-ai/internal/jobs/infrastructure/db"
+	jobshttp "go-ai/internal/jobs/transport/http"
+	"go-ai/internal/platform/config"
+	"go-ai/pkg/lock"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+)
+
+type JobsModule struct {
+	Handler   *jobshttp.JobHandler
+	Scheduler *jobapp.Scheduler
+	UserData  *jobapp.UserData
+}
+
+func InitJobsModule(pool *pgxpool.Pool, redis *redis.Client, modelStore jobapp.ModelStore, events jobapp.EventPublisher, cfg *config.Config, log zerolog.Logger) *JobsModule {
+	jobRepo := db.NewJobRepo(pool)
+
+	binance := coinai.NewBinanceClient(cfg.BinanceBaseURL, time.Duration(cfg.BinanceTimeout)*time.Second)
+	handlers := jobapp.Handlers{
+		job.TypeRetrainModel: jobapp.NewRetrainHandler(binance, modelStore, events, log),
+	}
+
+	createJobUseCase := jobapp.NewCreateJobUseCase(jobRepo, handlers)
+	listJobsUseCase := jobapp.NewListJobsUseCase(jobRepo)
+	updateJobUseCase := jobapp.NewUpdateJobUseCase(jobRepo)
+	listJobRunsUseCase := jobapp.NewListJobRunsUseCase(jobRepo)
+	handler := jobshttp.NewJobHandler(
+		createJobUseCase,
+		listJobsUseCase,
+		updateJobUseCase,
+		listJobRunsUseCase,
+		log,
+	)
+
+	scheduler := jobapp.NewScheduler(
+		jobRepo,
+		lock.New(redis, "job_lock_"),
+		handlers,
+		SchedulerConfigFromConfig(cfg),
+		log,
+	)
+
+	return &JobsModule{
+		Handler:   handler,
+		Scheduler: scheduler,
+		UserData:  jobapp.NewUserData(jobRepo),
+	}
+}
+
+// SchedulerConfigFromConfig creates SchedulerConfig from application config
+func SchedulerConfigFromConfig(cfg *config.Config) jobapp.SchedulerConfig {
+	return jobapp.SchedulerConfig{
+		Workers:      cfg.JobWorkers,
+		PollInterval: time.Duration(cfg.JobPollInterval) * time.Second,
+		LockTTL:      time.Duration(cfg.JobLockTTL) * time.Second,
+		RetryBackoff: time.Duration(cfg.JobRetryBackoff) * time.Second,
+		MaxBackoff:   time.Duration(cfg.JobMaxBackoff) * time.Second,
+	}
+}
@@ -0,0 +1,27 @@
+package container
+
+import (
+	"go-ai/internal/platform/config"
+	"go-ai/pkg/mailer"
+
+	"github.com/rs/zerolog"
+)
+
+// InitMailer builds the configured mailer. A broken mail setup must not stop
+// the API, so it falls back to an in-memory mailer and logs the error.
+func InitMailer(cfg *config.Config, log zerolog.Logger) mailer.Mailer {
+	m, err := mailer.New(mailer.Config{
+		Driver:    cfg.MailDriver,
+		From:      cfg.MailFrom,
+		OutboxDir: cfg.MailOutboxDir,
+		SMTPHost:  cfg.SMTPHost,
+		SMTPPort:  cfg.SMTPPort,
+		Username:  cfg.SMTPUsername,
+		Password:  cfg.SMTPPassword,
+	})
+	if err != nil {
+		log.Error().Err(err).Str("driver", cfg.MailDriver).Msg("failed to init mailer, emails will not be delivered")
+		return mailer.NewMemoryMailer()
+	}
+	return m
+}
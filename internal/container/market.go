@@ -0,0 +1,135 @@
+package container
+
+import (
+	"go-ai/internal/coinai"
+	alertapp "go-ai/internal/market/application/alert"
+	portfolioapp "go-ai/internal/market/application/portfolio"
+	streamapp "go-ai/internal/market/application/stream"
+	watchlistapp "go-ai/internal/market/application/watchlist"
+	"go-ai/internal/market/infrastructure/cache"
+	"go-ai/internal/market/infrastructure/db"
+	markethttp "go-ai/internal/market/transport/http"
+	"go-ai/internal/platform/config"
+	"go-ai/pkg/lock"
+	"go-ai/pkg/notify"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+)
+
+type MarketModule struct {
+	WatchlistHandler *markethttp.WatchlistHandler
+	UserData         *watchlistapp.UserData
+	AlertHandler     *markethttp.AlertHandler
+	AlertEvaluator   *alertapp.Evaluator
+	AlertUserData    *alertapp.UserData
+	PortfolioHandler *markethttp.PortfolioHandler
+	PortfolioData    *portfolioapp.UserData
+	StreamHandler    *markethttp.StreamHandler
+	StreamHub        *streamapp.Hub
+	StreamPoller     *streamapp.Poller
+}
+
+func InitMarketModule(pool *pgxpool.Pool, redis *redis.Client, modelStore coinai.ModelStore, dispatcher *notify.Dispatcher, events alertapp.EventPublisher, cfg *config.Config, log zerolog.Logger) *MarketModule {
+	watchlistRepo := db.NewWatchlistRepo(pool)
+	binance := coinai.NewBinanceClient(cfg.BinanceBaseURL, time.Duration(cfg.BinanceTimeout)*time.Second)
+
+	listWatchlistsUseCase := watchlistapp.NewListWatchlistsUseCase(watchlistRepo)
+	createWatchlistUseCase := watchlistapp.NewCreateWatchlistUseCase(watchlistRepo)
+	getWatchlistUseCase := watchlistapp.NewGetWatchlistUseCase(watchlistRepo)
+	updateWatchlistUseCase := watchlistapp.NewUpdateWatchlistUseCase(watchlistRepo)
+	deleteWatchlistUseCase := watchlistapp.NewDeleteWatchlistUseCase(watchlistRepo)
+	reorderWatchlistsUseCase := watchlistapp.NewReorderWatchlistsUseCase(watchlistRepo)
+	addItemUseCase := watchlistapp.NewAddItemUseCase(watchlistRepo)
+	removeItemUseCase := watchlistapp.NewRemoveItemUseCase(watchlistRepo)
+	reorderItemsUseCase := watchlistapp.NewReorderItemsUseCase(watchlistRepo)
+	quoteWatchlistUseCase := watchlistapp.NewQuoteWatchlistUseCase(watchlistRepo, binance, modelStore, log)
+	watchlistHandler := markethttp.NewWatchlistHandler(
+		listWatchlistsUseCase,
+		createWatchlistUseCase,
+		getWatchlistUseCase,
+		updateWatchlistUseCase,
+		deleteWatchlistUseCase,
+		reorderWatchlistsUseCase,
+		addItemUseCase,
+		removeItemUseCase,
+		reorderItemsUseCase,
+		quoteWatchlistUseCase,
+		log,
+	)
+
+	broker := cache.NewStreamBroker(redis, int64(cfg.StreamLogSize))
+	streamHub := streamapp.NewHub(broker, StreamHubConfigFromConfig(cfg), log)
+	streamPoller := streamapp.NewPoller(
+		broker,
+		binance,
+		modelStore,
+		lock.New(redis, "stream_poll_"),
+		time.Duration(cfg.StreamPollInterval)*time.Second,
+		log,
+	)
+	if cfg.StreamsEnabled {
+		events = alertapp.EventPublishers{events, streamapp.NewPublisher(broker)}
+	}
+
+	alertRepo := db.NewAlertRepo(pool)
+	alertHandler := markethttp.NewAlertHandler(
+		alertapp.NewListAlertsUseCase(alertRepo),
+		alertapp.NewCreateAlertUseCase(alertRepo, time.Duration(cfg.AlertDefaultCooldown)*time.Second),
+		alertapp.NewGetAlertUseCase(alertRepo),
+		alertapp.NewUpdateAlertUseCase(alertRepo),
+		alertapp.NewDeleteAlertUseCase(alertRepo),
+		alertapp.NewListAlertEventsUseCase(alertRepo),
+		log,
+	)
+	alertEvaluator := alertapp.NewEvaluator(
+		alertRepo,
+		binance,
+		modelStore,
+		dispatcher,
+		events,
+		lock.New(redis, "alert_eval_"),
+		time.Duration(cfg.AlertEvalInterval)*time.Second,
+		log,
+	)
+
+	portfolioRepo := db.NewPortfolioRepo(pool)
+	portfolioHandler := markethttp.NewPortfolioHandler(
+		portfolioapp.NewListPortfoliosUseCase(portfolioRepo),
+		portfolioapp.NewCreatePortfolioUseCase(portfolioRepo),
+		portfolioapp.NewGetPortfolioUseCase(portfolioRepo),
+		portfolioapp.NewUpdatePortfolioUseCase(portfolioRepo),
+		portfolioapp.NewDeletePortfolioUseCase(portfolioRepo),
+		portfolioapp.NewListTradesUseCase(portfolioRepo),
+		portfolioapp.NewAddTradeUseCase(portfolioRepo),
+		portfolioapp.NewSignalTradeUseCase(portfolioRepo, binance, modelStore, log),
+		portfolioapp.NewDeleteTradeUseCase(portfolioRepo),
+		portfolioapp.NewPositionsUseCase(portfolioRepo, binance, log),
+		portfolioapp.NewPerformanceUseCase(portfolioRepo, binance, log),
+		log,
+	)
+
+	return &MarketModule{
+		WatchlistHandler: watchlistHandler,
+		UserData:         watchlistapp.NewUserData(watchlistRepo),
+		AlertHandler:     alertHandler,
+		AlertEvaluator:   alertEvaluator,
+		AlertUserData:    alertapp.NewUserData(alertRepo),
+		PortfolioHandler: portfolioHandler,
+		PortfolioData:    portfolioapp.NewUserData(portfolioRepo),
+		StreamHandler:    markethttp.NewStreamHandler(streamHub, log),
+		StreamHub:        streamHub,
+		StreamPoller:     streamPoller,
+	}
+}
+
+// StreamHubConfigFromConfig creates HubConfig from application config
+func StreamHubConfigFromConfig(cfg *config.Config) streamapp.HubConfig {
+	return streamapp.HubConfig{
+		Heartbeat:             time.Duration(cfg.StreamHeartbeat) * time.Second,
+		MaxConnectionsPerUser: cfg.StreamMaxConnections,
+		ReplayLimit:           int64(cfg.StreamReplayLimit),
+	}
+}
@@ -12,6 +12,7 @@ import (
 type MediaModule struct {
 	Handler *uploadhttp.UpLoadHandler
 	Auth    *middlewares.IdentityMiddleware
+	Storage *storage.MinioClient
 }
 
 func InitMediaModule(auth *middlewares.IdentityMiddleware, cfg *config.Config, log zerolog.Logger) (*MediaModule, error) {
@@ -27,5 +28,6 @@ func InitMediaModule(auth *middlewares.IdentityMiddleware, cfg *config.Config, l
 	return &MediaModule{
 		Handler: handler,
 		Auth:    auth,
+		Storage: minioClient,
 	}, nil
 }
@@ -0,0 +1,45 @@
+package container
+
+import (
+	"go-ai/internal/coinai"
+	registryapp "go-ai/internal/modelregistry/application/registry"
+	"go-ai/internal/modelregistry/infrastructure/db"
+	registryhttp "go-ai/internal/modelregistry/transport/http"
+	"go-ai/internal/platform/config"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+type ModelRegistryModule struct {
+	Handler  *registryhttp.ModelHandler
+	Registry *registryapp.ModelRegistry
+	UserData *registryapp.UserData
+}
+
+func InitModelRegistryModule(pool *pgxpool.Pool, events registryapp.EventPublisher, cfg *config.Config, log zerolog.Logger) *ModelRegistryModule {
+	repo := db.NewModelVersionRepo(pool)
+	announcer := registryapp.NewAnnouncer(events, log)
+	binance := coinai.NewBinanceClient(cfg.BinanceBaseURL, time.Duration(cfg.BinanceTimeout)*time.Second)
+
+	listModelVersionsUseCase := registryapp.NewListModelVersionsUseCase(repo)
+	getModelVersionUseCase := registryapp.NewGetModelVersionUseCase(repo)
+	setModelStageUseCase := registryapp.NewSetModelStageUseCase(repo, announcer)
+	rollbackModelUseCase := registryapp.NewRollbackModelUseCase(repo, announcer)
+	predictUseCase := registryapp.NewPredictUseCase(repo, binance)
+	handler := registryhttp.NewModelHandler(
+		listModelVersionsUseCase,
+		getModelVersionUseCase,
+		setModelStageUseCase,
+		rollbackModelUseCase,
+		predictUseCase,
+		log,
+	)
+
+	return &ModelRegistryModule{
+		Handler:  handler,
+		Registry: registryapp.NewModelRegistry(repo, announcer),
+		UserData: registryapp.NewUserData(repo),
+	}
+}
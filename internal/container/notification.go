@@ -0,0 +1,98 @@
+package container
+
+import (
+	notificationapp "go-ai/internal/notification/application/notification"
+	webhookapp "go-ai/internal/notification/application/webhook"
+	"go-ai/internal/notification/domain/webhook"
+	"go-ai/internal/notification/infrastructure/db"
+	notificationhttp "go-ai/internal/notification/transport/http"
+	"go-ai/internal/platform/config"
+	"go-ai/pkg/mailer"
+	"go-ai/pkg/notify"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+type NotificationModule struct {
+	Handler        *notificationhttp.NotificationHandler
+	Dispatcher     *notify.Dispatcher
+	UserData       *notificationapp.UserData
+	WebhookHandler *notificationhttp.WebhookHandler
+	Webhooks       *webhookapp.Publisher
+	WebhookWorker  *webhookapp.DeliveryWorker
+	WebhookData    *webhookapp.UserData
+}
+
+func InitNotificationModule(pool *pgxpool.Pool, mail mailer.Mailer, cfg *config.Config, log zerolog.Logger) *NotificationModule {
+	notificationRepo := db.NewNotificationRepo(pool)
+
+	dispatcher := notify.NewDispatcher()
+	dispatcher.Register(notify.ChannelInApp, notificationapp.NewFeedNotifier(notificationRepo))
+	dispatcher.Register(notify.ChannelEmail, notificationapp.NewEmailNotifier(notificationRepo, mail))
+	dispatcher.Register(notify.ChannelWebhook, notify.NewWebhookNotifier(time.Duration(cfg.NotifyWebhookTimeout)*time.Second))
+
+	handler := notificationhttp.NewNotificationHandler(
+		notificationapp.NewListNotificationsUseCase(notificationRepo),
+		notificationapp.NewMarkReadUseCase(notificationRepo),
+		notificationapp.NewMarkAllReadUseCase(notificationRepo),
+		log,
+	)
+
+	webhookRepo := db.NewWebhookRepo(pool, cfg.WebhookEncryptionKey)
+	workerCfg := WebhookWorkerConfigFromConfig(cfg)
+	deliverer := webhookapp.NewDeliverer(webhookRepo, notify.NewWebhookSender(workerCfg.Timeout), WebhookRetryPolicyFromConfig(cfg))
+	webhookHandler := notificationhttp.NewWebhookHandler(
+		webhookapp.NewListEndpointsUseCase(webhookRepo),
+		webhookapp.NewCreateEndpointUseCase(webhookRepo),
+		webhookapp.NewGetEndpointUseCase(webhookRepo),
+		webhookapp.NewUpdateEndpointUseCase(webhookRepo),
+		webhookapp.NewDeleteEndpointUseCase(webhookRepo),
+		webhookapp.NewRotateSecretUseCase(webhookRepo),
+		webhookapp.NewSendTestEventUseCase(webhookRepo, deliverer),
+		webhookapp.NewListDeliveriesUseCase(webhookRepo),
+		webhookapp.NewGetDeliveryUseCase(webhookRepo),
+		webhookapp.NewRedeliverUseCase(webhookRepo, deliverer),
+		log,
+	)
+
+	return &NotificationModule{
+		Handler:        handler,
+		Dispatcher:     dispatcher,
+		UserData:       notificationapp.NewUserData(notificationRepo),
+		WebhookHandler: webhookHandler,
+		Webhooks:       webhookapp.NewPublisher(webhookRepo),
+		WebhookWorker:  webhookapp.NewDeliveryWorker(webhookRepo, deliverer, workerCfg, log),
+		WebhookData:    webhookapp.NewUserData(webhookRepo),
+	}
+}
+
+// WebhookWorkerConfigFromConfig creates DeliveryWorkerConfig from application config
+func WebhookWorkerConfigFromConfig(cfg *config.Config) webhookapp.DeliveryWorkerConfig {
+	return webhookapp.DeliveryWorkerConfig{
+		Workers:      cfg.WebhookWorkers,
+		PollInterval: time.Duration(cfg.WebhookPollInterval) * time.Second,
+		Timeout:      time.Duration(cfg.WebhookTimeout) * time.Second,
+		Retention:    time.Duration(cfg.WebhookRetention) * 24 * time.Hour,
+	}
+}
+
+// WebhookRetryPolicyFromConfig creates the delivery RetryPolicy from
+// application config, falling back to the defaults for unset values.
+func WebhookRetryPolicyFromConfig(cfg *config.Config) webhook.RetryPolicy {
+	policy := webhook.DefaultRetryPolicy()
+	if cfg.WebhookMaxAttempts > 0 {
+		policy.MaxAttempts = cfg.WebhookMaxAttempts
+	}
+	if cfg.WebhookRetryBackoff > 0 {
+		policy.Backoff = time.Duration(cfg.WebhookRetryBackoff) * time.Second
+	}
+	if cfg.WebhookMaxBackoff > 0 {
+		policy.MaxBackoff = time.Duration(cfg.WebhookMaxBackoff) * time.Second
+	}
+	if policy.MaxBackoff < policy.Backoff {
+		policy.MaxBackoff = policy.Backoff
+	}
+	return policy
+}
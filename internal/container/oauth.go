@@ -0,0 +1,53 @@
+package container
+
+import (
+	authapp "go-ai/internal/identity/application/auth"
+	"go-ai/internal/platform/config"
+	"go-ai/pkg/oidc"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// Well-known providers only need client credentials.
+var oauthPresets = map[string]oidc.Config{
+	"google": {Issuer: "https://accounts.google.com"},
+	"github": {Kind: oidc.KindGitHub},
+}
+
+// InitOAuthProviders builds the providers listed in OAUTH_PROVIDERS. Like the
+// mailer, a misconfigured provider is logged and left out rather than
+// stopping the API.
+func InitOAuthProviders(cfg *config.Config, log zerolog.Logger) authapp.OAuthProviders {
+	providers := authapp.OAuthProviders{}
+	for _, name := range strings.Split(cfg.OAuthProviders, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		settings := cfg.OAuthProvider(name)
+		pc := oauthPresets[name]
+		pc.Name = name
+		pc.ClientID = settings.ClientID
+		pc.ClientSecret = settings.ClientSecret
+		pc.Scopes = settings.Scopes
+		if settings.Kind != "" {
+			pc.Kind = settings.Kind
+		}
+		if settings.Issuer != "" {
+			pc.Issuer = settings.Issuer
+		}
+		pc.RedirectURL = settings.RedirectURL
+		if pc.RedirectURL == "" {
+			pc.RedirectURL = strings.TrimRight(cfg.AppBaseURL, "/") + "/oauth/callback/" + name
+		}
+
+		p, err := oidc.New(pc, nil)
+		if err != nil {
+			log.Error().Err(err).Str("provider", name).Msg("failed to init login provider")
+			continue
+		}
+		providers[name] = p
+	}
+	return providers
+}
@@ -0,0 +1,38 @@
+package container
+
+import (
+	"fmt"
+	authapp "go-ai/internal/identity/application/auth"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/platform/config"
+	"go-ai/pkg/password"
+
+	"github.com/rs/zerolog"
+)
+
+// InitPasswords builds the password policy and hasher. A blocklist that is
+// configured but cannot be read stops startup.
+func InitPasswords(cfg *config.Config, log zerolog.Logger) (*authapp.Passwords, error) {
+	policy := auth.PasswordPolicy{
+		MinLength:      cfg.PasswordMinLength,
+		MaxLength:      cfg.PasswordMaxLength,
+		RequireLower:   cfg.PasswordRequireLower,
+		RequireUpper:   cfg.PasswordRequireUpper,
+		RequireDigit:   cfg.PasswordRequireDigit,
+		RequireSpecial: cfg.PasswordRequireSpecial,
+	}
+	if cfg.PasswordBlocklistFile != "" {
+		blocked, err := password.LoadBlocklist(cfg.PasswordBlocklistFile)
+		if err != nil {
+			return nil, fmt.Errorf("load password blocklist: %w", err)
+		}
+		policy.Blocked = blocked
+		log.Info().Int("entries", len(blocked)).Msg("Password blocklist loaded")
+	}
+	hasher := password.New(password.Params{
+		Memory:      uint32(cfg.PasswordArgon2Memory),
+		Iterations:  uint32(cfg.PasswordArgon2Iterations),
+		Parallelism: uint8(cfg.PasswordArgon2Parallelism),
+	}, cfg.PasswordPepper)
+	return authapp.NewPasswords(policy, hasher), nil
+}
@@ -0,0 +1,19 @@
+package container
+
+import (
+	"go-ai/internal/platform/config"
+	"go-ai/pkg/sms"
+
+	"github.com/rs/zerolog"
+)
+
+// InitSMS builds the configured SMS sender, falling back to the log driver
+// so a bad setting does not stop the API.
+func InitSMS(cfg *config.Config, log zerolog.Logger) sms.Sender {
+	s, err := sms.New(cfg.SMSDriver, log)
+	if err != nil {
+		log.Error().Err(err).Str("driver", cfg.SMSDriver).Msg("failed to init sms sender, falling back to the log driver")
+		return sms.NewLogSender(log)
+	}
+	return s
+}
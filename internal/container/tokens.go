@@ -0,0 +1,31 @@
+package container
+
+import (
+	"fmt"
+	"go-ai/internal/platform/config"
+	"go-ai/pkg/jwtkeys"
+
+	"github.com/rs/zerolog"
+)
+
+// InitTokenIssuer builds the JWT issuer for the configured algorithm. Unlike
+// the mailer it has no fallback: a missing or mismatched key stops startup.
+func InitTokenIssuer(cfg *config.Config, log zerolog.Logger) (jwtkeys.Issuer, error) {
+	switch cfg.JwtAlgorithm {
+	case "", jwtkeys.AlgHS256:
+		return jwtkeys.NewHMACIssuer(cfg.JwtAccessSecret, cfg.JwtRefreshSecret), nil
+	case jwtkeys.AlgRS256, jwtkeys.AlgEdDSA:
+		ks, err := jwtkeys.LoadDir(cfg.JwtKeysDir, cfg.JwtActiveKID, cfg.JwtAlgorithm)
+		if err != nil {
+			return nil, err
+		}
+		log.Info().
+			Str("algorithm", cfg.JwtAlgorithm).
+			Str("kid", ks.ActiveKID()).
+			Int("keys", len(ks.JWKS().Keys)).
+			Msg("JWT signing keys loaded")
+		return ks, nil
+	default:
+		return nil, fmt.Errorf("unsupported JWT_ALGORITHM %q", cfg.JwtAlgorithm)
+	}
+}
@@ -0,0 +1,161 @@
+package childapp
+
+import (
+	"context"
+	"go-ai/internal/household/domain/child"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateChildRequest struct {
+	FullName string `json:"full_name"`
+	DOB      string `json:"dob"`
+	Grade    *int   `json:"grade"`
+	Relation string `json:"relation"`
+}
+
+// UpdateChildRequest only changes the fields that are set. An empty dob
+// clears the date of birth.
+type UpdateChildRequest struct {
+	FullName *string `json:"full_name"`
+	DOB      *string `json:"dob"`
+	Grade    *int    `json:"grade"`
+}
+
+type CreateChildUseCase struct {
+	Repo child.Repository
+}
+
+func NewCreateChildUseCase(repo child.Repository) *CreateChildUseCase {
+	return &CreateChildUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute creates a child profile with the caller as its primary guardian.
+func (uc *CreateChildUseCase) Execute(ctx context.Context, userID uuid.UUID, req CreateChildRequest) (*ChildResponse, error) {
+	relation, err := child.ParseRelation(req.Relation)
+	if err != nil {
+		return nil, err
+	}
+	dob, err := child.ParseDOB(req.DOB, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	c, err := child.NewChild(req.FullName, dob, req.Grade)
+	if err != nil {
+		return nil, err
+	}
+	g := child.Guardian{
+		UserID:    userID,
+		Relation:  relation,
+		IsPrimary: true,
+	}
+	created, err := uc.Repo.Create(ctx, c, &g)
+	if err != nil {
+		return nil, err
+	}
+	g.ChildID = created.ID
+	resp := toChildResponse(*created, g)
+	return &resp, nil
+}
+
+type ListChildrenUseCase struct {
+	Repo child.Repository
+}
+
+func NewListChildrenUseCase(repo child.Repository) *ListChildrenUseCase {
+	return &ListChildrenUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *ListChildrenUseCase) Execute(ctx context.Context, userID uuid.UUID) ([]ChildResponse, error) {
+	links, err := uc.Repo.ListForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]ChildResponse, 0, len(links))
+	for _, l := range links {
+		resp = append(resp, toChildResponse(l.Child, l.Guardian))
+	}
+	return resp, nil
+}
+
+type GetChildUseCase struct {
+	Repo child.Repository
+}
+
+func NewGetChildUseCase(repo child.Repository) *GetChildUseCase {
+	return &GetChildUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute returns the child as seen by actor, the caller's guardian link.
+func (uc *GetChildUseCase) Execute(ctx context.Context, actor child.Guardian) (*ChildResponse, error) {
+	c, err := uc.Repo.Get(ctx, actor.ChildID)
+	if err != nil {
+		return nil, err
+	}
+	resp := toChildResponse(*c, actor)
+	return &resp, nil
+}
+
+type UpdateChildUseCase struct {
+	Repo child.Repository
+}
+
+func NewUpdateChildUseCase(repo child.Repository) *UpdateChildUseCase {
+	return &UpdateChildUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *UpdateChildUseCase) Execute(ctx context.Context, actor child.Guardian, req UpdateChildRequest) (*ChildResponse, error) {
+	c, err := uc.Repo.Get(ctx, actor.ChildID)
+	if err != nil {
+		return nil, err
+	}
+	fullName, dob, grade := c.FullName, c.DOB, c.Grade
+	if req.FullName != nil {
+		fullName = *req.FullName
+	}
+	if req.DOB != nil {
+		if dob, err = child.ParseDOB(*req.DOB, time.Now().UTC()); err != nil {
+			return nil, err
+		}
+	}
+	if req.Grade != nil {
+		grade = req.Grade
+	}
+	if err := c.Update(fullName, dob, grade); err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.Update(ctx, c); err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.Repo.Get(ctx, c.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp := toChildResponse(*updated, actor)
+	return &resp, nil
+}
+
+type DeleteChildUseCase struct {
+	Repo child.Repository
+}
+
+func NewDeleteChildUseCase(repo child.Repository) *DeleteChildUseCase {
+	return &DeleteChildUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute deletes the child profile and every guardian link to it.
+func (uc *DeleteChildUseCase) Execute(ctx context.Context, childID uuid.UUID) error {
+	return uc.Repo.Delete(ctx, childID)
+}
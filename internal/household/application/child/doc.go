@@ -0,0 +1,25 @@
+package childapp
+
+import (
+	"go-ai/pkg/response"
+)
+
+type ChildSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *ChildResponse `json:"data,omitempty"`
+}
+
+type ListChildrenSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data []ChildResponse `json:"data,omitempty"`
+}
+
+type GuardianSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *GuardianResponse `json:"data,omitempty"`
+}
+
+type ListGuardiansSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data []GuardianResponse `json:"data,omitempty"`
+}
@@ -0,0 +1,78 @@
+package childapp
+
+import (
+	"go-ai/internal/household/domain/child"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var allPermissions = []child.Permission{
+	child.PermView,
+	child.PermEditPlan,
+	child.PermManageRewards,
+	child.PermViewReports,
+	child.PermManageGuardians,
+}
+
+type ChildResponse struct {
+	ID          uuid.UUID `json:"id"`
+	FullName    string    `json:"full_name"`
+	DOB         *string   `json:"dob,omitempty"`
+	Grade       *int      `json:"grade,omitempty"`
+	Relation    string    `json:"relation"`
+	IsPrimary   bool      `json:"is_primary"`
+	Permissions []string  `json:"permissions"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type GuardianResponse struct {
+	UserID           uuid.UUID `json:"user_id"`
+	Email            string    `json:"email"`
+	FullName         string    `json:"full_name"`
+	Relation         string    `json:"relation"`
+	IsPrimary        bool      `json:"is_primary"`
+	CanEditPlan      bool      `json:"can_edit_plan"`
+	CanManageRewards bool      `json:"can_manage_rewards"`
+	CanViewReports   bool      `json:"can_view_reports"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+// toChildResponse describes c as seen by guardian g.
+func toChildResponse(c child.Child, g child.Guardian) ChildResponse {
+	resp := ChildResponse{
+		ID:          c.ID,
+		FullName:    c.FullName,
+		Grade:       c.Grade,
+		Relation:    string(g.Relation),
+		IsPrimary:   g.IsPrimary,
+		Permissions: make([]string, 0, len(allPermissions)),
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+	if c.DOB != nil {
+		dob := c.DOB.Format(time.DateOnly)
+		resp.DOB = &dob
+	}
+	for _, p := range allPermissions {
+		if g.Allows(p) {
+			resp.Permissions = append(resp.Permissions, string(p))
+		}
+	}
+	return resp
+}
+
+func toGuardianResponse(g child.Guardian) GuardianResponse {
+	return GuardianResponse{
+		UserID:           g.UserID,
+		Email:            g.Email,
+		FullName:         g.FullName,
+		Relation:         string(g.Relation),
+		IsPrimary:        g.IsPrimary,
+		CanEditPlan:      g.CanEditPlan,
+		CanManageRewards: g.CanManageRewards,
+		CanViewReports:   g.CanViewReports,
+		CreatedAt:        g.CreatedAt,
+	}
+}
@@ -0,0 +1,193 @@
+package childapp
+
+import (
+	"context"
+	"go-ai/internal/household/domain/child"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// AddGuardianRequest links an existing account by email. Permission flags
+// default to true when omitted.
+type AddGuardianRequest struct {
+	Email            string `json:"email"`
+	Relation         string `json:"relation"`
+	CanEditPlan      *bool  `json:"can_edit_plan"`
+	CanManageRewards *bool  `json:"can_manage_rewards"`
+	CanViewReports   *bool  `json:"can_view_reports"`
+}
+
+// UpdateGuardianRequest only changes the fields that are set.
+type UpdateGuardianRequest struct {
+	Relation         *string `json:"relation"`
+	CanEditPlan      *bool   `json:"can_edit_plan"`
+	CanManageRewards *bool   `json:"can_manage_rewards"`
+	CanViewReports   *bool   `json:"can_view_reports"`
+}
+
+type ListGuardiansUseCase struct {
+	Repo child.Repository
+}
+
+func NewListGuardiansUseCase(repo child.Repository) *ListGuardiansUseCase {
+	return &ListGuardiansUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute lists the child's guardians, primary first.
+func (uc *ListGuardiansUseCase) Execute(ctx context.Context, childID uuid.UUID) ([]GuardianResponse, error) {
+	guardians, err := uc.Repo.ListGuardians(ctx, childID)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]GuardianResponse, 0, len(guardians))
+	for _, g := range guardians {
+		resp = append(resp, toGuardianResponse(g))
+	}
+	return resp, nil
+}
+
+type AddGuardianUseCase struct {
+	Repo child.Repository
+}
+
+func NewAddGuardianUseCase(repo child.Repository) *AddGuardianUseCase {
+	return &AddGuardianUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *AddGuardianUseCase) Execute(ctx context.Context, childID uuid.UUID, req AddGuardianRequest) (*GuardianResponse, error) {
+	relation, err := child.ParseRelation(req.Relation)
+	if err != nil {
+		return nil, err
+	}
+	email := strings.TrimSpace(req.Email)
+	if email == "" {
+		return nil, child.ErrUserNotFound
+	}
+	userID, err := uc.Repo.FindUserByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	g := &child.Guardian{
+		UserID:           userID,
+		ChildID:          childID,
+		Relation:         relation,
+		CanEditPlan:      flag(req.CanEditPlan, true),
+		CanManageRewards: flag(req.CanManageRewards, true),
+		CanViewReports:   flag(req.CanViewReports, true),
+	}
+	if err := uc.Repo.AddGuardian(ctx, g); err != nil {
+		return nil, err
+	}
+	return findGuardian(ctx, uc.Repo, childID, userID)
+}
+
+type UpdateGuardianUseCase struct {
+	Repo child.Repository
+}
+
+func NewUpdateGuardianUseCase(repo child.Repository) *UpdateGuardianUseCase {
+	return &UpdateGuardianUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute changes a guardian's relation or permission flags. The primary
+// guardian holds every permission whatever its flags say.
+func (uc *UpdateGuardianUseCase) Execute(ctx context.Context, childID, userID uuid.UUID, req UpdateGuardianRequest) (*GuardianResponse, error) {
+	g, err := uc.Repo.GetGuardian(ctx, childID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Relation != nil {
+		if g.Relation, err = child.ParseRelation(*req.Relation); err != nil {
+			return nil, err
+		}
+	}
+	g.CanEditPlan = flag(req.CanEditPlan, g.CanEditPlan)
+	g.CanManageRewards = flag(req.CanManageRewards, g.CanManageRewards)
+	g.CanViewReports = flag(req.CanViewReports, g.CanViewReports)
+	if err := uc.Repo.UpdateGuardian(ctx, g); err != nil {
+		return nil, err
+	}
+	return findGuardian(ctx, uc.Repo, childID, userID)
+}
+
+type RemoveGuardianUseCase struct {
+	Repo child.Repository
+}
+
+func NewRemoveGuardianUseCase(repo child.Repository) *RemoveGuardianUseCase {
+	return &RemoveGuardianUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute unlinks a guardian. Guardians may unlink themselves; unlinking
+// anyone else takes the primary guardian. The primary guardian must hand the
+// role over first.
+func (uc *RemoveGuardianUseCase) Execute(ctx context.Context, actor child.Guardian, userID uuid.UUID) error {
+	if userID != actor.UserID && !actor.Allows(child.PermManageGuardians) {
+		return child.ErrForbidden
+	}
+	g, err := uc.Repo.GetGuardian(ctx, actor.ChildID, userID)
+	if err != nil {
+		return err
+	}
+	if g.IsPrimary {
+		return child.ErrPrimaryGuardian
+	}
+	return uc.Repo.RemoveGuardian(ctx, actor.ChildID, userID)
+}
+
+type SetPrimaryGuardianUseCase struct {
+	Repo child.Repository
+}
+
+func NewSetPrimaryGuardianUseCase(repo child.Repository) *SetPrimaryGuardianUseCase {
+	return &SetPrimaryGuardianUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute hands the primary guardian role to another linked guardian.
+func (uc *SetPrimaryGuardianUseCase) Execute(ctx context.Context, childID, userID uuid.UUID) (*GuardianResponse, error) {
+	g, err := uc.Repo.GetGuardian(ctx, childID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if g.IsPrimary {
+		return nil, child.ErrAlreadyPrimary
+	}
+	if err := uc.Repo.SetPrimary(ctx, childID, userID); err != nil {
+		return nil, err
+	}
+	return findGuardian(ctx, uc.Repo, childID, userID)
+}
+
+// findGuardian reloads a guardian with the account details that only the
+// child's guardian list carries.
+func findGuardian(ctx context.Context, repo child.Repository, childID, userID uuid.UUID) (*GuardianResponse, error) {
+	guardians, err := repo.ListGuardians(ctx, childID)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range guardians {
+		if g.UserID == userID {
+			resp := toGuardianResponse(g)
+			return &resp, nil
+		}
+	}
+	return nil, child.ErrGuardianNotFound
+}
+
+func flag(v *bool, fallback bool) bool {
+	if v == nil {
+		return fallback
+	}
+	return *v
+}
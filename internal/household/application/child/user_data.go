@@ -0,0 +1,66 @@
+package childapp
+
+import (
+	"context"
+	"go-ai/internal/household/domain/child"
+
+	"github.com/google/uuid"
+)
+
+// UserData exports and purges the child profiles a user is a guardian of.
+type UserData struct {
+	Repo child.Repository
+}
+
+func NewUserData(repo child.Repository) *UserData {
+	return &UserData{
+		Repo: repo,
+	}
+}
+
+func (d *UserData) ExportUserData(ctx context.Context, userID uuid.UUID, orgIDs []uuid.UUID) (any, error) {
+	links, err := d.Repo.ListForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]ChildResponse, 0, len(links))
+	for _, l := range links {
+		resp = append(resp, toChildResponse(l.Child, l.Guardian))
+	}
+	return resp, nil
+}
+
+// PurgeUserData hands each child the user is primary for to the longest
+// linked co-guardian, and deletes children nobody else looks after. The
+// remaining links go with the account.
+func (d *UserData) PurgeUserData(ctx context.Context, userID uuid.UUID, orgIDs []uuid.UUID) error {
+	links, err := d.Repo.ListForUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, l := range links {
+		if !l.Guardian.IsPrimary {
+			continue
+		}
+		guardians, err := d.Repo.ListGuardians(ctx, l.Child.ID)
+		if err != nil {
+			return err
+		}
+		successor := uuid.Nil
+		for _, g := range guardians {
+			if g.UserID != userID {
+				successor = g.UserID
+				break
+			}
+		}
+		if successor == uuid.Nil {
+			err = d.Repo.Delete(ctx, l.Child.ID)
+		} else {
+			err = d.Repo.SetPrimary(ctx, l.Child.ID, successor)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
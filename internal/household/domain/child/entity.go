@@ -0,0 +1,140 @@
+package child
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Relation is how a guardian is related to a child.
+type Relation string
+
+const (
+	RelationParent   Relation = "parent"
+	RelationMother   Relation = "mother"
+	RelationFather   Relation = "father"
+	RelationGuardian Relation = "guardian"
+)
+
+// Permission is something a guardian may do with a child. The flags are
+// stored per guardian link.
+type Permission string
+
+const (
+	// PermView is held by every linked guardian.
+	PermView          Permission = "view"
+	PermEditPlan      Permission = "edit_plan"
+	PermManageRewards Permission = "manage_rewards"
+	PermViewReports   Permission = "view_reports"
+	// PermManageGuardians is only held by the primary guardian.
+	PermManageGuardians Permission = "manage_guardians"
+)
+
+const (
+	maxNameLength = 100
+	minGrade      = 1
+	maxGrade      = 12
+)
+
+type Child struct {
+	ID        uuid.UUID
+	FullName  string
+	DOB       *time.Time
+	Grade     *int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Guardian links a user to a child. Email and FullName are filled when
+// guardians are listed for a child.
+type Guardian struct {
+	UserID           uuid.UUID
+	ChildID          uuid.UUID
+	Email            string
+	FullName         string
+	Relation         Relation
+	IsPrimary        bool
+	CanEditPlan      bool
+	CanManageRewards bool
+	CanViewReports   bool
+	CreatedAt        time.Time
+}
+
+// ChildLink is a child as seen by one of its guardians.
+type ChildLink struct {
+	Child    Child
+	Guardian Guardian
+}
+
+// Allows reports whether the guardian holds perm. The primary guardian holds
+// every permission regardless of its flags.
+func (g Guardian) Allows(perm Permission) bool {
+	if g.IsPrimary {
+		return true
+	}
+	switch perm {
+	case PermView:
+		return true
+	case PermEditPlan:
+		return g.CanEditPlan
+	case PermManageRewards:
+		return g.CanManageRewards
+	case PermViewReports:
+		return g.CanViewReports
+	}
+	return false
+}
+
+func ParseRelation(s string) (Relation, error) {
+	if strings.TrimSpace(s) == "" {
+		return RelationParent, nil
+	}
+	r := Relation(strings.ToLower(strings.TrimSpace(s)))
+	switch r {
+	case RelationParent, RelationMother, RelationFather, RelationGuardian:
+		return r, nil
+	}
+	return "", ErrInvalidRelation
+}
+
+// ParseDOB parses a YYYY-MM-DD date of birth. An empty string means unknown.
+func ParseDOB(s string, now time.Time) (*time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	dob, err := time.Parse(time.DateOnly, s)
+	if err != nil || dob.After(now) {
+		return nil, ErrInvalidDOB
+	}
+	return &dob, nil
+}
+
+// NewChild validates a child profile.
+func NewChild(fullName string, dob *time.Time, grade *int) (*Child, error) {
+	c := &Child{}
+	if err := c.Update(fullName, dob, grade); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Update replaces the profile fields after validating them.
+func (c *Child) Update(fullName string, dob *time.Time, grade *int) error {
+	fullName = strings.TrimSpace(fullName)
+	if fullName == "" {
+		return ErrNameRequired
+	}
+	if utf8.RuneCountInString(fullName) > maxNameLength {
+		return ErrNameTooLong
+	}
+	if grade != nil && (*grade < minGrade || *grade > maxGrade) {
+		return ErrInvalidGrade
+	}
+	c.FullName = fullName
+	c.DOB = dob
+	c.Grade = grade
+	return nil
+}
@@ -0,0 +1,84 @@
+package child
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGuardianAllows(t *testing.T) {
+	coGuardian := Guardian{CanEditPlan: true}
+	tests := []struct {
+		perm Permission
+		want bool
+	}{
+		{PermView, true},
+		{PermEditPlan, true},
+		{PermManageRewards, false},
+		{PermViewReports, false},
+		{PermManageGuardians, false},
+	}
+	for _, tt := range tests {
+		if got := coGuardian.Allows(tt.perm); got != tt.want {
+			t.Fatalf("Allows(%s) = %v, want %v", tt.perm, got, tt.want)
+		}
+	}
+
+	primary := Guardian{IsPrimary: true}
+	for _, perm := range []Permission{PermView, PermEditPlan, PermManageRewards, PermViewReports, PermManageGuardians} {
+		if !primary.Allows(perm) {
+			t.Fatalf("primary guardian should hold %s", perm)
+		}
+	}
+}
+
+func TestNewChild(t *testing.T) {
+	grade := 3
+	c, err := NewChild("  An Nguyen ", nil, &grade)
+	if err != nil {
+		t.Fatalf("NewChild error: %v", err)
+	}
+	if c.FullName != "An Nguyen" {
+		t.Fatalf("full name = %q, want trimmed", c.FullName)
+	}
+
+	if _, err := NewChild(" ", nil, nil); !errors.Is(err, ErrNameRequired) {
+		t.Fatalf("error = %v, want ErrNameRequired", err)
+	}
+	if _, err := NewChild(strings.Repeat("a", maxNameLength+1), nil, nil); !errors.Is(err, ErrNameTooLong) {
+		t.Fatalf("error = %v, want ErrNameTooLong", err)
+	}
+	bad := 13
+	if _, err := NewChild("An", nil, &bad); !errors.Is(err, ErrInvalidGrade) {
+		t.Fatalf("error = %v, want ErrInvalidGrade", err)
+	}
+}
+
+func TestParseDOB(t *testing.T) {
+	now := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	if dob, err := ParseDOB("", now); err != nil || dob != nil {
+		t.Fatalf("ParseDOB(\"\") = %v, %v, want nil, nil", dob, err)
+	}
+	dob, err := ParseDOB("2018-09-05", now)
+	if err != nil || dob.Format(time.DateOnly) != "2018-09-05" {
+		t.Fatalf("ParseDOB = %v, %v", dob, err)
+	}
+	for _, in := range []string{"05/09/2018", "2027-01-01"} {
+		if _, err := ParseDOB(in, now); !errors.Is(err, ErrInvalidDOB) {
+			t.Fatalf("ParseDOB(%q) error = %v, want ErrInvalidDOB", in, err)
+		}
+	}
+}
+
+func TestParseRelation(t *testing.T) {
+	if r, err := ParseRelation(""); err != nil || r != RelationParent {
+		t.Fatalf("ParseRelation(\"\") = %s, %v, want parent", r, err)
+	}
+	if r, err := ParseRelation(" Mother "); err != nil || r != RelationMother {
+		t.Fatalf("ParseRelation = %s, %v, want mother", r, err)
+	}
+	if _, err := ParseRelation("uncle"); !errors.Is(err, ErrInvalidRelation) {
+		t.Fatalf("error = %v, want ErrInvalidRelation", err)
+	}
+}
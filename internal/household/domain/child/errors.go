@@ -0,0 +1,21 @@
+package child
+
+import (
+	domainerr "go-ai/pkg/domain_err"
+	"net/http"
+)
+
+var (
+	ErrNotFound         = domainerr.New(http.StatusNotFound, "Child not found")
+	ErrNameRequired     = domainerr.New(http.StatusBadRequest, "Child name is required")
+	ErrNameTooLong      = domainerr.New(http.StatusBadRequest, "Child name is too long")
+	ErrInvalidDOB       = domainerr.New(http.StatusBadRequest, "Date of birth must be a past date in YYYY-MM-DD format")
+	ErrInvalidGrade     = domainerr.New(http.StatusBadRequest, "Grade must be between 1 and 12")
+	ErrInvalidRelation  = domainerr.New(http.StatusBadRequest, "Relation must be one of parent, mother, father, guardian")
+	ErrForbidden        = domainerr.New(http.StatusForbidden, "You don't have permission for this child")
+	ErrGuardianNotFound = domainerr.New(http.StatusNotFound, "Guardian not found")
+	ErrAlreadyGuardian  = domainerr.New(http.StatusConflict, "User is already a guardian of this child")
+	ErrUserNotFound     = domainerr.New(http.StatusNotFound, "No user with this email")
+	ErrPrimaryGuardian  = domainerr.New(http.StatusConflict, "Transfer the primary guardian role before removing this guardian")
+	ErrAlreadyPrimary   = domainerr.New(http.StatusConflict, "Guardian is already the primary guardian")
+)
@@ -0,0 +1,28 @@
+package child
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// Create stores the child with g as its primary guardian.
+	Create(ctx context.Context, c *Child, g *Guardian) (*Child, error)
+	Get(ctx context.Context, id uuid.UUID) (*Child, error)
+	Update(ctx context.Context, c *Child) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListForUser(ctx context.Context, userID uuid.UUID) ([]ChildLink, error)
+
+	// GetGuardian returns ErrGuardianNotFound when the user is not linked.
+	GetGuardian(ctx context.Context, childID, userID uuid.UUID) (*Guardian, error)
+	ListGuardians(ctx context.Context, childID uuid.UUID) ([]Guardian, error)
+	AddGuardian(ctx context.Context, g *Guardian) error
+	UpdateGuardian(ctx context.Context, g *Guardian) error
+	RemoveGuardian(ctx context.Context, childID, userID uuid.UUID) error
+	// SetPrimary moves the primary guardian role to userID in one transaction.
+	SetPrimary(ctx context.Context, childID, userID uuid.UUID) error
+
+	// FindUserByEmail returns ErrUserNotFound when no account uses email.
+	FindUserByEmail(ctx context.Context, email string) (uuid.UUID, error)
+}
@@ -0,0 +1,286 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/household/domain/child"
+	sqlc "go-ai/internal/household/infrastructure/sqlc/child"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type ChildRepo struct {
+	pool    *pgxpool.Pool
+	queries *sqlc.Queries
+}
+
+func NewChildRepo(pool *pgxpool.Pool) *ChildRepo {
+	return &ChildRepo{
+		pool:    pool,
+		queries: sqlc.New(pool),
+	}
+}
+
+func (r *ChildRepo) Create(ctx context.Context, c *child.Child, g *child.Guardian) (*child.Child, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	q := r.queries.WithTx(tx)
+
+	row, err := q.CreateChild(ctx, sqlc.CreateChildParams{
+		FullName: c.FullName,
+		Dob:      c.DOB,
+		Grade:    toGrade(c.Grade),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := q.CreateChildGuardian(ctx, sqlc.CreateChildGuardianParams{
+		UserID:           g.UserID,
+		ChildID:          row.ID,
+		Relation:         string(g.Relation),
+		IsPrimary:        true,
+		CanEditPlan:      true,
+		CanManageRewards: true,
+		CanViewReports:   true,
+	}); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	created := toChild(row)
+	return &created, nil
+}
+
+func (r *ChildRepo) Get(ctx context.Context, id uuid.UUID) (*child.Child, error) {
+	row, err := r.queries.GetChild(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, child.ErrNotFound
+		}
+		return nil, err
+	}
+	c := toChild(row)
+	return &c, nil
+}
+
+func (r *ChildRepo) Update(ctx context.Context, c *child.Child) error {
+	affected, err := r.queries.UpdateChild(ctx, sqlc.UpdateChildParams{
+		FullName: c.FullName,
+		Dob:      c.DOB,
+		Grade:    toGrade(c.Grade),
+		ID:       c.ID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return child.ErrNotFound
+	}
+	return nil
+}
+
+func (r *ChildRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	affected, err := r.queries.DeleteChild(ctx, id)
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return child.ErrNotFound
+	}
+	return nil
+}
+
+func (r *ChildRepo) ListForUser(ctx context.Context, userID uuid.UUID) ([]child.ChildLink, error) {
+	rows, err := r.queries.ListUserChildren(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	links := make([]child.ChildLink, 0, len(rows))
+	for _, row := range rows {
+		links = append(links, child.ChildLink{
+			Child: toChild(sqlc.Children{
+				ID:        row.ID,
+				FullName:  row.FullName,
+				Dob:       row.Dob,
+				Grade:     row.Grade,
+				CreatedAt: row.CreatedAt,
+				UpdatedAt: row.UpdatedAt,
+			}),
+			Guardian: child.Guardian{
+				UserID:           userID,
+				ChildID:          row.ID,
+				Relation:         child.Relation(row.Relation),
+				IsPrimary:        row.IsPrimary,
+				CanEditPlan:      row.CanEditPlan,
+				CanManageRewards: row.CanManageRewards,
+				CanViewReports:   row.CanViewReports,
+			},
+		})
+	}
+	return links, nil
+}
+
+func (r *ChildRepo) GetGuardian(ctx context.Context, childID, userID uuid.UUID) (*child.Guardian, error) {
+	row, err := r.queries.GetChildGuardian(ctx, sqlc.GetChildGuardianParams{
+		UserID:  userID,
+		ChildID: childID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, child.ErrGuardianNotFound
+		}
+		return nil, err
+	}
+	return &child.Guardian{
+		UserID:           row.UserID,
+		ChildID:          row.ChildID,
+		Relation:         child.Relation(row.Relation),
+		IsPrimary:        row.IsPrimary,
+		CanEditPlan:      row.CanEditPlan,
+		CanManageRewards: row.CanManageRewards,
+		CanViewReports:   row.CanViewReports,
+		CreatedAt:        row.CreatedAt,
+	}, nil
+}
+
+func (r *ChildRepo) ListGuardians(ctx context.Context, childID uuid.UUID) ([]child.Guardian, error) {
+	rows, err := r.queries.ListChildGuardians(ctx, childID)
+	if err != nil {
+		return nil, err
+	}
+	guardians := make([]child.Guardian, 0, len(rows))
+	for _, row := range rows {
+		email := ""
+		if row.Email != nil {
+			email = *row.Email
+		}
+		guardians = append(guardians, child.Guardian{
+			UserID:           row.UserID,
+			ChildID:          childID,
+			Email:            email,
+			FullName:         row.FullName,
+			Relation:         child.Relation(row.Relation),
+			IsPrimary:        row.IsPrimary,
+			CanEditPlan:      row.CanEditPlan,
+			CanManageRewards: row.CanManageRewards,
+			CanViewReports:   row.CanViewReports,
+			CreatedAt:        row.CreatedAt,
+		})
+	}
+	return guardians, nil
+}
+
+func (r *ChildRepo) AddGuardian(ctx context.Context, g *child.Guardian) error {
+	affected, err := r.queries.CreateChildGuardian(ctx, sqlc.CreateChildGuardianParams{
+		UserID:           g.UserID,
+		ChildID:          g.ChildID,
+		Relation:         string(g.Relation),
+		IsPrimary:        false,
+		CanEditPlan:      g.CanEditPlan,
+		CanManageRewards: g.CanManageRewards,
+		CanViewReports:   g.CanViewReports,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return child.ErrAlreadyGuardian
+	}
+	return nil
+}
+
+func (r *ChildRepo) UpdateGuardian(ctx context.Context, g *child.Guardian) error {
+	affected, err := r.queries.UpdateChildGuardian(ctx, sqlc.UpdateChildGuardianParams{
+		Relation:         string(g.Relation),
+		CanEditPlan:      g.CanEditPlan,
+		CanManageRewards: g.CanManageRewards,
+		CanViewReports:   g.CanViewReports,
+		UserID:           g.UserID,
+		ChildID:          g.ChildID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return child.ErrGuardianNotFound
+	}
+	return nil
+}
+
+func (r *ChildRepo) RemoveGuardian(ctx context.Context, childID, userID uuid.UUID) error {
+	affected, err := r.queries.DeleteChildGuardian(ctx, sqlc.DeleteChildGuardianParams{
+		UserID:  userID,
+		ChildID: childID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return child.ErrGuardianNotFound
+	}
+	return nil
+}
+
+func (r *ChildRepo) SetPrimary(ctx context.Context, childID, userID uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	q := r.queries.WithTx(tx)
+
+	if err := q.ClearChildPrimaryGuardian(ctx, childID); err != nil {
+		return err
+	}
+	affected, err := q.SetChildPrimaryGuardian(ctx, sqlc.SetChildPrimaryGuardianParams{
+		UserID:  userID,
+		ChildID: childID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return child.ErrGuardianNotFound
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *ChildRepo) FindUserByEmail(ctx context.Context, email string) (uuid.UUID, error) {
+	id, err := r.queries.GetUserIDByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, child.ErrUserNotFound
+		}
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+func toChild(row sqlc.Children) child.Child {
+	c := child.Child{
+		ID:        row.ID,
+		FullName:  row.FullName,
+		DOB:       row.Dob,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+	if row.Grade != nil {
+		grade := int(*row.Grade)
+		c.Grade = &grade
+	}
+	return c
+}
+
+func toGrade(grade *int) *int32 {
+	if grade == nil {
+		return nil
+	}
+	g := int32(*grade)
+	return &g
+}
@@ -0,0 +1,340 @@
+package householdhttp
+
+import (
+	childapp "go-ai/internal/household/application/child"
+	"go-ai/internal/household/domain/child"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/rs/zerolog"
+)
+
+type ChildHandler struct {
+	CreateChildUseCase        *childapp.CreateChildUseCase
+	ListChildrenUseCase       *childapp.ListChildrenUseCase
+	GetChildUseCase           *childapp.GetChildUseCase
+	UpdateChildUseCase        *childapp.UpdateChildUseCase
+	DeleteChildUseCase        *childapp.DeleteChildUseCase
+	ListGuardiansUseCase      *childapp.ListGuardiansUseCase
+	AddGuardianUseCase        *childapp.AddGuardianUseCase
+	UpdateGuardianUseCase     *childapp.UpdateGuardianUseCase
+	RemoveGuardianUseCase     *childapp.RemoveGuardianUseCase
+	SetPrimaryGuardianUseCase *childapp.SetPrimaryGuardianUseCase
+	Logger                    zerolog.Logger
+}
+
+func NewChildHandler(
+	createChildUseCase *childapp.CreateChildUseCase,
+	listChildrenUseCase *childapp.ListChildrenUseCase,
+	getChildUseCase *childapp.GetChildUseCase,
+	updateChildUseCase *childapp.UpdateChildUseCase,
+	deleteChildUseCase *childapp.DeleteChildUseCase,
+	listGuardiansUseCase *childapp.ListGuardiansUseCase,
+	addGuardianUseCase *childapp.AddGuardianUseCase,
+	updateGuardianUseCase *childapp.UpdateGuardianUseCase,
+	removeGuardianUseCase *childapp.RemoveGuardianUseCase,
+	setPrimaryGuardianUseCase *childapp.SetPrimaryGuardianUseCase,
+	logger zerolog.Logger,
+) *ChildHandler {
+	return &ChildHandler{
+		CreateChildUseCase:        createChildUseCase,
+		ListChildrenUseCase:       listChildrenUseCase,
+		GetChildUseCase:           getChildUseCase,
+		UpdateChildUseCase:        updateChildUseCase,
+		DeleteChildUseCase:        deleteChildUseCase,
+		ListGuardiansUseCase:      listGuardiansUseCase,
+		AddGuardianUseCase:        addGuardianUseCase,
+		UpdateGuardianUseCase:     updateGuardianUseCase,
+		RemoveGuardianUseCase:     removeGuardianUseCase,
+		SetPrimaryGuardianUseCase: setPrimaryGuardianUseCase,
+		Logger:                    logger.With().Str("component", "ChildHandler").Logger(),
+	}
+}
+
+// CreateChild godoc
+// @Summary Create a child profile
+// @Description Create a child profile with the caller as its primary guardian
+// @Tags Household
+// @Accept json
+// @Produce json
+// @Param body body childapp.CreateChildRequest true "Name, optional date of birth (YYYY-MM-DD) and grade, and your relation"
+// @Success 200 {object} childapp.ChildSuccessResponseDoc "Child created successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/children [post]
+func (h *ChildHandler) CreateChild(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	var in childapp.CreateChildRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	result, err := h.CreateChildUseCase.Execute(c.Request().Context(), userID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to create child")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Child created successfully")
+}
+
+// ListChildren godoc
+// @Summary List my children
+// @Description List the children the caller is a guardian of, with the caller's permissions on each
+// @Tags Household
+// @Produce json
+// @Success 200 {object} childapp.ListChildrenSuccessResponseDoc "Children retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/children [get]
+func (h *ChildHandler) ListChildren(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	result, err := h.ListChildrenUseCase.Execute(c.Request().Context(), userID)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to list children")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Children retrieved successfully")
+}
+
+// GetChild godoc
+// @Summary Get a child profile
+// @Description Get a child profile. Any guardian of the child can read it
+// @Tags Household
+// @Produce json
+// @Param child_id path string true "Child ID"
+// @Success 200 {object} childapp.ChildSuccessResponseDoc "Child retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/children/{child_id} [get]
+func (h *ChildHandler) GetChild(c *echo.Context) error {
+	actor, ok := c.Get("child_guardian").(child.Guardian)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "Permission denied")
+	}
+	result, err := h.GetChildUseCase.Execute(c.Request().Context(), actor)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to get child")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Child retrieved successfully")
+}
+
+// UpdateChild godoc
+// @Summary Update a child profile
+// @Description Update a child profile. Needs the can_edit_plan permission
+// @Tags Household
+// @Accept json
+// @Produce json
+// @Param child_id path string true "Child ID"
+// @Param body body childapp.UpdateChildRequest true "Fields to change"
+// @Success 200 {object} childapp.ChildSuccessResponseDoc "Child updated successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/children/{child_id} [patch]
+func (h *ChildHandler) UpdateChild(c *echo.Context) error {
+	actor, ok := c.Get("child_guardian").(child.Guardian)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "Permission denied")
+	}
+	var in childapp.UpdateChildRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	result, err := h.UpdateChildUseCase.Execute(c.Request().Context(), actor, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to update child")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Child updated successfully")
+}
+
+// DeleteChild godoc
+// @Summary Delete a child profile
+// @Description Delete a child profile and all guardian links. Primary guardian only
+// @Tags Household
+// @Produce json
+// @Param child_id path string true "Child ID"
+// @Success 200 {object} response.SuccessBaseDoc "Child deleted successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/children/{child_id} [delete]
+func (h *ChildHandler) DeleteChild(c *echo.Context) error {
+	actor, ok := c.Get("child_guardian").(child.Guardian)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "Permission denied")
+	}
+	if err := h.DeleteChildUseCase.Execute(c.Request().Context(), actor.ChildID); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to delete child")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Child deleted successfully")
+}
+
+// ListGuardians godoc
+// @Summary List guardians
+// @Description List a child's guardians, primary first
+// @Tags Household
+// @Produce json
+// @Param child_id path string true "Child ID"
+// @Success 200 {object} childapp.ListGuardiansSuccessResponseDoc "Guardians retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/children/{child_id}/guardians [get]
+func (h *ChildHandler) ListGuardians(c *echo.Context) error {
+	actor, ok := c.Get("child_guardian").(child.Guardian)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "Permission denied")
+	}
+	result, err := h.ListGuardiansUseCase.Execute(c.Request().Context(), actor.ChildID)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to list guardians")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Guardians retrieved successfully")
+}
+
+// AddGuardian godoc
+// @Summary Link a co-guardian
+// @Description Link an existing account to the child by email. Primary guardian only
+// @Tags Household
+// @Accept json
+// @Produce json
+// @Param child_id path string true "Child ID"
+// @Param body body childapp.AddGuardianRequest true "Email, relation and permission flags"
+// @Success 200 {object} childapp.GuardianSuccessResponseDoc "Guardian added successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/children/{child_id}/guardians [post]
+func (h *ChildHandler) AddGuardian(c *echo.Context) error {
+	actor, ok := c.Get("child_guardian").(child.Guardian)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "Permission denied")
+	}
+	var in childapp.AddGuardianRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	result, err := h.AddGuardianUseCase.Execute(c.Request().Context(), actor.ChildID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to add guardian")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Guardian added successfully")
+}
+
+// UpdateGuardian godoc
+// @Summary Update a guardian
+// @Description Change a guardian's relation or permission flags. Primary guardian only
+// @Tags Household
+// @Accept json
+// @Produce json
+// @Param child_id path string true "Child ID"
+// @Param user_id path string true "Guardian user ID"
+// @Param body body childapp.UpdateGuardianRequest true "Fields to change"
+// @Success 200 {object} childapp.GuardianSuccessResponseDoc "Guardian updated successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/children/{child_id}/guardians/{user_id} [patch]
+func (h *ChildHandler) UpdateGuardian(c *echo.Context) error {
+	actor, ok := c.Get("child_guardian").(child.Guardian)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "Permission denied")
+	}
+	userID, err := uuid.Parse(c.Param("user_id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid user ID")
+	}
+	var in childapp.UpdateGuardianRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	result, err := h.UpdateGuardianUseCase.Execute(c.Request().Context(), actor.ChildID, userID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to update guardian")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Guardian updated successfully")
+}
+
+// RemoveGuardian godoc
+// @Summary Unlink a guardian
+// @Description Unlink a guardian from the child. Guardians can unlink themselves, the primary guardian can unlink anyone else
+// @Tags Household
+// @Produce json
+// @Param child_id path string true "Child ID"
+// @Param user_id path string true "Guardian user ID"
+// @Success 200 {object} response.SuccessBaseDoc "Guardian removed successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/children/{child_id}/guardians/{user_id} [delete]
+func (h *ChildHandler) RemoveGuardian(c *echo.Context) error {
+	actor, ok := c.Get("child_guardian").(child.Guardian)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "Permission denied")
+	}
+	userID, err := uuid.Parse(c.Param("user_id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid user ID")
+	}
+	if err := h.RemoveGuardianUseCase.Execute(c.Request().Context(), actor, userID); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to remove guardian")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Guardian removed successfully")
+}
+
+// SetPrimaryGuardian godoc
+// @Summary Make a guardian primary
+// @Description Hand the primary guardian role to another guardian of the child. Primary guardian only
+// @Tags Household
+// @Produce json
+// @Param child_id path string true "Child ID"
+// @Param user_id path string true "Guardian user ID"
+// @Success 200 {object} childapp.GuardianSuccessResponseDoc "Primary guardian changed successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/children/{child_id}/guardians/{user_id}/primary [post]
+func (h *ChildHandler) SetPrimaryGuardian(c *echo.Context) error {
+	actor, ok := c.Get("child_guardian").(child.Guardian)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "Permission denied")
+	}
+	userID, err := uuid.Parse(c.Param("user_id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid user ID")
+	}
+	result, err := h.SetPrimaryGuardianUseCase.Execute(c.Request().Context(), actor.ChildID, userID)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to set primary guardian")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Primary guardian changed successfully")
+}
@@ -0,0 +1,28 @@
+package householdhttp
+
+import (
+	"go-ai/internal/household/domain/child"
+	householdmw "go-ai/internal/household/transport/middlewares"
+	middlewares "go-ai/internal/identity/transport/middlewares"
+
+	"github.com/labstack/echo/v5"
+)
+
+func RegisterChildRoutes(api *echo.Group, h *ChildHandler, m *middlewares.IdentityMiddleware, repo child.Repository) {
+	children := api.Group("/children", m.SessionOnly)
+	view := householdmw.RequireChildPermission(repo, child.PermView)
+	editPlan := householdmw.RequireChildPermission(repo, child.PermEditPlan)
+	manage := householdmw.RequireChildPermission(repo, child.PermManageGuardians)
+
+	children.GET("", h.ListChildren)
+	children.POST("", h.CreateChild)
+	children.GET("/:child_id", h.GetChild, view)
+	children.PATCH("/:child_id", h.UpdateChild, editPlan)
+	children.DELETE("/:child_id", h.DeleteChild, manage)
+
+	children.GET("/:child_id/guardians", h.ListGuardians, view)
+	children.POST("/:child_id/guardians", h.AddGuardian, manage)
+	children.PATCH("/:child_id/guardians/:user_id", h.UpdateGuardian, manage)
+	children.DELETE("/:child_id/guardians/:user_id", h.RemoveGuardian, view)
+	children.POST("/:child_id/guardians/:user_id/primary", h.SetPrimaryGuardian, manage)
+}
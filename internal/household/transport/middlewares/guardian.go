@@ -0,0 +1,46 @@
+package middlewares
+
+import (
+	"errors"
+	"go-ai/internal/household/domain/child"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+// RequireChildPermission loads the caller's guardian link to the :child_id
+// route parameter and checks perm against its flags. The link is stored as
+// "child_guardian". Callers who aren't linked get 404 so child IDs can't be
+// probed.
+func RequireChildPermission(repo child.Repository, perm child.Permission) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c *echo.Context) error {
+			userID, ok := c.Get("user_id").(uuid.UUID)
+			if !ok {
+				return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+			}
+			childID, err := uuid.Parse(c.Param("child_id"))
+			if err != nil {
+				return response.Error(c, http.StatusBadRequest, "Invalid child ID")
+			}
+			g, err := repo.GetGuardian(c.Request().Context(), childID, userID)
+			if err != nil {
+				if errors.Is(err, child.ErrGuardianNotFound) {
+					err = child.ErrNotFound
+				}
+				if ae, ok := err.(domainerr.AppError); ok {
+					return response.Error(c, ae.Status, ae.Msg)
+				}
+				return response.Error(c, http.StatusInternalServerError, "Internal server error")
+			}
+			if !g.Allows(perm) {
+				return response.Error(c, child.ErrForbidden.Status, child.ErrForbidden.Msg)
+			}
+			c.Set("child_guardian", *g)
+			return next(c)
+		}
+	}
+}
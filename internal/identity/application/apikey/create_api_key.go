@@ -0,0 +1,96 @@
+package apikeyapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/apikey"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/rbac"
+	domainerr "go-ai/pkg/domain_err"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateAPIKeyUseCase struct {
+	Repo  apikey.Repository
+	Rbac  rbac.Service
+	Audit audit.AuditLogger
+}
+
+func NewCreateAPIKeyUseCase(repo apikey.Repository, rbacService rbac.Service, auditLog audit.AuditLogger) *CreateAPIKeyUseCase {
+	return &CreateAPIKeyUseCase{
+		Repo:  repo,
+		Rbac:  rbacService,
+		Audit: auditLog,
+	}
+}
+
+// Execute creates a key whose scopes must be covered by the user's current
+// permissions. The returned key is never retrievable again.
+func (uc *CreateAPIKeyUseCase) Execute(ctx context.Context, userID uuid.UUID, req CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, apikey.ErrNameRequired
+	}
+	if len(req.Scopes) == 0 {
+		return nil, apikey.ErrScopesRequired
+	}
+	scopes, err := rbac.NormalizePermissions(req.Scopes)
+	if err != nil {
+		return nil, apikey.ErrInvalidScope
+	}
+	allowedIPs, err := apikey.NormalizeAllowedIPs(req.AllowedIPs)
+	if err != nil {
+		return nil, err
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, apikey.ErrExpiryInPast
+	}
+
+	ur, err := uc.Rbac.Resolve(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, scope := range scopes {
+		if !ur.HasPermission(scope) {
+			return nil, apikey.ErrScopeNotGranted
+		}
+	}
+
+	count, err := uc.Repo.CountActive(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= apikey.MaxKeysPerUser {
+		return nil, apikey.ErrTooManyKeys
+	}
+
+	raw, prefix, hash, err := apikey.Generate()
+	if err != nil {
+		return nil, domainerr.ErrInternalServerError
+	}
+	created, err := uc.Repo.Create(ctx, &apikey.Entity{
+		UserID:     userID,
+		Name:       name,
+		Prefix:     prefix,
+		SecretHash: hash,
+		Scopes:     scopes,
+		AllowedIPs: allowedIPs,
+		ExpiresAt:  req.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	uc.Audit.Log(ctx, audit.Event{
+		ActorID:    userID,
+		Action:     audit.ActionAPIKeyCreated,
+		TargetType: audit.TargetAPIKey,
+		TargetID:   created.ID.String(),
+		Metadata:   map[string]any{"name": created.Name, "prefix": created.Prefix, "scopes": created.Scopes},
+	})
+	return &CreateAPIKeyResponse{
+		APIKeyResponse: toAPIKeyResponse(*created),
+		Key:            raw,
+	}, nil
+}
@@ -0,0 +1,19 @@
+package apikeyapp
+
+import (
+	"go-ai/pkg/response"
+)
+
+type CreateAPIKeySuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *CreateAPIKeyResponse `json:"data,omitempty"`
+}
+
+type ListAPIKeysSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data []APIKeyResponse `json:"data,omitempty"`
+}
+
+type RevokeAPIKeySuccessResponseDoc struct {
+	response.SuccessBaseDoc
+}
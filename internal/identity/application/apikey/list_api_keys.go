@@ -0,0 +1,30 @@
+package apikeyapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/apikey"
+
+	"github.com/google/uuid"
+)
+
+type ListAPIKeysUseCase struct {
+	Repo apikey.Repository
+}
+
+func NewListAPIKeysUseCase(repo apikey.Repository) *ListAPIKeysUseCase {
+	return &ListAPIKeysUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *ListAPIKeysUseCase) Execute(ctx context.Context, userID uuid.UUID) ([]APIKeyResponse, error) {
+	keys, err := uc.Repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]APIKeyResponse, 0, len(keys))
+	for _, k := range keys {
+		items = append(items, toAPIKeyResponse(k))
+	}
+	return items, nil
+}
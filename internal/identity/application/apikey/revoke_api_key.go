@@ -0,0 +1,34 @@
+package apikeyapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/apikey"
+	"go-ai/internal/identity/domain/audit"
+
+	"github.com/google/uuid"
+)
+
+type RevokeAPIKeyUseCase struct {
+	Repo  apikey.Repository
+	Audit audit.AuditLogger
+}
+
+func NewRevokeAPIKeyUseCase(repo apikey.Repository, auditLog audit.AuditLogger) *RevokeAPIKeyUseCase {
+	return &RevokeAPIKeyUseCase{
+		Repo:  repo,
+		Audit: auditLog,
+	}
+}
+
+func (uc *RevokeAPIKeyUseCase) Execute(ctx context.Context, userID, id uuid.UUID) error {
+	if err := uc.Repo.Revoke(ctx, userID, id); err != nil {
+		return err
+	}
+	uc.Audit.Log(ctx, audit.Event{
+		ActorID:    userID,
+		Action:     audit.ActionAPIKeyRevoked,
+		TargetType: audit.TargetAPIKey,
+		TargetID:   id.String(),
+	})
+	return nil
+}
@@ -0,0 +1,13 @@
+package auditapp
+
+import (
+	"go-ai/pkg/response"
+)
+
+type ListAuditEventsSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *struct {
+		response.PaginatedResponseDoc
+		Items []AuditEventResponse `json:"items"`
+	} `json:"data,omitempty"`
+}
@@ -0,0 +1,43 @@
+package auditapp
+
+import (
+	"go-ai/internal/identity/domain/audit"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AuditEventResponse struct {
+	ID         uuid.UUID      `json:"id"`
+	ActorID    *uuid.UUID     `json:"actor_id"`
+	Action     string         `json:"action"`
+	TargetType string         `json:"target_type"`
+	TargetID   string         `json:"target_id"`
+	IP         string         `json:"ip"`
+	UserAgent  string         `json:"user_agent"`
+	RequestID  string         `json:"request_id"`
+	Metadata   map[string]any `json:"metadata"`
+	CreatedAt  time.Time      `json:"created_at"`
+}
+
+func toAuditEventResponse(e audit.Event) AuditEventResponse {
+	resp := AuditEventResponse{
+		ID:         e.ID,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		TargetID:   e.TargetID,
+		IP:         e.IP,
+		UserAgent:  e.UserAgent,
+		RequestID:  e.RequestID,
+		Metadata:   e.Metadata,
+		CreatedAt:  e.CreatedAt,
+	}
+	if e.ActorID != uuid.Nil {
+		actorID := e.ActorID
+		resp.ActorID = &actorID
+	}
+	if resp.Metadata == nil {
+		resp.Metadata = map[string]any{}
+	}
+	return resp
+}
@@ -0,0 +1,83 @@
+package auditapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/pkg/response"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ListAuditEventsRequest struct {
+	ActorID    string `query:"actor_id"`
+	Action     string `query:"action"`
+	TargetType string `query:"target_type"`
+	TargetID   string `query:"target_id"`
+	IP         string `query:"ip"`
+	Since      string `query:"since"`
+	Until      string `query:"until"`
+	Page       *int32 `query:"page"`
+	Limit      *int32 `query:"limit"`
+}
+
+type ListAuditEventsUseCase struct {
+	Repo audit.Repository
+}
+
+func NewListAuditEventsUseCase(repo audit.Repository) *ListAuditEventsUseCase {
+	return &ListAuditEventsUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *ListAuditEventsUseCase) Execute(ctx context.Context, req ListAuditEventsRequest) (*response.PaginatedResponse[[]AuditEventResponse], error) {
+	filter := audit.Filter{
+		Action:     req.Action,
+		TargetType: req.TargetType,
+		TargetID:   req.TargetID,
+		IP:         req.IP,
+	}
+	if req.ActorID != "" {
+		id, err := uuid.Parse(req.ActorID)
+		if err != nil {
+			return nil, audit.ErrInvalidActorID
+		}
+		filter.ActorID = &id
+	}
+	var err error
+	if filter.Since, err = parseTime(req.Since); err != nil {
+		return nil, err
+	}
+	if filter.Until, err = parseTime(req.Until); err != nil {
+		return nil, err
+	}
+
+	page, limit, offset := response.ApplyDefaultPaginated(req.Page, req.Limit)
+	events, total, err := uc.Repo.List(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]AuditEventResponse, 0, len(events))
+	for _, e := range events {
+		items = append(items, toAuditEventResponse(e))
+	}
+	return &response.PaginatedResponse[[]AuditEventResponse]{
+		Page:       page,
+		Limit:      limit,
+		TotalItems: total,
+		TotalPages: response.CalculateTotalPages(total, int64(limit)),
+		Items:      items,
+	}, nil
+}
+
+func parseTime(v string) (*time.Time, error) {
+	if v == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, audit.ErrInvalidTimeRange
+	}
+	return &t, nil
+}
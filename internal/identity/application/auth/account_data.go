@@ -0,0 +1,51 @@
+package authapp
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// UserDataExporter returns what a module keeps about a user for the personal
+// data export. orgIDs are the organizations the user belongs to.
+type UserDataExporter interface {
+	ExportUserData(ctx context.Context, userID uuid.UUID, orgIDs []uuid.UUID) (any, error)
+}
+
+// UserDataPurger removes what a module keeps about a user whose account is
+// being deleted. orgIDs are the organizations deleted along with the account.
+type UserDataPurger interface {
+	PurgeUserData(ctx context.Context, userID uuid.UUID, orgIDs []uuid.UUID) error
+}
+
+// AccountData collects the exporters and purgers of the modules that keep
+// data about users. Modules register while the app is built.
+type AccountData struct {
+	exporters map[string]UserDataExporter
+	purgers   []UserDataPurger
+}
+
+func NewAccountData() *AccountData {
+	return &AccountData{
+		exporters: map[string]UserDataExporter{},
+	}
+}
+
+// AddExporter adds a section called name to every data export.
+func (d *AccountData) AddExporter(name string, e UserDataExporter) {
+	d.exporters[name] = e
+}
+
+func (d *AccountData) AddPurger(p UserDataPurger) {
+	d.purgers = append(d.purgers, p)
+}
+
+func (d *AccountData) sections() []string {
+	names := make([]string, 0, len(d.exporters))
+	for name := range d.exporters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
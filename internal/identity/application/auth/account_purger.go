@@ -0,0 +1,131 @@
+package authapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/identity/domain/org"
+	"go-ai/pkg/lock"
+	"go-ai/pkg/worker"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+const (
+	purgeBatchSize = 100
+	// purgeLockKey lets one replica purge per pass.
+	purgeLockKey = "pass"
+)
+
+// AccountPurger deletes accounts whose grace period has ended, together with
+// the organizations they were the only member of. Every module registered in
+// AccountData removes its own data first.
+type AccountPurger struct {
+	repo     auth.Repository
+	orgs     org.Repository
+	data     *AccountData
+	locker   *lock.Locker
+	interval time.Duration
+	audit    audit.AuditLogger
+	logger   zerolog.Logger
+
+	group *worker.Group
+}
+
+func NewAccountPurger(
+	repo auth.Repository,
+	orgs org.Repository,
+	data *AccountData,
+	locker *lock.Locker,
+	interval time.Duration,
+	auditLog audit.AuditLogger,
+	logger zerolog.Logger,
+) *AccountPurger {
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	logger = logger.With().Str("component", "AccountPurger").Logger()
+	return &AccountPurger{
+		repo:     repo,
+		orgs:     orgs,
+		data:     data,
+		locker:   locker,
+		interval: interval,
+		audit:    auditLog,
+		logger:   logger,
+		group:    worker.New("account purger", logger),
+	}
+}
+
+// Start launches the purge loop. It returns immediately.
+func (p *AccountPurger) Start(ctx context.Context) {
+	p.group.Start(ctx, p.loop)
+	p.logger.Info().Dur("interval", p.interval).Msg("account purger started")
+}
+
+// Shutdown stops the loop and waits for the current purge to finish, giving
+// up when ctx expires.
+func (p *AccountPurger) Shutdown(ctx context.Context) error {
+	return p.group.Shutdown(ctx)
+}
+
+func (p *AccountPurger) loop(ctx context.Context) {
+	worker.Every(ctx, p.interval, func(ctx context.Context) {
+		worker.Exclusive(ctx, p.locker, purgeLockKey, p.interval, p.logger, p.purge)
+	})
+}
+
+// purge deletes one batch of due accounts. It runs under the pass lock, so
+// two replicas never purge the same accounts.
+func (p *AccountPurger) purge(ctx context.Context) {
+	now := time.Now().UTC()
+	due, err := p.repo.ListDueDeletions(ctx, now, purgeBatchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			p.logger.Error().Err(err).Msg("failed to list accounts due for deletion")
+		}
+		return
+	}
+	for _, userID := range due {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := p.purgeUser(ctx, userID, now); err != nil {
+			// Left scheduled, so the next pass retries it.
+			p.logger.Error().Err(err).Str("user_id", userID.String()).Msg("failed to delete account")
+		}
+	}
+}
+
+// purgeUser runs the module purgers only once the repository has confirmed,
+// and locked, that the deletion is still due. A user who signed in since the
+// batch was listed keeps their data.
+func (p *AccountPurger) purgeUser(ctx context.Context, userID uuid.UUID, now time.Time) error {
+	var orgIDs []uuid.UUID
+	deleted, err := p.repo.DeleteAccount(ctx, userID, now, func(ctx context.Context) ([]uuid.UUID, error) {
+		ids, err := p.orgs.ListSoleMemberOrganizations(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		for _, purger := range p.data.purgers {
+			if err := purger.PurgeUserData(ctx, userID, ids); err != nil {
+				return nil, err
+			}
+		}
+		orgIDs = ids
+		return ids, nil
+	})
+	if err != nil || !deleted {
+		return err
+	}
+	p.audit.Log(ctx, audit.Event{
+		Action:     audit.ActionAccountDeleted,
+		TargetType: audit.TargetUser,
+		TargetID:   userID.String(),
+		Metadata:   map[string]any{"organizations_deleted": len(orgIDs)},
+	})
+	p.logger.Info().Str("user_id", userID.String()).Int("organizations", len(orgIDs)).Msg("account deleted")
+	return nil
+}
@@ -0,0 +1,130 @@
+package authapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/identity/domain/org"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// purgeRepo stands in for the locked transaction of AuthRepo.DeleteAccount.
+// A cancelled deletion is no longer due, so purge never runs.
+type purgeRepo struct {
+	auth.Repository
+	due     bool
+	steps   *[]string
+	deleted []uuid.UUID
+}
+
+func (r *purgeRepo) DeleteAccount(ctx context.Context, userID uuid.UUID, now time.Time, purge func(context.Context) ([]uuid.UUID, error)) (bool, error) {
+	*r.steps = append(*r.steps, "lock")
+	if !r.due {
+		return false, nil
+	}
+	orgIDs, err := purge(ctx)
+	if err != nil {
+		*r.steps = append(*r.steps, "rollback")
+		return false, err
+	}
+	*r.steps = append(*r.steps, "delete")
+	r.deleted = orgIDs
+	return true, nil
+}
+
+type soleOrgs struct {
+	org.Repository
+	ids   []uuid.UUID
+	steps *[]string
+}
+
+func (o soleOrgs) ListSoleMemberOrganizations(context.Context, uuid.UUID) ([]uuid.UUID, error) {
+	*o.steps = append(*o.steps, "orgs")
+	return o.ids, nil
+}
+
+type stepPurger struct {
+	name  string
+	err   error
+	steps *[]string
+}
+
+func (p stepPurger) PurgeUserData(ctx context.Context, userID uuid.UUID, orgIDs []uuid.UUID) error {
+	*p.steps = append(*p.steps, p.name)
+	return p.err
+}
+
+func newTestPurger(due bool, failing string) (*AccountPurger, *purgeRepo, *auditLog, *[]string) {
+	steps := &[]string{}
+	repo := &purgeRepo{due: due, steps: steps}
+	data := NewAccountData()
+	for _, name := range []string{"media", "alerts"} {
+		p := stepPurger{name: name, steps: steps}
+		if name == failing {
+			p.err = errors.New("storage down")
+		}
+		data.AddPurger(p)
+	}
+	log := &auditLog{}
+	orgs := soleOrgs{ids: []uuid.UUID{uuid.New()}, steps: steps}
+	return NewAccountPurger(repo, orgs, data, nil, time.Hour, log, zerolog.Nop()), repo, log, steps
+}
+
+func TestPurgeUserRunsModulePurgersUnderTheLock(t *testing.T) {
+	p, repo, log, steps := newTestPurger(true, "")
+	if err := p.purgeUser(context.Background(), uuid.New(), time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"lock", "orgs", "media", "alerts", "delete"}
+	if !slices.Equal(*steps, want) {
+		t.Fatalf("steps = %v, want %v", *steps, want)
+	}
+	if len(repo.deleted) != 1 {
+		t.Fatalf("deleted organizations = %v", repo.deleted)
+	}
+	if len(log.events) != 1 || log.events[0].Action != audit.ActionAccountDeleted {
+		t.Fatalf("audit = %+v", log.events)
+	}
+}
+
+func TestPurgeUserSkipsCancelledDeletion(t *testing.T) {
+	p, _, log, steps := newTestPurger(false, "")
+	if err := p.purgeUser(context.Background(), uuid.New(), time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(*steps, []string{"lock"}) {
+		t.Fatalf("steps = %v, want only the lock", *steps)
+	}
+	if len(log.events) != 0 {
+		t.Fatalf("audit = %+v", log.events)
+	}
+}
+
+func TestPurgeUserKeepsAccountWhenAPurgerFails(t *testing.T) {
+	p, _, log, steps := newTestPurger(true, "media")
+	if err := p.purgeUser(context.Background(), uuid.New(), time.Now()); err == nil {
+		t.Fatal("expected the purger error")
+	}
+	want := []string{"lock", "orgs", "media", "rollback"}
+	if !slices.Equal(*steps, want) {
+		t.Fatalf("steps = %v, want %v", *steps, want)
+	}
+	if len(log.events) != 0 {
+		t.Fatalf("audit = %+v", log.events)
+	}
+}
+
+func TestDeletionStripsChangedEmails(t *testing.T) {
+	e := emailChangedEvent(uuid.New(), "old@example.com", "new@example.com")
+	for key := range e.Metadata {
+		if !slices.Contains(audit.PersonalMetadata, key) {
+			t.Errorf("metadata %q survives account deletion", key)
+		}
+	}
+}
@@ -2,8 +2,9 @@ package authapp
 
 import (
 	"context"
+	"go-ai/internal/identity/domain/audit"
 	"go-ai/internal/identity/domain/auth"
-	"go-ai/pkg/helpers"
+	"go-ai/internal/identity/infrastructure/cache"
 	domainerr "go-ai/pkg/domain_err"
 
 	"github.com/google/uuid"
@@ -16,33 +17,42 @@ type ChangePasswordRequest struct {
 }
 
 type ChangePasswordUseCase struct {
-	Repo auth.Repository
+	Repo      auth.Repository
+	Passwords *Passwords
+	Cache     *cache.AuthCache
+	Audit     audit.AuditLogger
 }
 
-func NewChangePasswordUseCase(repo auth.Repository) *ChangePasswordUseCase {
+func NewChangePasswordUseCase(repo auth.Repository, passwords *Passwords, cache *cache.AuthCache, auditLog audit.AuditLogger) *ChangePasswordUseCase {
 	return &ChangePasswordUseCase{
-		Repo: repo,
+		Repo:      repo,
+		Passwords: passwords,
+		Cache:     cache,
+		Audit:     auditLog,
 	}
 }
 
-func (uc *ChangePasswordUseCase) Execute(ctx context.Context, req ChangePasswordRequest, userID uuid.UUID) error {
+// Execute changes the password and signs out every other session of the
+// user; the session that made the change stays signed in.
+func (uc *ChangePasswordUseCase) Execute(ctx context.Context, req ChangePasswordRequest, userID uuid.UUID, sid string) error {
 	passwordHashCurrent, err := uc.Repo.GetPasswordByID(ctx, userID)
 	if err != nil {
 		return domainerr.ErrInternalServerError
 	}
-	if !helpers.CheckPasswordHash(req.OldPassword, passwordHashCurrent) {
+	if ok, _ := uc.Passwords.Verify(req.OldPassword, passwordHashCurrent); !ok {
 		return auth.ErrOldPasswordIncorrect
 	}
 	if req.ConfirmPassword != req.NewPassword {
 		return auth.ErrConfirmPassword
 	}
-	newPassword, err := auth.NewPassword(req.NewPassword)
+	hashPassword, err := uc.Passwords.Hash(req.NewPassword)
 	if err != nil {
 		return err
 	}
-	hashPassword, err := helpers.HashPassword(newPassword.String())
-	if err != nil {
-		return auth.ErrHashPasswordFailed
+	if err := uc.Repo.ChangePassword(ctx, hashPassword, userID); err != nil {
+		return err
 	}
-	return uc.Repo.ChangePassword(ctx, hashPassword, userID)
+	uc.Audit.Log(ctx, userEvent(userID, audit.ActionPasswordChanged, userID))
+	_, err = uc.Cache.RevokeOtherSessions(ctx, userID, sid)
+	return err
 }
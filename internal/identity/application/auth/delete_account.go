@@ -0,0 +1,140 @@
+package authapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/apikey"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/identity/domain/org"
+	"go-ai/internal/identity/infrastructure/cache"
+	"go-ai/internal/platform/config"
+	"go-ai/pkg/mailer"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DeleteAccountRequest struct {
+	Password string `json:"password"`
+	// Code is a TOTP or recovery code, required when two-factor is enabled.
+	Code string `json:"code"`
+}
+
+type DeleteAccountResponse struct {
+	DeletionScheduledAt time.Time `json:"deletion_scheduled_at"`
+}
+
+type DeleteAccountUseCase struct {
+	Repo      auth.Repository
+	MFA       auth.MFARepository
+	MFACache  *cache.MFACache
+	Passwords *Passwords
+	Orgs      org.Repository
+	APIKeys   apikey.Repository
+	Cache     *cache.AuthCache
+	Mailer    mailer.Mailer
+	Config    *config.Config
+	Audit     audit.AuditLogger
+}
+
+func NewDeleteAccountUseCase(
+	repo auth.Repository,
+	mfa auth.MFARepository,
+	mfaCache *cache.MFACache,
+	passwords *Passwords,
+	orgs org.Repository,
+	apiKeys apikey.Repository,
+	cache *cache.AuthCache,
+	mailer mailer.Mailer,
+	config *config.Config,
+	auditLog audit.AuditLogger,
+) *DeleteAccountUseCase {
+	return &DeleteAccountUseCase{
+		Repo:      repo,
+		MFA:       mfa,
+		MFACache:  mfaCache,
+		Passwords: passwords,
+		Orgs:      orgs,
+		APIKeys:   apiKeys,
+		Cache:     cache,
+		Mailer:    mailer,
+		Config:    config,
+		Audit:     auditLog,
+	}
+}
+
+// Execute schedules the account for deletion once the grace period ends and
+// signs it out everywhere. Signing in again before then keeps the account.
+func (uc *DeleteAccountUseCase) Execute(ctx context.Context, userID uuid.UUID, req DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	user, err := uc.Repo.GetById(ctx, userID)
+	if err != nil {
+		return nil, auth.ErrUserNotFound
+	}
+	passwordHash, err := uc.Repo.GetPasswordByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if passwordHash == auth.NoPasswordHash {
+		return nil, auth.ErrDeletionNeedsPassword
+	}
+	if ok, _ := uc.Passwords.Verify(req.Password, passwordHash); !ok {
+		return nil, auth.ErrPasswordIncorrect
+	}
+	if user.TwoFactorEnabled {
+		state, err := uc.MFA.GetTOTP(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if err := verifySecondFactor(ctx, uc.MFA, uc.MFACache, uc.Config, userID, state, req.Code, true); err != nil {
+			return nil, err
+		}
+	}
+	orphaned, err := uc.Orgs.ListOrganizationsNeedingOwner(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(orphaned) > 0 {
+		return nil, auth.ErrDeletionNeedsNewOwner
+	}
+
+	grace := time.Duration(uc.Config.AccountDeletionGrace) * time.Second
+	at := time.Now().UTC().Add(grace)
+	if err := uc.Repo.ScheduleDeletion(ctx, userID, at); err != nil {
+		return nil, err
+	}
+	sessions, err := uc.Cache.RevokeUserSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := uc.APIKeys.RevokeAll(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	uc.Audit.Log(ctx, audit.Event{
+		ActorID:    userID,
+		Action:     audit.ActionDeletionRequested,
+		TargetType: audit.TargetUser,
+		TargetID:   userID.String(),
+		Metadata: map[string]any{
+			"deletion_scheduled_at": at,
+			"sessions_revoked":      sessions,
+			"api_keys_revoked":      keys,
+		},
+	})
+	// The deletion is already scheduled, so a failed notice is not an error.
+	_ = uc.Mailer.Send(ctx, deletionScheduledMessage(user.Email.String(), user.FullName, at))
+	return &DeleteAccountResponse{DeletionScheduledAt: at}, nil
+}
+
+// restoreAccount cancels a pending deletion. It runs on every sign-in, so
+// coming back during the grace period keeps the account.
+func restoreAccount(ctx context.Context, repo auth.Repository, auditLog audit.AuditLogger, userID uuid.UUID) error {
+	cancelled, err := repo.CancelDeletion(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if cancelled {
+		auditLog.Log(ctx, userEvent(userID, audit.ActionDeletionCancelled, userID))
+	}
+	return nil
+}
@@ -35,3 +35,99 @@ type ChangePasswordSuccessResponseDoc struct {
 type LogoutSuccessResponseDoc struct {
 	response.SuccessBaseDoc
 }
+
+type ListSessionsSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data []SessionResponse `json:"data,omitempty"`
+}
+
+type RevokeSessionSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+}
+
+type LogoutAllSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *LogoutAllResponse `json:"data,omitempty"`
+}
+
+type StreamTicketSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *StreamTicketResponse `json:"data,omitempty"`
+}
+
+type ForgotPasswordSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+}
+
+type ResetPasswordSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+}
+
+type VerifyEmailSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+}
+
+type ResendVerificationSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+}
+
+type MFAStatusSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *MFAStatusResponse `json:"data,omitempty"`
+}
+
+type EnrollMFASuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *EnrollMFAResponse `json:"data,omitempty"`
+}
+
+type RecoveryCodesSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *RecoveryCodesResponse `json:"data,omitempty"`
+}
+
+type DisableMFASuccessResponseDoc struct {
+	response.SuccessBaseDoc
+}
+
+type OAuthProvidersSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data []string `json:"data,omitempty"`
+}
+
+type OAuthStartSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *OAuthStartResponse `json:"data,omitempty"`
+}
+
+type IdentitySuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *IdentityResponse `json:"data,omitempty"`
+}
+
+type ListIdentitiesSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data []IdentityResponse `json:"data,omitempty"`
+}
+
+type UnlinkIdentitySuccessResponseDoc struct {
+	response.SuccessBaseDoc
+}
+
+type DeleteAccountSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *DeleteAccountResponse `json:"data,omitempty"`
+}
+
+type SendPhoneCodeSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *SendPhoneCodeResponse `json:"data,omitempty"`
+}
+
+type VerifyPhoneSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+}
+
+type RemovePhoneSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+}
@@ -0,0 +1,288 @@
+package authapp
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"go-ai/internal/identity/domain/apikey"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/identity/domain/org"
+	"go-ai/internal/identity/domain/user"
+	"go-ai/internal/identity/infrastructure/cache"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ExportFormatJSON = "json"
+	ExportFormatZIP  = "zip"
+)
+
+type ExportDataRequest struct {
+	Format string `query:"format"`
+}
+
+// ExportFile is an encoded data export, ready to download.
+type ExportFile struct {
+	Name        string
+	ContentType string
+	Body        []byte
+}
+
+type ExportProfile struct {
+	ID               uuid.UUID `json:"id"`
+	Email            string    `json:"email"`
+	FullName         string    `json:"full_name"`
+	Role             string    `json:"role"`
+	ImageUrl         string    `json:"image_url"`
+	IsActive         bool      `json:"is_active"`
+	EmailVerified    bool      `json:"email_verified"`
+	TwoFactorEnabled bool      `json:"two_factor_enabled"`
+	Phone            string    `json:"phone,omitempty"`
+	PhoneVerified    bool      `json:"phone_verified"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+type ExportAPIKey struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	AllowedIPs []string   `json:"allowed_ips"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	LastUsedIP string     `json:"last_used_ip,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type ExportMembership struct {
+	OrganizationID   uuid.UUID `json:"organization_id"`
+	OrganizationName string    `json:"organization_name"`
+	Role             string    `json:"role"`
+	JoinedAt         time.Time `json:"joined_at"`
+}
+
+type exportSection struct {
+	name string
+	data any
+}
+
+type ExportDataUseCase struct {
+	Repo       auth.Repository
+	Users      user.Repository
+	Cache      *cache.AuthCache
+	Identities auth.IdentityRepository
+	APIKeys    apikey.Repository
+	Orgs       org.Repository
+	Data       *AccountData
+	Audit      audit.AuditLogger
+}
+
+func NewExportDataUseCase(
+	repo auth.Repository,
+	users user.Repository,
+	cache *cache.AuthCache,
+	identities auth.IdentityRepository,
+	apiKeys apikey.Repository,
+	orgs org.Repository,
+	data *AccountData,
+	auditLog audit.AuditLogger,
+) *ExportDataUseCase {
+	return &ExportDataUseCase{
+		Repo:       repo,
+		Users:      users,
+		Cache:      cache,
+		Identities: identities,
+		APIKeys:    apiKeys,
+		Orgs:       orgs,
+		Data:       data,
+		Audit:      auditLog,
+	}
+}
+
+// Execute gathers everything kept about the user, as one JSON document or a
+// ZIP archive with a JSON file per section.
+func (uc *ExportDataUseCase) Execute(ctx context.Context, userID uuid.UUID, req ExportDataRequest) (*ExportFile, error) {
+	format := req.Format
+	if format == "" {
+		format = ExportFormatJSON
+	}
+	if format != ExportFormatJSON && format != ExportFormatZIP {
+		return nil, auth.ErrInvalidExportFormat
+	}
+	sections, err := uc.collect(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	name := fmt.Sprintf("account-export-%s", now.Format("20060102-150405"))
+
+	var file *ExportFile
+	if format == ExportFormatZIP {
+		file, err = encodeExportZIP(name, now, sections)
+	} else {
+		file, err = encodeExportJSON(name, now, sections)
+	}
+	if err != nil {
+		return nil, err
+	}
+	uc.Audit.Log(ctx, audit.Event{
+		ActorID:    userID,
+		Action:     audit.ActionDataExported,
+		TargetType: audit.TargetUser,
+		TargetID:   userID.String(),
+		Metadata:   map[string]any{"format": format},
+	})
+	return file, nil
+}
+
+func (uc *ExportDataUseCase) collect(ctx context.Context, userID uuid.UUID) ([]exportSection, error) {
+	account, err := uc.Users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	login, err := uc.Repo.GetById(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	profile := ExportProfile{
+		ID:               account.ID,
+		Email:            account.Email.String(),
+		FullName:         account.FullName,
+		Role:             account.Role,
+		ImageUrl:         account.ImageUrl,
+		IsActive:         account.IsActive,
+		EmailVerified:    login.EmailVerified,
+		TwoFactorEnabled: login.TwoFactorEnabled,
+		Phone:            login.Phone,
+		PhoneVerified:    login.PhoneVerified,
+		CreatedAt:        account.CreatedAt,
+		UpdatedAt:        account.UpdatedAt,
+	}
+
+	sessions, err := uc.Cache.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	sessionList := make([]SessionResponse, 0, len(sessions))
+	for _, s := range sessions {
+		sessionList = append(sessionList, SessionResponse{
+			ID:         s.ID,
+			UserAgent:  s.UserAgent,
+			IP:         s.IP,
+			CreatedAt:  s.CreatedAt,
+			LastSeenAt: s.LastSeenAt,
+		})
+	}
+
+	identities, err := uc.Identities.ListIdentities(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	identityList := make([]IdentityResponse, 0, len(identities))
+	for _, i := range identities {
+		identityList = append(identityList, toIdentityResponse(i))
+	}
+
+	keys, err := uc.APIKeys.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	keyList := make([]ExportAPIKey, 0, len(keys))
+	for _, k := range keys {
+		keyList = append(keyList, ExportAPIKey{
+			ID:         k.ID,
+			Name:       k.Name,
+			Prefix:     apikey.KeyPrefix + k.Prefix,
+			Scopes:     k.Scopes,
+			AllowedIPs: k.AllowedIPs,
+			ExpiresAt:  k.ExpiresAt,
+			LastUsedAt: k.LastUsedAt,
+			LastUsedIP: k.LastUsedIP,
+			RevokedAt:  k.RevokedAt,
+			CreatedAt:  k.CreatedAt,
+		})
+	}
+
+	memberships, err := uc.Orgs.ListForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	orgIDs := make([]uuid.UUID, 0, len(memberships))
+	membershipList := make([]ExportMembership, 0, len(memberships))
+	for _, m := range memberships {
+		orgIDs = append(orgIDs, m.OrganizationID)
+		membershipList = append(membershipList, ExportMembership{
+			OrganizationID:   m.OrganizationID,
+			OrganizationName: m.OrganizationName,
+			Role:             string(m.Role),
+			JoinedAt:         m.CreatedAt,
+		})
+	}
+
+	sections := []exportSection{
+		{name: "profile", data: profile},
+		{name: "sessions", data: sessionList},
+		{name: "identities", data: identityList},
+		{name: "api_keys", data: keyList},
+		{name: "organizations", data: membershipList},
+	}
+	for _, name := range uc.Data.sections() {
+		data, err := uc.Data.exporters[name].ExportUserData(ctx, userID, orgIDs)
+		if err != nil {
+			return nil, fmt.Errorf("export %s: %w", name, err)
+		}
+		sections = append(sections, exportSection{name: name, data: data})
+	}
+	return sections, nil
+}
+
+func encodeExportJSON(name string, at time.Time, sections []exportSection) (*ExportFile, error) {
+	doc := map[string]any{"exported_at": at}
+	for _, s := range sections {
+		doc[s.name] = s.data
+	}
+	body, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return &ExportFile{
+		Name:        name + ".json",
+		ContentType: "application/json",
+		Body:        body,
+	}, nil
+}
+
+func encodeExportZIP(name string, at time.Time, sections []exportSection) (*ExportFile, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, s := range sections {
+		w, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     name + "/" + s.name + ".json",
+			Method:   zip.Deflate,
+			Modified: at,
+		})
+		if err != nil {
+			return nil, err
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(s.data); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return &ExportFile{
+		Name:        name + ".zip",
+		ContentType: "application/zip",
+		Body:        buf.Bytes(),
+	}, nil
+}
@@ -0,0 +1,66 @@
+package authapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/identity/infrastructure/cache"
+	"go-ai/internal/platform/config"
+	"go-ai/pkg/helpers"
+	"go-ai/pkg/mailer"
+	"time"
+)
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+type ForgotPasswordUseCase struct {
+	Repo   auth.Repository
+	Tokens auth.TokenRepository
+	Resets *cache.ResetCache
+	Mailer mailer.Mailer
+	Config *config.Config
+}
+
+func NewForgotPasswordUseCase(repo auth.Repository, tokens auth.TokenRepository, resets *cache.ResetCache, mailer mailer.Mailer, config *config.Config) *ForgotPasswordUseCase {
+	return &ForgotPasswordUseCase{
+		Repo:   repo,
+		Tokens: tokens,
+		Resets: resets,
+		Mailer: mailer,
+		Config: config,
+	}
+}
+
+// Execute emails a password reset link. Unknown and inactive accounts are
+// skipped silently so the response never reveals whether an email exists.
+// The rate limit is checked before the lookup for the same reason.
+func (uc *ForgotPasswordUseCase) Execute(ctx context.Context, req ForgotPasswordRequest, client auth.ClientInfo) error {
+	email, err := helpers.NewEmail(req.Email)
+	if err != nil || email.String() == "" {
+		return auth.ErrInvalidEmail
+	}
+	ok, err := uc.Resets.ReserveSend(ctx, email.String(), client.IP,
+		time.Duration(uc.Config.PasswordResetCooldown)*time.Second,
+		time.Duration(uc.Config.PasswordResetIPWindow)*time.Second,
+		uc.Config.PasswordResetIPMax,
+	)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return auth.ErrResetRateLimited
+	}
+	user, err := uc.Repo.GetByEmail(ctx, email.String())
+	if err != nil || !user.IsActive {
+		return nil
+	}
+
+	ttl := time.Duration(uc.Config.PasswordResetTTL) * time.Second
+	raw, hash := auth.NewToken()
+	if err := uc.Tokens.CreateToken(ctx, user.ID, auth.TokenPasswordReset, hash, time.Now().UTC().Add(ttl)); err != nil {
+		return err
+	}
+	link := actionLink(uc.Config.AppBaseURL, "/reset-password", raw)
+	return uc.Mailer.Send(ctx, passwordResetMessage(user.Email.String(), user.FullName, link, ttl))
+}
@@ -3,26 +3,40 @@ package authapp
 import (
 	"context"
 	"fmt"
+	"go-ai/internal/identity/domain/audit"
 	"go-ai/internal/identity/domain/auth"
 	"go-ai/internal/identity/infrastructure/cache"
 	"go-ai/internal/platform/config"
 	domainerr "go-ai/pkg/domain_err"
 	"go-ai/pkg/helpers"
+	"go-ai/pkg/jwtkeys"
 
 	"time"
+
+	"github.com/google/uuid"
 )
 
 type LoginUseCase struct {
-	Repo   auth.Repository
-	Cache  *cache.AuthCache
-	Config *config.Config
+	Repo      auth.Repository
+	Cache     *cache.AuthCache
+	MFACache  *cache.MFACache
+	Guard     *LoginGuard
+	Passwords *Passwords
+	Tokens    jwtkeys.Issuer
+	Config    *config.Config
+	Audit     audit.AuditLogger
 }
 
-func NewLoginUseCase(repo auth.Repository, cache *cache.AuthCache, config *config.Config) *LoginUseCase {
+func NewLoginUseCase(repo auth.Repository, cache *cache.AuthCache, mfaCache *cache.MFACache, guard *LoginGuard, passwords *Passwords, tokens jwtkeys.Issuer, config *config.Config, auditLog audit.AuditLogger) *LoginUseCase {
 	return &LoginUseCase{
-		Repo:   repo,
-		Cache:  cache,
-		Config: config,
+		Repo:      repo,
+		Cache:     cache,
+		MFACache:  mfaCache,
+		Guard:     guard,
+		Passwords: passwords,
+		Tokens:    tokens,
+		Config:    config,
+		Audit:     auditLog,
 	}
 }
 
@@ -31,55 +45,174 @@ type LoginRequest struct {
 	Password string `json:"password"`
 }
 
+// LoginResponse carries either a token pair or, for accounts with two-factor
+// enabled, a challenge token to exchange at /api/auth/login/2fa.
 type LoginResponse struct {
-	AccessToken  string `json:"access_token"`
-	RefreshToken string `json:"refresh_token"`
-	ExpresIn     int    `json:"expires_in"`
+	AccessToken    string `json:"access_token,omitempty"`
+	RefreshToken   string `json:"refresh_token,omitempty"`
+	ExpresIn       int    `json:"expires_in"`
+	MFARequired    bool   `json:"mfa_required,omitempty"`
+	ChallengeToken string `json:"challenge_token,omitempty"`
 }
 
-func (s *LoginUseCase) Execute(ctx context.Context, req LoginRequest) (*LoginResponse, error) {
+func (s *LoginUseCase) Execute(ctx context.Context, req LoginRequest, client auth.ClientInfo) (*LoginResponse, error) {
 	email, err := helpers.NewEmail(req.Email)
 	if err != nil || email.String() == "" {
 		return nil, auth.ErrInvalidCredentials
 	}
+	// Locked and delayed logins look exactly like a wrong password.
+	if !s.Guard.Allow(ctx, email.String(), client.IP) {
+		s.Audit.Log(ctx, loginFailedEvent(uuid.Nil, email.String(), "locked"))
+		return nil, auth.ErrInvalidCredentials
+	}
 	storedUser, err := s.Repo.GetByEmail(ctx, email.String())
 	if err != nil {
+		s.Passwords.Burn(req.Password)
+		s.Guard.Fail(ctx, email.String(), client.IP)
+		s.Audit.Log(ctx, loginFailedEvent(uuid.Nil, email.String(), "unknown_email"))
 		return nil, auth.ErrInvalidCredentials
 	}
-	if storedUser.IsActive == false {
-		return nil, auth.ErrUserInactive
+	ok, rehash := s.Passwords.Verify(req.Password, storedUser.Password.String())
+	if !ok {
+		s.Guard.Fail(ctx, email.String(), client.IP)
+		s.Audit.Log(ctx, loginFailedEvent(storedUser.ID, email.String(), "bad_password"))
+		return nil, auth.ErrInvalidCredentials
 	}
-	if !helpers.CheckPasswordHash(req.Password, storedUser.Password.String()) {
+	// Checked only after the password, and answered like a wrong one, so the
+	// response does not reveal that the account exists.
+	if !storedUser.IsActive {
+		s.Audit.Log(ctx, loginFailedEvent(storedUser.ID, email.String(), "inactive"))
 		return nil, auth.ErrInvalidCredentials
 	}
+	if rehash {
+		s.upgradeHash(ctx, storedUser.ID, req.Password)
+	}
+	if storedUser.PasswordResetRequired {
+		return nil, auth.ErrPasswordResetRequired
+	}
+	if s.Config.RequireEmailVerification && !storedUser.EmailVerified {
+		return nil, auth.ErrEmailNotVerified
+	}
+	// With two-factor on, the failures are only cleared once the code
+	// passes, so the password alone cannot reset the count of wrong codes.
+	if storedUser.TwoFactorEnabled {
+		return startChallenge(ctx, s.MFACache, s.Config, storedUser.ID)
+	}
+	s.Guard.Succeed(ctx, email.String())
+	if err := restoreAccount(ctx, s.Repo, s.Audit, storedUser.ID); err != nil {
+		return nil, err
+	}
+	resp, err := startSession(ctx, s.Cache, s.Tokens, s.Config, storedUser, client)
+	if err == nil {
+		s.Audit.Log(ctx, loginEvent(storedUser.ID, "password"))
+	}
+	return resp, err
+}
+
+// upgradeHash stores the password again with the current algorithm and
+// parameters. The old hash still works, so failures only wait for the next
+// login.
+func (s *LoginUseCase) upgradeHash(ctx context.Context, userID uuid.UUID, raw string) {
+	hashed, err := s.Passwords.Hasher.Hash(raw)
+	if err != nil {
+		return
+	}
+	_ = s.Repo.ChangePassword(ctx, hashed, userID)
+}
+
+// loginFailedEvent targets the user when the email matched an account. The
+// actor stays anonymous since nobody has proven who they are.
+func loginFailedEvent(userID uuid.UUID, email, reason string) audit.Event {
+	e := audit.Event{
+		Action:     audit.ActionLoginFailed,
+		TargetType: audit.TargetUser,
+		Metadata:   map[string]any{"email": email, "reason": reason},
+	}
+	if userID != uuid.Nil {
+		e.TargetID = userID.String()
+	}
+	return e
+}
+
+// userEvent records an action taken by actorID on the account of userID.
+func userEvent(actorID uuid.UUID, action string, userID uuid.UUID) audit.Event {
+	return audit.Event{
+		ActorID:    actorID,
+		Action:     action,
+		TargetType: audit.TargetUser,
+		TargetID:   userID.String(),
+	}
+}
+
+func loginEvent(userID uuid.UUID, method string) audit.Event {
+	return audit.Event{
+		ActorID:    userID,
+		Action:     audit.ActionLogin,
+		TargetType: audit.TargetUser,
+		TargetID:   userID.String(),
+		Metadata:   map[string]any{"method": method},
+	}
+}
+
+// startChallenge parks a login that passed the password check until the
+// second factor is verified through the returned challenge token.
+func startChallenge(ctx context.Context, mfaCache *cache.MFACache, cfg *config.Config, userID uuid.UUID) (*LoginResponse, error) {
+	raw, hash := auth.NewToken()
+	ttl := time.Duration(cfg.MFAChallengeTTL) * time.Second
+	if err := mfaCache.CreateChallenge(ctx, hash, userID, ttl); err != nil {
+		return nil, domainerr.ErrInternalServerError
+	}
+	return &LoginResponse{
+		MFARequired:    true,
+		ChallengeToken: raw,
+		ExpresIn:       cfg.MFAChallengeTTL,
+	}, nil
+}
+
+// startSession issues a token pair for a fully authenticated user and
+// registers the session.
+func startSession(ctx context.Context, authCache *cache.AuthCache, tokens jwtkeys.Issuer, cfg *config.Config, user *auth.Entity, client auth.ClientInfo) (*LoginResponse, error) {
 	sid := helpers.GenerateKey()
-	accessToken, err := helpers.GenerateToken(sid, s.Config.JwtAccessSecret, s.Config.JwtExpiresIn)
+	accessToken, err := tokens.Issue(sid, jwtkeys.UseAccess, cfg.JwtExpiresIn)
 	if err != nil {
 		return nil, auth.ErrTokenGenerateFail
 	}
-	refreshToken, err := helpers.GenerateToken(sid, s.Config.JwtRefreshSecret, s.Config.JwtRefreshExpiresIn)
+	refreshToken, err := tokens.Issue(sid, jwtkeys.UseRefresh, cfg.JwtRefreshExpiresIn)
 	if err != nil {
 		return nil, auth.ErrTokenGenerateFail
 	}
 	dataCache := &cache.UserCache{
-		UserID:   storedUser.ID,
-		Role:     storedUser.Role,
-		Email:    storedUser.Email.String(),
-		IsActive: storedUser.IsActive,
-		FullName: storedUser.FullName,
-		ImageUrl: storedUser.ImageUrl,
+		UserID:   user.ID,
+		Role:     user.Role,
+		Email:    user.Email.String(),
+		IsActive: user.IsActive,
+		FullName: user.FullName,
+		ImageUrl: user.ImageUrl,
 	}
 	keyAuthCache := fmt.Sprintf("session_%s", sid)
 	keyRefreshToken := fmt.Sprintf("refresh_token_%s", sid)
 
-	sessionTTL := time.Duration(s.Config.JwtExpiresIn) * time.Second
-	refreshTTL := time.Duration(s.Config.JwtRefreshExpiresIn) * time.Second
-	if err := s.Cache.SetLoginCaches(ctx, keyAuthCache, dataCache, sessionTTL, keyRefreshToken, refreshToken, refreshTTL); err != nil {
+	// The session data lives as long as the refresh token, which needs it to
+	// find the user once the access token has expired. Access tokens carry
+	// their own expiry.
+	refreshTTL := time.Duration(cfg.JwtRefreshExpiresIn) * time.Second
+	if err := authCache.SetLoginCaches(ctx, keyAuthCache, dataCache, refreshTTL, keyRefreshToken, refreshToken, refreshTTL); err != nil {
+		return nil, domainerr.ErrInternalServerError
+	}
+	now := time.Now().UTC()
+	session := auth.Session{
+		ID:         sid,
+		UserAgent:  client.UserAgent,
+		IP:         client.IP,
+		CreatedAt:  now,
+		LastSeenAt: now,
+	}
+	if err := authCache.TrackSession(ctx, user.ID, session, refreshTTL); err != nil {
 		return nil, domainerr.ErrInternalServerError
 	}
 	return &LoginResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
-		ExpresIn:     s.Config.JwtExpiresIn,
+		ExpresIn:     cfg.JwtExpiresIn,
 	}, nil
 }
@@ -0,0 +1,83 @@
+package authapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/auth"
+
+	"github.com/rs/zerolog"
+)
+
+// LoginGuard applies the lockout policy around password checks. Redis
+// failures are logged and let the login through rather than locking every
+// user out.
+type LoginGuard struct {
+	Store  auth.LoginAttemptStore
+	Policy auth.LockoutPolicy
+	Logger zerolog.Logger
+}
+
+func NewLoginGuard(store auth.LoginAttemptStore, policy auth.LockoutPolicy, logger zerolog.Logger) *LoginGuard {
+	return &LoginGuard{
+		Store:  store,
+		Policy: policy,
+		Logger: logger,
+	}
+}
+
+// Allow reports whether a password or second-factor check may run for email
+// from ip.
+func (g *LoginGuard) Allow(ctx context.Context, email, ip string) bool {
+	blocked, err := g.Store.Blocked(ctx, email, ip)
+	if err != nil {
+		g.Logger.Error().Err(err).Msg("check login lockout")
+		return true
+	}
+	return !blocked
+}
+
+// Fail records a failed login, a wrong password or a wrong second-factor
+// code, and applies the delay or lockout it earns.
+func (g *LoginGuard) Fail(ctx context.Context, email, ip string) {
+	accountFailures, ipFailures, err := g.Store.RecordFailure(ctx, email, ip, g.Policy.Window)
+	if err != nil {
+		g.Logger.Error().Err(err).Msg("record failed login")
+		return
+	}
+
+	if g.Policy.MaxAttempts > 0 && accountFailures >= int64(g.Policy.MaxAttempts) {
+		if err := g.Store.LockAccount(ctx, email, g.Policy.Lockout); err != nil {
+			g.Logger.Error().Err(err).Msg("lock account")
+		} else {
+			g.Logger.Warn().
+				Str("event", "account_locked").
+				Str("email", email).
+				Str("ip", ip).
+				Int64("failures", accountFailures).
+				Dur("lockout", g.Policy.Lockout).
+				Msg("security event")
+		}
+	} else if err := g.Store.SetDelay(ctx, email, g.Policy.Delay(accountFailures)); err != nil {
+		g.Logger.Error().Err(err).Msg("set login delay")
+	}
+
+	if g.Policy.IPMaxAttempts > 0 && ipFailures >= int64(g.Policy.IPMaxAttempts) {
+		if err := g.Store.LockIP(ctx, ip, g.Policy.Lockout); err != nil {
+			g.Logger.Error().Err(err).Msg("lock ip")
+		} else {
+			g.Logger.Warn().
+				Str("event", "ip_locked").
+				Str("ip", ip).
+				Int64("failures", ipFailures).
+				Dur("lockout", g.Policy.Lockout).
+				Msg("security event")
+		}
+	}
+}
+
+// Succeed forgets the account's failures once the login has passed every
+// factor.
+func (g *LoginGuard) Succeed(ctx context.Context, email string) {
+	if err := g.Store.ClearAccount(ctx, email); err != nil {
+		g.Logger.Error().Err(err).Msg("clear failed logins")
+	}
+}
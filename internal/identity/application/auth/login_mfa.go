@@ -0,0 +1,125 @@
+package authapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/identity/infrastructure/cache"
+	"go-ai/internal/platform/config"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/jwtkeys"
+	"time"
+)
+
+// maxMFAAttempts is the number of wrong codes a challenge tolerates before
+// the user has to log in with the password again. Wrong codes also count
+// towards the account and IP lockout of LoginGuard, which new challenges do
+// not reset.
+const maxMFAAttempts = 5
+
+type LoginMFARequest struct {
+	ChallengeToken string `json:"challenge_token"`
+	Code           string `json:"code"`
+}
+
+type LoginMFAUseCase struct {
+	Repo     auth.Repository
+	MFA      auth.MFARepository
+	Cache    *cache.AuthCache
+	MFACache *cache.MFACache
+	Guard    *LoginGuard
+	Tokens   jwtkeys.Issuer
+	Config   *config.Config
+	Audit    audit.AuditLogger
+}
+
+func NewLoginMFAUseCase(repo auth.Repository, mfa auth.MFARepository, cache *cache.AuthCache, mfaCache *cache.MFACache, guard *LoginGuard, tokens jwtkeys.Issuer, config *config.Config, auditLog audit.AuditLogger) *LoginMFAUseCase {
+	return &LoginMFAUseCase{
+		Repo:     repo,
+		MFA:      mfa,
+		Cache:    cache,
+		MFACache: mfaCache,
+		Guard:    guard,
+		Tokens:   tokens,
+		Config:   config,
+		Audit:    auditLog,
+	}
+}
+
+// Execute completes a two-step login by exchanging the challenge token and a
+// TOTP or recovery code for a token pair.
+func (uc *LoginMFAUseCase) Execute(ctx context.Context, req LoginMFARequest, client auth.ClientInfo) (*LoginResponse, error) {
+	if req.ChallengeToken == "" {
+		return nil, auth.ErrMFAChallengeInvalid
+	}
+	challengeKey := auth.HashToken(req.ChallengeToken)
+	challenge, err := uc.MFACache.GetChallenge(ctx, challengeKey)
+	if err != nil {
+		return nil, domainerr.ErrInternalServerError
+	}
+	if challenge == nil {
+		return nil, auth.ErrMFAChallengeInvalid
+	}
+
+	user, err := uc.Repo.GetById(ctx, challenge.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsActive {
+		_ = uc.MFACache.DeleteChallenge(ctx, challengeKey)
+		return nil, auth.ErrUserInactive
+	}
+	state, err := uc.MFA.GetTOTP(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !state.Enabled {
+		_ = uc.MFACache.DeleteChallenge(ctx, challengeKey)
+		return nil, auth.ErrMFAChallengeInvalid
+	}
+	email := user.Email.String()
+	// As for passwords, a locked or delayed account gets the answer of a
+	// wrong code, and the code is not checked.
+	if !uc.Guard.Allow(ctx, email, client.IP) {
+		uc.Audit.Log(ctx, loginFailedEvent(user.ID, email, "locked"))
+		return nil, auth.ErrInvalidMFACode
+	}
+
+	err = verifySecondFactor(ctx, uc.MFA, uc.MFACache, uc.Config, user.ID, state, req.Code, true)
+	if errors.Is(err, auth.ErrInvalidMFACode) {
+		ttl := time.Duration(uc.Config.MFAChallengeTTL) * time.Second
+		attempts, ferr := uc.MFACache.FailChallenge(ctx, challengeKey, ttl)
+		if ferr != nil {
+			return nil, domainerr.ErrInternalServerError
+		}
+		uc.Guard.Fail(ctx, email, client.IP)
+		uc.Audit.Log(ctx, audit.Event{
+			Action:     audit.ActionMFAFailed,
+			TargetType: audit.TargetUser,
+			TargetID:   user.ID.String(),
+			Metadata:   map[string]any{"attempts": attempts},
+		})
+		if attempts >= maxMFAAttempts {
+			_ = uc.MFACache.DeleteChallenge(ctx, challengeKey)
+			return nil, auth.ErrMFAChallengeInvalid
+		}
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.MFACache.DeleteChallenge(ctx, challengeKey); err != nil {
+		return nil, domainerr.ErrInternalServerError
+	}
+	uc.Guard.Succeed(ctx, email)
+	if err := restoreAccount(ctx, uc.Repo, uc.Audit, user.ID); err != nil {
+		return nil, err
+	}
+	resp, err := startSession(ctx, uc.Cache, uc.Tokens, uc.Config, user, client)
+	if err == nil {
+		uc.Audit.Log(ctx, loginEvent(user.ID, "password+totp"))
+	}
+	return resp, err
+}
@@ -0,0 +1,120 @@
+package authapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/identity/infrastructure/cache"
+	"go-ai/internal/platform/config"
+	"go-ai/pkg/password"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+)
+
+type loginRepo struct {
+	auth.Repository
+	users map[string]*auth.Entity
+}
+
+func (r loginRepo) GetByEmail(_ context.Context, email string) (*auth.Entity, error) {
+	if u, ok := r.users[email]; ok {
+		return u, nil
+	}
+	return nil, auth.ErrUserNotFound
+}
+
+type attemptStore struct {
+	auth.LoginAttemptStore
+	failures int64
+	cleared  int
+}
+
+func (s *attemptStore) Blocked(context.Context, string, string) (bool, error) { return false, nil }
+
+func (s *attemptStore) RecordFailure(context.Context, string, string, time.Duration) (int64, int64, error) {
+	s.failures++
+	return s.failures, s.failures, nil
+}
+
+func (s *attemptStore) SetDelay(context.Context, string, time.Duration) error { return nil }
+
+func (s *attemptStore) ClearAccount(context.Context, string) error {
+	s.cleared++
+	return nil
+}
+
+type auditLog struct{ events []audit.Event }
+
+func (l *auditLog) Log(_ context.Context, e audit.Event) { l.events = append(l.events, e) }
+
+func testPassword(t *testing.T) (*password.Hasher, auth.Password) {
+	t.Helper()
+	hasher := password.New(password.Params{Memory: 64, Iterations: 1, Parallelism: 1}, "")
+	hash, err := hasher.Hash("Secret1!")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pw, err := auth.NewPasswordFromHash(hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hasher, pw
+}
+
+func TestLoginHidesInactiveAccounts(t *testing.T) {
+	hasher, pw := testPassword(t)
+	inactive := &auth.Entity{ID: uuid.New(), Password: pw, IsActive: false}
+	store := &attemptStore{}
+	log := &auditLog{}
+	uc := &LoginUseCase{
+		Repo:      loginRepo{users: map[string]*auth.Entity{"off@example.com": inactive}},
+		Guard:     NewLoginGuard(store, auth.LockoutPolicy{}, zerolog.Nop()),
+		Passwords: NewPasswords(auth.PasswordPolicy{}, hasher),
+		Audit:     log,
+	}
+
+	cases := []struct {
+		email, password, reason string
+	}{
+		{"nobody@example.com", "Secret1!", "unknown_email"},
+		{"off@example.com", "Wrong1!", "bad_password"},
+		{"off@example.com", "Secret1!", "inactive"},
+	}
+	for _, tc := range cases {
+		log.events = nil
+		_, err := uc.Execute(context.Background(), LoginRequest{Email: tc.email, Password: tc.password}, auth.ClientInfo{IP: "203.0.113.9"})
+		if !errors.Is(err, auth.ErrInvalidCredentials) {
+			t.Errorf("%s/%s: err = %v, want ErrInvalidCredentials", tc.email, tc.password, err)
+		}
+		if len(log.events) != 1 || log.events[0].Metadata["reason"] != tc.reason {
+			t.Errorf("%s/%s: audit = %+v, want reason %q", tc.email, tc.password, log.events, tc.reason)
+		}
+	}
+}
+
+func TestLoginKeepsFailuresUntilSecondFactor(t *testing.T) {
+	hasher, pw := testPassword(t)
+	mfaUser := &auth.Entity{ID: uuid.New(), Password: pw, IsActive: true, TwoFactorEnabled: true}
+	store := &attemptStore{}
+	// Nothing listens there, so the challenge cannot be stored. The guard
+	// has made its decision by then.
+	rdb := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1})
+	defer rdb.Close()
+	uc := &LoginUseCase{
+		Repo:      loginRepo{users: map[string]*auth.Entity{"mfa@example.com": mfaUser}},
+		MFACache:  cache.NewMFACache(rdb),
+		Guard:     NewLoginGuard(store, auth.LockoutPolicy{}, zerolog.Nop()),
+		Passwords: NewPasswords(auth.PasswordPolicy{}, hasher),
+		Config:    &config.Config{},
+		Audit:     &auditLog{},
+	}
+	_, _ = uc.Execute(context.Background(), LoginRequest{Email: "mfa@example.com", Password: "Secret1!"}, auth.ClientInfo{IP: "203.0.113.9"})
+	if store.cleared != 0 {
+		t.Fatal("the password alone cleared the failed attempts")
+	}
+}
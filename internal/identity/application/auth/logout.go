@@ -2,7 +2,7 @@ package authapp
 
 import (
 	"context"
-	"fmt"
+	"go-ai/internal/identity/domain/audit"
 	"go-ai/internal/identity/domain/auth"
 	"go-ai/internal/identity/infrastructure/cache"
 
@@ -12,25 +12,25 @@ import (
 type LogoutUseCase struct {
 	Repo  auth.Repository
 	Cache *cache.AuthCache
+	Audit audit.AuditLogger
 }
 
-func NewLogoutUseCase(repo auth.Repository, cache *cache.AuthCache) *LogoutUseCase {
+func NewLogoutUseCase(repo auth.Repository, cache *cache.AuthCache, auditLog audit.AuditLogger) *LogoutUseCase {
 	return &LogoutUseCase{
 		Repo:  repo,
 		Cache: cache,
+		Audit: auditLog,
 	}
 }
 
-func (uc *LogoutUseCase) Execute(ctx context.Context, userID uuid.UUID) error {
-	sessionKey := fmt.Sprintf("session_%s", userID)
-	refreshKey := fmt.Sprintf("refresh_token_%s", userID)
-	err := uc.Cache.DeleteAuthCache(ctx, sessionKey)
-	errRefresh := uc.Cache.DeleteRefreshTokenCache(ctx, refreshKey)
-	if err != nil {
-		return err
+// Execute ends the session the request was made with.
+func (uc *LogoutUseCase) Execute(ctx context.Context, userID uuid.UUID, sid string) error {
+	if sid == "" {
+		return auth.ErrUnauthorizedAccess
 	}
-	if errRefresh != nil {
-		return errRefresh
+	if err := uc.Cache.RevokeSession(ctx, userID, sid); err != nil {
+		return err
 	}
+	uc.Audit.Log(ctx, sessionEvent(userID, audit.ActionLogout, sid))
 	return nil
 }
@@ -0,0 +1,46 @@
+package authapp
+
+import (
+	"fmt"
+	"go-ai/pkg/mailer"
+	"net/url"
+	"strings"
+	"time"
+)
+
+func actionLink(baseURL, path, token string) string {
+	return fmt.Sprintf("%s%s?token=%s", strings.TrimRight(baseURL, "/"), path, url.QueryEscape(token))
+}
+
+func passwordResetMessage(to, name, link string, ttl time.Duration) mailer.Message {
+	return mailer.Message{
+		To:      to,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nWe received a request to reset your password. Open the link below to choose a new one:\n\n%s\n\nThe link expires in %s. If you did not ask for a reset, you can ignore this email.\n",
+			name, link, ttl,
+		),
+	}
+}
+
+func deletionScheduledMessage(to, name string, at time.Time) mailer.Message {
+	return mailer.Message{
+		To:      to,
+		Subject: "Your account will be deleted",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nYour account and its data will be deleted permanently on %s. You have been signed out on every device.\n\nChanged your mind? Sign in again before then and the deletion is cancelled.\n",
+			name, at.Format("2 January 2006 15:04 MST"),
+		),
+	}
+}
+
+func emailVerificationMessage(to, name, link string, ttl time.Duration) mailer.Message {
+	return mailer.Message{
+		To:      to,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nPlease confirm your email address by opening the link below:\n\n%s\n\nThe link expires in %s.\n",
+			name, link, ttl,
+		),
+	}
+}
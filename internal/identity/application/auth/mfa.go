@@ -0,0 +1,274 @@
+package authapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/identity/infrastructure/cache"
+	"go-ai/internal/platform/config"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/helpers"
+	"go-ai/pkg/totp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type MFACodeRequest struct {
+	Code string `json:"code"`
+}
+
+type DisableMFARequest struct {
+	Password string `json:"password"`
+	Code     string `json:"code"`
+}
+
+type MFAStatusResponse struct {
+	Enabled                bool  `json:"enabled"`
+	RecoveryCodesRemaining int64 `json:"recovery_codes_remaining"`
+}
+
+type EnrollMFAResponse struct {
+	Secret     string `json:"secret"`
+	OtpauthURI string `json:"otpauth_uri"`
+}
+
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// verifySecondFactor accepts a TOTP code from the current window, each step
+// at most once, or, when allowRecovery is set, an unused recovery code.
+func verifySecondFactor(
+	ctx context.Context,
+	mfa auth.MFARepository,
+	mfaCache *cache.MFACache,
+	cfg *config.Config,
+	userID uuid.UUID,
+	state *auth.TOTPState,
+	code string,
+	allowRecovery bool,
+) error {
+	code = strings.TrimSpace(code)
+	if code == "" || state.Secret == "" {
+		return auth.ErrInvalidMFACode
+	}
+	secret, err := helpers.DecryptString(cfg.TOTPEncryptionKey, state.Secret)
+	if err != nil {
+		return err
+	}
+	if step, ok := totp.Validate(secret, code, time.Now(), 1); ok {
+		fresh, err := mfaCache.UseTOTPStep(ctx, userID, step, 3*totp.Period)
+		if err != nil {
+			return err
+		}
+		if !fresh {
+			return auth.ErrInvalidMFACode
+		}
+		return nil
+	}
+	if allowRecovery {
+		used, err := mfa.ConsumeRecoveryCode(ctx, userID, auth.HashRecoveryCode(code))
+		if err != nil {
+			return err
+		}
+		if used {
+			return nil
+		}
+	}
+	return auth.ErrInvalidMFACode
+}
+
+type MFAStatusUseCase struct {
+	MFA auth.MFARepository
+}
+
+func NewMFAStatusUseCase(mfa auth.MFARepository) *MFAStatusUseCase {
+	return &MFAStatusUseCase{
+		MFA: mfa,
+	}
+}
+
+func (uc *MFAStatusUseCase) Execute(ctx context.Context, userID uuid.UUID) (*MFAStatusResponse, error) {
+	state, err := uc.MFA.GetTOTP(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &MFAStatusResponse{Enabled: state.Enabled}
+	if state.Enabled {
+		resp.RecoveryCodesRemaining, err = uc.MFA.CountRecoveryCodes(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+type EnrollMFAUseCase struct {
+	Repo   auth.Repository
+	MFA    auth.MFARepository
+	Config *config.Config
+}
+
+func NewEnrollMFAUseCase(repo auth.Repository, mfa auth.MFARepository, config *config.Config) *EnrollMFAUseCase {
+	return &EnrollMFAUseCase{
+		Repo:   repo,
+		MFA:    mfa,
+		Config: config,
+	}
+}
+
+// Execute starts enrolment with a fresh secret. Two-factor stays off until
+// the secret is confirmed with a first code; enrolling again replaces it.
+func (uc *EnrollMFAUseCase) Execute(ctx context.Context, userID uuid.UUID) (*EnrollMFAResponse, error) {
+	user, err := uc.Repo.GetById(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.TwoFactorEnabled {
+		return nil, auth.ErrMFAAlreadyEnabled
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, domainerr.ErrInternalServerError
+	}
+	encrypted, err := helpers.EncryptString(uc.Config.TOTPEncryptionKey, secret)
+	if err != nil {
+		return nil, domainerr.ErrInternalServerError
+	}
+	if err := uc.MFA.SetPendingTOTP(ctx, userID, encrypted); err != nil {
+		return nil, err
+	}
+	return &EnrollMFAResponse{
+		Secret:     secret,
+		OtpauthURI: totp.URI(uc.Config.TOTPIssuer, user.Email.String(), secret),
+	}, nil
+}
+
+type ConfirmMFAUseCase struct {
+	MFA      auth.MFARepository
+	MFACache *cache.MFACache
+	Config   *config.Config
+	Audit    audit.AuditLogger
+}
+
+func NewConfirmMFAUseCase(mfa auth.MFARepository, mfaCache *cache.MFACache, config *config.Config, auditLog audit.AuditLogger) *ConfirmMFAUseCase {
+	return &ConfirmMFAUseCase{
+		MFA:      mfa,
+		MFACache: mfaCache,
+		Config:   config,
+		Audit:    auditLog,
+	}
+}
+
+// Execute enables two-factor once the authenticator produces a valid code and
+// returns the recovery codes. They are shown only this once.
+func (uc *ConfirmMFAUseCase) Execute(ctx context.Context, userID uuid.UUID, req MFACodeRequest) (*RecoveryCodesResponse, error) {
+	state, err := uc.MFA.GetTOTP(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if state.Enabled {
+		return nil, auth.ErrMFAAlreadyEnabled
+	}
+	if state.Secret == "" {
+		return nil, auth.ErrMFANotEnrolled
+	}
+	if err := verifySecondFactor(ctx, uc.MFA, uc.MFACache, uc.Config, userID, state, req.Code, false); err != nil {
+		return nil, err
+	}
+	codes, hashes, err := auth.NewRecoveryCodes(auth.RecoveryCodeCount)
+	if err != nil {
+		return nil, domainerr.ErrInternalServerError
+	}
+	if err := uc.MFA.EnableTOTP(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+	uc.Audit.Log(ctx, userEvent(userID, audit.ActionMFAEnabled, userID))
+	return &RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+type DisableMFAUseCase struct {
+	Repo      auth.Repository
+	MFA       auth.MFARepository
+	MFACache  *cache.MFACache
+	Passwords *Passwords
+	Config    *config.Config
+	Audit     audit.AuditLogger
+}
+
+func NewDisableMFAUseCase(repo auth.Repository, mfa auth.MFARepository, mfaCache *cache.MFACache, passwords *Passwords, config *config.Config, auditLog audit.AuditLogger) *DisableMFAUseCase {
+	return &DisableMFAUseCase{
+		Repo:      repo,
+		MFA:       mfa,
+		MFACache:  mfaCache,
+		Passwords: passwords,
+		Config:    config,
+		Audit:     auditLog,
+	}
+}
+
+// Execute turns two-factor off. It needs the password and a current code or
+// a recovery code.
+func (uc *DisableMFAUseCase) Execute(ctx context.Context, userID uuid.UUID, req DisableMFARequest) error {
+	passwordHash, err := uc.Repo.GetPasswordByID(ctx, userID)
+	if err != nil {
+		return domainerr.ErrInternalServerError
+	}
+	if ok, _ := uc.Passwords.Verify(req.Password, passwordHash); !ok {
+		return auth.ErrPasswordIncorrect
+	}
+	state, err := uc.MFA.GetTOTP(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !state.Enabled {
+		return auth.ErrMFANotEnabled
+	}
+	if err := verifySecondFactor(ctx, uc.MFA, uc.MFACache, uc.Config, userID, state, req.Code, true); err != nil {
+		return err
+	}
+	if err := uc.MFA.DisableTOTP(ctx, userID); err != nil {
+		return err
+	}
+	uc.Audit.Log(ctx, userEvent(userID, audit.ActionMFADisabled, userID))
+	return nil
+}
+
+type RegenerateRecoveryCodesUseCase struct {
+	MFA      auth.MFARepository
+	MFACache *cache.MFACache
+	Config   *config.Config
+}
+
+func NewRegenerateRecoveryCodesUseCase(mfa auth.MFARepository, mfaCache *cache.MFACache, config *config.Config) *RegenerateRecoveryCodesUseCase {
+	return &RegenerateRecoveryCodesUseCase{
+		MFA:      mfa,
+		MFACache: mfaCache,
+		Config:   config,
+	}
+}
+
+// Execute replaces every recovery code. Only an authenticator code is
+// accepted so a leaked recovery code cannot mint new ones.
+func (uc *RegenerateRecoveryCodesUseCase) Execute(ctx context.Context, userID uuid.UUID, req MFACodeRequest) (*RecoveryCodesResponse, error) {
+	state, err := uc.MFA.GetTOTP(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !state.Enabled {
+		return nil, auth.ErrMFANotEnabled
+	}
+	if err := verifySecondFactor(ctx, uc.MFA, uc.MFACache, uc.Config, userID, state, req.Code, false); err != nil {
+		return nil, err
+	}
+	codes, hashes, err := auth.NewRecoveryCodes(auth.RecoveryCodeCount)
+	if err != nil {
+		return nil, domainerr.ErrInternalServerError
+	}
+	if err := uc.MFA.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+	return &RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
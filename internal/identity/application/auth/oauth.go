@@ -0,0 +1,348 @@
+package authapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/identity/infrastructure/cache"
+	"go-ai/internal/platform/config"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/helpers"
+	"go-ai/pkg/jwtkeys"
+	"go-ai/pkg/oidc"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OAuthProviders are the configured login providers by name.
+type OAuthProviders map[string]oidc.Provider
+
+func (p OAuthProviders) Names() []string {
+	names := make([]string, 0, len(p))
+	for name := range p {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type OAuthStartResponse struct {
+	AuthorizationURL string `json:"authorization_url"`
+}
+
+type OAuthCallbackRequest struct {
+	Code  string `json:"code"`
+	State string `json:"state"`
+}
+
+type IdentityResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Provider    string     `json:"provider"`
+	Email       string     `json:"email,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+}
+
+func toIdentityResponse(i auth.Identity) IdentityResponse {
+	return IdentityResponse{
+		ID:          i.ID,
+		Provider:    i.Provider,
+		Email:       i.Email,
+		CreatedAt:   i.CreatedAt,
+		LastLoginAt: i.LastLoginAt,
+	}
+}
+
+// StartOAuthUseCase begins the authorization code flow. The PKCE verifier
+// and nonce stay in Redis under the state until the callback.
+type StartOAuthUseCase struct {
+	Providers OAuthProviders
+	Cache     *cache.OAuthCache
+	Config    *config.Config
+}
+
+func NewStartOAuthUseCase(providers OAuthProviders, cache *cache.OAuthCache, config *config.Config) *StartOAuthUseCase {
+	return &StartOAuthUseCase{
+		Providers: providers,
+		Cache:     cache,
+		Config:    config,
+	}
+}
+
+// Execute starts a login, or a link to linkUserID when it is set.
+func (uc *StartOAuthUseCase) Execute(ctx context.Context, provider string, linkUserID uuid.UUID) (*OAuthStartResponse, error) {
+	p, ok := uc.Providers[provider]
+	if !ok {
+		return nil, auth.ErrOAuthProviderUnknown
+	}
+	state, nonce, verifier := oidc.NewState(), oidc.NewState(), oidc.NewVerifier()
+	authURL, err := p.AuthCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		return nil, auth.ErrOAuthFailed
+	}
+	ttl := time.Duration(uc.Config.OAuthStateTTL) * time.Second
+	if err := uc.Cache.SaveState(ctx, auth.HashToken(state), &cache.OAuthState{
+		Provider:   provider,
+		Verifier:   verifier,
+		Nonce:      nonce,
+		LinkUserID: linkUserID,
+	}, ttl); err != nil {
+		return nil, domainerr.ErrInternalServerError
+	}
+	return &OAuthStartResponse{AuthorizationURL: authURL}, nil
+}
+
+// redeemOAuth checks the state and exchanges the code for the provider's
+// identity. The state is consumed whatever the outcome.
+func redeemOAuth(ctx context.Context, providers OAuthProviders, stateCache *cache.OAuthCache, provider string, req OAuthCallbackRequest, linkUserID uuid.UUID) (*oidc.UserInfo, error) {
+	p, ok := providers[provider]
+	if !ok {
+		return nil, auth.ErrOAuthProviderUnknown
+	}
+	if req.Code == "" || req.State == "" {
+		return nil, auth.ErrOAuthStateInvalid
+	}
+	state, err := stateCache.TakeState(ctx, auth.HashToken(req.State))
+	if err != nil {
+		return nil, domainerr.ErrInternalServerError
+	}
+	if state == nil || state.Provider != provider || state.LinkUserID != linkUserID {
+		return nil, auth.ErrOAuthStateInvalid
+	}
+	info, err := p.Exchange(ctx, req.Code, state.Verifier, state.Nonce)
+	if err != nil {
+		return nil, auth.ErrOAuthFailed
+	}
+	return info, nil
+}
+
+type OAuthLoginUseCase struct {
+	Providers  OAuthProviders
+	OAuthCache *cache.OAuthCache
+	Repo       auth.Repository
+	Identities auth.IdentityRepository
+	Cache      *cache.AuthCache
+	MFACache   *cache.MFACache
+	Tokens     jwtkeys.Issuer
+	Config     *config.Config
+	Audit      audit.AuditLogger
+}
+
+func NewOAuthLoginUseCase(providers OAuthProviders, oauthCache *cache.OAuthCache, repo auth.Repository, identities auth.IdentityRepository, cache *cache.AuthCache, mfaCache *cache.MFACache, tokens jwtkeys.Issuer, config *config.Config, auditLog audit.AuditLogger) *OAuthLoginUseCase {
+	return &OAuthLoginUseCase{
+		Providers:  providers,
+		OAuthCache: oauthCache,
+		Repo:       repo,
+		Identities: identities,
+		Cache:      cache,
+		MFACache:   mfaCache,
+		Tokens:     tokens,
+		Config:     config,
+		Audit:      auditLog,
+	}
+}
+
+// Execute finishes a provider login. A known identity signs in its user; an
+// unknown one is linked to the account with the same verified email, or a
+// new account is created. The session is issued exactly as for a password
+// login, including the two-factor step.
+func (uc *OAuthLoginUseCase) Execute(ctx context.Context, provider string, req OAuthCallbackRequest, client auth.ClientInfo) (*LoginResponse, error) {
+	info, err := redeemOAuth(ctx, uc.Providers, uc.OAuthCache, provider, req, uuid.Nil)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uc.resolveUser(ctx, provider, info)
+	if err != nil {
+		return nil, err
+	}
+	user, err := uc.Repo.GetById(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsActive {
+		return nil, auth.ErrUserInactive
+	}
+	if user.PasswordResetRequired {
+		return nil, auth.ErrPasswordResetRequired
+	}
+	if uc.Config.RequireEmailVerification && !user.EmailVerified {
+		return nil, auth.ErrEmailNotVerified
+	}
+	if user.TwoFactorEnabled {
+		return startChallenge(ctx, uc.MFACache, uc.Config, user.ID)
+	}
+	if err := restoreAccount(ctx, uc.Repo, uc.Audit, user.ID); err != nil {
+		return nil, err
+	}
+	resp, err := startSession(ctx, uc.Cache, uc.Tokens, uc.Config, user, client)
+	if err == nil {
+		uc.Audit.Log(ctx, loginEvent(user.ID, "oauth:"+provider))
+	}
+	return resp, err
+}
+
+func (uc *OAuthLoginUseCase) resolveUser(ctx context.Context, provider string, info *oidc.UserInfo) (uuid.UUID, error) {
+	identity, err := uc.Identities.GetIdentity(ctx, provider, info.Subject)
+	if err == nil {
+		_ = uc.Identities.TouchIdentity(ctx, identity.ID, info.Email)
+		return identity.UserID, nil
+	}
+	if !errors.Is(err, auth.ErrIdentityNotFound) {
+		return uuid.Nil, err
+	}
+
+	if info.Email == "" || !info.EmailVerified {
+		return uuid.Nil, auth.ErrOAuthEmailUnverified
+	}
+	email, err := helpers.NewEmail(info.Email)
+	if err != nil {
+		return uuid.Nil, auth.ErrInvalidEmail
+	}
+	link := &auth.Identity{Provider: provider, Subject: info.Subject, Email: email.String()}
+
+	if existing, err := uc.Repo.GetByEmail(ctx, email.String()); err == nil {
+		// Linking to an account whose owner never proved the address would
+		// hand it to whoever registered it first.
+		if !existing.EmailVerified {
+			return uuid.Nil, auth.ErrIdentityLinkRequired
+		}
+		link.UserID = existing.ID
+		if err := uc.Identities.LinkIdentity(ctx, link); err != nil {
+			return uuid.Nil, err
+		}
+		uc.Audit.Log(ctx, identityEvent(existing.ID, audit.ActionIdentityLinked, link.ID, provider))
+		return existing.ID, nil
+	}
+
+	return uc.createUser(ctx, email, info.Name, link)
+}
+
+// createUser registers a password-less account. Full names are unique, so
+// a taken name gets a short suffix.
+func (uc *OAuthLoginUseCase) createUser(ctx context.Context, email helpers.Email, name string, link *auth.Identity) (uuid.UUID, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name, _, _ = strings.Cut(email.String(), "@")
+	}
+	pw, _ := auth.NewPasswordFromHash(auth.NoPasswordHash)
+	candidate := name
+	for attempt := 0; attempt < 3; attempt++ {
+		id, err := uc.Identities.CreateUserWithIdentity(ctx, &auth.Entity{
+			FullName: candidate,
+			Email:    email,
+			Password: pw,
+		}, link)
+		if !errors.Is(err, auth.ErrNameAlreadyExists) {
+			return id, err
+		}
+		candidate = name + " " + helpers.GenerateKey()[:4]
+	}
+	return uuid.Nil, auth.ErrNameAlreadyExists
+}
+
+// LinkOAuthUseCase finishes a link started by a signed-in user.
+type LinkOAuthUseCase struct {
+	Providers  OAuthProviders
+	OAuthCache *cache.OAuthCache
+	Identities auth.IdentityRepository
+	Audit      audit.AuditLogger
+}
+
+func NewLinkOAuthUseCase(providers OAuthProviders, oauthCache *cache.OAuthCache, identities auth.IdentityRepository, auditLog audit.AuditLogger) *LinkOAuthUseCase {
+	return &LinkOAuthUseCase{
+		Providers:  providers,
+		OAuthCache: oauthCache,
+		Identities: identities,
+		Audit:      auditLog,
+	}
+}
+
+func (uc *LinkOAuthUseCase) Execute(ctx context.Context, userID uuid.UUID, provider string, req OAuthCallbackRequest) (*IdentityResponse, error) {
+	info, err := redeemOAuth(ctx, uc.Providers, uc.OAuthCache, provider, req, userID)
+	if err != nil {
+		return nil, err
+	}
+	identity := &auth.Identity{UserID: userID, Provider: provider, Subject: info.Subject, Email: info.Email}
+	if err := uc.Identities.LinkIdentity(ctx, identity); err != nil {
+		return nil, err
+	}
+	uc.Audit.Log(ctx, identityEvent(userID, audit.ActionIdentityLinked, identity.ID, provider))
+	resp := toIdentityResponse(*identity)
+	return &resp, nil
+}
+
+type ListIdentitiesUseCase struct {
+	Identities auth.IdentityRepository
+}
+
+func NewListIdentitiesUseCase(identities auth.IdentityRepository) *ListIdentitiesUseCase {
+	return &ListIdentitiesUseCase{
+		Identities: identities,
+	}
+}
+
+func (uc *ListIdentitiesUseCase) Execute(ctx context.Context, userID uuid.UUID) ([]IdentityResponse, error) {
+	identities, err := uc.Identities.ListIdentities(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]IdentityResponse, 0, len(identities))
+	for _, i := range identities {
+		resp = append(resp, toIdentityResponse(i))
+	}
+	return resp, nil
+}
+
+type UnlinkIdentityUseCase struct {
+	Repo       auth.Repository
+	Identities auth.IdentityRepository
+	Audit      audit.AuditLogger
+}
+
+func NewUnlinkIdentityUseCase(repo auth.Repository, identities auth.IdentityRepository, auditLog audit.AuditLogger) *UnlinkIdentityUseCase {
+	return &UnlinkIdentityUseCase{
+		Repo:       repo,
+		Identities: identities,
+		Audit:      auditLog,
+	}
+}
+
+// Execute removes a linked provider unless it is the only way left to sign
+// in.
+func (uc *UnlinkIdentityUseCase) Execute(ctx context.Context, userID, identityID uuid.UUID) error {
+	count, err := uc.Identities.CountIdentities(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if count <= 1 {
+		hash, err := uc.Repo.GetPasswordByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if hash == auth.NoPasswordHash {
+			return auth.ErrLastLoginMethod
+		}
+	}
+	if err := uc.Identities.UnlinkIdentity(ctx, userID, identityID); err != nil {
+		return err
+	}
+	uc.Audit.Log(ctx, identityEvent(userID, audit.ActionIdentityUnlinked, identityID, ""))
+	return nil
+}
+
+func identityEvent(userID uuid.UUID, action string, identityID uuid.UUID, provider string) audit.Event {
+	e := audit.Event{
+		ActorID:    userID,
+		Action:     action,
+		TargetType: audit.TargetIdentity,
+		TargetID:   identityID.String(),
+	}
+	if provider != "" {
+		e.Metadata = map[string]any{"provider": provider}
+	}
+	return e
+}
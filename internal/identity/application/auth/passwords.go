@@ -0,0 +1,53 @@
+package authapp
+
+import (
+	"go-ai/internal/identity/domain/auth"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/password"
+	"sync"
+)
+
+// Passwords checks new passwords against the policy and hashes them, and
+// verifies stored hashes.
+type Passwords struct {
+	Policy auth.PasswordPolicy
+	Hasher *password.Hasher
+
+	dummyOnce sync.Once
+	dummyHash string
+}
+
+func NewPasswords(policy auth.PasswordPolicy, hasher *password.Hasher) *Passwords {
+	return &Passwords{
+		Policy: policy,
+		Hasher: hasher,
+	}
+}
+
+// Hash validates raw against the policy and returns its encoded hash.
+func (p *Passwords) Hash(raw string) (string, error) {
+	pw, err := p.Policy.NewPassword(raw)
+	if err != nil {
+		return "", err
+	}
+	hashed, err := p.Hasher.Hash(pw.String())
+	if err != nil {
+		return "", domainerr.ErrInternalServerError
+	}
+	return hashed, nil
+}
+
+// Verify reports whether raw matches hash and whether hash should be
+// replaced with one made with the current parameters.
+func (p *Passwords) Verify(raw, hash string) (ok, rehash bool) {
+	return p.Hasher.Verify(raw, hash)
+}
+
+// Burn spends the same hashing work as a real check so unknown emails cannot
+// be told apart by response time.
+func (p *Passwords) Burn(raw string) {
+	p.dummyOnce.Do(func() {
+		p.dummyHash, _ = p.Hasher.Hash("not-a-real-password")
+	})
+	p.Hasher.Verify(raw, p.dummyHash)
+}
@@ -0,0 +1,201 @@
+package authapp
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/identity/infrastructure/cache"
+	"go-ai/internal/platform/config"
+	"go-ai/pkg/sms"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SendPhoneCodeResponse struct {
+	// Phone is the number the code went to, with most digits hidden.
+	Phone     string `json:"phone"`
+	ExpiresIn int    `json:"expires_in"`
+}
+
+type VerifyPhoneRequest struct {
+	Code string `json:"code"`
+}
+
+type SendPhoneCodeUseCase struct {
+	Repo   auth.Repository
+	Phones *cache.PhoneCache
+	SMS    sms.Sender
+	Config *config.Config
+}
+
+func NewSendPhoneCodeUseCase(repo auth.Repository, phones *cache.PhoneCache, sender sms.Sender, config *config.Config) *SendPhoneCodeUseCase {
+	return &SendPhoneCodeUseCase{
+		Repo:   repo,
+		Phones: phones,
+		SMS:    sender,
+		Config: config,
+	}
+}
+
+// Execute texts a verification code to the phone number on the profile.
+// Sending a new code replaces the previous one.
+func (uc *SendPhoneCodeUseCase) Execute(ctx context.Context, userID uuid.UUID) (*SendPhoneCodeResponse, error) {
+	user, err := uc.Repo.GetById(ctx, userID)
+	if err != nil {
+		return nil, auth.ErrUserNotFound
+	}
+	if user.Phone == "" {
+		return nil, auth.ErrPhoneRequired
+	}
+	if user.PhoneVerified {
+		return nil, auth.ErrPhoneAlreadyVerified
+	}
+	phone, err := auth.NewPhone(user.Phone, "")
+	if err != nil {
+		return nil, err
+	}
+	ok, err := uc.Phones.ReserveSend(ctx, userID, phone.String(),
+		time.Duration(uc.Config.PhoneCodeCooldown)*time.Second,
+		time.Duration(uc.Config.PhoneCodeWindow)*time.Second,
+		uc.Config.PhoneCodeMaxSends,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, auth.ErrPhoneCodeRateLimited
+	}
+
+	code, hash, err := auth.NewPhoneCode(phone)
+	if err != nil {
+		return nil, err
+	}
+	ttl := time.Duration(uc.Config.PhoneCodeTTL) * time.Second
+	if err := uc.Phones.SaveCode(ctx, userID, cache.PhoneCode{Phone: phone.String(), CodeHash: hash}, ttl); err != nil {
+		return nil, err
+	}
+	msg := sms.Message{
+		To:   phone.String(),
+		Body: fmt.Sprintf("Your verification code is %s. It expires in %d minutes.", code, int(ttl.Minutes())),
+	}
+	if err := uc.SMS.Send(ctx, msg); err != nil {
+		return nil, err
+	}
+	return &SendPhoneCodeResponse{Phone: phone.Masked(), ExpiresIn: uc.Config.PhoneCodeTTL}, nil
+}
+
+type VerifyPhoneUseCase struct {
+	Repo   auth.Repository
+	Phones *cache.PhoneCache
+	Cache  *cache.AuthCache
+	Config *config.Config
+	Audit  audit.AuditLogger
+}
+
+func NewVerifyPhoneUseCase(repo auth.Repository, phones *cache.PhoneCache, cache *cache.AuthCache, config *config.Config, auditLog audit.AuditLogger) *VerifyPhoneUseCase {
+	return &VerifyPhoneUseCase{
+		Repo:   repo,
+		Phones: phones,
+		Cache:  cache,
+		Config: config,
+		Audit:  auditLog,
+	}
+}
+
+// Execute confirms the phone number with the code sent to it. Too many wrong
+// codes discard the pending one.
+func (uc *VerifyPhoneUseCase) Execute(ctx context.Context, userID uuid.UUID, req VerifyPhoneRequest) error {
+	pending, err := uc.Phones.GetCode(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if pending == nil {
+		return auth.ErrPhoneCodeInvalid
+	}
+	phone, err := auth.NewPhone(pending.Phone, "")
+	if err != nil {
+		return auth.ErrPhoneCodeInvalid
+	}
+	got := auth.HashPhoneCode(phone, req.Code)
+	if subtle.ConstantTimeCompare([]byte(got), []byte(pending.CodeHash)) != 1 {
+		ttl := time.Duration(uc.Config.PhoneCodeTTL) * time.Second
+		failures, err := uc.Phones.FailCode(ctx, userID, ttl)
+		if err != nil {
+			return err
+		}
+		if failures >= int64(uc.Config.PhoneCodeMaxAttempts) {
+			_ = uc.Phones.DeleteCode(ctx, userID)
+		}
+		return auth.ErrPhoneCodeInvalid
+	}
+
+	if err := uc.Repo.MarkPhoneVerified(ctx, userID, phone.String()); err != nil {
+		return err
+	}
+	_ = uc.Phones.DeleteCode(ctx, userID)
+	_ = uc.Cache.UpdateUserSessions(ctx, userID, func(data *cache.UserCache) {
+		data.Phone = phone.String()
+		data.PhoneVerified = true
+	})
+
+	e := userEvent(userID, audit.ActionPhoneVerified, userID)
+	e.Metadata = map[string]any{"phone": phone.Masked()}
+	uc.Audit.Log(ctx, e)
+	return nil
+}
+
+type RemovePhoneUseCase struct {
+	Repo   auth.Repository
+	Phones *cache.PhoneCache
+	Cache  *cache.AuthCache
+	Audit  audit.AuditLogger
+}
+
+func NewRemovePhoneUseCase(repo auth.Repository, phones *cache.PhoneCache, cache *cache.AuthCache, auditLog audit.AuditLogger) *RemovePhoneUseCase {
+	return &RemovePhoneUseCase{
+		Repo:   repo,
+		Phones: phones,
+		Cache:  cache,
+		Audit:  auditLog,
+	}
+}
+
+func (uc *RemovePhoneUseCase) Execute(ctx context.Context, userID uuid.UUID) error {
+	user, err := uc.Repo.GetById(ctx, userID)
+	if err != nil {
+		return auth.ErrUserNotFound
+	}
+	if user.Phone == "" {
+		return nil
+	}
+	if err := uc.Repo.SetPhone(ctx, userID, ""); err != nil {
+		return err
+	}
+	_ = uc.Phones.DeleteCode(ctx, userID)
+	uc.Audit.Log(ctx, phoneChangedEvent(userID, user.Phone, ""))
+	user.Phone = ""
+	user.PhoneVerified = false
+	_ = refreshSessions(ctx, uc.Cache, user)
+	return nil
+}
+
+// phoneChangedEvent records masked numbers only.
+func phoneChangedEvent(userID uuid.UUID, oldPhone, newPhone string) audit.Event {
+	e := userEvent(userID, audit.ActionPhoneChanged, userID)
+	e.Metadata = map[string]any{
+		"old_phone": maskPhone(oldPhone),
+		"new_phone": maskPhone(newPhone),
+	}
+	return e
+}
+
+func maskPhone(v string) string {
+	p, err := auth.NewPhone(v, "")
+	if err != nil {
+		return ""
+	}
+	return p.Masked()
+}
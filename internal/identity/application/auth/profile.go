@@ -7,8 +7,6 @@ import (
 	"go-ai/internal/identity/infrastructure/cache"
 	domainerr "go-ai/pkg/domain_err"
 
-	"time"
-
 	"github.com/google/uuid"
 )
 
@@ -19,6 +17,8 @@ type GetProfileResponse struct {
 	IsActive bool   `json:"is_active"`
 	ImageUrl string `json:"image_url"`
 	Phone    string `json:"phone"`
+	// PhoneVerified is true once the number was confirmed with a code.
+	PhoneVerified bool `json:"phone_verified"`
 }
 
 type GetProfileUseCase struct {
@@ -33,26 +33,20 @@ func NewGetProfileUseCase(repo auth.Repository, cache *cache.AuthCache) *GetProf
 	}
 }
 
-func (uc *GetProfileUseCase) Execute(ctx context.Context, userID uuid.UUID) (*GetProfileResponse, error) {
-	sessionKey := fmt.Sprintf("session_%s", userID.String())
-	cacheData, err := uc.Cache.GetAuthCache(ctx, sessionKey)
+// Execute returns the profile cached in the caller's session, or reads it
+// from the database once the session data is gone.
+func (uc *GetProfileUseCase) Execute(ctx context.Context, userID uuid.UUID, sid string) (*GetProfileResponse, error) {
+	cacheData, err := uc.Cache.GetAuthCache(ctx, fmt.Sprintf("session_%s", sid))
 	if err != nil {
 		return nil, domainerr.ErrInternalServerError
 	}
-	if cacheData == nil {
+	if cacheData == nil || cacheData.UserID != userID {
 		record, err := uc.Repo.GetById(ctx, userID)
 		if err != nil {
 			return nil, auth.ErrUserNotFound
 		}
-		authData := &cache.UserCache{
-			UserID:   userID,
-			Email:    record.Email.String(),
-			FullName: record.FullName,
-			Role:     record.Role,
-			IsActive: record.IsActive,
-			ImageUrl: record.ImageUrl,
-		}
-		uc.Cache.SetAuthCache(ctx, sessionKey, authData, time.Duration(60*int(time.Minute)))
+		cacheData = &cache.UserCache{UserID: userID}
+		copyProfile(cacheData, record)
 	}
 	profile := &GetProfileResponse{
 		Email:    cacheData.Email,
@@ -60,6 +54,27 @@ func (uc *GetProfileUseCase) Execute(ctx context.Context, userID uuid.UUID) (*Ge
 		Role:     cacheData.Role,
 		IsActive: cacheData.IsActive,
 		ImageUrl: cacheData.ImageUrl,
+		Phone:    cacheData.Phone,
+
+		PhoneVerified: cacheData.PhoneVerified,
 	}
 	return profile, nil
 }
+
+// refreshSessions writes the user's current profile into every one of their
+// sessions, so a change made on one device shows on the others.
+func refreshSessions(ctx context.Context, authCache *cache.AuthCache, user *auth.Entity) error {
+	return authCache.UpdateUserSessions(ctx, user.ID, func(data *cache.UserCache) {
+		copyProfile(data, user)
+	})
+}
+
+func copyProfile(data *cache.UserCache, user *auth.Entity) {
+	data.Email = user.Email.String()
+	data.FullName = user.FullName
+	data.Role = user.Role
+	data.IsActive = user.IsActive
+	data.ImageUrl = user.ImageUrl
+	data.Phone = user.Phone
+	data.PhoneVerified = user.PhoneVerified
+}
@@ -8,6 +8,7 @@ import (
 	"go-ai/internal/platform/config"
 	domainerr "go-ai/pkg/domain_err"
 	"go-ai/pkg/helpers"
+	"go-ai/pkg/jwtkeys"
 	"time"
 )
 
@@ -24,22 +25,24 @@ type RefreshTokenResponse struct {
 type RefreshTokenUseCase struct {
 	Repo   auth.Repository
 	Cache  *cache.AuthCache
+	Tokens jwtkeys.Issuer
 	Config *config.Config
 }
 
-func NewRefreshTokenUseCase(repo auth.Repository, cache *cache.AuthCache, config *config.Config) *RefreshTokenUseCase {
+func NewRefreshTokenUseCase(repo auth.Repository, cache *cache.AuthCache, tokens jwtkeys.Issuer, config *config.Config) *RefreshTokenUseCase {
 	return &RefreshTokenUseCase{
 		Repo:   repo,
 		Cache:  cache,
+		Tokens: tokens,
 		Config: config,
 	}
 }
 
-func (uc *RefreshTokenUseCase) Execute(ctx context.Context, request RefreshTokenRequest) (*RefreshTokenResponse, error) {
+func (uc *RefreshTokenUseCase) Execute(ctx context.Context, request RefreshTokenRequest, client auth.ClientInfo) (*RefreshTokenResponse, error) {
 	if request.RefreshToken == "" {
 		return nil, auth.ErrTokenInvalid
 	}
-	claims, err := helpers.VerifyToken(request.RefreshToken, uc.Config.JwtRefreshSecret)
+	claims, err := uc.Tokens.Verify(request.RefreshToken, jwtkeys.UseRefresh)
 	if err != nil {
 		return nil, auth.ErrTokenNotActive
 	}
@@ -70,21 +73,34 @@ func (uc *RefreshTokenUseCase) Execute(ctx context.Context, request RefreshToken
 	if err != nil {
 		return nil, err
 	}
-	record, err := uc.Repo.GetByEmail(ctx, authData.Email)
+	if authData == nil {
+		return nil, auth.ErrSessionNotFound
+	}
+	// Reloaded by ID, since the email may have changed since the login.
+	record, err := uc.Repo.GetById(ctx, authData.UserID)
 	if err != nil {
+		if isNotFound(err) {
+			return nil, auth.ErrUserNotFound
+		}
 		return nil, err
 	}
 	if !record.IsActive {
 		return nil, auth.ErrUserInactive
 	}
+	if record.PasswordResetRequired {
+		return nil, auth.ErrPasswordResetRequired
+	}
+	if uc.Config.RequireEmailVerification && !record.EmailVerified {
+		return nil, auth.ErrEmailNotVerified
+	}
 
 	// Generate new session
 	newSid := helpers.GenerateKey()
-	accessToken, err := helpers.GenerateToken(newSid, uc.Config.JwtAccessSecret, uc.Config.JwtExpiresIn)
+	accessToken, err := uc.Tokens.Issue(newSid, jwtkeys.UseAccess, uc.Config.JwtExpiresIn)
 	if err != nil {
 		return nil, auth.ErrTokenGenerateFail
 	}
-	refreshToken, err := helpers.GenerateToken(newSid, uc.Config.JwtRefreshSecret, uc.Config.JwtRefreshExpiresIn)
+	refreshToken, err := uc.Tokens.Issue(newSid, jwtkeys.UseRefresh, uc.Config.JwtRefreshExpiresIn)
 	if err != nil {
 		return nil, auth.ErrTokenGenerateFail
 	}
@@ -95,9 +111,23 @@ func (uc *RefreshTokenUseCase) Execute(ctx context.Context, request RefreshToken
 		return nil, domainerr.ErrInternalServerError
 	}
 
+	// The refreshed session keeps the original login time
+	now := time.Now().UTC()
+	session := auth.Session{
+		ID:         newSid,
+		UserAgent:  client.UserAgent,
+		IP:         client.IP,
+		CreatedAt:  now,
+		LastSeenAt: now,
+	}
+	if old, err := uc.Cache.GetSession(ctx, record.ID, oldSid); err == nil && old != nil {
+		session.CreatedAt = old.CreatedAt
+	}
+
 	// Delete old session and refresh token caches
 	_ = uc.Cache.DeleteAuthCache(ctx, oldSessionKey)
 	_ = uc.Cache.DeleteRefreshTokenCache(ctx, oldRefreshKey)
+	_ = uc.Cache.UntrackSession(ctx, record.ID, oldSid)
 
 	// Store new session and refresh token with new sid
 	newSessionKey := fmt.Sprintf("session_%s", newSid)
@@ -109,14 +139,19 @@ func (uc *RefreshTokenUseCase) Execute(ctx context.Context, request RefreshToken
 		IsActive: record.IsActive,
 		FullName: record.FullName,
 		ImageUrl: record.ImageUrl,
+		OrgID:    authData.OrgID,
 	}
+	refreshTTL := time.Duration(uc.Config.JwtRefreshExpiresIn) * time.Second
 	if err := uc.Cache.SetLoginCaches(
 		ctx,
-		newSessionKey, dataCache, time.Duration(uc.Config.JwtExpiresIn*int(time.Second)),
-		newRefreshKey, refreshToken, time.Duration(uc.Config.JwtRefreshExpiresIn*int(time.Second)),
+		newSessionKey, dataCache, refreshTTL,
+		newRefreshKey, refreshToken, refreshTTL,
 	); err != nil {
 		return nil, domainerr.ErrInternalServerError
 	}
+	if err := uc.Cache.TrackSession(ctx, record.ID, session, refreshTTL); err != nil {
+		return nil, domainerr.ErrInternalServerError
+	}
 
 	return &RefreshTokenResponse{
 		AccessToken:  accessToken,
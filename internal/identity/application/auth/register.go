@@ -4,7 +4,6 @@ import (
 	"context"
 
 	"go-ai/internal/identity/domain/auth"
-	domainerr "go-ai/pkg/domain_err"
 	"go-ai/pkg/helpers"
 
 	"github.com/google/uuid"
@@ -18,12 +17,16 @@ type RegisterRequest struct {
 }
 
 type RegisterUseCase struct {
-	Repo auth.Repository
+	Repo         auth.Repository
+	Passwords    *Passwords
+	Verification *SendVerificationEmailUseCase
 }
 
-func NewRegisterUseCase(repo auth.Repository) *RegisterUseCase {
+func NewRegisterUseCase(repo auth.Repository, passwords *Passwords, verification *SendVerificationEmailUseCase) *RegisterUseCase {
 	return &RegisterUseCase{
-		Repo: repo,
+		Repo:         repo,
+		Passwords:    passwords,
+		Verification: verification,
 	}
 }
 
@@ -34,19 +37,21 @@ func (s *RegisterUseCase) Execute(ctx context.Context, request RegisterRequest)
 		return uuid.Nil, err
 	}
 
-	rawPassword, err := auth.NewPassword(request.Password)
+	hashedPassword, err := s.Passwords.Hash(request.Password)
 	if err != nil {
 		return uuid.Nil, err
 	}
-
-	hashedPassword, err := helpers.HashPassword(rawPassword.String())
-	if err != nil {
-		return uuid.Nil, domainerr.ErrInternalServerError
-	}
 	pw, _ := auth.NewPasswordFromHash(hashedPassword)
-	return s.Repo.CreateUser(ctx, &auth.Entity{
+	id, err := s.Repo.CreateUser(ctx, &auth.Entity{
 		FullName: request.FullName,
 		Email:    email,
 		Password: pw,
 	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	// The account exists even if the mail fails; the user can ask for the
+	// verification email again.
+	_ = s.Verification.Execute(ctx, id)
+	return id, nil
 }
@@ -0,0 +1,59 @@
+package authapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/identity/infrastructure/cache"
+)
+
+type ResetPasswordRequest struct {
+	Token           string `json:"token"`
+	NewPassword     string `json:"new_password"`
+	ConfirmPassword string `json:"confirm_password"`
+}
+
+type ResetPasswordUseCase struct {
+	Repo      auth.Repository
+	Tokens    auth.TokenRepository
+	Passwords *Passwords
+	Cache     *cache.AuthCache
+	Audit     audit.AuditLogger
+}
+
+func NewResetPasswordUseCase(repo auth.Repository, tokens auth.TokenRepository, passwords *Passwords, cache *cache.AuthCache, auditLog audit.AuditLogger) *ResetPasswordUseCase {
+	return &ResetPasswordUseCase{
+		Repo:      repo,
+		Tokens:    tokens,
+		Passwords: passwords,
+		Cache:     cache,
+		Audit:     auditLog,
+	}
+}
+
+// Execute sets a new password from a reset token, clears any forced reset
+// and signs the user out everywhere.
+func (uc *ResetPasswordUseCase) Execute(ctx context.Context, req ResetPasswordRequest) error {
+	if req.Token == "" {
+		return auth.ErrActionTokenInvalid
+	}
+	if req.NewPassword != req.ConfirmPassword {
+		return auth.ErrConfirmPassword
+	}
+	// Validate before consuming so a weak password does not burn the token.
+	hashed, err := uc.Passwords.Hash(req.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	userID, err := uc.Tokens.ConsumeToken(ctx, auth.TokenPasswordReset, auth.HashToken(req.Token))
+	if err != nil {
+		return err
+	}
+	if err := uc.Repo.ResetPassword(ctx, userID, hashed); err != nil {
+		return err
+	}
+	uc.Audit.Log(ctx, userEvent(userID, audit.ActionPasswordReset, userID))
+	_, err = uc.Cache.RevokeUserSessions(ctx, userID)
+	return err
+}
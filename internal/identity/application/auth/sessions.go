@@ -0,0 +1,117 @@
+package authapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/auth"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IP         string    `json:"ip"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	Current    bool      `json:"current"`
+}
+
+type LogoutAllResponse struct {
+	Revoked int `json:"revoked"`
+}
+
+type ListSessionsUseCase struct {
+	Sessions auth.SessionStore
+}
+
+func NewListSessionsUseCase(sessions auth.SessionStore) *ListSessionsUseCase {
+	return &ListSessionsUseCase{
+		Sessions: sessions,
+	}
+}
+
+func (uc *ListSessionsUseCase) Execute(ctx context.Context, userID uuid.UUID, currentSid string) ([]SessionResponse, error) {
+	sessions, err := uc.Sessions.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]SessionResponse, 0, len(sessions))
+	for _, s := range sessions {
+		resp = append(resp, SessionResponse{
+			ID:         s.ID,
+			UserAgent:  s.UserAgent,
+			IP:         s.IP,
+			CreatedAt:  s.CreatedAt,
+			LastSeenAt: s.LastSeenAt,
+			Current:    s.ID == currentSid,
+		})
+	}
+	return resp, nil
+}
+
+type RevokeSessionUseCase struct {
+	Sessions auth.SessionStore
+	Audit    audit.AuditLogger
+}
+
+func NewRevokeSessionUseCase(sessions auth.SessionStore, auditLog audit.AuditLogger) *RevokeSessionUseCase {
+	return &RevokeSessionUseCase{
+		Sessions: sessions,
+		Audit:    auditLog,
+	}
+}
+
+// Execute revokes one of the user's own sessions.
+func (uc *RevokeSessionUseCase) Execute(ctx context.Context, userID uuid.UUID, sid string) error {
+	s, err := uc.Sessions.GetSession(ctx, userID, sid)
+	if err != nil {
+		return err
+	}
+	if s == nil {
+		return auth.ErrSessionNotFound
+	}
+	if err := uc.Sessions.RevokeSession(ctx, userID, sid); err != nil {
+		return err
+	}
+	uc.Audit.Log(ctx, sessionEvent(userID, audit.ActionSessionRevoked, sid))
+	return nil
+}
+
+type LogoutAllUseCase struct {
+	Sessions auth.SessionStore
+	Audit    audit.AuditLogger
+}
+
+func NewLogoutAllUseCase(sessions auth.SessionStore, auditLog audit.AuditLogger) *LogoutAllUseCase {
+	return &LogoutAllUseCase{
+		Sessions: sessions,
+		Audit:    auditLog,
+	}
+}
+
+// Execute revokes every session of the user, including the current one.
+func (uc *LogoutAllUseCase) Execute(ctx context.Context, userID uuid.UUID) (*LogoutAllResponse, error) {
+	n, err := uc.Sessions.RevokeUserSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	uc.Audit.Log(ctx, audit.Event{
+		ActorID:    userID,
+		Action:     audit.ActionLogoutAll,
+		TargetType: audit.TargetUser,
+		TargetID:   userID.String(),
+		Metadata:   map[string]any{"revoked": n},
+	})
+	return &LogoutAllResponse{Revoked: n}, nil
+}
+
+func sessionEvent(userID uuid.UUID, action, sid string) audit.Event {
+	return audit.Event{
+		ActorID:    userID,
+		Action:     action,
+		TargetType: audit.TargetSession,
+		TargetID:   sid,
+	}
+}
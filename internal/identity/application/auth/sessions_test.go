@@ -0,0 +1,106 @@
+package authapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/auth"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+type sessionStore struct {
+	sessions map[uuid.UUID][]auth.Session
+}
+
+func (s *sessionStore) ListSessions(_ context.Context, userID uuid.UUID) ([]auth.Session, error) {
+	return s.sessions[userID], nil
+}
+
+func (s *sessionStore) GetSession(_ context.Context, userID uuid.UUID, sid string) (*auth.Session, error) {
+	for _, sess := range s.sessions[userID] {
+		if sess.ID == sid {
+			return &sess, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *sessionStore) RevokeSession(_ context.Context, userID uuid.UUID, sid string) error {
+	kept := s.sessions[userID][:0]
+	for _, sess := range s.sessions[userID] {
+		if sess.ID != sid {
+			kept = append(kept, sess)
+		}
+	}
+	s.sessions[userID] = kept
+	return nil
+}
+
+func (s *sessionStore) RevokeUserSessions(_ context.Context, userID uuid.UUID) (int, error) {
+	n := len(s.sessions[userID])
+	delete(s.sessions, userID)
+	return n, nil
+}
+
+func newSessionStore(userID uuid.UUID, sids ...string) *sessionStore {
+	store := &sessionStore{sessions: map[uuid.UUID][]auth.Session{}}
+	for _, sid := range sids {
+		store.sessions[userID] = append(store.sessions[userID], auth.Session{ID: sid, IP: "203.0.113.9"})
+	}
+	return store
+}
+
+func TestListSessionsMarksCurrent(t *testing.T) {
+	userID := uuid.New()
+	uc := NewListSessionsUseCase(newSessionStore(userID, "a", "b"))
+	resp, err := uc.Execute(context.Background(), userID, "b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 2 || resp[0].Current || !resp[1].Current {
+		t.Fatalf("sessions = %+v, want only b current", resp)
+	}
+}
+
+func TestRevokeSession(t *testing.T) {
+	userID := uuid.New()
+	store := newSessionStore(userID, "a", "b")
+	log := &auditLog{}
+	uc := NewRevokeSessionUseCase(store, log)
+
+	// Another user's sid is unknown to this user.
+	if err := uc.Execute(context.Background(), uuid.New(), "a"); !errors.Is(err, auth.ErrSessionNotFound) {
+		t.Fatalf("err = %v, want ErrSessionNotFound", err)
+	}
+	if len(log.events) != 0 {
+		t.Fatalf("audit = %+v", log.events)
+	}
+
+	if err := uc.Execute(context.Background(), userID, "a"); err != nil {
+		t.Fatal(err)
+	}
+	if len(store.sessions[userID]) != 1 || store.sessions[userID][0].ID != "b" {
+		t.Fatalf("sessions = %+v, want only b", store.sessions[userID])
+	}
+	if len(log.events) != 1 || log.events[0].Action != audit.ActionSessionRevoked || log.events[0].TargetID != "a" {
+		t.Fatalf("audit = %+v", log.events)
+	}
+}
+
+func TestLogoutAllRevokesEverySession(t *testing.T) {
+	userID := uuid.New()
+	store := newSessionStore(userID, "a", "b", "c")
+	log := &auditLog{}
+	resp, err := NewLogoutAllUseCase(store, log).Execute(context.Background(), userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Revoked != 3 || len(store.sessions[userID]) != 0 {
+		t.Fatalf("revoked = %d, left = %d", resp.Revoked, len(store.sessions[userID]))
+	}
+	if len(log.events) != 1 || log.events[0].Action != audit.ActionLogoutAll || log.events[0].Metadata["revoked"] != 3 {
+		t.Fatalf("audit = %+v", log.events)
+	}
+}
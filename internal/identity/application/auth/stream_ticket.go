@@ -0,0 +1,39 @@
+package authapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/platform/config"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type StreamTicketResponse struct {
+	Ticket    string `json:"ticket"`
+	ExpiresIn int    `json:"expires_in"`
+}
+
+type IssueStreamTicketUseCase struct {
+	Tickets auth.StreamTicketStore
+	Config  *config.Config
+}
+
+func NewIssueStreamTicketUseCase(tickets auth.StreamTicketStore, config *config.Config) *IssueStreamTicketUseCase {
+	return &IssueStreamTicketUseCase{
+		Tickets: tickets,
+		Config:  config,
+	}
+}
+
+// Execute issues a single-use ticket that opens one event stream for the
+// session sid in the organization orgID. Only its hash is stored.
+func (uc *IssueStreamTicketUseCase) Execute(ctx context.Context, userID uuid.UUID, sid string, orgID uuid.UUID) (*StreamTicketResponse, error) {
+	raw, hash := auth.NewToken()
+	ttl := time.Duration(uc.Config.StreamTicketTTL) * time.Second
+	t := auth.StreamTicket{UserID: userID, Sid: sid, OrgID: orgID}
+	if err := uc.Tickets.SaveStreamTicket(ctx, hash, t, ttl); err != nil {
+		return nil, err
+	}
+	return &StreamTicketResponse{Ticket: raw, ExpiresIn: uc.Config.StreamTicketTTL}, nil
+}
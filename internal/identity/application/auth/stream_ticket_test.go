@@ -0,0 +1,52 @@
+package authapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/platform/config"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ticketStore struct {
+	tickets map[string]auth.StreamTicket
+	ttl     time.Duration
+}
+
+func (s *ticketStore) SaveStreamTicket(_ context.Context, hash string, t auth.StreamTicket, ttl time.Duration) error {
+	s.tickets[hash] = t
+	s.ttl = ttl
+	return nil
+}
+
+func (s *ticketStore) TakeStreamTicket(_ context.Context, hash string) (*auth.StreamTicket, error) {
+	t, ok := s.tickets[hash]
+	if !ok {
+		return nil, nil
+	}
+	delete(s.tickets, hash)
+	return &t, nil
+}
+
+func TestIssueStreamTicketStoresOnlyTheHash(t *testing.T) {
+	store := &ticketStore{tickets: map[string]auth.StreamTicket{}}
+	uc := NewIssueStreamTicketUseCase(store, &config.Config{StreamTicketTTL: 30})
+	userID, orgID := uuid.New(), uuid.New()
+
+	resp, err := uc.Execute(context.Background(), userID, "sid-1", orgID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.ExpiresIn != 30 || store.ttl != 30*time.Second {
+		t.Fatalf("expires_in = %d, ttl = %s", resp.ExpiresIn, store.ttl)
+	}
+	if _, ok := store.tickets[resp.Ticket]; ok {
+		t.Fatal("raw ticket stored")
+	}
+	got, _ := store.TakeStreamTicket(context.Background(), auth.HashToken(resp.Ticket))
+	if got == nil || *got != (auth.StreamTicket{UserID: userID, Sid: "sid-1", OrgID: orgID}) {
+		t.Fatalf("ticket = %+v", got)
+	}
+}
@@ -4,11 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-	"fmt"
+	"go-ai/internal/identity/domain/audit"
 	"go-ai/internal/identity/domain/auth"
 	"go-ai/internal/identity/infrastructure/cache"
+	"go-ai/internal/platform/config"
 	domainerr "go-ai/pkg/domain_err"
-	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -26,14 +26,18 @@ type UpdateProfileRequest struct {
 }
 
 type UpdateProfileUseCase struct {
-	Repo  auth.Repository
-	Cache *cache.AuthCache
+	Repo   auth.Repository
+	Cache  *cache.AuthCache
+	Config *config.Config
+	Audit  audit.AuditLogger
 }
 
-func NewUpdateProfileUseCase(repo auth.Repository, cache *cache.AuthCache) *UpdateProfileUseCase {
+func NewUpdateProfileUseCase(repo auth.Repository, cache *cache.AuthCache, config *config.Config, auditLog audit.AuditLogger) *UpdateProfileUseCase {
 	return &UpdateProfileUseCase{
-		Repo:  repo,
-		Cache: cache,
+		Repo:   repo,
+		Cache:  cache,
+		Config: config,
+		Audit:  auditLog,
 	}
 }
 
@@ -55,7 +59,8 @@ func (uc *UpdateProfileUseCase) Execute(ctx context.Context, userID uuid.UUID, r
 		}
 	}
 
-	if req.Email != "" && req.Email != user.Email.String() {
+	oldEmail := user.Email.String()
+	if req.Email != "" && req.Email != oldEmail {
 		if err := user.UpdateEmail(req.Email); err != nil {
 			return nil, err
 		}
@@ -67,12 +72,36 @@ func (uc *UpdateProfileUseCase) Execute(ctx context.Context, userID uuid.UUID, r
 		}
 	}
 
+	// A new number is saved unverified; the user confirms it with a code.
+	oldPhone := user.Phone
+	if req.Phone != "" {
+		phone, err := auth.NewPhone(req.Phone, uc.Config.PhoneDefaultCountryCode)
+		if err != nil {
+			return nil, err
+		}
+		if phone.String() != user.Phone {
+			user.Phone = phone.String()
+			user.PhoneVerified = false
+		}
+	}
+
 	if err := uc.Repo.UpdateProfile(ctx, user); err != nil {
 		if ae, ok := err.(domainerr.AppError); ok {
 			return nil, ae
 		}
 		return nil, domainerr.ErrInternalServerError
 	}
+	if user.Phone != oldPhone {
+		if err := uc.Repo.SetPhone(ctx, userID, user.Phone); err != nil {
+			return nil, domainerr.ErrInternalServerError
+		}
+		uc.Audit.Log(ctx, phoneChangedEvent(userID, oldPhone, user.Phone))
+	}
+	if user.Email.String() != oldEmail {
+		uc.Audit.Log(ctx, emailChangedEvent(userID, oldEmail, user.Email.String()))
+	} else {
+		uc.Audit.Log(ctx, userEvent(userID, audit.ActionProfileUpdated, userID))
+	}
 
 	profile := &GetProfileResponse{
 		Email:    user.Email.String(),
@@ -80,17 +109,20 @@ func (uc *UpdateProfileUseCase) Execute(ctx context.Context, userID uuid.UUID, r
 		Role:     user.Role,
 		IsActive: user.IsActive,
 		ImageUrl: user.ImageUrl,
+		Phone:    user.Phone,
+
+		PhoneVerified: user.PhoneVerified,
 	}
 
-	sessionKey := fmt.Sprintf("session_%s", userID.String())
-	uc.Cache.SetAuthCache(ctx, sessionKey, &cache.UserCache{
-		UserID:   userID,
-		Email:    user.Email.String(),
-		FullName: user.FullName,
-		Role:     user.Role,
-		IsActive: user.IsActive,
-		ImageUrl: user.ImageUrl,
-	}, time.Duration(60*int(time.Minute)))
+	_ = refreshSessions(ctx, uc.Cache, user)
 
 	return profile, nil
 }
+
+// emailChangedEvent keeps both addresses, which account deletion strips as
+// audit.PersonalMetadata.
+func emailChangedEvent(userID uuid.UUID, oldEmail, newEmail string) audit.Event {
+	e := userEvent(userID, audit.ActionEmailChanged, userID)
+	e.Metadata = map[string]any{"old_email": oldEmail, "new_email": newEmail}
+	return e
+}
@@ -0,0 +1,80 @@
+package authapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/platform/config"
+	"go-ai/pkg/mailer"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+type SendVerificationEmailUseCase struct {
+	Repo   auth.Repository
+	Tokens auth.TokenRepository
+	Mailer mailer.Mailer
+	Config *config.Config
+}
+
+func NewSendVerificationEmailUseCase(repo auth.Repository, tokens auth.TokenRepository, mailer mailer.Mailer, config *config.Config) *SendVerificationEmailUseCase {
+	return &SendVerificationEmailUseCase{
+		Repo:   repo,
+		Tokens: tokens,
+		Mailer: mailer,
+		Config: config,
+	}
+}
+
+// Execute emails a fresh verification link; earlier links stop working.
+func (uc *SendVerificationEmailUseCase) Execute(ctx context.Context, userID uuid.UUID) error {
+	user, err := uc.Repo.GetById(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.EmailVerified {
+		return auth.ErrEmailAlreadyVerified
+	}
+
+	ttl := time.Duration(uc.Config.EmailVerificationTTL) * time.Second
+	raw, hash := auth.NewToken()
+	if err := uc.Tokens.CreateToken(ctx, user.ID, auth.TokenEmailVerification, hash, time.Now().UTC().Add(ttl)); err != nil {
+		return err
+	}
+	link := actionLink(uc.Config.AppBaseURL, "/verify-email", raw)
+	return uc.Mailer.Send(ctx, emailVerificationMessage(user.Email.String(), user.FullName, link, ttl))
+}
+
+type VerifyEmailUseCase struct {
+	Repo   auth.Repository
+	Tokens auth.TokenRepository
+	Audit  audit.AuditLogger
+}
+
+func NewVerifyEmailUseCase(repo auth.Repository, tokens auth.TokenRepository, auditLog audit.AuditLogger) *VerifyEmailUseCase {
+	return &VerifyEmailUseCase{
+		Repo:   repo,
+		Tokens: tokens,
+		Audit:  auditLog,
+	}
+}
+
+func (uc *VerifyEmailUseCase) Execute(ctx context.Context, req VerifyEmailRequest) error {
+	if req.Token == "" {
+		return auth.ErrActionTokenInvalid
+	}
+	userID, err := uc.Tokens.ConsumeToken(ctx, auth.TokenEmailVerification, auth.HashToken(req.Token))
+	if err != nil {
+		return err
+	}
+	if err := uc.Repo.MarkEmailVerified(ctx, userID); err != nil {
+		return err
+	}
+	uc.Audit.Log(ctx, userEvent(userID, audit.ActionEmailVerified, userID))
+	return nil
+}
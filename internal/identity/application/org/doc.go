@@ -0,0 +1,30 @@
+package orgapp
+
+import (
+	"go-ai/pkg/response"
+)
+
+type OrganizationSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *OrganizationResponse `json:"data,omitempty"`
+}
+
+type ListOrganizationsSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data []OrganizationResponse `json:"data,omitempty"`
+}
+
+type ListMembersSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data []MemberResponse `json:"data,omitempty"`
+}
+
+type InvitationSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *InvitationResponse `json:"data,omitempty"`
+}
+
+type ListInvitationsSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data []InvitationResponse `json:"data,omitempty"`
+}
@@ -0,0 +1,53 @@
+package orgapp
+
+import (
+	"go-ai/internal/identity/domain/org"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OrganizationResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Role      string    `json:"role"`
+	Active    bool      `json:"active"`
+	JoinedAt  time.Time `json:"joined_at"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+type MemberResponse struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Email    string    `json:"email"`
+	FullName string    `json:"full_name"`
+	Role     string    `json:"role"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+type InvitationResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func toMemberResponse(m org.Membership) MemberResponse {
+	return MemberResponse{
+		UserID:   m.UserID,
+		Email:    m.Email,
+		FullName: m.FullName,
+		Role:     string(m.Role),
+		JoinedAt: m.CreatedAt,
+	}
+}
+
+func toInvitationResponse(i org.Invitation) InvitationResponse {
+	return InvitationResponse{
+		ID:        i.ID,
+		Email:     i.Email,
+		Role:      string(i.Role),
+		ExpiresAt: i.ExpiresAt,
+		CreatedAt: i.CreatedAt,
+	}
+}
@@ -0,0 +1,195 @@
+package orgapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/identity/domain/org"
+	"go-ai/internal/platform/config"
+	"go-ai/pkg/helpers"
+	"go-ai/pkg/mailer"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type InviteMemberRequest struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+type AcceptInvitationRequest struct {
+	Token string `json:"token"`
+}
+
+type InviteMemberUseCase struct {
+	Repo   org.Repository
+	Users  auth.Repository
+	Mailer mailer.Mailer
+	Config *config.Config
+	Audit  audit.AuditLogger
+}
+
+func NewInviteMemberUseCase(repo org.Repository, users auth.Repository, mailer mailer.Mailer, config *config.Config, auditLog audit.AuditLogger) *InviteMemberUseCase {
+	return &InviteMemberUseCase{
+		Repo:   repo,
+		Users:  users,
+		Mailer: mailer,
+		Config: config,
+		Audit:  auditLog,
+	}
+}
+
+// Execute emails an invitation link. Inviting the same address again
+// replaces the earlier invitation.
+func (uc *InviteMemberUseCase) Execute(ctx context.Context, actorID, orgID uuid.UUID, req InviteMemberRequest) (*InvitationResponse, error) {
+	email, err := helpers.NewEmail(req.Email)
+	if err != nil || email.String() == "" {
+		return nil, auth.ErrInvalidEmail
+	}
+	role, err := org.ParseRole(req.Role)
+	if err != nil {
+		return nil, err
+	}
+	actorRole, err := uc.Repo.MemberRole(ctx, orgID, actorID)
+	if err != nil {
+		return nil, err
+	}
+	if !actorRole.CanInvite(role) {
+		return nil, org.ErrForbidden
+	}
+	o, err := uc.Repo.Get(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	inviter, err := uc.Users.GetById(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := time.Duration(uc.Config.OrgInvitationTTL) * time.Second
+	raw, hash := auth.NewToken()
+	inv, err := uc.Repo.CreateInvitation(ctx, &org.Invitation{
+		OrganizationID: orgID,
+		Email:          email.String(),
+		Role:           role,
+		InvitedBy:      &actorID,
+		ExpiresAt:      time.Now().UTC().Add(ttl),
+	}, hash)
+	if err != nil {
+		return nil, err
+	}
+	link := invitationLink(uc.Config.AppBaseURL, raw)
+	if err := uc.Mailer.Send(ctx, invitationMessage(inv.Email, inviter.FullName, o.Name, role, link, ttl)); err != nil {
+		return nil, err
+	}
+	uc.Audit.Log(ctx, orgEvent(actorID, audit.ActionOrgMemberInvited, orgID, map[string]any{
+		"email": inv.Email,
+		"role":  string(role),
+	}))
+	resp := toInvitationResponse(*inv)
+	return &resp, nil
+}
+
+type ListInvitationsUseCase struct {
+	Repo org.Repository
+}
+
+func NewListInvitationsUseCase(repo org.Repository) *ListInvitationsUseCase {
+	return &ListInvitationsUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute lists pending invitations. Staff cannot see them.
+func (uc *ListInvitationsUseCase) Execute(ctx context.Context, actorID, orgID uuid.UUID) ([]InvitationResponse, error) {
+	actorRole, err := uc.Repo.MemberRole(ctx, orgID, actorID)
+	if err != nil {
+		return nil, err
+	}
+	if !actorRole.CanInvite(org.RoleStaff) {
+		return nil, org.ErrForbidden
+	}
+	invitations, err := uc.Repo.ListInvitations(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]InvitationResponse, 0, len(invitations))
+	for _, i := range invitations {
+		resp = append(resp, toInvitationResponse(i))
+	}
+	return resp, nil
+}
+
+type RevokeInvitationUseCase struct {
+	Repo  org.Repository
+	Audit audit.AuditLogger
+}
+
+func NewRevokeInvitationUseCase(repo org.Repository, auditLog audit.AuditLogger) *RevokeInvitationUseCase {
+	return &RevokeInvitationUseCase{
+		Repo:  repo,
+		Audit: auditLog,
+	}
+}
+
+func (uc *RevokeInvitationUseCase) Execute(ctx context.Context, actorID, orgID, invitationID uuid.UUID) error {
+	actorRole, err := uc.Repo.MemberRole(ctx, orgID, actorID)
+	if err != nil {
+		return err
+	}
+	if !actorRole.CanInvite(org.RoleStaff) {
+		return org.ErrForbidden
+	}
+	if err := uc.Repo.RevokeInvitation(ctx, orgID, invitationID); err != nil {
+		return err
+	}
+	uc.Audit.Log(ctx, orgEvent(actorID, audit.ActionOrgInviteRevoked, orgID, map[string]any{
+		"invitation_id": invitationID.String(),
+	}))
+	return nil
+}
+
+type AcceptInvitationUseCase struct {
+	Repo  org.Repository
+	Users auth.Repository
+	Audit audit.AuditLogger
+}
+
+func NewAcceptInvitationUseCase(repo org.Repository, users auth.Repository, auditLog audit.AuditLogger) *AcceptInvitationUseCase {
+	return &AcceptInvitationUseCase{
+		Repo:  repo,
+		Users: users,
+		Audit: auditLog,
+	}
+}
+
+// Execute joins the signed-in user to the inviting organization. The
+// invitation only works for the address it was sent to.
+func (uc *AcceptInvitationUseCase) Execute(ctx context.Context, userID uuid.UUID, req AcceptInvitationRequest) (*OrganizationResponse, error) {
+	if req.Token == "" {
+		return nil, org.ErrInvitationInvalid
+	}
+	user, err := uc.Users.GetById(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	inv, err := uc.Repo.AcceptInvitation(ctx, auth.HashToken(req.Token), userID, user.Email.String())
+	if err != nil {
+		return nil, err
+	}
+	o, err := uc.Repo.Get(ctx, inv.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	uc.Audit.Log(ctx, orgEvent(userID, audit.ActionOrgMemberJoined, o.ID, map[string]any{
+		"role": string(inv.Role),
+	}))
+	return &OrganizationResponse{
+		ID:        o.ID,
+		Name:      o.Name,
+		Role:      string(inv.Role),
+		JoinedAt:  *inv.AcceptedAt,
+		CreatedAt: o.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,25 @@
+package orgapp
+
+import (
+	"fmt"
+	"go-ai/internal/identity/domain/org"
+	"go-ai/pkg/mailer"
+	"net/url"
+	"strings"
+	"time"
+)
+
+func invitationLink(baseURL, token string) string {
+	return fmt.Sprintf("%s/invitations/accept?token=%s", strings.TrimRight(baseURL, "/"), url.QueryEscape(token))
+}
+
+func invitationMessage(to, inviter, orgName string, role org.Role, link string, ttl time.Duration) mailer.Message {
+	return mailer.Message{
+		To:      to,
+		Subject: fmt.Sprintf("You're invited to join %s", orgName),
+		Body: fmt.Sprintf(
+			"Hi,\n\n%s invited you to join %s as %s. Sign in with this email address and open the link below to accept:\n\n%s\n\nThe link expires in %s.\n",
+			inviter, orgName, role, link, ttl,
+		),
+	}
+}
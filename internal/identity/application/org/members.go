@@ -0,0 +1,148 @@
+package orgapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/org"
+
+	"github.com/google/uuid"
+)
+
+type ChangeMemberRoleRequest struct {
+	Role string `json:"role"`
+}
+
+type ListMembersUseCase struct {
+	Repo org.Repository
+}
+
+func NewListMembersUseCase(repo org.Repository) *ListMembersUseCase {
+	return &ListMembersUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *ListMembersUseCase) Execute(ctx context.Context, userID, orgID uuid.UUID) ([]MemberResponse, error) {
+	if _, err := uc.Repo.MemberRole(ctx, orgID, userID); err != nil {
+		return nil, err
+	}
+	members, err := uc.Repo.ListMembers(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]MemberResponse, 0, len(members))
+	for _, m := range members {
+		resp = append(resp, toMemberResponse(m))
+	}
+	return resp, nil
+}
+
+type ChangeMemberRoleUseCase struct {
+	Repo  org.Repository
+	Audit audit.AuditLogger
+}
+
+func NewChangeMemberRoleUseCase(repo org.Repository, auditLog audit.AuditLogger) *ChangeMemberRoleUseCase {
+	return &ChangeMemberRoleUseCase{
+		Repo:  repo,
+		Audit: auditLog,
+	}
+}
+
+// Execute changes a member's role within the rules of org.Role.CanManage and
+// never leaves the organization without an owner.
+func (uc *ChangeMemberRoleUseCase) Execute(ctx context.Context, actorID, orgID, memberID uuid.UUID, req ChangeMemberRoleRequest) error {
+	role, err := org.ParseRole(req.Role)
+	if err != nil {
+		return err
+	}
+	actorRole, err := uc.Repo.MemberRole(ctx, orgID, actorID)
+	if err != nil {
+		return err
+	}
+	current, err := memberRole(ctx, uc.Repo, orgID, memberID)
+	if err != nil {
+		return err
+	}
+	if current == role {
+		return nil
+	}
+	if !actorRole.CanManage(current, role) {
+		return org.ErrForbidden
+	}
+	if current == org.RoleOwner {
+		if err := ensureAnotherOwner(ctx, uc.Repo, orgID); err != nil {
+			return err
+		}
+	}
+	if err := uc.Repo.SetMemberRole(ctx, orgID, memberID, role); err != nil {
+		return err
+	}
+	uc.Audit.Log(ctx, orgEvent(actorID, audit.ActionOrgMemberRole, orgID, map[string]any{
+		"user_id": memberID.String(),
+		"from":    string(current),
+		"to":      string(role),
+	}))
+	return nil
+}
+
+type RemoveMemberUseCase struct {
+	Repo  org.Repository
+	Audit audit.AuditLogger
+}
+
+func NewRemoveMemberUseCase(repo org.Repository, auditLog audit.AuditLogger) *RemoveMemberUseCase {
+	return &RemoveMemberUseCase{
+		Repo:  repo,
+		Audit: auditLog,
+	}
+}
+
+// Execute removes a member. Anyone may leave on their own, except the last
+// owner.
+func (uc *RemoveMemberUseCase) Execute(ctx context.Context, actorID, orgID, memberID uuid.UUID) error {
+	actorRole, err := uc.Repo.MemberRole(ctx, orgID, actorID)
+	if err != nil {
+		return err
+	}
+	current, err := memberRole(ctx, uc.Repo, orgID, memberID)
+	if err != nil {
+		return err
+	}
+	if actorID != memberID && !actorRole.CanManage(current, "") {
+		return org.ErrForbidden
+	}
+	if current == org.RoleOwner {
+		if err := ensureAnotherOwner(ctx, uc.Repo, orgID); err != nil {
+			return err
+		}
+	}
+	if err := uc.Repo.RemoveMember(ctx, orgID, memberID); err != nil {
+		return err
+	}
+	uc.Audit.Log(ctx, orgEvent(actorID, audit.ActionOrgMemberRemoved, orgID, map[string]any{
+		"user_id": memberID.String(),
+		"role":    string(current),
+	}))
+	return nil
+}
+
+func memberRole(ctx context.Context, repo org.Repository, orgID, userID uuid.UUID) (org.Role, error) {
+	role, err := repo.MemberRole(ctx, orgID, userID)
+	if errors.Is(err, org.ErrNotMember) {
+		return "", org.ErrMemberNotFound
+	}
+	return role, err
+}
+
+func ensureAnotherOwner(ctx context.Context, repo org.Repository, orgID uuid.UUID) error {
+	owners, err := repo.CountOwners(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if owners <= 1 {
+		return org.ErrLastOwner
+	}
+	return nil
+}
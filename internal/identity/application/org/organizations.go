@@ -0,0 +1,176 @@
+package orgapp
+
+import (
+	"context"
+	"fmt"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/org"
+	"go-ai/internal/identity/infrastructure/cache"
+
+	"github.com/google/uuid"
+)
+
+type CreateOrganizationRequest struct {
+	Name string `json:"name"`
+}
+
+type RenameOrganizationRequest struct {
+	Name string `json:"name"`
+}
+
+type CreateOrganizationUseCase struct {
+	Repo  org.Repository
+	Audit audit.AuditLogger
+}
+
+func NewCreateOrganizationUseCase(repo org.Repository, auditLog audit.AuditLogger) *CreateOrganizationUseCase {
+	return &CreateOrganizationUseCase{
+		Repo:  repo,
+		Audit: auditLog,
+	}
+}
+
+// Execute creates an organization with the caller as its owner.
+func (uc *CreateOrganizationUseCase) Execute(ctx context.Context, userID uuid.UUID, req CreateOrganizationRequest) (*OrganizationResponse, error) {
+	name, err := org.NormalizeName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	created, err := uc.Repo.Create(ctx, name, userID)
+	if err != nil {
+		return nil, err
+	}
+	uc.Audit.Log(ctx, orgEvent(userID, audit.ActionOrgCreated, created.ID, map[string]any{"name": name}))
+	return &OrganizationResponse{
+		ID:        created.ID,
+		Name:      created.Name,
+		Role:      string(org.RoleOwner),
+		JoinedAt:  created.CreatedAt,
+		CreatedAt: created.CreatedAt,
+	}, nil
+}
+
+type ListOrganizationsUseCase struct {
+	Repo  org.Repository
+	Cache *cache.AuthCache
+}
+
+func NewListOrganizationsUseCase(repo org.Repository, cache *cache.AuthCache) *ListOrganizationsUseCase {
+	return &ListOrganizationsUseCase{
+		Repo:  repo,
+		Cache: cache,
+	}
+}
+
+// Execute lists the caller's organizations and flags the one the session
+// works in.
+func (uc *ListOrganizationsUseCase) Execute(ctx context.Context, userID uuid.UUID, sid string) ([]OrganizationResponse, error) {
+	memberships, err := uc.Repo.ListForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	active := uuid.Nil
+	if session, err := uc.Cache.GetAuthCache(ctx, fmt.Sprintf("session_%s", sid)); err == nil && session != nil {
+		active = session.OrgID
+	}
+	if active == uuid.Nil && len(memberships) > 0 {
+		active = memberships[0].OrganizationID
+	}
+	resp := make([]OrganizationResponse, 0, len(memberships))
+	for _, m := range memberships {
+		resp = append(resp, OrganizationResponse{
+			ID:       m.OrganizationID,
+			Name:     m.OrganizationName,
+			Role:     string(m.Role),
+			Active:   m.OrganizationID == active,
+			JoinedAt: m.CreatedAt,
+		})
+	}
+	return resp, nil
+}
+
+type RenameOrganizationUseCase struct {
+	Repo  org.Repository
+	Audit audit.AuditLogger
+}
+
+func NewRenameOrganizationUseCase(repo org.Repository, auditLog audit.AuditLogger) *RenameOrganizationUseCase {
+	return &RenameOrganizationUseCase{
+		Repo:  repo,
+		Audit: auditLog,
+	}
+}
+
+// Execute renames an organization. Only owners may do it.
+func (uc *RenameOrganizationUseCase) Execute(ctx context.Context, userID, orgID uuid.UUID, req RenameOrganizationRequest) (*OrganizationResponse, error) {
+	name, err := org.NormalizeName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	role, err := uc.Repo.MemberRole(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if role != org.RoleOwner {
+		return nil, org.ErrForbidden
+	}
+	if err := uc.Repo.Rename(ctx, orgID, name); err != nil {
+		return nil, err
+	}
+	uc.Audit.Log(ctx, orgEvent(userID, audit.ActionOrgRenamed, orgID, map[string]any{"name": name}))
+	o, err := uc.Repo.Get(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return &OrganizationResponse{
+		ID:        o.ID,
+		Name:      o.Name,
+		Role:      string(role),
+		CreatedAt: o.CreatedAt,
+	}, nil
+}
+
+type SwitchOrganizationUseCase struct {
+	Repo  org.Repository
+	Cache *cache.AuthCache
+}
+
+func NewSwitchOrganizationUseCase(repo org.Repository, cache *cache.AuthCache) *SwitchOrganizationUseCase {
+	return &SwitchOrganizationUseCase{
+		Repo:  repo,
+		Cache: cache,
+	}
+}
+
+// Execute makes orgID the organization the session works in until it ends
+// or switches again. Other sessions of the user are not affected.
+func (uc *SwitchOrganizationUseCase) Execute(ctx context.Context, userID uuid.UUID, sid string, orgID uuid.UUID) (*OrganizationResponse, error) {
+	role, err := uc.Repo.MemberRole(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	o, err := uc.Repo.Get(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.Cache.SetActiveOrganization(ctx, sid, orgID); err != nil {
+		return nil, err
+	}
+	return &OrganizationResponse{
+		ID:        o.ID,
+		Name:      o.Name,
+		Role:      string(role),
+		Active:    true,
+		CreatedAt: o.CreatedAt,
+	}, nil
+}
+
+func orgEvent(actorID uuid.UUID, action string, orgID uuid.UUID, metadata map[string]any) audit.Event {
+	return audit.Event{
+		ActorID:    actorID,
+		Action:     action,
+		TargetType: audit.TargetOrg,
+		TargetID:   orgID.String(),
+		Metadata:   metadata,
+	}
+}
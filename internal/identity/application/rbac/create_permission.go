@@ -0,0 +1,35 @@
+package rbacapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/rbac"
+	"strings"
+)
+
+type CreatePermissionRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type CreatePermissionUseCase struct {
+	Repo rbac.Repository
+}
+
+func NewCreatePermissionUseCase(repo rbac.Repository) *CreatePermissionUseCase {
+	return &CreatePermissionUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *CreatePermissionUseCase) Execute(ctx context.Context, req CreatePermissionRequest) (*PermissionResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if !rbac.ValidPermissionName(name) {
+		return nil, rbac.ErrInvalidPermissionName
+	}
+	p, err := uc.Repo.CreatePermission(ctx, name, strings.TrimSpace(req.Description))
+	if err != nil {
+		return nil, err
+	}
+	resp := toPermissionResponse(*p)
+	return &resp, nil
+}
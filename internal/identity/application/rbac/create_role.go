@@ -0,0 +1,47 @@
+package rbacapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/rbac"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type CreateRoleRequest struct {
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+type CreateRoleUseCase struct {
+	Repo    rbac.Repository
+	Service rbac.Service
+}
+
+func NewCreateRoleUseCase(repo rbac.Repository, service rbac.Service) *CreateRoleUseCase {
+	return &CreateRoleUseCase{
+		Repo:    repo,
+		Service: service,
+	}
+}
+
+// Execute creates a role with grants the actor holds themselves.
+func (uc *CreateRoleUseCase) Execute(ctx context.Context, actorID uuid.UUID, req CreateRoleRequest) (*RoleResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if !rbac.ValidRoleName(name) {
+		return nil, rbac.ErrInvalidRoleName
+	}
+	perms, err := rbac.NormalizePermissions(req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.Service.CheckGrants(ctx, actorID, perms); err != nil {
+		return nil, err
+	}
+	role, err := uc.Repo.CreateRole(ctx, name, perms)
+	if err != nil {
+		return nil, err
+	}
+	resp := toRoleResponse(*role)
+	return &resp, nil
+}
@@ -0,0 +1,36 @@
+package rbacapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/rbac"
+)
+
+type DeleteRoleUseCase struct {
+	Repo rbac.Repository
+}
+
+func NewDeleteRoleUseCase(repo rbac.Repository) *DeleteRoleUseCase {
+	return &DeleteRoleUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute deletes a custom role. Roles that are still assigned are refused
+// because users.role_id would silently fall back to NULL.
+func (uc *DeleteRoleUseCase) Execute(ctx context.Context, id int) error {
+	role, err := uc.Repo.GetRoleByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if role.IsBuiltin() {
+		return rbac.ErrBuiltinRole
+	}
+	n, err := uc.Repo.CountUsersByRole(ctx, id)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return rbac.ErrRoleInUse
+	}
+	return uc.Repo.DeleteRole(ctx, id)
+}
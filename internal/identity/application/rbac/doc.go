@@ -0,0 +1,29 @@
+package rbacapp
+
+import (
+	"go-ai/pkg/response"
+)
+
+type RoleSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *RoleResponse `json:"data,omitempty"`
+}
+
+type ListRolesSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data []RoleResponse `json:"data,omitempty"`
+}
+
+type DeleteRoleSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+}
+
+type PermissionSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *PermissionResponse `json:"data,omitempty"`
+}
+
+type ListPermissionsSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data []PermissionResponse `json:"data,omitempty"`
+}
@@ -0,0 +1,46 @@
+package rbacapp
+
+import (
+	"go-ai/internal/identity/domain/rbac"
+	"time"
+)
+
+type RoleResponse struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Builtin     bool      `json:"builtin"`
+	Permissions []string  `json:"permissions"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type PermissionResponse struct {
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func toRoleResponse(r rbac.Role) RoleResponse {
+	perms := r.Permissions
+	if perms == nil {
+		perms = []string{}
+	}
+	return RoleResponse{
+		ID:          r.ID,
+		Name:        r.Name,
+		Builtin:     r.IsBuiltin(),
+		Permissions: perms,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
+
+func toPermissionResponse(p rbac.Permission) PermissionResponse {
+	return PermissionResponse{
+		ID:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		CreatedAt:   p.CreatedAt,
+	}
+}
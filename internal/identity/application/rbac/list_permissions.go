@@ -0,0 +1,28 @@
+package rbacapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/rbac"
+)
+
+type ListPermissionsUseCase struct {
+	Repo rbac.Repository
+}
+
+func NewListPermissionsUseCase(repo rbac.Repository) *ListPermissionsUseCase {
+	return &ListPermissionsUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *ListPermissionsUseCase) Execute(ctx context.Context) ([]PermissionResponse, error) {
+	perms, err := uc.Repo.ListPermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]PermissionResponse, 0, len(perms))
+	for _, p := range perms {
+		resp = append(resp, toPermissionResponse(p))
+	}
+	return resp, nil
+}
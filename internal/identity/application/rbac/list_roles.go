@@ -0,0 +1,28 @@
+package rbacapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/rbac"
+)
+
+type ListRolesUseCase struct {
+	Repo rbac.Repository
+}
+
+func NewListRolesUseCase(repo rbac.Repository) *ListRolesUseCase {
+	return &ListRolesUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *ListRolesUseCase) Execute(ctx context.Context) ([]RoleResponse, error) {
+	roles, err := uc.Repo.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]RoleResponse, 0, len(roles))
+	for _, r := range roles {
+		resp = append(resp, toRoleResponse(r))
+	}
+	return resp, nil
+}
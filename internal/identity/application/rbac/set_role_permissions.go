@@ -0,0 +1,59 @@
+package rbacapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/rbac"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+type SetRolePermissionsRequest struct {
+	Permissions []string `json:"permissions"`
+}
+
+type SetRolePermissionsUseCase struct {
+	Repo    rbac.Repository
+	Service rbac.Service
+}
+
+func NewSetRolePermissionsUseCase(repo rbac.Repository, service rbac.Service) *SetRolePermissionsUseCase {
+	return &SetRolePermissionsUseCase{
+		Repo:    repo,
+		Service: service,
+	}
+}
+
+// Execute replaces the grants of a role and invalidates cached permission
+// sets. The owner role always keeps "*" so the system cannot be locked out.
+// The actor must hold both the old and the new grants, so they can neither
+// raise a role above themselves nor strip one that outranks them.
+func (uc *SetRolePermissionsUseCase) Execute(ctx context.Context, actorID uuid.UUID, id int, req SetRolePermissionsRequest) (*RoleResponse, error) {
+	perms, err := rbac.NormalizePermissions(req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	role, err := uc.Repo.GetRoleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if role.Name == rbac.Owner {
+		return nil, rbac.ErrOwnerRoleLocked
+	}
+	if err := uc.Service.CheckGrants(ctx, actorID, slices.Concat(perms, role.Permissions)); err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.SetRolePermissions(ctx, id, perms); err != nil {
+		return nil, err
+	}
+	if err := uc.Service.InvalidateAll(ctx); err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.Repo.GetRoleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := toRoleResponse(*updated)
+	return &resp, nil
+}
@@ -0,0 +1,57 @@
+package userapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/rbac"
+	"go-ai/internal/identity/domain/user"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type ChangeUserRoleRequest struct {
+	Role string `json:"role"`
+}
+
+type ChangeUserRoleUseCase struct {
+	Repo  user.Repository
+	Rbac  rbac.Service
+	Audit audit.AuditLogger
+}
+
+func NewChangeUserRoleUseCase(repo user.Repository, rbacService rbac.Service, auditLog audit.AuditLogger) *ChangeUserRoleUseCase {
+	return &ChangeUserRoleUseCase{
+		Repo:  repo,
+		Rbac:  rbacService,
+		Audit: auditLog,
+	}
+}
+
+func (uc *ChangeUserRoleUseCase) Execute(ctx context.Context, actorID, id uuid.UUID, req ChangeUserRoleRequest) (*UserResponse, error) {
+	role := strings.TrimSpace(req.Role)
+	if role == "" {
+		return nil, user.ErrRoleRequired
+	}
+	target, err := uc.Repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := guardTarget(ctx, uc.Rbac, actorID, target, role); err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.SetRole(ctx, id, role); err != nil {
+		return nil, err
+	}
+	if err := uc.Rbac.InvalidateUser(ctx, id); err != nil {
+		return nil, err
+	}
+	uc.Audit.Log(ctx, adminEvent(actorID, audit.ActionUserRoleChanged, id, map[string]any{"from": target.Role, "to": role}))
+
+	updated, err := uc.Repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := toUserResponse(*updated)
+	return &resp, nil
+}
@@ -0,0 +1,71 @@
+package userapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/identity/domain/rbac"
+	"go-ai/internal/identity/domain/user"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestChangeUserRoleGuard(t *testing.T) {
+	cases := []struct {
+		name   string
+		actor  func(*fixture) uuid.UUID
+		target func(*fixture) uuid.UUID
+		role   string
+		err    error
+	}{
+		{"admin changes a user", func(f *fixture) uuid.UUID { return f.admin }, func(f *fixture) uuid.UUID { return f.member }, rbac.Manager, nil},
+		{"admin demotes themselves", func(f *fixture) uuid.UUID { return f.admin }, func(f *fixture) uuid.UUID { return f.admin }, rbac.Staff, user.ErrCannotModifySelf},
+		{"owner demotes themselves", func(f *fixture) uuid.UUID { return f.owner }, func(f *fixture) uuid.UUID { return f.owner }, rbac.Admin, user.ErrCannotModifySelf},
+		{"admin demotes an owner", func(f *fixture) uuid.UUID { return f.admin }, func(f *fixture) uuid.UUID { return f.owner }, rbac.Staff, user.ErrOwnerProtected},
+		{"admin promotes to owner", func(f *fixture) uuid.UUID { return f.admin }, func(f *fixture) uuid.UUID { return f.member }, rbac.Owner, user.ErrOwnerProtected},
+		{"owner promotes to owner", func(f *fixture) uuid.UUID { return f.owner }, func(f *fixture) uuid.UUID { return f.member }, rbac.Owner, nil},
+		{"admin assigns another role holding *", func(f *fixture) uuid.UUID { return f.admin }, func(f *fixture) uuid.UUID { return f.member }, "root", user.ErrOwnerProtected},
+		{"admin assigns a grant they lack", func(f *fixture) uuid.UUID { return f.admin }, func(f *fixture) uuid.UUID { return f.member }, "auditor", user.ErrRoleOutranksActor},
+		{"owner assigns a role holding *", func(f *fixture) uuid.UUID { return f.owner }, func(f *fixture) uuid.UUID { return f.member }, "root", nil},
+		{"blank role", func(f *fixture) uuid.UUID { return f.admin }, func(f *fixture) uuid.UUID { return f.member }, "  ", user.ErrRoleRequired},
+	}
+	for _, tc := range cases {
+		f := newFixture()
+		target := tc.target(f)
+		before := f.repo.users[target].Role
+		uc := NewChangeUserRoleUseCase(f.repo, f.rbac, f.audit)
+		resp, err := uc.Execute(context.Background(), tc.actor(f), target, ChangeUserRoleRequest{Role: tc.role})
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%s: err = %v, want %v", tc.name, err, tc.err)
+			continue
+		}
+		if tc.err != nil {
+			if f.repo.users[target].Role != before || len(f.audit.events) != 0 {
+				t.Errorf("%s: role = %q, audit = %+v after a rejected change", tc.name, f.repo.users[target].Role, f.audit.events)
+			}
+			continue
+		}
+		if resp.Role != tc.role {
+			t.Errorf("%s: role = %q, want %q", tc.name, resp.Role, tc.role)
+		}
+	}
+}
+
+// Owners can only be changed by another owner and never by themselves, so
+// the acting owner always remains.
+func TestChangeUserRoleKeepsAnOwner(t *testing.T) {
+	f := newFixture()
+	second := uuid.New()
+	f.repo.users[second] = &user.Entity{ID: second, Role: rbac.Owner, IsActive: true}
+	uc := NewChangeUserRoleUseCase(f.repo, f.rbac, f.audit)
+
+	if _, err := uc.Execute(context.Background(), f.owner, second, ChangeUserRoleRequest{Role: rbac.Admin}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := uc.Execute(context.Background(), f.owner, f.owner, ChangeUserRoleRequest{Role: rbac.Admin}); !errors.Is(err, user.ErrCannotModifySelf) {
+		t.Fatalf("err = %v, want ErrCannotModifySelf", err)
+	}
+	if f.repo.users[f.owner].Role != rbac.Owner {
+		t.Fatal("the last owner was demoted")
+	}
+}
@@ -0,0 +1,22 @@
+package userapp
+
+import (
+	"go-ai/pkg/response"
+)
+
+type UserSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *UserResponse `json:"data,omitempty"`
+}
+
+type ListUsersSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *struct {
+		response.PaginatedResponseDoc
+		Items []UserResponse `json:"items"`
+	} `json:"data,omitempty"`
+}
+
+type UnlockUserSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+}
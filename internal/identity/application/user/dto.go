@@ -0,0 +1,34 @@
+package userapp
+
+import (
+	"go-ai/internal/identity/domain/user"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UserResponse struct {
+	ID                    uuid.UUID `json:"id"`
+	Email                 string    `json:"email"`
+	FullName              string    `json:"full_name"`
+	Role                  string    `json:"role"`
+	ImageUrl              string    `json:"image_url"`
+	IsActive              bool      `json:"is_active"`
+	PasswordResetRequired bool      `json:"password_reset_required"`
+	CreatedAt             time.Time `json:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at"`
+}
+
+func toUserResponse(u user.Entity) UserResponse {
+	return UserResponse{
+		ID:                    u.ID,
+		Email:                 u.Email.String(),
+		FullName:              u.FullName,
+		Role:                  u.Role,
+		ImageUrl:              u.ImageUrl,
+		IsActive:              u.IsActive,
+		PasswordResetRequired: u.PasswordResetRequired,
+		CreatedAt:             u.CreatedAt,
+		UpdatedAt:             u.UpdatedAt,
+	}
+}
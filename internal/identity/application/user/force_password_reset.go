@@ -0,0 +1,53 @@
+package userapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/identity/domain/rbac"
+	"go-ai/internal/identity/domain/user"
+
+	"github.com/google/uuid"
+)
+
+type ForcePasswordResetUseCase struct {
+	Repo     user.Repository
+	Sessions auth.SessionStore
+	Rbac     rbac.Service
+	Audit    audit.AuditLogger
+}
+
+func NewForcePasswordResetUseCase(repo user.Repository, sessions auth.SessionStore, rbacService rbac.Service, auditLog audit.AuditLogger) *ForcePasswordResetUseCase {
+	return &ForcePasswordResetUseCase{
+		Repo:     repo,
+		Sessions: sessions,
+		Rbac:     rbacService,
+		Audit:    auditLog,
+	}
+}
+
+// Execute flags the account so that login is refused until the password is
+// reset, and signs the user out of every session.
+func (uc *ForcePasswordResetUseCase) Execute(ctx context.Context, actorID, id uuid.UUID) (*UserResponse, error) {
+	target, err := uc.Repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := guardTarget(ctx, uc.Rbac, actorID, target, ""); err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.SetPasswordResetRequired(ctx, id, true); err != nil {
+		return nil, err
+	}
+	if _, err := uc.Sessions.RevokeUserSessions(ctx, id); err != nil {
+		return nil, err
+	}
+	uc.Audit.Log(ctx, adminEvent(actorID, audit.ActionUserResetForced, id, nil))
+
+	updated, err := uc.Repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := toUserResponse(*updated)
+	return &resp, nil
+}
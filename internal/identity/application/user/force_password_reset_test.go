@@ -0,0 +1,41 @@
+package userapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/user"
+	"testing"
+)
+
+func TestForcePasswordResetRevokesSessions(t *testing.T) {
+	f := newFixture()
+	uc := NewForcePasswordResetUseCase(f.repo, f.sessions, f.rbac, f.audit)
+	resp, err := uc.Execute(context.Background(), f.admin, f.member)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.PasswordResetRequired {
+		t.Fatal("password_reset_required not set")
+	}
+	if len(f.sessions.revoked) != 1 || f.sessions.revoked[0] != f.member {
+		t.Fatalf("revoked = %v", f.sessions.revoked)
+	}
+	if len(f.audit.events) != 1 || f.audit.events[0].Action != audit.ActionUserResetForced {
+		t.Fatalf("audit = %+v", f.audit.events)
+	}
+}
+
+func TestForcePasswordResetGuard(t *testing.T) {
+	f := newFixture()
+	uc := NewForcePasswordResetUseCase(f.repo, f.sessions, f.rbac, f.audit)
+	if _, err := uc.Execute(context.Background(), f.admin, f.admin); !errors.Is(err, user.ErrCannotModifySelf) {
+		t.Errorf("own account: err = %v", err)
+	}
+	if _, err := uc.Execute(context.Background(), f.admin, f.owner); !errors.Is(err, user.ErrOwnerProtected) {
+		t.Errorf("owner: err = %v", err)
+	}
+	if len(f.sessions.revoked) != 0 || f.repo.users[f.owner].PasswordResetRequired {
+		t.Fatalf("rejected reset changed the account: revoked = %v", f.sessions.revoked)
+	}
+}
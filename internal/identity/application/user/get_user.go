@@ -0,0 +1,27 @@
+package userapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/user"
+
+	"github.com/google/uuid"
+)
+
+type GetUserUseCase struct {
+	Repo user.Repository
+}
+
+func NewGetUserUseCase(repo user.Repository) *GetUserUseCase {
+	return &GetUserUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *GetUserUseCase) Execute(ctx context.Context, id uuid.UUID) (*UserResponse, error) {
+	u, err := uc.Repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := toUserResponse(*u)
+	return &resp, nil
+}
@@ -0,0 +1,51 @@
+package userapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/rbac"
+	"go-ai/internal/identity/domain/user"
+
+	"github.com/google/uuid"
+)
+
+// guardTarget rejects changes an administrator must not make: changing their
+// own account, and touching an account whose current or new role holds a
+// grant they lack. Roles are judged by their grants rather than their name,
+// so any role holding "*" is protected like owner.
+func guardTarget(ctx context.Context, svc rbac.Service, actorID uuid.UUID, target *user.Entity, newRole string) error {
+	if actorID == target.ID {
+		return user.ErrCannotModifySelf
+	}
+	actor, err := svc.Resolve(ctx, actorID)
+	if err != nil {
+		return err
+	}
+	for _, role := range []string{target.Role, newRole} {
+		if role == "" {
+			continue
+		}
+		grants, err := svc.RolePermissions(ctx, role)
+		if err != nil {
+			return err
+		}
+		if actor.Permissions.Covers(grants) {
+			continue
+		}
+		if grants.Allows(rbac.Wildcard) {
+			return user.ErrOwnerProtected
+		}
+		return user.ErrRoleOutranksActor
+	}
+	return nil
+}
+
+func adminEvent(actorID uuid.UUID, action string, targetID uuid.UUID, metadata map[string]any) audit.Event {
+	return audit.Event{
+		ActorID:    actorID,
+		Action:     action,
+		TargetType: audit.TargetUser,
+		TargetID:   targetID.String(),
+		Metadata:   metadata,
+	}
+}
@@ -0,0 +1,50 @@
+package userapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/user"
+	"go-ai/pkg/response"
+)
+
+type ListUsersRequest struct {
+	Search   string `query:"search"`
+	Role     string `query:"role"`
+	IsActive *bool  `query:"is_active"`
+	Page     *int32 `query:"page"`
+	Limit    *int32 `query:"limit"`
+}
+
+type ListUsersUseCase struct {
+	Repo user.Repository
+}
+
+func NewListUsersUseCase(repo user.Repository) *ListUsersUseCase {
+	return &ListUsersUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *ListUsersUseCase) Execute(ctx context.Context, req ListUsersRequest) (*response.PaginatedResponse[[]UserResponse], error) {
+	filter := user.ListFilter{
+		Search:   req.Search,
+		Role:     req.Role,
+		IsActive: req.IsActive,
+	}
+	page, limit, offset := response.ApplyDefaultPaginated(req.Page, req.Limit)
+	users, total, err := uc.Repo.List(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]UserResponse, 0, len(users))
+	for _, u := range users {
+		items = append(items, toUserResponse(u))
+	}
+	return &response.PaginatedResponse[[]UserResponse]{
+		Page:       page,
+		Limit:      limit,
+		TotalItems: total,
+		TotalPages: response.CalculateTotalPages(total, int64(limit)),
+		Items:      items,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package userapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/rbac"
+	"testing"
+)
+
+func TestListUsersMapsFilterAndPage(t *testing.T) {
+	active := true
+	cases := []struct {
+		name                            string
+		page, limit                     *int32
+		wantPage, wantLimit, wantOffset int32
+		wantPages                       int64
+	}{
+		{"defaults", nil, nil, 1, 10, 0, 3},
+		{"third page of five", ptr(3), ptr(5), 3, 5, 10, 5},
+		{"limit over 100 falls back", ptr(2), ptr(500), 2, 10, 10, 3},
+		{"non-positive values fall back", ptr(0), ptr(-1), 1, 10, 0, 3},
+	}
+	for _, tc := range cases {
+		f := newFixture()
+		uc := NewListUsersUseCase(f.repo)
+		req := ListUsersRequest{Search: "ann", Role: rbac.Staff, IsActive: &active, Page: tc.page, Limit: tc.limit}
+		resp, err := uc.Execute(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.repo.filter.Search != "ann" || f.repo.filter.Role != rbac.Staff || f.repo.filter.IsActive != &active {
+			t.Errorf("%s: filter = %+v", tc.name, f.repo.filter)
+		}
+		if f.repo.limit != tc.wantLimit || f.repo.offset != tc.wantOffset {
+			t.Errorf("%s: limit, offset = %d, %d, want %d, %d", tc.name, f.repo.limit, f.repo.offset, tc.wantLimit, tc.wantOffset)
+		}
+		if resp.Page != tc.wantPage || resp.Limit != tc.wantLimit || resp.TotalItems != 25 || resp.TotalPages != tc.wantPages {
+			t.Errorf("%s: page = %+v", tc.name, resp)
+		}
+		if len(resp.Items) != len(f.repo.users) {
+			t.Errorf("%s: items = %d, want %d", tc.name, len(resp.Items), len(f.repo.users))
+		}
+	}
+}
+
+func ptr(v int32) *int32 { return &v }
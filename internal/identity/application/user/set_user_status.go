@@ -0,0 +1,65 @@
+package userapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/identity/domain/rbac"
+	"go-ai/internal/identity/domain/user"
+
+	"github.com/google/uuid"
+)
+
+type SetUserStatusRequest struct {
+	IsActive *bool `json:"is_active"`
+}
+
+type SetUserStatusUseCase struct {
+	Repo     user.Repository
+	Sessions auth.SessionStore
+	Rbac     rbac.Service
+	Audit    audit.AuditLogger
+}
+
+func NewSetUserStatusUseCase(repo user.Repository, sessions auth.SessionStore, rbacService rbac.Service, auditLog audit.AuditLogger) *SetUserStatusUseCase {
+	return &SetUserStatusUseCase{
+		Repo:     repo,
+		Sessions: sessions,
+		Rbac:     rbacService,
+		Audit:    auditLog,
+	}
+}
+
+// Execute activates or deactivates an account. Deactivation revokes every
+// session of the user, so their access tokens stop working immediately.
+func (uc *SetUserStatusUseCase) Execute(ctx context.Context, actorID, id uuid.UUID, req SetUserStatusRequest) (*UserResponse, error) {
+	if req.IsActive == nil {
+		return nil, user.ErrActiveRequired
+	}
+	target, err := uc.Repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := guardTarget(ctx, uc.Rbac, actorID, target, ""); err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.SetActive(ctx, id, *req.IsActive); err != nil {
+		return nil, err
+	}
+	if !*req.IsActive {
+		if _, err := uc.Sessions.RevokeUserSessions(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+	if err := uc.Rbac.InvalidateUser(ctx, id); err != nil {
+		return nil, err
+	}
+	uc.Audit.Log(ctx, adminEvent(actorID, audit.ActionUserStatusChanged, id, map[string]any{"from": target.IsActive, "to": *req.IsActive}))
+
+	updated, err := uc.Repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := toUserResponse(*updated)
+	return &resp, nil
+}
@@ -0,0 +1,163 @@
+package userapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/identity/domain/rbac"
+	"go-ai/internal/identity/domain/user"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+type userRepo struct {
+	users map[uuid.UUID]*user.Entity
+
+	filter        user.ListFilter
+	limit, offset int32
+}
+
+func (r *userRepo) List(_ context.Context, filter user.ListFilter, limit, offset int32) ([]user.Entity, int64, error) {
+	r.filter, r.limit, r.offset = filter, limit, offset
+	var out []user.Entity
+	for _, u := range r.users {
+		out = append(out, *u)
+	}
+	return out, 25, nil
+}
+
+func (r *userRepo) GetByID(_ context.Context, id uuid.UUID) (*user.Entity, error) {
+	u, ok := r.users[id]
+	if !ok {
+		return nil, user.ErrUserNotFound
+	}
+	cp := *u
+	return &cp, nil
+}
+
+func (r *userRepo) SetRole(_ context.Context, id uuid.UUID, role string) error {
+	r.users[id].Role = role
+	return nil
+}
+
+func (r *userRepo) SetActive(_ context.Context, id uuid.UUID, active bool) error {
+	r.users[id].IsActive = active
+	return nil
+}
+
+func (r *userRepo) SetPasswordResetRequired(_ context.Context, id uuid.UUID, required bool) error {
+	r.users[id].PasswordResetRequired = required
+	return nil
+}
+
+// roleRepo serves the grants of each role, and users hold those of the role
+// userRepo gives them. Service has no cache in tests.
+type roleRepo struct {
+	users *userRepo
+	roles map[string]rbac.PermissionSet
+}
+
+func (r roleRepo) GetUserRole(_ context.Context, userID uuid.UUID) (rbac.UserRole, error) {
+	u, ok := r.users.users[userID]
+	if !ok {
+		return rbac.UserRole{UserID: userID}, nil
+	}
+	return rbac.UserRole{UserID: userID, Role: u.Role, Permissions: r.roles[u.Role]}, nil
+}
+
+func (r roleRepo) GetRolePermissions(_ context.Context, role string) (rbac.PermissionSet, error) {
+	return r.roles[role], nil
+}
+
+type sessionStore struct {
+	auth.SessionStore
+	revoked []uuid.UUID
+}
+
+func (s *sessionStore) RevokeUserSessions(_ context.Context, userID uuid.UUID) (int, error) {
+	s.revoked = append(s.revoked, userID)
+	return 2, nil
+}
+
+type auditLog struct{ events []audit.Event }
+
+func (l *auditLog) Log(_ context.Context, e audit.Event) { l.events = append(l.events, e) }
+
+// fixture has an admin, an owner holding "*", and a plain user. The custom
+// roles root and auditor hold "*" and a grant admins lack.
+type fixture struct {
+	repo                 *userRepo
+	rbac                 rbac.Service
+	sessions             *sessionStore
+	audit                *auditLog
+	admin, owner, member uuid.UUID
+}
+
+func newFixture() *fixture {
+	f := &fixture{admin: uuid.New(), owner: uuid.New(), member: uuid.New(), sessions: &sessionStore{}, audit: &auditLog{}}
+	f.repo = &userRepo{users: map[uuid.UUID]*user.Entity{
+		f.admin:  {ID: f.admin, Role: rbac.Admin, IsActive: true},
+		f.owner:  {ID: f.owner, Role: rbac.Owner, IsActive: true},
+		f.member: {ID: f.member, Role: rbac.Staff, IsActive: true},
+	}}
+	f.rbac = rbac.Service{Repo: roleRepo{users: f.repo, roles: map[string]rbac.PermissionSet{
+		rbac.Owner:   {rbac.Wildcard},
+		rbac.Admin:   {"models:*", "users:*", rbac.RolesManage},
+		rbac.Manager: {"models:*"},
+		rbac.Staff:   {"models:read"},
+		"root":       {rbac.Wildcard},
+		"auditor":    {"audit:read"},
+	}}}
+	return f
+}
+
+func TestSetUserStatus(t *testing.T) {
+	off, on := false, true
+	cases := []struct {
+		name        string
+		actor       func(*fixture) uuid.UUID
+		target      func(*fixture) uuid.UUID
+		active      *bool
+		err         error
+		wantRevoked bool
+	}{
+		{"deactivation revokes sessions", func(f *fixture) uuid.UUID { return f.admin }, func(f *fixture) uuid.UUID { return f.member }, &off, nil, true},
+		{"activation keeps sessions", func(f *fixture) uuid.UUID { return f.admin }, func(f *fixture) uuid.UUID { return f.member }, &on, nil, false},
+		{"missing is_active", func(f *fixture) uuid.UUID { return f.admin }, func(f *fixture) uuid.UUID { return f.member }, nil, user.ErrActiveRequired, false},
+		{"own account", func(f *fixture) uuid.UUID { return f.admin }, func(f *fixture) uuid.UUID { return f.admin }, &off, user.ErrCannotModifySelf, false},
+		{"owner by an admin", func(f *fixture) uuid.UUID { return f.admin }, func(f *fixture) uuid.UUID { return f.owner }, &off, user.ErrOwnerProtected, false},
+		{"custom role holding * by an admin", func(f *fixture) uuid.UUID { return f.admin }, func(f *fixture) uuid.UUID {
+			id := uuid.New()
+			f.repo.users[id] = &user.Entity{ID: id, Role: "root", IsActive: true}
+			return id
+		}, &off, user.ErrOwnerProtected, false},
+		{"unknown user", func(f *fixture) uuid.UUID { return f.admin }, func(*fixture) uuid.UUID { return uuid.New() }, &off, user.ErrUserNotFound, false},
+	}
+	for _, tc := range cases {
+		f := newFixture()
+		target := tc.target(f)
+		uc := NewSetUserStatusUseCase(f.repo, f.sessions, f.rbac, f.audit)
+		resp, err := uc.Execute(context.Background(), tc.actor(f), target, SetUserStatusRequest{IsActive: tc.active})
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%s: err = %v, want %v", tc.name, err, tc.err)
+			continue
+		}
+		if revoked := len(f.sessions.revoked) == 1 && f.sessions.revoked[0] == target; revoked != tc.wantRevoked {
+			t.Errorf("%s: revoked = %v, want revoked %v", tc.name, f.sessions.revoked, tc.wantRevoked)
+		}
+		if tc.err != nil {
+			if len(f.audit.events) != 0 {
+				t.Errorf("%s: audit = %+v", tc.name, f.audit.events)
+			}
+			continue
+		}
+		if resp.IsActive != *tc.active {
+			t.Errorf("%s: is_active = %v, want %v", tc.name, resp.IsActive, *tc.active)
+		}
+		if len(f.audit.events) != 1 || f.audit.events[0].Action != audit.ActionUserStatusChanged {
+			t.Errorf("%s: audit = %+v", tc.name, f.audit.events)
+		}
+	}
+}
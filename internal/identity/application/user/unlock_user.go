@@ -0,0 +1,53 @@
+package userapp
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/audit"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/identity/domain/rbac"
+	"go-ai/internal/identity/domain/user"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+type UnlockUserUseCase struct {
+	Repo     user.Repository
+	Attempts auth.LoginAttemptStore
+	Rbac     rbac.Service
+	Logger   zerolog.Logger
+	Audit    audit.AuditLogger
+}
+
+func NewUnlockUserUseCase(repo user.Repository, attempts auth.LoginAttemptStore, rbacService rbac.Service, logger zerolog.Logger, auditLog audit.AuditLogger) *UnlockUserUseCase {
+	return &UnlockUserUseCase{
+		Repo:     repo,
+		Attempts: attempts,
+		Rbac:     rbacService,
+		Logger:   logger,
+		Audit:    auditLog,
+	}
+}
+
+// Execute lifts a login lockout early and forgets the account's failed
+// attempts. IP lockouts are left to expire.
+func (uc *UnlockUserUseCase) Execute(ctx context.Context, actorID, id uuid.UUID) error {
+	target, err := uc.Repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := guardTarget(ctx, uc.Rbac, actorID, target, ""); err != nil {
+		return err
+	}
+	if err := uc.Attempts.ClearAccount(ctx, target.Email.String()); err != nil {
+		return err
+	}
+	uc.Logger.Warn().
+		Str("event", "account_unlocked").
+		Str("email", target.Email.String()).
+		Str("user_id", id.String()).
+		Str("actor_id", actorID.String()).
+		Msg("security event")
+	uc.Audit.Log(ctx, adminEvent(actorID, audit.ActionUserUnlocked, id, nil))
+	return nil
+}
@@ -0,0 +1,149 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// KeyPrefix starts every API key so leaked keys are easy to spot in logs and
+// secret scanners. A key reads gma_<prefix>_<secret>.
+const KeyPrefix = "gma_"
+
+// MaxKeysPerUser caps the live keys of one user.
+const MaxKeysPerUser = 25
+
+type Entity struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Name       string
+	Prefix     string
+	SecretHash string
+	Scopes     []string
+	AllowedIPs []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	LastUsedIP string
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+func (k Entity) Expired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+// AllowsIP reports whether ip may use the key. An empty allowlist allows
+// every address; entries are single addresses or CIDR ranges.
+func (k Entity) AllowsIP(ip string) bool {
+	if len(k.AllowedIPs) == 0 {
+		return true
+	}
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, entry := range k.AllowedIPs {
+		prefix, err := parseAllowEntry(entry)
+		if err == nil && prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Credential is a key looked up for authentication together with the state
+// of its owner.
+type Credential struct {
+	Key         Entity
+	OwnerRole   string
+	OwnerActive bool
+}
+
+// Principal is the identity an authenticated key acts as.
+type Principal struct {
+	KeyID  uuid.UUID
+	UserID uuid.UUID
+	Role   string
+	Scopes []string
+}
+
+// Generate returns a new raw key with its lookup prefix and secret hash.
+func Generate() (raw, prefix, secretHash string, err error) {
+	p := make([]byte, 6)
+	s := make([]byte, 32)
+	if _, err := rand.Read(p); err != nil {
+		return "", "", "", err
+	}
+	if _, err := rand.Read(s); err != nil {
+		return "", "", "", err
+	}
+	prefix = hex.EncodeToString(p)
+	secret := hex.EncodeToString(s)
+	return KeyPrefix + prefix + "_" + secret, prefix, HashSecret(secret), nil
+}
+
+// Parse splits a raw key into its prefix and secret.
+func Parse(raw string) (prefix, secret string, ok bool) {
+	rest, found := strings.CutPrefix(strings.TrimSpace(raw), KeyPrefix)
+	if !found {
+		return "", "", false
+	}
+	prefix, secret, ok = strings.Cut(rest, "_")
+	if !ok || prefix == "" || secret == "" {
+		return "", "", false
+	}
+	return prefix, secret, true
+}
+
+func HashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func VerifySecret(secretHash, secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(secretHash), []byte(HashSecret(secret))) == 1
+}
+
+// NormalizeAllowedIPs validates an allowlist and rewrites every entry in
+// canonical CIDR form.
+func NormalizeAllowedIPs(entries []string) ([]string, error) {
+	out := make([]string, 0, len(entries))
+	seen := make(map[string]struct{}, len(entries))
+	for _, e := range entries {
+		prefix, err := parseAllowEntry(e)
+		if err != nil {
+			return nil, ErrInvalidAllowedIP
+		}
+		s := prefix.String()
+		if _, ok := seen[s]; ok {
+			continue
+		}
+		seen[s] = struct{}{}
+		out = append(out, s)
+	}
+	return out, nil
+}
+
+func parseAllowEntry(entry string) (netip.Prefix, error) {
+	entry = strings.TrimSpace(entry)
+	if strings.Contains(entry, "/") {
+		p, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return p.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(entry)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
@@ -0,0 +1,82 @@
+package apikey
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGenerateAndParse(t *testing.T) {
+	raw, prefix, hash, err := Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(raw, KeyPrefix+prefix+"_") {
+		t.Fatalf("unexpected key layout %q", raw)
+	}
+	p, secret, ok := Parse(raw)
+	if !ok || p != prefix {
+		t.Fatalf("parse failed: %q %v", p, ok)
+	}
+	if !VerifySecret(hash, secret) {
+		t.Fatal("secret does not match its hash")
+	}
+	if VerifySecret(hash, secret+"x") {
+		t.Fatal("tampered secret accepted")
+	}
+	for _, bad := range []string{"", "gma_", "gma_abc", "xyz_abc_def", "gma__secret"} {
+		if _, _, ok := Parse(bad); ok {
+			t.Fatalf("expected %q to be rejected", bad)
+		}
+	}
+}
+
+func TestAllowsIP(t *testing.T) {
+	k := Entity{AllowedIPs: []string{"10.0.0.0/8", "203.0.113.7/32", "2001:db8::/32"}}
+	cases := map[string]bool{
+		"10.1.2.3":        true,
+		"203.0.113.7":     true,
+		"203.0.113.8":     false,
+		"::ffff:10.9.9.9": true,
+		"2001:db8::1":     true,
+		"2001:db9::1":     false,
+		"not-an-ip":       false,
+	}
+	for ip, want := range cases {
+		if got := k.AllowsIP(ip); got != want {
+			t.Fatalf("AllowsIP(%q) = %v, want %v", ip, got, want)
+		}
+	}
+	if !(Entity{}).AllowsIP("198.51.100.1") {
+		t.Fatal("empty allowlist must allow every address")
+	}
+}
+
+func TestNormalizeAllowedIPs(t *testing.T) {
+	got, err := NormalizeAllowedIPs([]string{" 10.1.2.3 ", "10.1.2.3/32", "192.168.1.77/24"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"10.1.2.3/32", "192.168.1.0/24"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if _, err := NormalizeAllowedIPs([]string{"10.0.0.0/33"}); err != ErrInvalidAllowedIP {
+		t.Fatalf("expected ErrInvalidAllowedIP, got %v", err)
+	}
+}
+
+func TestExpired(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Minute)
+	if (Entity{}).Expired(now) {
+		t.Fatal("key without expiry must not expire")
+	}
+	if !(Entity{ExpiresAt: &past}).Expired(now) {
+		t.Fatal("expected past expiry to be expired")
+	}
+	if (Entity{ExpiresAt: &future}).Expired(now) {
+		t.Fatal("expected future expiry to be valid")
+	}
+}
@@ -0,0 +1,21 @@
+package apikey
+
+import (
+	domainerr "go-ai/pkg/domain_err"
+	"net/http"
+)
+
+var (
+	ErrAPIKeyNotFound   = domainerr.New(http.StatusNotFound, "API key not found")
+	ErrNameRequired     = domainerr.New(http.StatusBadRequest, "API key name is required")
+	ErrScopesRequired   = domainerr.New(http.StatusBadRequest, "At least one scope is required")
+	ErrInvalidScope     = domainerr.New(http.StatusBadRequest, "Invalid scope")
+	ErrScopeNotGranted  = domainerr.New(http.StatusForbidden, "Scope exceeds your permissions")
+	ErrInvalidAllowedIP = domainerr.New(http.StatusBadRequest, "Invalid IP address or CIDR range")
+	ErrExpiryInPast     = domainerr.New(http.StatusBadRequest, "Expiry must be in the future")
+	ErrTooManyKeys      = domainerr.New(http.StatusConflict, "API key limit reached")
+	ErrInvalidAPIKey    = domainerr.New(http.StatusUnauthorized, "Invalid API key")
+	ErrAPIKeyExpired    = domainerr.New(http.StatusUnauthorized, "API key has expired")
+	ErrIPNotAllowed     = domainerr.New(http.StatusForbidden, "API key is not allowed from this IP address")
+	ErrOwnerInactive    = domainerr.New(http.StatusForbidden, "User is inactive")
+)
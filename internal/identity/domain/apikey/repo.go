@@ -0,0 +1,18 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, k *Entity) (*Entity, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]Entity, error)
+	CountActive(ctx context.Context, userID uuid.UUID) (int64, error)
+	// FindByPrefix returns nil when no key has the prefix.
+	FindByPrefix(ctx context.Context, prefix string) (*Credential, error)
+	Revoke(ctx context.Context, userID, id uuid.UUID) error
+	RevokeAll(ctx context.Context, userID uuid.UUID) (int64, error)
+	Touch(ctx context.Context, id uuid.UUID, ip string) error
+}
@@ -0,0 +1,43 @@
+package apikey
+
+import (
+	"context"
+	"time"
+)
+
+type Service struct {
+	Repo Repository
+}
+
+// Authenticate resolves a raw X-API-Key value used from ip. Revoked keys are
+// reported like unknown ones.
+func (s Service) Authenticate(ctx context.Context, raw, ip string) (*Principal, error) {
+	prefix, secret, ok := Parse(raw)
+	if !ok {
+		return nil, ErrInvalidAPIKey
+	}
+	cred, err := s.Repo.FindByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	if cred == nil || cred.Key.RevokedAt != nil || !VerifySecret(cred.Key.SecretHash, secret) {
+		return nil, ErrInvalidAPIKey
+	}
+	if cred.Key.Expired(time.Now()) {
+		return nil, ErrAPIKeyExpired
+	}
+	if !cred.OwnerActive {
+		return nil, ErrOwnerInactive
+	}
+	if !cred.Key.AllowsIP(ip) {
+		return nil, ErrIPNotAllowed
+	}
+	// Usage tracking must not fail the request.
+	_ = s.Repo.Touch(ctx, cred.Key.ID, ip)
+	return &Principal{
+		KeyID:  cred.Key.ID,
+		UserID: cred.Key.UserID,
+		Role:   cred.OwnerRole,
+		Scopes: cred.Key.Scopes,
+	}, nil
+}
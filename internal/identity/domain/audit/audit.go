@@ -0,0 +1,99 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Actions recorded in the audit log.
+const (
+	ActionLogin             = "auth.login"
+	ActionLoginFailed       = "auth.login_failed"
+	ActionLogout            = "auth.logout"
+	ActionLogoutAll         = "auth.logout_all"
+	ActionSessionRevoked    = "auth.session_revoked"
+	ActionPasswordChanged   = "auth.password_changed"
+	ActionPasswordReset     = "auth.password_reset"
+	ActionProfileUpdated    = "auth.profile_updated"
+	ActionEmailChanged      = "auth.email_changed"
+	ActionEmailVerified     = "auth.email_verified"
+	ActionMFAEnabled        = "auth.mfa_enabled"
+	ActionMFADisabled       = "auth.mfa_disabled"
+	ActionMFAFailed         = "auth.mfa_failed"
+	ActionAPIKeyCreated     = "auth.api_key_created"
+	ActionAPIKeyRevoked     = "auth.api_key_revoked"
+	ActionIdentityLinked    = "auth.identity_linked"
+	ActionIdentityUnlinked  = "auth.identity_unlinked"
+	ActionDeletionRequested = "auth.deletion_requested"
+	ActionDeletionCancelled = "auth.deletion_cancelled"
+	ActionAccountDeleted    = "auth.account_deleted"
+	ActionDataExported      = "auth.data_exported"
+	ActionPhoneChanged      = "auth.phone_changed"
+	ActionPhoneVerified     = "auth.phone_verified"
+	ActionUserRoleChanged   = "user.role_changed"
+	ActionUserStatusChanged = "user.status_changed"
+	ActionUserResetForced   = "user.password_reset_forced"
+	ActionUserUnlocked      = "user.unlocked"
+	ActionPermissionDenied  = "rbac.permission_denied"
+	ActionOrgCreated        = "org.created"
+	ActionOrgRenamed        = "org.renamed"
+	ActionOrgMemberInvited  = "org.member_invited"
+	ActionOrgInviteRevoked  = "org.invitation_revoked"
+	ActionOrgMemberJoined   = "org.member_joined"
+	ActionOrgMemberRole     = "org.member_role_changed"
+	ActionOrgMemberRemoved  = "org.member_removed"
+)
+
+// Target types.
+const (
+	TargetUser       = "user"
+	TargetSession    = "session"
+	TargetAPIKey     = "api_key"
+	TargetIdentity   = "identity"
+	TargetPermission = "permission"
+	TargetOrg        = "organization"
+)
+
+// PersonalMetadata lists the metadata keys that hold contact details. They
+// are stripped from a user's events when the account is deleted.
+var PersonalMetadata = []string{"email", "phone", "old_email", "new_email"}
+
+// Event is one audit record. ActorID is uuid.Nil when nobody is signed in,
+// such as a failed login. Request fields are filled from the context when
+// left empty.
+type Event struct {
+	ID         uuid.UUID
+	ActorID    uuid.UUID
+	Action     string
+	TargetType string
+	TargetID   string
+	IP         string
+	UserAgent  string
+	RequestID  string
+	Metadata   map[string]any
+	CreatedAt  time.Time
+}
+
+// AuditLogger records events. It never fails the caller: an event that
+// cannot be stored is reported in the application log instead.
+type AuditLogger interface {
+	Log(ctx context.Context, e Event)
+}
+
+type Filter struct {
+	ActorID    *uuid.UUID
+	Action     string
+	TargetType string
+	TargetID   string
+	IP         string
+	Since      *time.Time
+	Until      *time.Time
+}
+
+// Repository is append-only; the table rejects updates and deletes.
+type Repository interface {
+	Append(ctx context.Context, e Event) error
+	List(ctx context.Context, filter Filter, limit, offset int32) ([]Event, int64, error)
+}
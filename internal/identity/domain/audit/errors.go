@@ -0,0 +1,11 @@
+package audit
+
+import (
+	domainerr "go-ai/pkg/domain_err"
+	"net/http"
+)
+
+var (
+	ErrInvalidActorID   = domainerr.New(http.StatusBadRequest, "Invalid actor_id")
+	ErrInvalidTimeRange = domainerr.New(http.StatusBadRequest, "since and until must be RFC 3339 timestamps")
+)
@@ -0,0 +1,44 @@
+package audit
+
+import (
+	"context"
+	"go-ai/pkg/requestmeta"
+
+	"github.com/rs/zerolog"
+)
+
+// Recorder is the AuditLogger backed by the audit_events table.
+type Recorder struct {
+	Repo   Repository
+	Logger zerolog.Logger
+}
+
+func NewRecorder(repo Repository, logger zerolog.Logger) *Recorder {
+	return &Recorder{
+		Repo:   repo,
+		Logger: logger,
+	}
+}
+
+func (r *Recorder) Log(ctx context.Context, e Event) {
+	meta := requestmeta.From(ctx)
+	if e.IP == "" {
+		e.IP = meta.IP
+	}
+	if e.UserAgent == "" {
+		e.UserAgent = meta.UserAgent
+	}
+	if e.RequestID == "" {
+		e.RequestID = meta.RequestID
+	}
+	// The event is kept even when the request is cancelled mid-way.
+	if err := r.Repo.Append(context.WithoutCancel(ctx), e); err != nil {
+		r.Logger.Error().Err(err).
+			Str("event", e.Action).
+			Str("actor_id", e.ActorID.String()).
+			Str("target_type", e.TargetType).
+			Str("target_id", e.TargetID).
+			Str("request_id", e.RequestID).
+			Msg("failed to write audit event")
+	}
+}
@@ -0,0 +1,64 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"go-ai/pkg/requestmeta"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+type memRepo struct {
+	events []Event
+	err    error
+}
+
+func (r *memRepo) Append(ctx context.Context, e Event) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.events = append(r.events, e)
+	return nil
+}
+
+func (r *memRepo) List(ctx context.Context, filter Filter, limit, offset int32) ([]Event, int64, error) {
+	return r.events, int64(len(r.events)), nil
+}
+
+func TestRecorderFillsRequestMeta(t *testing.T) {
+	repo := &memRepo{}
+	rec := NewRecorder(repo, zerolog.Nop())
+	ctx := requestmeta.With(context.Background(), requestmeta.Meta{RequestID: "req-1", IP: "203.0.113.7", UserAgent: "curl/8"})
+
+	rec.Log(ctx, Event{Action: ActionLogin})
+	rec.Log(ctx, Event{Action: ActionLogin, IP: "198.51.100.1"})
+
+	if len(repo.events) != 2 {
+		t.Fatalf("got %d events, want 2", len(repo.events))
+	}
+	e := repo.events[0]
+	if e.RequestID != "req-1" || e.IP != "203.0.113.7" || e.UserAgent != "curl/8" {
+		t.Fatalf("request meta not applied: %+v", e)
+	}
+	if repo.events[1].IP != "198.51.100.1" {
+		t.Fatalf("explicit IP overwritten: %q", repo.events[1].IP)
+	}
+}
+
+func TestRecorderSurvivesCancelledContext(t *testing.T) {
+	repo := &memRepo{}
+	rec := NewRecorder(repo, zerolog.Nop())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	rec.Log(ctx, Event{Action: ActionLogout})
+	if len(repo.events) != 1 {
+		t.Fatalf("got %d events, want 1", len(repo.events))
+	}
+}
+
+func TestRecorderSwallowsStoreErrors(t *testing.T) {
+	rec := NewRecorder(&memRepo{err: errors.New("db down")}, zerolog.Nop())
+	rec.Log(context.Background(), Event{Action: ActionLoginFailed})
+}
@@ -18,6 +18,14 @@ type Entity struct {
 	Role     string
 	ImageUrl string
 	IsActive bool
+	// PasswordResetRequired is set by an administrator; login is refused
+	// until the password is reset.
+	PasswordResetRequired bool
+	EmailVerified         bool
+	TwoFactorEnabled      bool
+	// Phone is in E.164 form, or empty.
+	Phone         string
+	PhoneVerified bool
 }
 
 func NewAuth(fullName, email, password, role string) (*Entity, error) {
@@ -32,4 +32,33 @@ var (
 	ErrTokenGenerateFail       = domainerr.New(http.StatusInternalServerError, "Failed to generate token")
 	ErrConfirmPassword         = domainerr.New(http.StatusBadRequest, "New password and confirm password do not match")
 	ErrWeakPassword            = domainerr.New(http.StatusBadRequest, "Password must contain uppercase, lowercase, digit and special character")
+	ErrPasswordResetRequired   = domainerr.New(http.StatusForbidden, "Password reset required")
+	ErrSessionNotFound         = domainerr.New(http.StatusNotFound, "Session not found")
+	ErrActionTokenInvalid      = domainerr.New(http.StatusBadRequest, "Token is invalid or expired")
+	ErrEmailNotVerified        = domainerr.New(http.StatusForbidden, "Email is not verified")
+	ErrEmailAlreadyVerified    = domainerr.New(http.StatusConflict, "Email is already verified")
+	ErrPasswordIncorrect       = domainerr.New(http.StatusBadRequest, "Password is incorrect")
+	ErrMFAAlreadyEnabled       = domainerr.New(http.StatusConflict, "Two-factor authentication is already enabled")
+	ErrMFANotEnabled           = domainerr.New(http.StatusBadRequest, "Two-factor authentication is not enabled")
+	ErrMFANotEnrolled          = domainerr.New(http.StatusBadRequest, "Two-factor enrolment has not been started")
+	ErrInvalidMFACode          = domainerr.New(http.StatusBadRequest, "Invalid two-factor code")
+	ErrMFAChallengeInvalid     = domainerr.New(http.StatusUnauthorized, "Two-factor challenge is invalid or expired")
+	ErrOAuthProviderUnknown    = domainerr.New(http.StatusNotFound, "Login provider not found")
+	ErrOAuthStateInvalid       = domainerr.New(http.StatusBadRequest, "Login request is invalid or expired")
+	ErrOAuthFailed             = domainerr.New(http.StatusBadGateway, "Login with the provider failed")
+	ErrOAuthEmailUnverified    = domainerr.New(http.StatusForbidden, "The provider has not verified this email")
+	ErrIdentityNotFound        = domainerr.New(http.StatusNotFound, "Linked account not found")
+	ErrIdentityAlreadyLinked   = domainerr.New(http.StatusConflict, "This provider account is already linked")
+	ErrIdentityLinkRequired    = domainerr.New(http.StatusConflict, "An account with this email exists; sign in and link the provider from your profile")
+	ErrLastLoginMethod         = domainerr.New(http.StatusBadRequest, "Set a password before unlinking your last login provider")
+	ErrDeletionNeedsPassword   = domainerr.New(http.StatusBadRequest, "Set a password before deleting your account")
+	ErrDeletionNeedsNewOwner   = domainerr.New(http.StatusConflict, "Make another member an owner of your organizations before deleting your account")
+	ErrInvalidExportFormat     = domainerr.New(http.StatusBadRequest, "Export format must be json or zip")
+	ErrPhoneRequired           = domainerr.New(http.StatusBadRequest, "Add a phone number to your profile first")
+	ErrPhoneAlreadyVerified    = domainerr.New(http.StatusConflict, "Phone number is already verified")
+	ErrPhoneAlreadyUsed        = domainerr.New(http.StatusConflict, "Phone number is verified on another account")
+	ErrPhoneCodeInvalid        = domainerr.New(http.StatusBadRequest, "Verification code is invalid or expired")
+	ErrPhoneCodeRateLimited    = domainerr.New(http.StatusTooManyRequests, "Too many verification codes requested; try again later")
+	ErrResetRateLimited        = domainerr.New(http.StatusTooManyRequests, "Too many password reset requests; try again later")
+	ErrCommonPassword          = domainerr.New(http.StatusBadRequest, "This password is too common; choose another")
 )
@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NoPasswordHash is stored for accounts created through a login provider. It
+// never matches a password, so password login stays closed until the user
+// sets one with the reset flow.
+const NoPasswordHash = "!"
+
+// Identity links an account at an external login provider to a user.
+type Identity struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	Provider    string
+	Subject     string
+	Email       string
+	CreatedAt   time.Time
+	LastLoginAt *time.Time
+}
+
+type IdentityRepository interface {
+	GetIdentity(ctx context.Context, provider, subject string) (*Identity, error)
+	LinkIdentity(ctx context.Context, identity *Identity) error
+	// CreateUserWithIdentity creates a verified account and its first
+	// identity together.
+	CreateUserWithIdentity(ctx context.Context, u *Entity, identity *Identity) (uuid.UUID, error)
+	TouchIdentity(ctx context.Context, id uuid.UUID, email string) error
+	ListIdentities(ctx context.Context, userID uuid.UUID) ([]Identity, error)
+	CountIdentities(ctx context.Context, userID uuid.UUID) (int64, error)
+	UnlinkIdentity(ctx context.Context, userID, id uuid.UUID) error
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+	"time"
+)
+
+// LockoutPolicy limits password guessing. Failures are counted per account
+// and per client IP within Window. Every account failure delays the next
+// attempt a little longer; MaxAttempts failures lock the account and
+// IPMaxAttempts failures lock the IP, both for Lockout.
+type LockoutPolicy struct {
+	MaxAttempts   int
+	IPMaxAttempts int
+	Window        time.Duration
+	Lockout       time.Duration
+	BaseDelay     time.Duration
+	MaxDelay      time.Duration
+}
+
+// Delay returns how long an account must wait after its nth failure: the
+// base delay doubled per failure and capped at MaxDelay.
+func (p LockoutPolicy) Delay(failures int64) time.Duration {
+	if failures <= 0 || p.BaseDelay <= 0 {
+		return 0
+	}
+	d := p.BaseDelay
+	for i := int64(1); i < failures; i++ {
+		d *= 2
+		if p.MaxDelay > 0 && d >= p.MaxDelay {
+			return p.MaxDelay
+		}
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return d
+}
+
+// LoginAttemptStore tracks failed logins. Accounts are identified by the
+// email that was typed, whether or not such a user exists, so lockouts
+// reveal nothing about which accounts are registered.
+type LoginAttemptStore interface {
+	// Blocked reports whether the account or IP is locked or still waiting
+	// out its delay.
+	Blocked(ctx context.Context, email, ip string) (bool, error)
+	// RecordFailure counts a failure and returns the account and IP totals
+	// within window.
+	RecordFailure(ctx context.Context, email, ip string, window time.Duration) (accountFailures, ipFailures int64, err error)
+	SetDelay(ctx context.Context, email string, d time.Duration) error
+	LockAccount(ctx context.Context, email string, d time.Duration) error
+	LockIP(ctx context.Context, ip string, d time.Duration) error
+	// ClearAccount drops the failures, delay and lock of an account.
+	ClearAccount(ctx context.Context, email string) error
+}
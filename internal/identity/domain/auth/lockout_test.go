@@ -0,0 +1,27 @@
+package auth
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLockoutPolicyDelay(t *testing.T) {
+	p := LockoutPolicy{BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+	cases := map[int64]time.Duration{
+		0:  0,
+		1:  time.Second,
+		2:  2 * time.Second,
+		3:  4 * time.Second,
+		4:  8 * time.Second,
+		5:  10 * time.Second,
+		60: 10 * time.Second,
+	}
+	for failures, want := range cases {
+		if got := p.Delay(failures); got != want {
+			t.Fatalf("Delay(%d) = %s, want %s", failures, got, want)
+		}
+	}
+	if (LockoutPolicy{}).Delay(3) != 0 {
+		t.Fatal("zero base delay must disable delays")
+	}
+}
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const RecoveryCodeCount = 10
+
+// TOTPState is the stored two-factor state of a user. Secret is encrypted
+// and empty until enrolment starts.
+type TOTPState struct {
+	Secret  string
+	Enabled bool
+}
+
+type MFARepository interface {
+	GetTOTP(ctx context.Context, userID uuid.UUID) (*TOTPState, error)
+	// SetPendingTOTP stores a new encrypted secret for an account that has not
+	// enabled two-factor yet.
+	SetPendingTOTP(ctx context.Context, userID uuid.UUID, encryptedSecret string) error
+	// EnableTOTP turns on two-factor and replaces the recovery codes.
+	EnableTOTP(ctx context.Context, userID uuid.UUID, codeHashes []string) error
+	DisableTOTP(ctx context.Context, userID uuid.UUID) error
+	ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error
+	ConsumeRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error)
+	CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error)
+}
+
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// NewRecoveryCodes returns n one-time codes formatted as xxxxx-xxxxx together
+// with the hashes to store.
+func NewRecoveryCodes(n int) (codes, hashes []string, err error) {
+	codes = make([]string, n)
+	hashes = make([]string, n)
+	for i := range n {
+		buf := make([]byte, 7)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+		raw := strings.ToLower(recoveryEncoding.EncodeToString(buf))[:10]
+		codes[i] = raw[:5] + "-" + raw[5:]
+		hashes[i] = HashRecoveryCode(codes[i])
+	}
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode ignores case, spaces and dashes so codes can be typed the
+// way they are read.
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(code)))
+	return HashToken(normalized)
+}
@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Phone is a phone number in E.164 form: a plus sign, a country code and
+// the subscriber number, at most 15 digits in all.
+type Phone struct {
+	value string
+}
+
+// NewPhone normalises raw to E.164. Spaces, dots, dashes and brackets are
+// dropped and a leading 00 is read as +. A national number starting with a
+// single 0 takes defaultCountryCode; without one it is rejected.
+func NewPhone(raw, defaultCountryCode string) (Phone, error) {
+	v := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '.', '-', '(', ')', '\t':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(raw))
+
+	switch {
+	case strings.HasPrefix(v, "+"):
+		v = v[1:]
+	case strings.HasPrefix(v, "00"):
+		v = v[2:]
+	case strings.HasPrefix(v, "0") && defaultCountryCode != "":
+		v = strings.TrimPrefix(defaultCountryCode, "+") + v[1:]
+	default:
+		return Phone{}, ErrInvalidPhoneNumber
+	}
+	if len(v) < 8 || len(v) > 15 || v[0] == '0' {
+		return Phone{}, ErrInvalidPhoneNumber
+	}
+	for _, r := range v {
+		if r < '0' || r > '9' {
+			return Phone{}, ErrInvalidPhoneNumber
+		}
+	}
+	return Phone{value: "+" + v}, nil
+}
+
+func (p Phone) String() string {
+	return p.value
+}
+
+// Masked hides all but the last three digits, for messages and logs.
+func (p Phone) Masked() string {
+	if len(p.value) <= 4 {
+		return p.value
+	}
+	return p.value[:1] + strings.Repeat("*", len(p.value)-4) + p.value[len(p.value)-3:]
+}
+
+// PhoneCodeDigits is the length of phone verification codes.
+const PhoneCodeDigits = 6
+
+// NewPhoneCode returns a random numeric code and the hash to store for it.
+// The hash covers the phone too, so a code only verifies the number it was
+// sent to.
+func NewPhoneCode(phone Phone) (code, hash string, err error) {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(PhoneCodeDigits), nil)
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", "", err
+	}
+	code = fmt.Sprintf("%0*d", PhoneCodeDigits, n.Int64())
+	return code, HashPhoneCode(phone, code), nil
+}
+
+func HashPhoneCode(phone Phone, code string) string {
+	return HashToken(phone.String() + ":" + strings.TrimSpace(code))
+}
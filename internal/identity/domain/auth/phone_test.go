@@ -0,0 +1,65 @@
+package auth
+
+import "testing"
+
+func TestNewPhone(t *testing.T) {
+	cases := []struct {
+		raw, country, want string
+	}{
+		{"+84 912 345 678", "", "+84912345678"},
+		{"0084-912-345-678", "", "+84912345678"},
+		{"(+1) 415.555.2671", "", "+14155552671"},
+		{"0912345678", "84", "+84912345678"},
+		{"0912345678", "+84", "+84912345678"},
+	}
+	for _, c := range cases {
+		p, err := NewPhone(c.raw, c.country)
+		if err != nil {
+			t.Fatalf("NewPhone(%q, %q) failed: %v", c.raw, c.country, err)
+		}
+		if p.String() != c.want {
+			t.Fatalf("NewPhone(%q, %q) = %q, want %q", c.raw, c.country, p, c.want)
+		}
+	}
+}
+
+func TestNewPhoneRejects(t *testing.T) {
+	for _, raw := range []string{
+		"",
+		"0912345678",
+		"912345678",
+		"+0912345678",
+		"+84 91234",
+		"+1234567890123456",
+		"+84 912 ABC 678",
+	} {
+		if _, err := NewPhone(raw, ""); err != ErrInvalidPhoneNumber {
+			t.Fatalf("NewPhone(%q) = %v, want ErrInvalidPhoneNumber", raw, err)
+		}
+	}
+}
+
+func TestPhoneMasked(t *testing.T) {
+	p, _ := NewPhone("+84912345678", "")
+	if got := p.Masked(); got != "+********678" {
+		t.Fatalf("Masked() = %q", got)
+	}
+}
+
+func TestPhoneCodeIsBoundToNumber(t *testing.T) {
+	a, _ := NewPhone("+84912345678", "")
+	b, _ := NewPhone("+84912345679", "")
+	code, hash, err := NewPhoneCode(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(code) != PhoneCodeDigits {
+		t.Fatalf("code %q has %d digits", code, len(code))
+	}
+	if HashPhoneCode(a, " "+code+" ") != hash {
+		t.Fatal("code does not verify the number it was sent to")
+	}
+	if HashPhoneCode(b, code) == hash {
+		t.Fatal("code verifies another number")
+	}
+}
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -13,4 +14,23 @@ type Repository interface {
 	ChangePassword(ctx context.Context, password string, userID uuid.UUID) error
 	GetPasswordByID(ctx context.Context, userID uuid.UUID) (string, error)
 	UpdateProfile(ctx context.Context, u *Entity) error
+	ResetPassword(ctx context.Context, userID uuid.UUID, passwordHash string) error
+	MarkEmailVerified(ctx context.Context, userID uuid.UUID) error
+	// SetPhone stores phone, which is E.164 or empty to remove it. A
+	// different number loses its verification.
+	SetPhone(ctx context.Context, userID uuid.UUID, phone string) error
+	// MarkPhoneVerified verifies phone if it is still the user's number.
+	MarkPhoneVerified(ctx context.Context, userID uuid.UUID, phone string) error
+
+	ScheduleDeletion(ctx context.Context, userID uuid.UUID, at time.Time) error
+	// CancelDeletion reports whether a deletion was pending.
+	CancelDeletion(ctx context.Context, userID uuid.UUID) (bool, error)
+	ListDueDeletions(ctx context.Context, now time.Time, limit int32) ([]uuid.UUID, error)
+	// DeleteAccount removes the user, provided the deletion is still due, in
+	// one transaction that also anonymizes the user's audit events. The user
+	// row stays locked from the check to the commit, so signing in cannot
+	// cancel the deletion halfway. purge runs under that lock and returns the
+	// organizations to remove with the user; its error keeps the account. It
+	// reports whether the user was removed.
+	DeleteAccount(ctx context.Context, userID uuid.UUID, now time.Time, purge func(ctx context.Context) (orgIDs []uuid.UUID, err error)) (bool, error)
 }
@@ -0,0 +1,53 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ClientInfo describes the device a session was opened from.
+type ClientInfo struct {
+	UserAgent string
+	IP        string
+}
+
+// Session is one signed-in device. ID is the sid carried by the tokens; it
+// changes on every refresh while CreatedAt keeps the original login time.
+type Session struct {
+	ID         string
+	UserAgent  string
+	IP         string
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+}
+
+// SessionStore is the index of each user's sessions. Revoking a session also
+// deletes its cached user data and refresh token.
+type SessionStore interface {
+	// ListSessions returns the sessions whose refresh token is still live.
+	ListSessions(ctx context.Context, userID uuid.UUID) ([]Session, error)
+	// GetSession returns nil when the user has no session sid.
+	GetSession(ctx context.Context, userID uuid.UUID, sid string) (*Session, error)
+	RevokeSession(ctx context.Context, userID uuid.UUID, sid string) error
+	// RevokeUserSessions returns how many sessions were revoked.
+	RevokeUserSessions(ctx context.Context, userID uuid.UUID) (int, error)
+}
+
+// StreamTicket lets a client that cannot send headers, such as a browser
+// EventSource, open the event stream as the session that asked for it.
+type StreamTicket struct {
+	UserID uuid.UUID
+	Sid    string
+	// OrgID is the organization the stream acts in.
+	OrgID uuid.UUID
+}
+
+// StreamTicketStore keeps stream tickets keyed by the hash of the ticket.
+type StreamTicketStore interface {
+	SaveStreamTicket(ctx context.Context, hash string, t StreamTicket, ttl time.Duration) error
+	// TakeStreamTicket returns and deletes the ticket, or nil when it is
+	// unknown or expired.
+	TakeStreamTicket(ctx context.Context, hash string) (*StreamTicket, error)
+}
@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"go-ai/pkg/helpers"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TokenPurpose string
+
+const (
+	TokenPasswordReset     TokenPurpose = "password_reset"
+	TokenEmailVerification TokenPurpose = "email_verification"
+)
+
+// NewToken returns a random single-use token and the hash that is stored in
+// its place. Only the hash ever reaches the database.
+func NewToken() (raw, hash string) {
+	raw = helpers.GenerateKey()
+	return raw, HashToken(raw)
+}
+
+func HashToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+type TokenRepository interface {
+	// CreateToken stores a token hash and revokes older unused tokens of the
+	// same purpose for the user.
+	CreateToken(ctx context.Context, userID uuid.UUID, purpose TokenPurpose, hash string, expiresAt time.Time) error
+	// ConsumeToken marks a token as used and returns its user. Unknown, used
+	// and expired tokens all return ErrActionTokenInvalid.
+	ConsumeToken(ctx context.Context, purpose TokenPurpose, hash string) (uuid.UUID, error)
+}
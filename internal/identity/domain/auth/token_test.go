@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewTokenHashesRawValue(t *testing.T) {
+	raw, hash := NewToken()
+	if raw == "" || hash == "" {
+		t.Fatal("empty token")
+	}
+	if raw == hash {
+		t.Fatal("hash must differ from the raw token")
+	}
+	if HashToken(raw) != hash {
+		t.Fatal("HashToken must be deterministic")
+	}
+	other, _ := NewToken()
+	if other == raw {
+		t.Fatal("tokens must be random")
+	}
+}
+
+func TestNewRecoveryCodes(t *testing.T) {
+	codes, hashes, err := NewRecoveryCodes(RecoveryCodeCount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(codes) != RecoveryCodeCount || len(hashes) != RecoveryCodeCount {
+		t.Fatalf("got %d codes and %d hashes", len(codes), len(hashes))
+	}
+	seen := map[string]bool{}
+	for i, code := range codes {
+		if len(code) != 11 || code[5] != '-' {
+			t.Fatalf("unexpected code format %q", code)
+		}
+		if seen[code] {
+			t.Fatalf("duplicate code %q", code)
+		}
+		seen[code] = true
+		if HashRecoveryCode(strings.ToUpper(strings.ReplaceAll(code, "-", " "))) != hashes[i] {
+			t.Fatalf("hash of %q must ignore case and separators", code)
+		}
+	}
+}
@@ -1,43 +1,107 @@
 package auth
 
 import (
-	"regexp"
+	"fmt"
+	domainerr "go-ai/pkg/domain_err"
+	"net/http"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 type Password struct {
 	value string
 }
 
-var (
-	lowercase   = regexp.MustCompile(`[a-z]`)
-	uppercase   = regexp.MustCompile(`[A-Z]`)
-	number      = regexp.MustCompile(`[0-9]`)
-	specialChar = regexp.MustCompile(`[@$!%*?&]`)
-)
+// PasswordPolicy decides which new passwords are accepted. Blocked holds
+// lower-cased passwords that are too common to allow.
+type PasswordPolicy struct {
+	MinLength      int
+	MaxLength      int
+	RequireLower   bool
+	RequireUpper   bool
+	RequireDigit   bool
+	RequireSpecial bool
+	Blocked        map[string]struct{}
+}
+
+var DefaultPasswordPolicy = PasswordPolicy{
+	MinLength:      6,
+	MaxLength:      128,
+	RequireLower:   true,
+	RequireUpper:   true,
+	RequireDigit:   true,
+	RequireSpecial: true,
+}
 
 func NewPassword(v string) (Password, error) {
-	if strings.TrimSpace(v) == "" {
-		return Password{}, ErrPasswordTooShort
-	}
-	if len(v) < 6 {
-		return Password{}, ErrPasswordTooShort
+	return DefaultPasswordPolicy.NewPassword(v)
+}
+
+// NewPassword checks v against the policy. Any rune that is not a letter,
+// digit or space counts as a special character.
+func (p PasswordPolicy) NewPassword(v string) (Password, error) {
+	n := utf8.RuneCountInString(v)
+	if strings.TrimSpace(v) == "" || n < p.MinLength {
+		return Password{}, p.tooShort()
 	}
-	if !lowercase.MatchString(v) {
-		return Password{}, ErrWeakPassword
+	if p.MaxLength > 0 && n > p.MaxLength {
+		return Password{}, domainerr.New(http.StatusBadRequest, fmt.Sprintf("Password must be at most %d characters", p.MaxLength))
 	}
-	if !uppercase.MatchString(v) {
-		return Password{}, ErrWeakPassword
+	var lower, upper, digit, special bool
+	for _, r := range v {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsLetter(r) && !unicode.IsSpace(r):
+			special = true
+		}
 	}
-	if !number.MatchString(v) {
-		return Password{}, ErrWeakPassword
+	if (p.RequireLower && !lower) || (p.RequireUpper && !upper) ||
+		(p.RequireDigit && !digit) || (p.RequireSpecial && !special) {
+		return Password{}, p.weak()
 	}
-	if !specialChar.MatchString(v) {
-		return Password{}, ErrWeakPassword
+	if _, ok := p.Blocked[strings.ToLower(v)]; ok {
+		return Password{}, ErrCommonPassword
 	}
 	return Password{value: v}, nil
 }
 
+func (p PasswordPolicy) tooShort() error {
+	if p.MinLength == DefaultPasswordPolicy.MinLength {
+		return ErrPasswordTooShort
+	}
+	return domainerr.New(http.StatusBadRequest, fmt.Sprintf("Password must be at least %d characters", p.MinLength))
+}
+
+func (p PasswordPolicy) weak() error {
+	if p.RequireLower && p.RequireUpper && p.RequireDigit && p.RequireSpecial {
+		return ErrWeakPassword
+	}
+	var need []string
+	if p.RequireUpper {
+		need = append(need, "uppercase")
+	}
+	if p.RequireLower {
+		need = append(need, "lowercase")
+	}
+	if p.RequireDigit {
+		need = append(need, "digit")
+	}
+	if p.RequireSpecial {
+		need = append(need, "special character")
+	}
+	list := need[0]
+	if len(need) > 1 {
+		list = strings.Join(need[:len(need)-1], ", ") + " and " + need[len(need)-1]
+	}
+	return domainerr.New(http.StatusBadRequest, "Password must contain "+list)
+}
+
 func (p Password) String() string {
 	return p.value
 }
@@ -0,0 +1,62 @@
+package auth
+
+import (
+	domainerr "go-ai/pkg/domain_err"
+	"testing"
+)
+
+func TestDefaultPasswordPolicy(t *testing.T) {
+	cases := map[string]error{
+		"":          ErrPasswordTooShort,
+		"Ab1!":      ErrPasswordTooShort,
+		"abcdef1!":  ErrWeakPassword,
+		"ABCDEF1!":  ErrWeakPassword,
+		"Abcdefg!":  ErrWeakPassword,
+		"Abcdefg1":  ErrWeakPassword,
+		"Abcdef1!":  nil,
+		"Abcdef1#":  nil,
+		"Abcdef1_":  nil,
+		"Ábcdéf1€":  nil,
+		"Abc def1 ": ErrWeakPassword,
+	}
+	for in, want := range cases {
+		_, err := NewPassword(in)
+		if err != want {
+			t.Fatalf("NewPassword(%q) = %v, want %v", in, err, want)
+		}
+	}
+}
+
+func TestPasswordPolicyLimits(t *testing.T) {
+	p := PasswordPolicy{MinLength: 12, MaxLength: 16}
+	_, err := p.NewPassword("short")
+	if ae, ok := err.(domainerr.AppError); !ok || ae.Msg != "Password must be at least 12 characters" {
+		t.Fatalf("got %v", err)
+	}
+	_, err = p.NewPassword("a very long passphrase")
+	if ae, ok := err.(domainerr.AppError); !ok || ae.Msg != "Password must be at most 16 characters" {
+		t.Fatalf("got %v", err)
+	}
+	if _, err := p.NewPassword("correct horse"); err != nil {
+		t.Fatalf("passphrase rejected: %v", err)
+	}
+}
+
+func TestPasswordPolicyPartialRequirements(t *testing.T) {
+	p := PasswordPolicy{MinLength: 6, RequireUpper: true, RequireDigit: true}
+	_, err := p.NewPassword("abcdefg")
+	if ae, ok := err.(domainerr.AppError); !ok || ae.Msg != "Password must contain uppercase and digit" {
+		t.Fatalf("got %v", err)
+	}
+}
+
+func TestPasswordPolicyBlocklist(t *testing.T) {
+	p := DefaultPasswordPolicy
+	p.Blocked = map[string]struct{}{"password1!": {}}
+	if _, err := p.NewPassword("Password1!"); err != ErrCommonPassword {
+		t.Fatalf("got %v, want ErrCommonPassword", err)
+	}
+	if _, err := p.NewPassword("Password2!"); err != nil {
+		t.Fatalf("got %v", err)
+	}
+}
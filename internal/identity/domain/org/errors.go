@@ -0,0 +1,23 @@
+package org
+
+import (
+	domainerr "go-ai/pkg/domain_err"
+	"net/http"
+)
+
+var (
+	ErrNameRequired        = domainerr.New(http.StatusBadRequest, "Organization name is required")
+	ErrNameTooLong         = domainerr.New(http.StatusBadRequest, "Organization name must be at most 100 characters")
+	ErrInvalidRole         = domainerr.New(http.StatusBadRequest, "Role must be owner, manager or staff")
+	ErrInvalidOrganization = domainerr.New(http.StatusBadRequest, "Invalid organization ID")
+	ErrNotFound            = domainerr.New(http.StatusNotFound, "Organization not found")
+	ErrNotMember           = domainerr.New(http.StatusForbidden, "You are not a member of this organization")
+	ErrNoOrganization      = domainerr.New(http.StatusForbidden, "Create or join an organization first")
+	ErrForbidden           = domainerr.New(http.StatusForbidden, "Your organization role does not allow this")
+	ErrMemberNotFound      = domainerr.New(http.StatusNotFound, "Member not found")
+	ErrAlreadyMember       = domainerr.New(http.StatusConflict, "User is already a member of this organization")
+	ErrLastOwner           = domainerr.New(http.StatusConflict, "An organization needs at least one owner")
+	ErrInvitationNotFound  = domainerr.New(http.StatusNotFound, "Invitation not found")
+	ErrInvitationInvalid   = domainerr.New(http.StatusBadRequest, "Invitation is invalid or has expired")
+	ErrInvitationEmail     = domainerr.New(http.StatusForbidden, "Invitation was sent to a different email address")
+)
@@ -0,0 +1,105 @@
+package org
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Role is a member's role inside one organization. It is separate from the
+// global role on the user, which still decides what the API lets them do.
+type Role string
+
+const (
+	RoleOwner   Role = "owner"
+	RoleManager Role = "manager"
+	RoleStaff   Role = "staff"
+)
+
+// Header lets API key requests, or sessions that don't want to switch, name
+// the organization a request acts on.
+const Header = "X-Organization-ID"
+
+const maxNameLength = 100
+
+type Organization struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedBy *uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Membership is a user's place in an organization. The organization and user
+// details are filled depending on which side it was listed from.
+type Membership struct {
+	OrganizationID   uuid.UUID
+	OrganizationName string
+	UserID           uuid.UUID
+	Email            string
+	FullName         string
+	Role             Role
+	CreatedAt        time.Time
+}
+
+type Invitation struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Email          string
+	Role           Role
+	InvitedBy      *uuid.UUID
+	ExpiresAt      time.Time
+	AcceptedAt     *time.Time
+	CreatedAt      time.Time
+}
+
+func ParseRole(s string) (Role, error) {
+	role := Role(strings.ToLower(strings.TrimSpace(s)))
+	if role.rank() == 0 {
+		return "", ErrInvalidRole
+	}
+	return role, nil
+}
+
+func NormalizeName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", ErrNameRequired
+	}
+	if len([]rune(name)) > maxNameLength {
+		return "", ErrNameTooLong
+	}
+	return name, nil
+}
+
+func (r Role) rank() int {
+	switch r {
+	case RoleOwner:
+		return 3
+	case RoleManager:
+		return 2
+	case RoleStaff:
+		return 1
+	}
+	return 0
+}
+
+// CanInvite reports whether a member with role r may invite someone as role.
+// Nobody can hand out a role above their own.
+func (r Role) CanInvite(role Role) bool {
+	return r.rank() >= RoleManager.rank() && role.rank() <= r.rank()
+}
+
+// CanManage reports whether a member with role r may move a member from one
+// role to another; to is empty for a removal. Owners manage everyone;
+// managers only manage staff and can promote them as far as manager.
+func (r Role) CanManage(from, to Role) bool {
+	if r == RoleOwner {
+		return true
+	}
+	if r.rank() < RoleManager.rank() {
+		return false
+	}
+	return from.rank() < r.rank() && to.rank() <= r.rank()
+}
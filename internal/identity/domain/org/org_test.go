@@ -0,0 +1,66 @@
+package org
+
+import "testing"
+
+func TestParseRole(t *testing.T) {
+	for in, want := range map[string]Role{"owner": RoleOwner, " Manager ": RoleManager, "STAFF": RoleStaff} {
+		got, err := ParseRole(in)
+		if err != nil || got != want {
+			t.Fatalf("ParseRole(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+	for _, in := range []string{"", "admin", "user"} {
+		if _, err := ParseRole(in); err != ErrInvalidRole {
+			t.Fatalf("ParseRole(%q) err = %v, want ErrInvalidRole", in, err)
+		}
+	}
+}
+
+func TestCanInvite(t *testing.T) {
+	cases := []struct {
+		actor, role Role
+		want        bool
+	}{
+		{RoleOwner, RoleOwner, true},
+		{RoleOwner, RoleStaff, true},
+		{RoleManager, RoleManager, true},
+		{RoleManager, RoleStaff, true},
+		{RoleManager, RoleOwner, false},
+		{RoleStaff, RoleStaff, false},
+	}
+	for _, tc := range cases {
+		if got := tc.actor.CanInvite(tc.role); got != tc.want {
+			t.Errorf("%s.CanInvite(%s) = %v, want %v", tc.actor, tc.role, got, tc.want)
+		}
+	}
+}
+
+func TestCanManage(t *testing.T) {
+	cases := []struct {
+		actor, from, to Role
+		want            bool
+	}{
+		{RoleOwner, RoleOwner, RoleStaff, true},
+		{RoleOwner, RoleStaff, "", true},
+		{RoleManager, RoleStaff, RoleManager, true},
+		{RoleManager, RoleStaff, "", true},
+		{RoleManager, RoleStaff, RoleOwner, false},
+		{RoleManager, RoleManager, RoleStaff, false},
+		{RoleManager, RoleOwner, "", false},
+		{RoleStaff, RoleStaff, "", false},
+	}
+	for _, tc := range cases {
+		if got := tc.actor.CanManage(tc.from, tc.to); got != tc.want {
+			t.Errorf("%s.CanManage(%s, %q) = %v, want %v", tc.actor, tc.from, tc.to, got, tc.want)
+		}
+	}
+}
+
+func TestNormalizeName(t *testing.T) {
+	if name, err := NormalizeName("  Desk A "); err != nil || name != "Desk A" {
+		t.Fatalf("NormalizeName = %q, %v", name, err)
+	}
+	if _, err := NormalizeName("   "); err != ErrNameRequired {
+		t.Fatalf("blank name err = %v", err)
+	}
+}
@@ -0,0 +1,36 @@
+package org
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// Create stores the organization with ownerID as its first owner.
+	Create(ctx context.Context, name string, ownerID uuid.UUID) (*Organization, error)
+	Get(ctx context.Context, id uuid.UUID) (*Organization, error)
+	Rename(ctx context.Context, id uuid.UUID, name string) error
+
+	// MemberRole returns ErrNotMember when the user is not in the organization.
+	MemberRole(ctx context.Context, orgID, userID uuid.UUID) (Role, error)
+	ListForUser(ctx context.Context, userID uuid.UUID) ([]Membership, error)
+	ListMembers(ctx context.Context, orgID uuid.UUID) ([]Membership, error)
+	SetMemberRole(ctx context.Context, orgID, userID uuid.UUID, role Role) error
+	RemoveMember(ctx context.Context, orgID, userID uuid.UUID) error
+	CountOwners(ctx context.Context, orgID uuid.UUID) (int64, error)
+	// ListSoleMemberOrganizations returns the organizations userID is the
+	// only member of.
+	ListSoleMemberOrganizations(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	// ListOrganizationsNeedingOwner returns the organizations that would be
+	// left with members but no owner if userID left.
+	ListOrganizationsNeedingOwner(ctx context.Context, userID uuid.UUID) ([]Organization, error)
+
+	// CreateInvitation replaces any pending invitation for the same email.
+	CreateInvitation(ctx context.Context, inv *Invitation, tokenHash string) (*Invitation, error)
+	ListInvitations(ctx context.Context, orgID uuid.UUID) ([]Invitation, error)
+	RevokeInvitation(ctx context.Context, orgID, id uuid.UUID) error
+	// AcceptInvitation consumes the invitation and adds userID with its role,
+	// provided email matches the invited address. Nothing changes otherwise.
+	AcceptInvitation(ctx context.Context, tokenHash string, userID uuid.UUID, email string) (*Invitation, error)
+}
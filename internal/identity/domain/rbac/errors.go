@@ -0,0 +1,19 @@
+package rbac
+
+import (
+	domainerr "go-ai/pkg/domain_err"
+	"net/http"
+)
+
+var (
+	ErrRoleNotFound          = domainerr.New(http.StatusNotFound, "Role not found")
+	ErrRoleAlreadyExists     = domainerr.New(http.StatusConflict, "Role already exists")
+	ErrInvalidRoleName       = domainerr.New(http.StatusBadRequest, "Role name must be 2-32 lowercase letters, digits, '_' or '-'")
+	ErrBuiltinRole           = domainerr.New(http.StatusConflict, "Built-in roles cannot be deleted")
+	ErrRoleInUse             = domainerr.New(http.StatusConflict, "Role is still assigned to users")
+	ErrOwnerRoleLocked       = domainerr.New(http.StatusConflict, "Owner role permissions cannot be changed")
+	ErrInvalidPermissionName = domainerr.New(http.StatusBadRequest, "Permission must look like resource:action, resource:* or *")
+	ErrPermissionNotFound    = domainerr.New(http.StatusBadRequest, "Permission does not exist")
+	ErrPermissionExists      = domainerr.New(http.StatusConflict, "Permission already exists")
+	ErrGrantNotHeld          = domainerr.New(http.StatusForbidden, "You can only grant permissions you hold")
+)
@@ -0,0 +1,18 @@
+package rbac
+
+// Permissions checked by the HTTP layer. Roles receive them through
+// role_permissions, directly or via a wildcard grant.
+const (
+	ModelsRead    = "models:read"
+	ModelsPredict = "models:predict"
+	ModelsPromote = "models:promote"
+	JobsRead      = "jobs:read"
+	JobsManage    = "jobs:manage"
+	UsersRead     = "users:read"
+	UsersManage   = "users:manage"
+	RolesManage   = "roles:manage"
+	AuditRead     = "audit:read"
+	// WebhooksManage guards outgoing webhooks, whose delivery log shows
+	// what the receiving hosts answered.
+	WebhooksManage = "webhooks:manage"
+)
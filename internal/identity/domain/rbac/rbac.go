@@ -1,22 +1,109 @@
 package rbac
 
-import "github.com/google/uuid"
+import (
+	"regexp"
+	"strings"
+	"time"
 
-type Permission string
+	"github.com/google/uuid"
+)
+
+// Wildcard grants every permission, including ones created later.
+const Wildcard = "*"
+
+var (
+	permissionNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*(:[a-z][a-z0-9_-]*)*(:\*)?$`)
+	roleNamePattern       = regexp.MustCompile(`^[a-z][a-z0-9_-]{1,31}$`)
+)
+
+type Permission struct {
+	ID          int
+	Name        string
+	Description string
+	CreatedAt   time.Time
+}
 
 type Role struct {
-	ID         int
-	Permission string
+	ID          int
+	Name        string
+	Permissions []string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (r Role) IsBuiltin() bool {
+	return IsBuiltinRole(r.Name)
 }
 
 type UserRole struct {
-	UserID uuid.UUID
-	Role   string
+	UserID      uuid.UUID
+	Role        string
+	Permissions PermissionSet
 }
 
 func (ur UserRole) HasPermission(p string) bool {
-	if ur.Role == p {
-		return true
+	return ur.Permissions.Allows(p)
+}
+
+// PermissionSet is the effective list of grants of a role. Grants are either
+// exact ("models:read"), a resource wildcard ("models:*") or "*".
+type PermissionSet []string
+
+func (ps PermissionSet) Allows(perm string) bool {
+	for _, grant := range ps {
+		if Matches(grant, perm) {
+			return true
+		}
 	}
 	return false
 }
+
+// Covers reports whether ps allows every one of grants, wildcards included,
+// so that its holder may hand them out. Only "*" covers "*".
+func (ps PermissionSet) Covers(grants []string) bool {
+	for _, grant := range grants {
+		if !ps.Allows(grant) {
+			return false
+		}
+	}
+	return true
+}
+
+// Matches reports whether grant covers perm. "a:*" covers "a:x" and "a:x:y"
+// as well as "a:*" itself, but not "a".
+func Matches(grant, perm string) bool {
+	if grant == Wildcard || grant == perm {
+		return true
+	}
+	prefix, ok := strings.CutSuffix(grant, "*")
+	if !ok || !strings.HasSuffix(prefix, ":") {
+		return false
+	}
+	return strings.HasPrefix(perm, prefix)
+}
+
+func ValidPermissionName(name string) bool {
+	return name == Wildcard || permissionNamePattern.MatchString(name)
+}
+
+func ValidRoleName(name string) bool {
+	return roleNamePattern.MatchString(name)
+}
+
+// NormalizePermissions validates and de-duplicates a list of grants.
+func NormalizePermissions(names []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(names))
+	out := make([]string, 0, len(names))
+	for _, n := range names {
+		n = strings.TrimSpace(n)
+		if !ValidPermissionName(n) {
+			return nil, ErrInvalidPermissionName
+		}
+		if _, ok := seen[n]; ok {
+			continue
+		}
+		seen[n] = struct{}{}
+		out = append(out, n)
+	}
+	return out, nil
+}
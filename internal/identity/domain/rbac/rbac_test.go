@@ -0,0 +1,96 @@
+package rbac
+
+import "testing"
+
+func TestMatches(t *testing.T) {
+	cases := []struct {
+		grant, perm string
+		want        bool
+	}{
+		{"*", "models:read", true},
+		{"*", "roles:manage", true},
+		{"models:read", "models:read", true},
+		{"models:read", "models:predict", false},
+		{"models:*", "models:read", true},
+		{"models:*", "models:registry:write", true},
+		{"models:*", "models:*", true},
+		{"models:*", "models", false},
+		{"models:*", "modelsx:read", false},
+		{"models:*", "jobs:read", false},
+		{"models:registry:*", "models:read", false},
+		{"models:registry:*", "models:registry:write", true},
+	}
+	for _, tc := range cases {
+		if got := Matches(tc.grant, tc.perm); got != tc.want {
+			t.Errorf("Matches(%q, %q) = %v, want %v", tc.grant, tc.perm, got, tc.want)
+		}
+	}
+}
+
+func TestUserRoleHasPermission(t *testing.T) {
+	ur := UserRole{Role: Staff, Permissions: PermissionSet{"models:read", "jobs:*"}}
+	if !ur.HasPermission(JobsManage) {
+		t.Fatal("jobs:* should grant jobs:manage")
+	}
+	if ur.HasPermission(ModelsPromote) {
+		t.Fatal("staff should not be able to promote models")
+	}
+	// The role name itself is not a permission.
+	if ur.HasPermission(Staff) {
+		t.Fatal("role name must not act as a permission")
+	}
+	if (UserRole{}).HasPermission(ModelsRead) {
+		t.Fatal("empty permission set must deny")
+	}
+}
+
+func TestValidPermissionName(t *testing.T) {
+	valid := []string{"*", "models:read", "models:*", "models:registry:write", "users_v2:read-all"}
+	for _, n := range valid {
+		if !ValidPermissionName(n) {
+			t.Errorf("%q should be valid", n)
+		}
+	}
+	invalid := []string{"", "Models:read", "models:", ":read", "models:*:read", "models*", "**", "models:read "}
+	for _, n := range invalid {
+		if ValidPermissionName(n) {
+			t.Errorf("%q should be invalid", n)
+		}
+	}
+}
+
+func TestNormalizePermissions(t *testing.T) {
+	got, err := NormalizePermissions([]string{"models:read", " models:read", "jobs:*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0] != "models:read" || got[1] != "jobs:*" {
+		t.Fatalf("unexpected result %v", got)
+	}
+	if _, err := NormalizePermissions([]string{"bad perm"}); err != ErrInvalidPermissionName {
+		t.Fatalf("expected ErrInvalidPermissionName, got %v", err)
+	}
+}
+
+func TestPermissionSetCovers(t *testing.T) {
+	admin := PermissionSet{"models:*", "users:*", RolesManage}
+	cases := []struct {
+		grants []string
+		want   bool
+	}{
+		{[]string{"models:read", "models:registry:write"}, true},
+		{[]string{"models:*", "users:manage"}, true},
+		{[]string{RolesManage}, true},
+		{[]string{Wildcard}, false},
+		{[]string{"models:read", "jobs:read"}, false},
+		{nil, true},
+	}
+	for _, tc := range cases {
+		if got := admin.Covers(tc.grants); got != tc.want {
+			t.Errorf("Covers(%v) = %v, want %v", tc.grants, got, tc.want)
+		}
+	}
+	if !(PermissionSet{Wildcard}).Covers([]string{Wildcard, "jobs:*"}) {
+		t.Fatal("* should cover everything")
+	}
+}
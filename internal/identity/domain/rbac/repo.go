@@ -8,4 +8,40 @@ import (
 
 type UserRoleRepo interface {
 	GetUserRole(ctx context.Context, userID uuid.UUID) (UserRole, error)
+	// GetRolePermissions returns the grants of a role by name, empty when
+	// the role does not exist.
+	GetRolePermissions(ctx context.Context, role string) (PermissionSet, error)
+}
+
+type Repository interface {
+	UserRoleRepo
+	ListRoles(ctx context.Context) ([]Role, error)
+	GetRoleByID(ctx context.Context, id int) (*Role, error)
+	CreateRole(ctx context.Context, name string, permissions []string) (*Role, error)
+	DeleteRole(ctx context.Context, id int) error
+	CountUsersByRole(ctx context.Context, id int) (int64, error)
+	SetRolePermissions(ctx context.Context, id int, permissions []string) error
+	ListPermissions(ctx context.Context) ([]Permission, error)
+	CreatePermission(ctx context.Context, name, description string) (*Permission, error)
+}
+
+// CacheVersion pins a cached UserRole to the generation it was loaded
+// under: Global moves with InvalidateAll, User with InvalidateUser.
+type CacheVersion struct {
+	Global int64
+	User   int64
+}
+
+// PermissionCache keeps resolved UserRoles so that permission checks do not
+// hit Postgres on every request.
+type PermissionCache interface {
+	// Version is the cache generation for userID.
+	Version(ctx context.Context, userID uuid.UUID) (CacheVersion, error)
+	Get(ctx context.Context, version CacheVersion, userID uuid.UUID) (*UserRole, error)
+	// Set stores ur under version, and skips the write when the cache has
+	// moved past version since, so a set loaded before an invalidation is
+	// not cached after it.
+	Set(ctx context.Context, version CacheVersion, ur UserRole) error
+	InvalidateUser(ctx context.Context, userID uuid.UUID) error
+	InvalidateAll(ctx context.Context) error
 }
@@ -1,5 +1,7 @@
 package rbac
 
+// Built-in roles seeded by db/schemas/users.schema.sql. Their grants can be
+// edited (except owner) but the roles themselves cannot be deleted.
 var (
 	User    = "user"
 	Admin   = "admin"
@@ -7,3 +9,11 @@ var (
 	Manager = "manager"
 	Staff   = "staff"
 )
+
+func IsBuiltinRole(name string) bool {
+	switch name {
+	case User, Admin, Owner, Manager, Staff:
+		return true
+	}
+	return false
+}
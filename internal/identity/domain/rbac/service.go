@@ -2,18 +2,84 @@ package rbac
 
 import (
 	"context"
+	"go-ai/internal/identity/domain/audit"
 
 	"github.com/google/uuid"
 )
 
+// Service resolves permissions. Audit, when set, records the checks that
+// RequirePermission denies.
 type Service struct {
-	Repo UserRoleRepo
+	Repo  UserRoleRepo
+	Cache PermissionCache
+	Audit audit.AuditLogger
+}
+
+// Resolve returns the user's role and effective permissions, reading through
+// the cache when one is configured. Cache errors fall back to the database.
+// The cache version is read before the database, so a grant or role change
+// landing in between keeps the result out of the cache.
+func (s Service) Resolve(ctx context.Context, userID uuid.UUID) (UserRole, error) {
+	if s.Cache == nil {
+		return s.Repo.GetUserRole(ctx, userID)
+	}
+	version, verr := s.Cache.Version(ctx, userID)
+	if verr == nil {
+		if ur, err := s.Cache.Get(ctx, version, userID); err == nil && ur != nil {
+			return *ur, nil
+		}
+	}
+	ur, err := s.Repo.GetUserRole(ctx, userID)
+	if err != nil {
+		return UserRole{}, err
+	}
+	if verr == nil {
+		_ = s.Cache.Set(ctx, version, ur)
+	}
+	return ur, nil
 }
 
 func (s Service) Check(ctx context.Context, userID uuid.UUID, perm string) (bool, error) {
-	agg, err := s.Repo.GetUserRole(ctx, userID)
+	agg, err := s.Resolve(ctx, userID)
 	if err != nil {
 		return false, err
 	}
 	return agg.HasPermission(perm), nil
 }
+
+// RolePermissions returns the grants of a role by name. Users hold exactly
+// the grants of their role.
+func (s Service) RolePermissions(ctx context.Context, role string) (PermissionSet, error) {
+	return s.Repo.GetRolePermissions(ctx, role)
+}
+
+// CheckGrants returns ErrGrantNotHeld unless userID holds every one of
+// grants, so that nobody can give a role more than they have themselves.
+func (s Service) CheckGrants(ctx context.Context, userID uuid.UUID, grants []string) error {
+	agg, err := s.Resolve(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !agg.Permissions.Covers(grants) {
+		return ErrGrantNotHeld
+	}
+	return nil
+}
+
+// InvalidateUser drops the cached permissions of one user, e.g. after a
+// role change or deactivation. Like InvalidateAll it moves a version, so a
+// Resolve already in flight cannot cache the old set afterwards.
+func (s Service) InvalidateUser(ctx context.Context, userID uuid.UUID) error {
+	if s.Cache == nil {
+		return nil
+	}
+	return s.Cache.InvalidateUser(ctx, userID)
+}
+
+// InvalidateAll drops every cached permission set, e.g. after grants change.
+func (s Service) InvalidateAll(ctx context.Context) error {
+	if s.Cache == nil {
+		return nil
+	}
+	return s.Cache.InvalidateAll(ctx)
+}
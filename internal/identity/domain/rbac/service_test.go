@@ -0,0 +1,132 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// memCache follows the contract of the Redis cache: Set is dropped once the
+// version has moved on.
+type memCache struct {
+	version int64
+	users   map[uuid.UUID]int64
+	entries map[string]UserRole
+}
+
+func (c *memCache) key(version CacheVersion, userID uuid.UUID) string {
+	return fmt.Sprintf("%d_%d_%s", version.Global, version.User, userID)
+}
+
+func (c *memCache) Version(_ context.Context, userID uuid.UUID) (CacheVersion, error) {
+	return CacheVersion{Global: c.version, User: c.users[userID]}, nil
+}
+
+func (c *memCache) Get(_ context.Context, version CacheVersion, userID uuid.UUID) (*UserRole, error) {
+	if ur, ok := c.entries[c.key(version, userID)]; ok {
+		return &ur, nil
+	}
+	return nil, nil
+}
+
+func (c *memCache) Set(ctx context.Context, version CacheVersion, ur UserRole) error {
+	if current, _ := c.Version(ctx, ur.UserID); current == version {
+		c.entries[c.key(version, ur.UserID)] = ur
+	}
+	return nil
+}
+
+func (c *memCache) InvalidateUser(_ context.Context, userID uuid.UUID) error {
+	c.users[userID]++
+	return nil
+}
+
+func (c *memCache) InvalidateAll(context.Context) error {
+	c.version++
+	return nil
+}
+
+// grantsRepo returns the role as it was when the query ran; during is called
+// after that, before Resolve gets the result.
+type grantsRepo struct {
+	role   UserRole
+	during func()
+}
+
+func (r *grantsRepo) GetUserRole(context.Context, uuid.UUID) (UserRole, error) {
+	ur := r.role
+	if r.during != nil {
+		r.during()
+	}
+	return ur, nil
+}
+
+func (r *grantsRepo) GetRolePermissions(context.Context, string) (PermissionSet, error) {
+	return nil, nil
+}
+
+func TestResolveSkipsCacheWhenInvalidatedDuringLoad(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	cache := &memCache{users: map[uuid.UUID]int64{}, entries: map[string]UserRole{}}
+	repo := &grantsRepo{role: UserRole{UserID: userID, Role: "manager", Permissions: PermissionSet{"models:*"}}}
+	svc := Service{Repo: repo, Cache: cache}
+
+	// The grant is revoked after the query read the old permissions.
+	repo.during = func() {
+		repo.role.Permissions = nil
+		_ = svc.InvalidateAll(ctx)
+	}
+	if ok, _ := svc.Check(ctx, userID, "models:read"); !ok {
+		t.Fatal("first check should see the grant it loaded")
+	}
+	repo.during = nil
+	if ok, _ := svc.Check(ctx, userID, "models:read"); ok {
+		t.Fatal("revoked grant was served from the cache")
+	}
+	if ok, _ := svc.Check(ctx, userID, "models:read"); ok {
+		t.Fatal("revoked grant came back")
+	}
+	if len(cache.entries) != 1 {
+		t.Fatalf("cache entries = %d, want 1", len(cache.entries))
+	}
+}
+
+func TestResolveSkipsCacheWhenUserInvalidatedDuringLoad(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	cache := &memCache{users: map[uuid.UUID]int64{}, entries: map[string]UserRole{}}
+	repo := &grantsRepo{role: UserRole{UserID: userID, Role: Admin, Permissions: PermissionSet{"users:*"}}}
+	svc := Service{Repo: repo, Cache: cache}
+
+	// The user is demoted after the query read the old role.
+	repo.during = func() {
+		repo.role = UserRole{UserID: userID, Role: "member"}
+		_ = svc.InvalidateUser(ctx, userID)
+	}
+	if ok, _ := svc.Check(ctx, userID, "users:read"); !ok {
+		t.Fatal("first check should see the role it loaded")
+	}
+	repo.during = nil
+	if ok, _ := svc.Check(ctx, userID, "users:read"); ok {
+		t.Fatal("old role was served from the cache")
+	}
+}
+
+func TestCheckGrantsRejectsWhatTheActorLacks(t *testing.T) {
+	ctx := context.Background()
+	actor := uuid.New()
+	svc := Service{Repo: &grantsRepo{role: UserRole{UserID: actor, Role: Admin, Permissions: PermissionSet{"models:*", RolesManage}}}}
+
+	if err := svc.CheckGrants(ctx, actor, []string{"models:read", RolesManage}); err != nil {
+		t.Fatalf("held grants rejected: %v", err)
+	}
+	for _, grants := range [][]string{{Wildcard}, {"jobs:read"}, {"models:read", "users:*"}} {
+		if err := svc.CheckGrants(ctx, actor, grants); !errors.Is(err, ErrGrantNotHeld) {
+			t.Errorf("CheckGrants(%v) = %v, want ErrGrantNotHeld", grants, err)
+		}
+	}
+}
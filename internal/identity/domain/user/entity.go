@@ -2,24 +2,26 @@ package user
 
 import (
 	"go-ai/pkg/helpers"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+// Entity is a user account as seen by administrators.
 type Entity struct {
-	ID       uuid.UUID
-	FullName string
-	Email    helpers.Email
-	Role     string
-	IsActive bool
+	ID                    uuid.UUID
+	FullName              string
+	Email                 helpers.Email
+	Role                  string
+	ImageUrl              string
+	IsActive              bool
+	PasswordResetRequired bool
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
 }
 
-// func (u *User) Validate() error {
-// 	if !strings.Contains(u.Email, "@") {
-// 		return ErrInvalidEmail
-// 	}
-// 	if strings.TrimSpace(u.FullName) == "" {
-// 		return ErrInvalidName
-// 	}
-// 	return nil
-// }
+type ListFilter struct {
+	Search   string
+	Role     string
+	IsActive *bool
+}
@@ -1 +1,16 @@
 package user
+
+import (
+	domainerr "go-ai/pkg/domain_err"
+	"net/http"
+)
+
+var (
+	ErrUserNotFound      = domainerr.New(http.StatusNotFound, "User not found")
+	ErrRoleNotFound      = domainerr.New(http.StatusBadRequest, "Role does not exist")
+	ErrRoleRequired      = domainerr.New(http.StatusBadRequest, "Role is required")
+	ErrActiveRequired    = domainerr.New(http.StatusBadRequest, "is_active is required")
+	ErrCannotModifySelf  = domainerr.New(http.StatusForbidden, "You cannot change your own role or status")
+	ErrOwnerProtected    = domainerr.New(http.StatusForbidden, "Only owners can manage owner accounts")
+	ErrRoleOutranksActor = domainerr.New(http.StatusForbidden, "You cannot manage a role with permissions you do not hold")
+)
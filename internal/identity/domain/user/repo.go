@@ -1,7 +1,15 @@
 package user
 
-// type Repository interface {
-// 	GetByID(id int64) (User, error)
-// 	GetByEmail(email string) (User, error)
-// 	Create(u User) (int64, error)
-// }
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	List(ctx context.Context, filter ListFilter, limit, offset int32) ([]Entity, int64, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*Entity, error)
+	SetRole(ctx context.Context, id uuid.UUID, role string) error
+	SetActive(ctx context.Context, id uuid.UUID, active bool) error
+	SetPasswordResetRequired(ctx context.Context, id uuid.UUID, required bool) error
+}
@@ -3,7 +3,12 @@ package cache
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"go-ai/internal/identity/domain/auth"
 	pkgcache "go-ai/pkg/cache"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,6 +22,11 @@ type UserCache struct {
 	Role     string
 	IsActive bool
 	ImageUrl string
+	Phone    string
+	// PhoneVerified is only kept for the profile.
+	PhoneVerified bool
+	// OrgID is the organization the session has switched to, or uuid.Nil.
+	OrgID uuid.UUID
 }
 
 type AuthCache struct {
@@ -73,6 +83,54 @@ func (a *AuthCache) SetLoginCaches(
 	return err
 }
 
+// SetActiveOrganization records the organization a session works in. The
+// session keeps its remaining lifetime.
+func (a *AuthCache) SetActiveOrganization(ctx context.Context, sid string, orgID uuid.UUID) error {
+	key := fmt.Sprintf("session_%s", sid)
+	data, err := a.session.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		return auth.ErrSessionNotFound
+	}
+	data.OrgID = orgID
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return a.client.Set(ctx, key, raw, redis.KeepTTL).Err()
+}
+
+// UpdateUserSessions applies update to the cached data of every indexed
+// session of the user, e.g. after a profile change. Each session keeps its
+// remaining lifetime, and sessions that have already expired are skipped.
+func (a *AuthCache) UpdateUserSessions(ctx context.Context, userID uuid.UUID, update func(*UserCache)) error {
+	sids, err := a.client.HKeys(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return err
+	}
+	for _, sid := range sids {
+		key := fmt.Sprintf("session_%s", sid)
+		data, err := a.session.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		if data == nil || data.UserID != userID {
+			continue
+		}
+		update(data)
+		raw, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		if err := a.client.SetArgs(ctx, key, raw, redis.SetArgs{KeepTTL: true, Mode: "XX"}).Err(); err != nil && !errors.Is(err, redis.Nil) {
+			return err
+		}
+	}
+	return nil
+}
+
 func (a *AuthCache) DeleteAuthCache(ctx context.Context, key string) error {
 	return a.session.Delete(ctx, key)
 }
@@ -108,3 +166,162 @@ func (a *AuthCache) IsTokenBlacklisted(ctx context.Context, key string) (bool, e
 	}
 	return val != nil, nil
 }
+
+func userSessionsKey(userID uuid.UUID) string {
+	return fmt.Sprintf("user_sessions_%s", userID)
+}
+
+func userSessionsSeenKey(userID uuid.UUID) string {
+	return fmt.Sprintf("user_sessions_seen_%s", userID)
+}
+
+// TrackSession records a session in the user's session index. The index is
+// a hash of sid to session metadata, with last-seen times kept in a second
+// hash so that TouchSession is a single HSET. Both expire with the last
+// refresh token.
+func (a *AuthCache) TrackSession(ctx context.Context, userID uuid.UUID, s auth.Session, ttl time.Duration) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	key := userSessionsKey(userID)
+	seenKey := userSessionsSeenKey(userID)
+	pipe := a.client.Pipeline()
+	pipe.HSet(ctx, key, s.ID, data)
+	pipe.HSet(ctx, seenKey, s.ID, s.LastSeenAt.Unix())
+	pipe.Expire(ctx, key, ttl)
+	pipe.Expire(ctx, seenKey, ttl)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (a *AuthCache) GetSession(ctx context.Context, userID uuid.UUID, sid string) (*auth.Session, error) {
+	val, err := a.client.HGet(ctx, userSessionsKey(userID), sid).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var s auth.Session
+	if err := json.Unmarshal([]byte(val), &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// TouchSession updates the last-seen time of a session.
+func (a *AuthCache) TouchSession(ctx context.Context, userID uuid.UUID, sid string, at time.Time) error {
+	return a.client.HSet(ctx, userSessionsSeenKey(userID), sid, at.Unix()).Err()
+}
+
+// ListSessions returns the user's live sessions. Entries whose refresh token
+// has expired are pruned from the index.
+func (a *AuthCache) ListSessions(ctx context.Context, userID uuid.UUID) ([]auth.Session, error) {
+	key := userSessionsKey(userID)
+	seenKey := userSessionsSeenKey(userID)
+	raw, err := a.client.HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+	seen, err := a.client.HGetAll(ctx, seenKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	sids := make([]string, 0, len(raw))
+	pipe := a.client.Pipeline()
+	exists := make([]*redis.IntCmd, 0, len(raw))
+	for sid := range raw {
+		sids = append(sids, sid)
+		exists = append(exists, pipe.Exists(ctx, fmt.Sprintf("refresh_token_%s", sid)))
+	}
+	if len(sids) > 0 {
+		if _, err := pipe.Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	sessions := make([]auth.Session, 0, len(sids))
+	var stale []string
+	for i, sid := range sids {
+		if exists[i].Val() == 0 {
+			stale = append(stale, sid)
+			continue
+		}
+		var s auth.Session
+		if err := json.Unmarshal([]byte(raw[sid]), &s); err != nil {
+			stale = append(stale, sid)
+			continue
+		}
+		if ts, err := strconv.ParseInt(seen[sid], 10, 64); err == nil {
+			s.LastSeenAt = time.Unix(ts, 0).UTC()
+		}
+		sessions = append(sessions, s)
+	}
+	if len(stale) > 0 {
+		pipe := a.client.Pipeline()
+		pipe.HDel(ctx, key, stale...)
+		pipe.HDel(ctx, seenKey, stale...)
+		_, _ = pipe.Exec(ctx)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenAt.After(sessions[j].LastSeenAt)
+	})
+	return sessions, nil
+}
+
+// RevokeSession deletes one session and its refresh token and removes it
+// from the index.
+func (a *AuthCache) RevokeSession(ctx context.Context, userID uuid.UUID, sid string) error {
+	pipe := a.client.Pipeline()
+	pipe.Del(ctx, fmt.Sprintf("session_%s", sid), fmt.Sprintf("refresh_token_%s", sid))
+	pipe.HDel(ctx, userSessionsKey(userID), sid)
+	pipe.HDel(ctx, userSessionsSeenKey(userID), sid)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// UntrackSession removes a session from the index without touching its keys.
+func (a *AuthCache) UntrackSession(ctx context.Context, userID uuid.UUID, sid string) error {
+	pipe := a.client.Pipeline()
+	pipe.HDel(ctx, userSessionsKey(userID), sid)
+	pipe.HDel(ctx, userSessionsSeenKey(userID), sid)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// RevokeUserSessions revokes every indexed session of the user and returns
+// how many sessions were revoked.
+func (a *AuthCache) RevokeUserSessions(ctx context.Context, userID uuid.UUID) (int, error) {
+	return a.RevokeOtherSessions(ctx, userID, "")
+}
+
+// RevokeOtherSessions revokes every session of the user except keepSid.
+func (a *AuthCache) RevokeOtherSessions(ctx context.Context, userID uuid.UUID, keepSid string) (int, error) {
+	key := userSessionsKey(userID)
+	seenKey := userSessionsSeenKey(userID)
+	sids, err := a.client.HKeys(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	pipe := a.client.Pipeline()
+	revoked := 0
+	for _, sid := range sids {
+		if sid == keepSid {
+			continue
+		}
+		pipe.Del(ctx, fmt.Sprintf("session_%s", sid), fmt.Sprintf("refresh_token_%s", sid))
+		pipe.HDel(ctx, key, sid)
+		pipe.HDel(ctx, seenKey, sid)
+		revoked++
+	}
+	if revoked == 0 {
+		return 0, nil
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return revoked, nil
+}
@@ -0,0 +1,79 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"go-ai/internal/identity/domain/auth"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// LoginAttemptCache keeps failed-login counters in Redis so limits hold across
+// replicas. Account keys use a hash of the normalised email instead of the
+// address itself.
+type LoginAttemptCache struct {
+	client *redis.Client
+}
+
+func NewLoginAttemptCache(client *redis.Client) *LoginAttemptCache {
+	return &LoginAttemptCache{client: client}
+}
+
+func accountKey(email string) string {
+	return auth.HashToken(strings.ToLower(strings.TrimSpace(email)))
+}
+
+func (l *LoginAttemptCache) Blocked(ctx context.Context, email, ip string) (bool, error) {
+	account := accountKey(email)
+	n, err := l.client.Exists(ctx,
+		fmt.Sprintf("login_lock_%s", account),
+		fmt.Sprintf("login_delay_%s", account),
+		fmt.Sprintf("login_lock_ip_%s", ip),
+	).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (l *LoginAttemptCache) RecordFailure(ctx context.Context, email, ip string, window time.Duration) (int64, int64, error) {
+	accountFails := fmt.Sprintf("login_fail_%s", accountKey(email))
+	ipFails := fmt.Sprintf("login_fail_ip_%s", ip)
+
+	// The window starts at the first failure and is not extended by later ones.
+	pipe := l.client.TxPipeline()
+	account := pipe.Incr(ctx, accountFails)
+	pipe.ExpireNX(ctx, accountFails, window)
+	byIP := pipe.Incr(ctx, ipFails)
+	pipe.ExpireNX(ctx, ipFails, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, 0, err
+	}
+	return account.Val(), byIP.Val(), nil
+}
+
+func (l *LoginAttemptCache) SetDelay(ctx context.Context, email string, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	return l.client.Set(ctx, fmt.Sprintf("login_delay_%s", accountKey(email)), 1, d).Err()
+}
+
+func (l *LoginAttemptCache) LockAccount(ctx context.Context, email string, d time.Duration) error {
+	return l.client.Set(ctx, fmt.Sprintf("login_lock_%s", accountKey(email)), 1, d).Err()
+}
+
+func (l *LoginAttemptCache) LockIP(ctx context.Context, ip string, d time.Duration) error {
+	return l.client.Set(ctx, fmt.Sprintf("login_lock_ip_%s", ip), 1, d).Err()
+}
+
+func (l *LoginAttemptCache) ClearAccount(ctx context.Context, email string) error {
+	account := accountKey(email)
+	return l.client.Del(ctx,
+		fmt.Sprintf("login_fail_%s", account),
+		fmt.Sprintf("login_delay_%s", account),
+		fmt.Sprintf("login_lock_%s", account),
+	).Err()
+}
@@ -0,0 +1,76 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	pkgcache "go-ai/pkg/cache"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// MFAChallenge links a login that passed the password check to its user until
+// the second factor is verified.
+type MFAChallenge struct {
+	UserID uuid.UUID
+}
+
+// MFACache holds login challenges, their failed attempt counters and the TOTP
+// steps already used per user. Challenges are keyed by the hash of the token.
+type MFACache struct {
+	client    *redis.Client
+	challenge *pkgcache.Cache[MFAChallenge]
+}
+
+func NewMFACache(client *redis.Client) *MFACache {
+	return &MFACache{
+		client: client,
+		challenge: pkgcache.New[MFAChallenge](client, pkgcache.Options{
+			CacheType: "mfa_challenge",
+			KeyPrefix: "mfa_challenge_",
+		}),
+	}
+}
+
+func (m *MFACache) CreateChallenge(ctx context.Context, tokenHash string, userID uuid.UUID, ttl time.Duration) error {
+	return m.challenge.Set(ctx, tokenHash, &MFAChallenge{UserID: userID}, ttl)
+}
+
+// GetChallenge returns nil when the challenge is unknown or expired.
+func (m *MFACache) GetChallenge(ctx context.Context, tokenHash string) (*MFAChallenge, error) {
+	return m.challenge.Get(ctx, tokenHash)
+}
+
+// FailChallenge records a wrong code and returns the number of failures so far.
+func (m *MFACache) FailChallenge(ctx context.Context, tokenHash string, ttl time.Duration) (int64, error) {
+	key := fmt.Sprintf("mfa_challenge_attempts_%s", tokenHash)
+	pipe := m.client.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+func (m *MFACache) DeleteChallenge(ctx context.Context, tokenHash string) error {
+	return m.client.Del(ctx,
+		fmt.Sprintf("mfa_challenge_%s", tokenHash),
+		fmt.Sprintf("mfa_challenge_attempts_%s", tokenHash),
+	).Err()
+}
+
+// UseTOTPStep marks a TOTP step as used by the user. It returns false when the
+// step was already used, so a code cannot be replayed inside its window.
+func (m *MFACache) UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64, ttl time.Duration) (bool, error) {
+	key := fmt.Sprintf("totp_used_%s_%d", userID, step)
+	err := m.client.SetArgs(ctx, key, 1, redis.SetArgs{Mode: "NX", TTL: ttl}).Err()
+	if err == redis.Nil {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
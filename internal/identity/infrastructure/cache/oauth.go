@@ -0,0 +1,54 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// OAuthState is what a login started with a provider needs at the callback.
+// LinkUserID is set when a signed-in user is linking a provider.
+type OAuthState struct {
+	Provider   string
+	Verifier   string
+	Nonce      string
+	LinkUserID uuid.UUID
+}
+
+// OAuthCache keeps pending provider logins keyed by the hash of the state
+// parameter. A state can be taken only once.
+type OAuthCache struct {
+	client *redis.Client
+}
+
+func NewOAuthCache(client *redis.Client) *OAuthCache {
+	return &OAuthCache{client: client}
+}
+
+func (o *OAuthCache) SaveState(ctx context.Context, stateHash string, state *OAuthState, ttl time.Duration) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return o.client.Set(ctx, "oauth_state_"+stateHash, data, ttl).Err()
+}
+
+// TakeState returns and deletes the state, or nil when it is unknown or
+// expired.
+func (o *OAuthCache) TakeState(ctx context.Context, stateHash string) (*OAuthState, error) {
+	val, err := o.client.GetDel(ctx, "oauth_state_"+stateHash).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var state OAuthState
+	if err := json.Unmarshal([]byte(val), &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"go-ai/internal/identity/domain/auth"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ResetCache throttles password reset emails. Limits count per email, keyed
+// by a hash of the address, and per client IP.
+type ResetCache struct {
+	client *redis.Client
+}
+
+func NewResetCache(client *redis.Client) *ResetCache {
+	return &ResetCache{client: client}
+}
+
+// ReserveSend reports whether a reset email may be requested for email from
+// ip. It enforces the cooldown between requests for one address and at most
+// max requests per window from one IP. Unknown addresses count the same as
+// known ones, so the limit does not reveal which accounts exist.
+func (r *ResetCache) ReserveSend(ctx context.Context, email, ip string, cooldown, window time.Duration, max int) (bool, error) {
+	if max > 0 && ip != "" {
+		key := fmt.Sprintf("password_reset_ip_%s", ip)
+		// The window starts at the first request and is not extended by later ones.
+		pipe := r.client.TxPipeline()
+		sends := pipe.Incr(ctx, key)
+		pipe.ExpireNX(ctx, key, window)
+		if _, err := pipe.Exec(ctx); err != nil {
+			return false, err
+		}
+		if sends.Val() > int64(max) {
+			return false, nil
+		}
+	}
+	if cooldown > 0 {
+		key := fmt.Sprintf("password_reset_cooldown_%s", auth.HashToken(email))
+		err := r.client.SetArgs(ctx, key, 1, redis.SetArgs{Mode: "NX", TTL: cooldown}).Err()
+		if err == redis.Nil {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
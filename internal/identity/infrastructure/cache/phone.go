@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"go-ai/internal/identity/domain/auth"
+	pkgcache "go-ai/pkg/cache"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// PhoneCode is the pending verification code of a user, stored as a hash.
+type PhoneCode struct {
+	Phone    string
+	CodeHash string
+}
+
+// PhoneCache holds pending phone verification codes, their failed attempt
+// counters and the send limits. Limits count per user and per number, the
+// latter keyed by a hash of the number.
+type PhoneCache struct {
+	client *redis.Client
+	code   *pkgcache.Cache[PhoneCode]
+}
+
+func NewPhoneCache(client *redis.Client) *PhoneCache {
+	return &PhoneCache{
+		client: client,
+		code: pkgcache.New[PhoneCode](client, pkgcache.Options{
+			CacheType: "phone_code",
+			KeyPrefix: "phone_code_",
+		}),
+	}
+}
+
+// ReserveSend reports whether a code may be sent to phone for the user. It
+// enforces the cooldown between codes and at most max codes per window, for
+// the user and for the number.
+func (p *PhoneCache) ReserveSend(ctx context.Context, userID uuid.UUID, phone string, cooldown, window time.Duration, max int) (bool, error) {
+	if cooldown > 0 {
+		key := fmt.Sprintf("phone_code_cooldown_%s", userID)
+		err := p.client.SetArgs(ctx, key, 1, redis.SetArgs{Mode: "NX", TTL: cooldown}).Err()
+		if err == redis.Nil {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+	if max <= 0 {
+		return true, nil
+	}
+	byUser := fmt.Sprintf("phone_code_sends_%s", userID)
+	byPhone := fmt.Sprintf("phone_code_sends_num_%s", auth.HashToken(phone))
+
+	// The window starts at the first code and is not extended by later ones.
+	pipe := p.client.TxPipeline()
+	userSends := pipe.Incr(ctx, byUser)
+	pipe.ExpireNX(ctx, byUser, window)
+	phoneSends := pipe.Incr(ctx, byPhone)
+	pipe.ExpireNX(ctx, byPhone, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, err
+	}
+	return userSends.Val() <= int64(max) && phoneSends.Val() <= int64(max), nil
+}
+
+// SaveCode replaces the user's pending code and resets its failed attempts.
+func (p *PhoneCache) SaveCode(ctx context.Context, userID uuid.UUID, code PhoneCode, ttl time.Duration) error {
+	if err := p.code.Set(ctx, userID.String(), &code, ttl); err != nil {
+		return err
+	}
+	return p.client.Del(ctx, fmt.Sprintf("phone_code_attempts_%s", userID)).Err()
+}
+
+// GetCode returns nil when no code is pending or it expired.
+func (p *PhoneCache) GetCode(ctx context.Context, userID uuid.UUID) (*PhoneCode, error) {
+	return p.code.Get(ctx, userID.String())
+}
+
+// FailCode records a wrong code and returns the number of failures so far.
+func (p *PhoneCache) FailCode(ctx context.Context, userID uuid.UUID, ttl time.Duration) (int64, error) {
+	key := fmt.Sprintf("phone_code_attempts_%s", userID)
+	pipe := p.client.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+func (p *PhoneCache) DeleteCode(ctx context.Context, userID uuid.UUID) error {
+	return p.client.Del(ctx,
+		fmt.Sprintf("phone_code_%s", userID),
+		fmt.Sprintf("phone_code_attempts_%s", userID),
+	).Err()
+}
@@ -0,0 +1,116 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go-ai/internal/identity/domain/rbac"
+	pkgcache "go-ai/pkg/cache"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	rbacVersionKey     = "rbac_perms_version"
+	rbacUserVersionKey = "rbac_perms_version_"
+	rbacKeyPrefix      = "rbac_perms_"
+	rbacTTL            = 10 * time.Minute
+)
+
+// setIfVersionScript stores ARGV[3] at KEYS[3] only while the global version
+// at KEYS[1] and the user version at KEYS[2], missing meaning 0, still equal
+// ARGV[1] and ARGV[2].
+var setIfVersionScript = redis.NewScript(`
+local global = redis.call("GET", KEYS[1]) or "0"
+local user = redis.call("GET", KEYS[2]) or "0"
+if global ~= ARGV[1] or user ~= ARGV[2] then
+	return 0
+end
+redis.call("SET", KEYS[3], ARGV[3], "PX", ARGV[4])
+return 1`)
+
+// RbacCache stores resolved permission sets per user. Keys embed a global
+// version so that a grant change invalidates every user with a single INCR,
+// and a per-user version bumped on role or status changes.
+type RbacCache struct {
+	client *redis.Client
+	perms  *pkgcache.Cache[rbac.UserRole]
+}
+
+func NewRbacCache(client *redis.Client) *RbacCache {
+	return &RbacCache{
+		client: client,
+		perms: pkgcache.New[rbac.UserRole](client, pkgcache.Options{
+			CacheType:  "rbac_perms",
+			KeyPrefix:  rbacKeyPrefix,
+			DefaultTTL: rbacTTL,
+		}),
+	}
+}
+
+func (r *RbacCache) Version(ctx context.Context, userID uuid.UUID) (rbac.CacheVersion, error) {
+	vals, err := r.client.MGet(ctx, rbacVersionKey, rbacUserVersionKey+userID.String()).Result()
+	if err != nil {
+		return rbac.CacheVersion{}, err
+	}
+	global, err := parseVersion(vals[0])
+	if err != nil {
+		return rbac.CacheVersion{}, err
+	}
+	user, err := parseVersion(vals[1])
+	if err != nil {
+		return rbac.CacheVersion{}, err
+	}
+	return rbac.CacheVersion{Global: global, User: user}, nil
+}
+
+func (r *RbacCache) Get(ctx context.Context, version rbac.CacheVersion, userID uuid.UUID) (*rbac.UserRole, error) {
+	return r.perms.Get(ctx, rbacKey(version, userID))
+}
+
+// Set writes in one script with the version check, so an invalidation
+// cannot slip in between the two.
+func (r *RbacCache) Set(ctx context.Context, version rbac.CacheVersion, ur rbac.UserRole) error {
+	data, err := json.Marshal(ur)
+	if err != nil {
+		return err
+	}
+	keys := []string{rbacVersionKey, rbacUserVersionKey + ur.UserID.String(), rbacKeyPrefix + rbacKey(version, ur.UserID)}
+	return setIfVersionScript.Run(ctx, r.client, keys, version.Global, version.User, data, rbacTTL.Milliseconds()).Err()
+}
+
+// InvalidateUser bumps the user's version. The counter lives for rbacTTL
+// after the last bump: by then every entry written under an older value has
+// expired, so restarting from 0 cannot revive one.
+func (r *RbacCache) InvalidateUser(ctx context.Context, userID uuid.UUID) error {
+	key := rbacUserVersionKey + userID.String()
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Incr(ctx, key)
+		pipe.PExpire(ctx, key, rbacTTL)
+		return nil
+	})
+	return err
+}
+
+// InvalidateAll bumps the global version; stale entries expire on their own.
+func (r *RbacCache) InvalidateAll(ctx context.Context) error {
+	return r.client.Incr(ctx, rbacVersionKey).Err()
+}
+
+func rbacKey(version rbac.CacheVersion, userID uuid.UUID) string {
+	return fmt.Sprintf("%d_%d_%s", version.Global, version.User, userID)
+}
+
+func parseVersion(v any) (int64, error) {
+	if v == nil {
+		return 0, nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return 0, fmt.Errorf("rbac cache: unexpected version %T", v)
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
@@ -0,0 +1,35 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"go-ai/internal/identity/domain/auth"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func (a *AuthCache) SaveStreamTicket(ctx context.Context, hash string, t auth.StreamTicket, ttl time.Duration) error {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return err
+	}
+	return a.client.Set(ctx, "stream_ticket_"+hash, data, ttl).Err()
+}
+
+// TakeStreamTicket reads and deletes the ticket in one command, so it can be
+// used only once.
+func (a *AuthCache) TakeStreamTicket(ctx context.Context, hash string) (*auth.StreamTicket, error) {
+	val, err := a.client.GetDel(ctx, "stream_ticket_"+hash).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var t auth.StreamTicket
+	if err := json.Unmarshal([]byte(val), &t); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
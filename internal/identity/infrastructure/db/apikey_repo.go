@@ -0,0 +1,133 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/identity/domain/apikey"
+	sqlc "go-ai/internal/identity/infrastructure/sqlc/user"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type APIKeyRepo struct {
+	queries *sqlc.Queries
+}
+
+func NewAPIKeyRepo(pool *pgxpool.Pool) *APIKeyRepo {
+	return &APIKeyRepo{
+		queries: sqlc.New(pool),
+	}
+}
+
+func (r *APIKeyRepo) Create(ctx context.Context, k *apikey.Entity) (*apikey.Entity, error) {
+	row, err := r.queries.CreateAPIKey(ctx, sqlc.CreateAPIKeyParams{
+		UserID:     k.UserID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		SecretHash: k.SecretHash,
+		Scopes:     k.Scopes,
+		AllowedIps: k.AllowedIPs,
+		ExpiresAt:  k.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	created := toAPIKey(row)
+	return &created, nil
+}
+
+func (r *APIKeyRepo) ListByUser(ctx context.Context, userID uuid.UUID) ([]apikey.Entity, error) {
+	rows, err := r.queries.ListAPIKeysByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]apikey.Entity, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, toAPIKey(row))
+	}
+	return keys, nil
+}
+
+func (r *APIKeyRepo) CountActive(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return r.queries.CountActiveAPIKeys(ctx, userID)
+}
+
+func (r *APIKeyRepo) FindByPrefix(ctx context.Context, prefix string) (*apikey.Credential, error) {
+	row, err := r.queries.GetAPIKeyByPrefix(ctx, prefix)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	role := ""
+	if row.RoleName != nil {
+		role = *row.RoleName
+	}
+	return &apikey.Credential{
+		Key: toAPIKey(sqlc.ApiKey{
+			ID:         row.ID,
+			UserID:     row.UserID,
+			Name:       row.Name,
+			Prefix:     row.Prefix,
+			SecretHash: row.SecretHash,
+			Scopes:     row.Scopes,
+			AllowedIps: row.AllowedIps,
+			ExpiresAt:  row.ExpiresAt,
+			LastUsedAt: row.LastUsedAt,
+			LastUsedIp: row.LastUsedIp,
+			RevokedAt:  row.RevokedAt,
+			CreatedAt:  row.CreatedAt,
+		}),
+		OwnerRole:   role,
+		OwnerActive: row.IsActive,
+	}, nil
+}
+
+func (r *APIKeyRepo) Revoke(ctx context.Context, userID, id uuid.UUID) error {
+	n, err := r.queries.RevokeAPIKey(ctx, sqlc.RevokeAPIKeyParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return apikey.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+func (r *APIKeyRepo) RevokeAll(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return r.queries.RevokeUserAPIKeys(ctx, userID)
+}
+
+func (r *APIKeyRepo) Touch(ctx context.Context, id uuid.UUID, ip string) error {
+	return r.queries.TouchAPIKey(ctx, sqlc.TouchAPIKeyParams{
+		Ip: ip,
+		ID: id,
+	})
+}
+
+func toAPIKey(row sqlc.ApiKey) apikey.Entity {
+	lastUsedIP := ""
+	if row.LastUsedIp != nil {
+		lastUsedIP = *row.LastUsedIp
+	}
+	return apikey.Entity{
+		ID:         row.ID,
+		UserID:     row.UserID,
+		Name:       row.Name,
+		Prefix:     row.Prefix,
+		SecretHash: row.SecretHash,
+		Scopes:     row.Scopes,
+		AllowedIPs: row.AllowedIps,
+		ExpiresAt:  row.ExpiresAt,
+		LastUsedAt: row.LastUsedAt,
+		LastUsedIP: lastUsedIP,
+		RevokedAt:  row.RevokedAt,
+		CreatedAt:  row.CreatedAt,
+	}
+}
@@ -0,0 +1,100 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"go-ai/internal/identity/domain/audit"
+	sqlc "go-ai/internal/identity/infrastructure/sqlc/user"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AuditRepo struct {
+	queries *sqlc.Queries
+}
+
+func NewAuditRepo(pool *pgxpool.Pool) *AuditRepo {
+	return &AuditRepo{
+		queries: sqlc.New(pool),
+	}
+}
+
+func (r *AuditRepo) Append(ctx context.Context, e audit.Event) error {
+	metadata := []byte("{}")
+	if len(e.Metadata) > 0 {
+		b, err := json.Marshal(e.Metadata)
+		if err != nil {
+			return err
+		}
+		metadata = b
+	}
+	var actorID *uuid.UUID
+	if e.ActorID != uuid.Nil {
+		actorID = &e.ActorID
+	}
+	return r.queries.CreateAuditEvent(ctx, sqlc.CreateAuditEventParams{
+		ActorID:    actorID,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		TargetID:   e.TargetID,
+		Ip:         e.IP,
+		UserAgent:  e.UserAgent,
+		RequestID:  e.RequestID,
+		Metadata:   metadata,
+	})
+}
+
+func (r *AuditRepo) List(ctx context.Context, filter audit.Filter, limit, offset int32) ([]audit.Event, int64, error) {
+	action := optionalText(filter.Action)
+	targetType := optionalText(filter.TargetType)
+	targetID := optionalText(filter.TargetID)
+	ip := optionalText(filter.IP)
+
+	rows, err := r.queries.ListAuditEvents(ctx, sqlc.ListAuditEventsParams{
+		ActorID:    filter.ActorID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Ip:         ip,
+		Since:      filter.Since,
+		Until:      filter.Until,
+		LimitRows:  limit,
+		OffsetRows: offset,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := r.queries.CountAuditEvents(ctx, sqlc.CountAuditEventsParams{
+		ActorID:    filter.ActorID,
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   targetID,
+		Ip:         ip,
+		Since:      filter.Since,
+		Until:      filter.Until,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	events := make([]audit.Event, 0, len(rows))
+	for _, row := range rows {
+		e := audit.Event{
+			ID:         row.ID,
+			Action:     row.Action,
+			TargetType: row.TargetType,
+			TargetID:   row.TargetID,
+			IP:         row.Ip,
+			UserAgent:  row.UserAgent,
+			RequestID:  row.RequestID,
+			CreatedAt:  row.CreatedAt,
+		}
+		if row.ActorID != nil {
+			e.ActorID = *row.ActorID
+		}
+		_ = json.Unmarshal(row.Metadata, &e.Metadata)
+		events = append(events, e)
+	}
+	return events, total, nil
+}
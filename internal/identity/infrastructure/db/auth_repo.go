@@ -2,21 +2,27 @@ package db
 
 import (
 	"context"
+	"errors"
+	"go-ai/internal/identity/domain/audit"
 	"go-ai/internal/identity/domain/auth"
 	sqlc "go-ai/internal/identity/infrastructure/sqlc/user"
 	"go-ai/pkg/helpers"
 	"go-ai/pkg/pgerr"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type AuthRepo struct {
+	pool    *pgxpool.Pool
 	queries *sqlc.Queries
 }
 
 func NewAuthRepo(pool *pgxpool.Pool) *AuthRepo {
 	return &AuthRepo{
+		pool:    pool,
 		queries: sqlc.New(pool),
 	}
 }
@@ -41,6 +47,10 @@ func (au *AuthRepo) GetByEmail(ctx context.Context, email string) (*auth.Entity,
 	if u.ImageUrl != nil {
 		imageUrl = *u.ImageUrl
 	}
+	phone := ""
+	if u.Phone != nil {
+		phone = *u.Phone
+	}
 	pw, _ := auth.NewPasswordFromHash(u.PasswordHash)
 	return &auth.Entity{
 		ID:       u.ID,
@@ -50,6 +60,12 @@ func (au *AuthRepo) GetByEmail(ctx context.Context, email string) (*auth.Entity,
 		Role:     role,
 		ImageUrl: imageUrl,
 		IsActive: u.IsActive,
+
+		PasswordResetRequired: u.PasswordResetRequired,
+		EmailVerified:         u.EmailVerifiedAt != nil,
+		TwoFactorEnabled:      u.TotpEnabledAt != nil,
+		Phone:                 phone,
+		PhoneVerified:         u.PhoneVerifiedAt != nil,
 	}, nil
 }
 
@@ -83,18 +99,38 @@ func (au *AuthRepo) GetById(ctx context.Context, id uuid.UUID) (*auth.Entity, er
 	if u.ID == uuid.Nil {
 		return nil, auth.ErrUserNotFound
 	}
+	if u.Email == nil {
+		return nil, auth.ErrInvalidEmail
+	}
 	em, err := helpers.NewEmail(*u.Email)
+	if err != nil {
+		return nil, auth.ErrInvalidEmail
+	}
+	role := ""
+	if u.RoleName != nil {
+		role = *u.RoleName
+	}
 	imageUrl := ""
 	if u.ImageUrl != nil {
 		imageUrl = *u.ImageUrl
 	}
+	phone := ""
+	if u.Phone != nil {
+		phone = *u.Phone
+	}
 	return &auth.Entity{
 		ID:       u.ID,
 		Email:    em,
 		FullName: u.FullName,
-		Role:     *u.RoleName,
+		Role:     role,
 		ImageUrl: imageUrl,
 		IsActive: u.IsActive,
+
+		PasswordResetRequired: u.PasswordResetRequired,
+		EmailVerified:         u.EmailVerifiedAt != nil,
+		TwoFactorEnabled:      u.TotpEnabledAt != nil,
+		Phone:                 phone,
+		PhoneVerified:         u.PhoneVerifiedAt != nil,
 	}, nil
 }
 
@@ -133,3 +169,173 @@ func (au *AuthRepo) UpdateProfile(ctx context.Context, u *auth.Entity) error {
 	}
 	return err
 }
+
+func (au *AuthRepo) ResetPassword(ctx context.Context, userID uuid.UUID, passwordHash string) error {
+	return au.queries.ResetPasswordByID(ctx, sqlc.ResetPasswordByIDParams{
+		PasswordHash: passwordHash,
+		UserID:       userID,
+	})
+}
+
+func (au *AuthRepo) MarkEmailVerified(ctx context.Context, userID uuid.UUID) error {
+	return au.queries.MarkEmailVerified(ctx, userID)
+}
+
+func (au *AuthRepo) SetPhone(ctx context.Context, userID uuid.UUID, phone string) error {
+	var value *string
+	if phone != "" {
+		value = &phone
+	}
+	n, err := au.queries.SetUserPhone(ctx, sqlc.SetUserPhoneParams{
+		Phone:  value,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return auth.ErrUserNotFound
+	}
+	return nil
+}
+
+func (au *AuthRepo) MarkPhoneVerified(ctx context.Context, userID uuid.UUID, phone string) error {
+	n, err := au.queries.MarkPhoneVerified(ctx, sqlc.MarkPhoneVerifiedParams{
+		UserID: userID,
+		Phone:  phone,
+	})
+	if err != nil {
+		if pgerr.IsUniqueViolation(err, "idx_users_phone_verified") {
+			return auth.ErrPhoneAlreadyUsed
+		}
+		return err
+	}
+	if n == 0 {
+		// The number changed after the code was sent.
+		return auth.ErrPhoneCodeInvalid
+	}
+	return nil
+}
+
+func (au *AuthRepo) ScheduleDeletion(ctx context.Context, userID uuid.UUID, at time.Time) error {
+	n, err := au.queries.ScheduleUserDeletion(ctx, sqlc.ScheduleUserDeletionParams{
+		DeletionScheduledAt: at,
+		UserID:              userID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return auth.ErrUserNotFound
+	}
+	return nil
+}
+
+func (au *AuthRepo) CancelDeletion(ctx context.Context, userID uuid.UUID) (bool, error) {
+	n, err := au.queries.CancelUserDeletion(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (au *AuthRepo) ListDueDeletions(ctx context.Context, now time.Time, limit int32) ([]uuid.UUID, error) {
+	return au.queries.ListUsersDueForDeletion(ctx, sqlc.ListUsersDueForDeletionParams{
+		Now:        now,
+		LimitCount: limit,
+	})
+}
+
+func (au *AuthRepo) DeleteAccount(ctx context.Context, userID uuid.UUID, now time.Time, purge func(ctx context.Context) ([]uuid.UUID, error)) (bool, error) {
+	tx, err := au.pool.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+	q := au.queries.WithTx(tx)
+
+	// NO KEY UPDATE blocks CancelUserDeletion but not the foreign key checks
+	// of the module purgers, which run on other connections.
+	email, err := q.LockScheduledUser(ctx, sqlc.LockScheduledUserParams{
+		UserID: userID,
+		Now:    now,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	orgIDs, err := purge(ctx)
+	if err != nil {
+		return false, err
+	}
+	if err := q.EnableAuditAnonymization(ctx); err != nil {
+		return false, err
+	}
+	if _, err := q.AnonymizeUserAuditEvents(ctx, sqlc.AnonymizeUserAuditEventsParams{
+		Keys:   audit.PersonalMetadata,
+		UserID: userID,
+		Email:  email,
+	}); err != nil {
+		return false, err
+	}
+	n, err := q.DeleteScheduledUser(ctx, sqlc.DeleteScheduledUserParams{
+		UserID: userID,
+		Now:    now,
+	})
+	if err != nil {
+		return false, err
+	}
+	if n == 0 {
+		return false, nil
+	}
+	for _, id := range orgIDs {
+		if _, err := q.DeleteOrganization(ctx, id); err != nil {
+			return false, err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (au *AuthRepo) CreateToken(ctx context.Context, userID uuid.UUID, purpose auth.TokenPurpose, hash string, expiresAt time.Time) error {
+	tx, err := au.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	q := au.queries.WithTx(tx)
+
+	if err := q.RevokeUserTokens(ctx, sqlc.RevokeUserTokensParams{
+		UserID:  userID,
+		Purpose: string(purpose),
+	}); err != nil {
+		return err
+	}
+	if err := q.CreateUserToken(ctx, sqlc.CreateUserTokenParams{
+		UserID:    userID,
+		Purpose:   string(purpose),
+		TokenHash: hash,
+		ExpiresAt: expiresAt,
+	}); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (au *AuthRepo) ConsumeToken(ctx context.Context, purpose auth.TokenPurpose, hash string) (uuid.UUID, error) {
+	userID, err := au.queries.ConsumeUserToken(ctx, sqlc.ConsumeUserTokenParams{
+		TokenHash: hash,
+		Purpose:   string(purpose),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, auth.ErrActionTokenInvalid
+		}
+		return uuid.Nil, err
+	}
+	return userID, nil
+}
@@ -0,0 +1,149 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/identity/domain/auth"
+	sqlc "go-ai/internal/identity/infrastructure/sqlc/user"
+	"go-ai/pkg/pgerr"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type IdentityRepo struct {
+	pool    *pgxpool.Pool
+	queries *sqlc.Queries
+}
+
+func NewIdentityRepo(pool *pgxpool.Pool) *IdentityRepo {
+	return &IdentityRepo{
+		pool:    pool,
+		queries: sqlc.New(pool),
+	}
+}
+
+func (r *IdentityRepo) GetIdentity(ctx context.Context, provider, subject string) (*auth.Identity, error) {
+	row, err := r.queries.GetUserIdentity(ctx, sqlc.GetUserIdentityParams{
+		Provider: provider,
+		Subject:  subject,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, auth.ErrIdentityNotFound
+		}
+		return nil, err
+	}
+	identity := toIdentity(row)
+	return &identity, nil
+}
+
+func (r *IdentityRepo) LinkIdentity(ctx context.Context, identity *auth.Identity) error {
+	return r.createIdentity(ctx, r.queries, identity)
+}
+
+func (r *IdentityRepo) CreateUserWithIdentity(ctx context.Context, u *auth.Entity, identity *auth.Identity) (uuid.UUID, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	defer tx.Rollback(ctx)
+	q := r.queries.WithTx(tx)
+
+	id, err := q.CreateUser(ctx, sqlc.CreateUserParams{
+		Email:        u.Email.String(),
+		PasswordHash: u.Password.String(),
+		FullName:     u.FullName,
+	})
+	if err != nil {
+		if pgerr.IsUniqueViolation(err, "user_email_key") {
+			return uuid.Nil, auth.ErrEmailAlreadyExists
+		}
+		if pgerr.IsUniqueViolation(err, "users_full_name_unique") {
+			return uuid.Nil, auth.ErrNameAlreadyExists
+		}
+		return uuid.Nil, err
+	}
+	if err := q.MarkEmailVerified(ctx, id); err != nil {
+		return uuid.Nil, err
+	}
+	identity.UserID = id
+	if err := r.createIdentity(ctx, q, identity); err != nil {
+		return uuid.Nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+func (r *IdentityRepo) TouchIdentity(ctx context.Context, id uuid.UUID, email string) error {
+	return r.queries.TouchUserIdentity(ctx, sqlc.TouchUserIdentityParams{
+		Email: optionalText(email),
+		ID:    id,
+	})
+}
+
+func (r *IdentityRepo) ListIdentities(ctx context.Context, userID uuid.UUID) ([]auth.Identity, error) {
+	rows, err := r.queries.ListUserIdentities(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	identities := make([]auth.Identity, 0, len(rows))
+	for _, row := range rows {
+		identities = append(identities, toIdentity(row))
+	}
+	return identities, nil
+}
+
+func (r *IdentityRepo) CountIdentities(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return r.queries.CountUserIdentities(ctx, userID)
+}
+
+func (r *IdentityRepo) UnlinkIdentity(ctx context.Context, userID, id uuid.UUID) error {
+	n, err := r.queries.DeleteUserIdentity(ctx, sqlc.DeleteUserIdentityParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return auth.ErrIdentityNotFound
+	}
+	return nil
+}
+
+func (r *IdentityRepo) createIdentity(ctx context.Context, q *sqlc.Queries, identity *auth.Identity) error {
+	row, err := q.CreateUserIdentity(ctx, sqlc.CreateUserIdentityParams{
+		UserID:   identity.UserID,
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		Email:    optionalText(identity.Email),
+	})
+	if err != nil {
+		if pgerr.IsUniqueViolation(err, "") {
+			return auth.ErrIdentityAlreadyLinked
+		}
+		return err
+	}
+	*identity = toIdentity(row)
+	return nil
+}
+
+func toIdentity(row sqlc.UserIdentity) auth.Identity {
+	email := ""
+	if row.Email != nil {
+		email = *row.Email
+	}
+	return auth.Identity{
+		ID:          row.ID,
+		UserID:      row.UserID,
+		Provider:    row.Provider,
+		Subject:     row.Subject,
+		Email:       email,
+		CreatedAt:   row.CreatedAt,
+		LastLoginAt: row.LastLoginAt,
+	}
+}
@@ -0,0 +1,129 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/identity/domain/auth"
+	sqlc "go-ai/internal/identity/infrastructure/sqlc/user"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type MFARepo struct {
+	pool    *pgxpool.Pool
+	queries *sqlc.Queries
+}
+
+func NewMFARepo(pool *pgxpool.Pool) *MFARepo {
+	return &MFARepo{
+		pool:    pool,
+		queries: sqlc.New(pool),
+	}
+}
+
+func (r *MFARepo) GetTOTP(ctx context.Context, userID uuid.UUID) (*auth.TOTPState, error) {
+	row, err := r.queries.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, auth.ErrUserNotFound
+		}
+		return nil, err
+	}
+	state := &auth.TOTPState{Enabled: row.TotpEnabledAt != nil}
+	if row.TotpSecret != nil {
+		state.Secret = *row.TotpSecret
+	}
+	return state, nil
+}
+
+func (r *MFARepo) SetPendingTOTP(ctx context.Context, userID uuid.UUID, encryptedSecret string) error {
+	n, err := r.queries.SetUserTOTPSecret(ctx, sqlc.SetUserTOTPSecretParams{
+		TotpSecret: encryptedSecret,
+		UserID:     userID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return auth.ErrMFAAlreadyEnabled
+	}
+	return nil
+}
+
+func (r *MFARepo) EnableTOTP(ctx context.Context, userID uuid.UUID, codeHashes []string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	q := r.queries.WithTx(tx)
+
+	n, err := q.EnableUserTOTP(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return auth.ErrMFANotEnrolled
+	}
+	if err := replaceRecoveryCodes(ctx, q, userID, codeHashes); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *MFARepo) DisableTOTP(ctx context.Context, userID uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	q := r.queries.WithTx(tx)
+
+	if err := q.DisableUserTOTP(ctx, userID); err != nil {
+		return err
+	}
+	if err := q.DeleteRecoveryCodes(ctx, userID); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *MFARepo) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, codeHashes []string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := replaceRecoveryCodes(ctx, r.queries.WithTx(tx), userID, codeHashes); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *MFARepo) ConsumeRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error) {
+	n, err := r.queries.ConsumeRecoveryCode(ctx, sqlc.ConsumeRecoveryCodeParams{
+		UserID:   userID,
+		CodeHash: codeHash,
+	})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *MFARepo) CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return r.queries.CountRecoveryCodes(ctx, userID)
+}
+
+func replaceRecoveryCodes(ctx context.Context, q *sqlc.Queries, userID uuid.UUID, codeHashes []string) error {
+	if err := q.DeleteRecoveryCodes(ctx, userID); err != nil {
+		return err
+	}
+	return q.CreateRecoveryCodes(ctx, sqlc.CreateRecoveryCodesParams{
+		UserID:     userID,
+		CodeHashes: codeHashes,
+	})
+}
@@ -0,0 +1,301 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/identity/domain/org"
+	sqlc "go-ai/internal/identity/infrastructure/sqlc/user"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type OrgRepo struct {
+	pool    *pgxpool.Pool
+	queries *sqlc.Queries
+}
+
+func NewOrgRepo(pool *pgxpool.Pool) *OrgRepo {
+	return &OrgRepo{
+		pool:    pool,
+		queries: sqlc.New(pool),
+	}
+}
+
+func (r *OrgRepo) Create(ctx context.Context, name string, ownerID uuid.UUID) (*org.Organization, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	q := r.queries.WithTx(tx)
+
+	row, err := q.CreateOrganization(ctx, sqlc.CreateOrganizationParams{
+		Name:      name,
+		CreatedBy: &ownerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := q.AddOrganizationMember(ctx, sqlc.AddOrganizationMemberParams{
+		OrganizationID: row.ID,
+		UserID:         ownerID,
+		Role:           string(org.RoleOwner),
+	}); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	o := toOrganization(row)
+	return &o, nil
+}
+
+func (r *OrgRepo) Get(ctx context.Context, id uuid.UUID) (*org.Organization, error) {
+	row, err := r.queries.GetOrganization(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, org.ErrNotFound
+		}
+		return nil, err
+	}
+	o := toOrganization(row)
+	return &o, nil
+}
+
+func (r *OrgRepo) Rename(ctx context.Context, id uuid.UUID, name string) error {
+	n, err := r.queries.RenameOrganization(ctx, sqlc.RenameOrganizationParams{
+		Name: name,
+		ID:   id,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return org.ErrNotFound
+	}
+	return nil
+}
+
+func (r *OrgRepo) MemberRole(ctx context.Context, orgID, userID uuid.UUID) (org.Role, error) {
+	role, err := r.queries.GetOrganizationMemberRole(ctx, sqlc.GetOrganizationMemberRoleParams{
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", org.ErrNotMember
+		}
+		return "", err
+	}
+	return org.Role(role), nil
+}
+
+func (r *OrgRepo) ListForUser(ctx context.Context, userID uuid.UUID) ([]org.Membership, error) {
+	rows, err := r.queries.ListUserOrganizations(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	memberships := make([]org.Membership, 0, len(rows))
+	for _, row := range rows {
+		memberships = append(memberships, org.Membership{
+			OrganizationID:   row.ID,
+			OrganizationName: row.Name,
+			UserID:           userID,
+			Role:             org.Role(row.Role),
+			CreatedAt:        row.CreatedAt,
+		})
+	}
+	return memberships, nil
+}
+
+func (r *OrgRepo) ListMembers(ctx context.Context, orgID uuid.UUID) ([]org.Membership, error) {
+	rows, err := r.queries.ListOrganizationMembers(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]org.Membership, 0, len(rows))
+	for _, row := range rows {
+		email := ""
+		if row.Email != nil {
+			email = *row.Email
+		}
+		members = append(members, org.Membership{
+			OrganizationID: orgID,
+			UserID:         row.UserID,
+			Email:          email,
+			FullName:       row.FullName,
+			Role:           org.Role(row.Role),
+			CreatedAt:      row.CreatedAt,
+		})
+	}
+	return members, nil
+}
+
+func (r *OrgRepo) SetMemberRole(ctx context.Context, orgID, userID uuid.UUID, role org.Role) error {
+	n, err := r.queries.UpdateOrganizationMemberRole(ctx, sqlc.UpdateOrganizationMemberRoleParams{
+		Role:           string(role),
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return org.ErrMemberNotFound
+	}
+	return nil
+}
+
+func (r *OrgRepo) RemoveMember(ctx context.Context, orgID, userID uuid.UUID) error {
+	n, err := r.queries.DeleteOrganizationMember(ctx, sqlc.DeleteOrganizationMemberParams{
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return org.ErrMemberNotFound
+	}
+	return nil
+}
+
+func (r *OrgRepo) CountOwners(ctx context.Context, orgID uuid.UUID) (int64, error) {
+	return r.queries.CountOrganizationOwners(ctx, orgID)
+}
+
+func (r *OrgRepo) ListSoleMemberOrganizations(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	return r.queries.ListSoleMemberOrganizations(ctx, userID)
+}
+
+func (r *OrgRepo) ListOrganizationsNeedingOwner(ctx context.Context, userID uuid.UUID) ([]org.Organization, error) {
+	rows, err := r.queries.ListOrganizationsNeedingOwner(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	orgs := make([]org.Organization, 0, len(rows))
+	for _, row := range rows {
+		orgs = append(orgs, toOrganization(row))
+	}
+	return orgs, nil
+}
+
+func (r *OrgRepo) CreateInvitation(ctx context.Context, inv *org.Invitation, tokenHash string) (*org.Invitation, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	q := r.queries.WithTx(tx)
+
+	if err := q.DeletePendingOrganizationInvitations(ctx, sqlc.DeletePendingOrganizationInvitationsParams{
+		OrganizationID: inv.OrganizationID,
+		Email:          inv.Email,
+	}); err != nil {
+		return nil, err
+	}
+	row, err := q.CreateOrganizationInvitation(ctx, sqlc.CreateOrganizationInvitationParams{
+		OrganizationID: inv.OrganizationID,
+		Email:          inv.Email,
+		Role:           string(inv.Role),
+		TokenHash:      tokenHash,
+		InvitedBy:      inv.InvitedBy,
+		ExpiresAt:      inv.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	created := toInvitation(row)
+	return &created, nil
+}
+
+func (r *OrgRepo) ListInvitations(ctx context.Context, orgID uuid.UUID) ([]org.Invitation, error) {
+	rows, err := r.queries.ListOrganizationInvitations(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	invitations := make([]org.Invitation, 0, len(rows))
+	for _, row := range rows {
+		invitations = append(invitations, toInvitation(row))
+	}
+	return invitations, nil
+}
+
+func (r *OrgRepo) RevokeInvitation(ctx context.Context, orgID, id uuid.UUID) error {
+	n, err := r.queries.DeleteOrganizationInvitation(ctx, sqlc.DeleteOrganizationInvitationParams{
+		ID:             id,
+		OrganizationID: orgID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return org.ErrInvitationNotFound
+	}
+	return nil
+}
+
+func (r *OrgRepo) AcceptInvitation(ctx context.Context, tokenHash string, userID uuid.UUID, email string) (*org.Invitation, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	q := r.queries.WithTx(tx)
+
+	row, err := q.AcceptOrganizationInvitation(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, org.ErrInvitationInvalid
+		}
+		return nil, err
+	}
+	if !strings.EqualFold(row.Email, email) {
+		return nil, org.ErrInvitationEmail
+	}
+	n, err := q.AddOrganizationMember(ctx, sqlc.AddOrganizationMemberParams{
+		OrganizationID: row.OrganizationID,
+		UserID:         userID,
+		Role:           row.Role,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, org.ErrAlreadyMember
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	inv := toInvitation(row)
+	return &inv, nil
+}
+
+func toOrganization(row sqlc.Organization) org.Organization {
+	return org.Organization{
+		ID:        row.ID,
+		Name:      row.Name,
+		CreatedBy: row.CreatedBy,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
+
+func toInvitation(row sqlc.OrganizationInvitation) org.Invitation {
+	return org.Invitation{
+		ID:             row.ID,
+		OrganizationID: row.OrganizationID,
+		Email:          row.Email,
+		Role:           org.Role(row.Role),
+		InvitedBy:      row.InvitedBy,
+		ExpiresAt:      row.ExpiresAt,
+		AcceptedAt:     row.AcceptedAt,
+		CreatedAt:      row.CreatedAt,
+	}
+}
@@ -2,34 +2,225 @@ package db
 
 import (
 	"context"
+	"errors"
 	"go-ai/internal/identity/domain/rbac"
 	sqlc "go-ai/internal/identity/infrastructure/sqlc/user"
+	"go-ai/pkg/pgerr"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type RbacRepo struct {
-	q *sqlc.Queries
+	pool *pgxpool.Pool
+	q    *sqlc.Queries
 }
 
 func NewRbacRepo(pool *pgxpool.Pool) *RbacRepo {
 	return &RbacRepo{
-		q: sqlc.New(pool),
+		pool: pool,
+		q:    sqlc.New(pool),
 	}
 }
 
+// GetUserRole returns an empty permission set for unknown or inactive users
+// so that permission checks deny them instead of failing.
 func (ru *RbacRepo) GetUserRole(ctx context.Context, userID uuid.UUID) (rbac.UserRole, error) {
 	u, err := ru.q.GetUserRole(ctx, sqlc.GetUserRoleParams{
 		UserID:   userID,
 		IsActive: true,
 	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return rbac.UserRole{UserID: userID}, nil
+		}
+		return rbac.UserRole{}, err
+	}
+	perms, err := ru.q.ListUserPermissions(ctx, userID)
 	if err != nil {
 		return rbac.UserRole{}, err
 	}
 
+	role := ""
+	if u != nil {
+		role = *u
+	}
 	return rbac.UserRole{
-		UserID: userID,
-		Role:   *u,
+		UserID:      userID,
+		Role:        role,
+		Permissions: perms,
 	}, nil
 }
+
+// GetRolePermissions returns the grants of the role with that name, or none
+// when there is no such role.
+func (ru *RbacRepo) GetRolePermissions(ctx context.Context, role string) (rbac.PermissionSet, error) {
+	return ru.q.ListPermissionsByRoleName(ctx, role)
+}
+
+func (ru *RbacRepo) ListRoles(ctx context.Context) ([]rbac.Role, error) {
+	rows, err := ru.q.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	grants, err := ru.q.ListRolePermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byRole := make(map[int32][]string, len(rows))
+	for _, g := range grants {
+		byRole[g.RoleID] = append(byRole[g.RoleID], g.Name)
+	}
+
+	roles := make([]rbac.Role, 0, len(rows))
+	for _, r := range rows {
+		roles = append(roles, toRole(r, byRole[r.ID]))
+	}
+	return roles, nil
+}
+
+func (ru *RbacRepo) GetRoleByID(ctx context.Context, id int) (*rbac.Role, error) {
+	r, err := ru.q.GetRoleByID(ctx, int32(id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, rbac.ErrRoleNotFound
+		}
+		return nil, err
+	}
+	perms, err := ru.q.ListPermissionsByRole(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	role := toRole(r, perms)
+	return &role, nil
+}
+
+func (ru *RbacRepo) CreateRole(ctx context.Context, name string, permissions []string) (*rbac.Role, error) {
+	tx, err := ru.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	q := ru.q.WithTx(tx)
+
+	r, err := q.CreateRole(ctx, name)
+	if err != nil {
+		if pgerr.IsUniqueViolation(err, "roles_role_name_key") {
+			return nil, rbac.ErrRoleAlreadyExists
+		}
+		return nil, err
+	}
+	if err := addRolePermissions(ctx, q, r.ID, permissions); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	role := toRole(r, permissions)
+	return &role, nil
+}
+
+func (ru *RbacRepo) DeleteRole(ctx context.Context, id int) error {
+	n, err := ru.q.DeleteRole(ctx, int32(id))
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return rbac.ErrRoleNotFound
+	}
+	return nil
+}
+
+func (ru *RbacRepo) CountUsersByRole(ctx context.Context, id int) (int64, error) {
+	return ru.q.CountUsersByRole(ctx, int32(id))
+}
+
+// SetRolePermissions replaces the grants of a role in one transaction.
+func (ru *RbacRepo) SetRolePermissions(ctx context.Context, id int, permissions []string) error {
+	tx, err := ru.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	q := ru.q.WithTx(tx)
+
+	if err := q.DeleteRolePermissions(ctx, int32(id)); err != nil {
+		return err
+	}
+	if err := addRolePermissions(ctx, q, int32(id), permissions); err != nil {
+		return err
+	}
+	if err := q.TouchRole(ctx, int32(id)); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (ru *RbacRepo) ListPermissions(ctx context.Context) ([]rbac.Permission, error) {
+	rows, err := ru.q.ListPermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	perms := make([]rbac.Permission, 0, len(rows))
+	for _, p := range rows {
+		perms = append(perms, toPermission(p))
+	}
+	return perms, nil
+}
+
+func (ru *RbacRepo) CreatePermission(ctx context.Context, name, description string) (*rbac.Permission, error) {
+	p, err := ru.q.CreatePermission(ctx, sqlc.CreatePermissionParams{
+		Name:        name,
+		Description: description,
+	})
+	if err != nil {
+		if pgerr.IsUniqueViolation(err, "permissions_name_key") {
+			return nil, rbac.ErrPermissionExists
+		}
+		return nil, err
+	}
+	perm := toPermission(p)
+	return &perm, nil
+}
+
+// addRolePermissions inserts grants by name and fails when one of the names
+// is not in the permissions catalogue.
+func addRolePermissions(ctx context.Context, q *sqlc.Queries, roleID int32, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	n, err := q.AddRolePermissions(ctx, sqlc.AddRolePermissionsParams{
+		RoleID: roleID,
+		Names:  names,
+	})
+	if err != nil {
+		return err
+	}
+	if n != int64(len(names)) {
+		return rbac.ErrPermissionNotFound
+	}
+	return nil
+}
+
+func toRole(r sqlc.Role, permissions []string) rbac.Role {
+	if permissions == nil {
+		permissions = []string{}
+	}
+	return rbac.Role{
+		ID:          int(r.ID),
+		Name:        r.RoleName,
+		Permissions: permissions,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
+
+func toPermission(p sqlc.Permission) rbac.Permission {
+	return rbac.Permission{
+		ID:          int(p.ID),
+		Name:        p.Name,
+		Description: p.Description,
+		CreatedAt:   p.CreatedAt,
+	}
+}
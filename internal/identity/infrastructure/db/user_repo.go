@@ -0,0 +1,141 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/identity/domain/user"
+	sqlc "go-ai/internal/identity/infrastructure/sqlc/user"
+	"go-ai/pkg/helpers"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type UserRepo struct {
+	queries *sqlc.Queries
+}
+
+func NewUserRepo(pool *pgxpool.Pool) *UserRepo {
+	return &UserRepo{
+		queries: sqlc.New(pool),
+	}
+}
+
+func (r *UserRepo) List(ctx context.Context, filter user.ListFilter, limit, offset int32) ([]user.Entity, int64, error) {
+	search := optionalText(filter.Search)
+	role := optionalText(filter.Role)
+
+	rows, err := r.queries.ListUsers(ctx, sqlc.ListUsersParams{
+		Search:     search,
+		RoleName:   role,
+		IsActive:   filter.IsActive,
+		LimitRows:  limit,
+		OffsetRows: offset,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := r.queries.CountUsers(ctx, sqlc.CountUsersParams{
+		Search:   search,
+		RoleName: role,
+		IsActive: filter.IsActive,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	users := make([]user.Entity, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, toUser(sqlc.GetUserAccountRow(row)))
+	}
+	return users, total, nil
+}
+
+func (r *UserRepo) GetByID(ctx context.Context, id uuid.UUID) (*user.Entity, error) {
+	row, err := r.queries.GetUserAccount(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, user.ErrUserNotFound
+		}
+		return nil, err
+	}
+	u := toUser(row)
+	return &u, nil
+}
+
+// SetRole assigns a role by name. It reports ErrRoleNotFound when either the
+// role or the user is missing, so callers should load the user first.
+func (r *UserRepo) SetRole(ctx context.Context, id uuid.UUID, role string) error {
+	n, err := r.queries.SetUserRole(ctx, sqlc.SetUserRoleParams{
+		RoleName: role,
+		UserID:   id,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return user.ErrRoleNotFound
+	}
+	return nil
+}
+
+func (r *UserRepo) SetActive(ctx context.Context, id uuid.UUID, active bool) error {
+	n, err := r.queries.SetUserActive(ctx, sqlc.SetUserActiveParams{
+		IsActive: active,
+		UserID:   id,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return user.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *UserRepo) SetPasswordResetRequired(ctx context.Context, id uuid.UUID, required bool) error {
+	n, err := r.queries.SetPasswordResetRequired(ctx, sqlc.SetPasswordResetRequiredParams{
+		Required: required,
+		UserID:   id,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return user.ErrUserNotFound
+	}
+	return nil
+}
+
+func toUser(row sqlc.GetUserAccountRow) user.Entity {
+	u := user.Entity{
+		ID:                    row.ID,
+		FullName:              row.FullName,
+		IsActive:              row.IsActive,
+		PasswordResetRequired: row.PasswordResetRequired,
+		CreatedAt:             row.CreatedAt,
+		UpdatedAt:             row.UpdatedAt,
+	}
+	if row.Email != nil {
+		if em, err := helpers.NewEmail(*row.Email); err == nil {
+			u.Email = em
+		}
+	}
+	if row.RoleName != nil {
+		u.Role = *row.RoleName
+	}
+	if row.ImageUrl != nil {
+		u.ImageUrl = *row.ImageUrl
+	}
+	return u
+}
+
+func optionalText(v string) *string {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return nil
+	}
+	return &v
+}
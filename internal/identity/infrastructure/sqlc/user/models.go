@@ -10,6 +10,68 @@ import (
 	"github.com/google/uuid"
 )
 
+type ApiKey struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Name       string
+	Prefix     string
+	SecretHash string
+	Scopes     []string
+	AllowedIps []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	LastUsedIp *string
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+type AuditEvent struct {
+	ID         uuid.UUID
+	ActorID    *uuid.UUID
+	Action     string
+	TargetType string
+	TargetID   string
+	Ip         string
+	UserAgent  string
+	RequestID  string
+	Metadata   []byte
+	CreatedAt  time.Time
+}
+
+type Organization struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedBy *uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type OrganizationInvitation struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Email          string
+	Role           string
+	TokenHash      string
+	InvitedBy      *uuid.UUID
+	ExpiresAt      time.Time
+	AcceptedAt     *time.Time
+	CreatedAt      time.Time
+}
+
+type OrganizationMember struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	Role           string
+	CreatedAt      time.Time
+}
+
+type Permission struct {
+	ID          int32
+	Name        string
+	Description string
+	CreatedAt   time.Time
+}
+
 type Role struct {
 	ID        int32
 	RoleName  string
@@ -17,14 +79,55 @@ type Role struct {
 	UpdatedAt time.Time
 }
 
-type User struct {
-	ID           uuid.UUID
-	FullName     string
-	Email        *string
-	PasswordHash string
-	RoleID       int
-	ImageUrl     *string
-	IsActive     bool
+type RolePermission struct {
+	RoleID       int32
+	PermissionID int32
 	CreatedAt    time.Time
-	UpdatedAt    time.Time
+}
+
+type User struct {
+	ID                    uuid.UUID
+	FullName              string
+	Email                 *string
+	PasswordHash          string
+	RoleID                int
+	ImageUrl              *string
+	Phone                 *string
+	PhoneVerifiedAt       *time.Time
+	IsActive              bool
+	PasswordResetRequired bool
+	EmailVerifiedAt       *time.Time
+	TotpSecret            *string
+	TotpEnabledAt         *time.Time
+	DeletionScheduledAt   *time.Time
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+type UserIdentity struct {
+	ID          uuid.UUID
+	UserID      uuid.UUID
+	Provider    string
+	Subject     string
+	Email       *string
+	CreatedAt   time.Time
+	LastLoginAt *time.Time
+}
+
+type UserRecoveryCode struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	CodeHash  string
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+type UserToken struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Purpose   string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
 }
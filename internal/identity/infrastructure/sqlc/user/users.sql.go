@@ -12,6 +12,509 @@ import (
 	"github.com/google/uuid"
 )
 
+const acceptOrganizationInvitation = `-- name: AcceptOrganizationInvitation :one
+UPDATE "organization_invitations"
+SET accepted_at = NOW()
+WHERE token_hash = $1::TEXT
+  AND accepted_at IS NULL
+  AND expires_at > NOW()
+RETURNING id, organization_id, email, role, token_hash, invited_by, expires_at, accepted_at, created_at
+`
+
+func (q *Queries) AcceptOrganizationInvitation(ctx context.Context, tokenHash string) (OrganizationInvitation, error) {
+	row := q.db.QueryRow(ctx, acceptOrganizationInvitation, tokenHash)
+	var i OrganizationInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Email,
+		&i.Role,
+		&i.TokenHash,
+		&i.InvitedBy,
+		&i.ExpiresAt,
+		&i.AcceptedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const addOrganizationMember = `-- name: AddOrganizationMember :execrows
+INSERT INTO "organization_members" (organization_id, user_id, role)
+VALUES (
+    $1::UUID,
+    $2::UUID,
+    $3::TEXT
+)
+ON CONFLICT (organization_id, user_id) DO NOTHING
+`
+
+type AddOrganizationMemberParams struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	Role           string
+}
+
+func (q *Queries) AddOrganizationMember(ctx context.Context, arg AddOrganizationMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, addOrganizationMember, arg.OrganizationID, arg.UserID, arg.Role)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const addRolePermissions = `-- name: AddRolePermissions :execrows
+INSERT INTO "role_permissions" (role_id, permission_id)
+SELECT $1::INT, p.id
+FROM "permissions" p
+WHERE p.name = ANY($2::TEXT[])
+ON CONFLICT DO NOTHING
+`
+
+type AddRolePermissionsParams struct {
+	RoleID int32
+	Names  []string
+}
+
+func (q *Queries) AddRolePermissions(ctx context.Context, arg AddRolePermissionsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, addRolePermissions, arg.RoleID, arg.Names)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const anonymizeUserAuditEvents = `-- name: AnonymizeUserAuditEvents :execrows
+UPDATE "audit_events"
+SET ip = '',
+    user_agent = '',
+    metadata = metadata - $1::TEXT[]
+WHERE actor_id = $2::UUID
+   OR (target_type = 'user' AND target_id = $2::UUID::TEXT)
+   OR (action = 'auth.login_failed' AND metadata->>'email' = $3::TEXT)
+`
+
+type AnonymizeUserAuditEventsParams struct {
+	Keys   []string
+	UserID uuid.UUID
+	Email  *string
+}
+
+func (q *Queries) AnonymizeUserAuditEvents(ctx context.Context, arg AnonymizeUserAuditEventsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, anonymizeUserAuditEvents, arg.Keys, arg.UserID, arg.Email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const cancelUserDeletion = `-- name: CancelUserDeletion :execrows
+UPDATE "users"
+SET deletion_scheduled_at = NULL
+WHERE id = $1::UUID
+  AND deletion_scheduled_at IS NOT NULL
+`
+
+func (q *Queries) CancelUserDeletion(ctx context.Context, userID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, cancelUserDeletion, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const consumeRecoveryCode = `-- name: ConsumeRecoveryCode :execrows
+UPDATE "user_recovery_codes"
+SET used_at = NOW()
+WHERE user_id = $1::UUID
+  AND code_hash = $2::TEXT
+  AND used_at IS NULL
+`
+
+type ConsumeRecoveryCodeParams struct {
+	UserID   uuid.UUID
+	CodeHash string
+}
+
+func (q *Queries) ConsumeRecoveryCode(ctx context.Context, arg ConsumeRecoveryCodeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, consumeRecoveryCode, arg.UserID, arg.CodeHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const consumeUserToken = `-- name: ConsumeUserToken :one
+UPDATE "user_tokens"
+SET used_at = NOW()
+WHERE token_hash = $1::TEXT
+  AND purpose = $2::TEXT
+  AND used_at IS NULL
+  AND expires_at > NOW()
+RETURNING user_id
+`
+
+type ConsumeUserTokenParams struct {
+	TokenHash string
+	Purpose   string
+}
+
+func (q *Queries) ConsumeUserToken(ctx context.Context, arg ConsumeUserTokenParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, consumeUserToken, arg.TokenHash, arg.Purpose)
+	var user_id uuid.UUID
+	err := row.Scan(&user_id)
+	return user_id, err
+}
+
+const countActiveAPIKeys = `-- name: CountActiveAPIKeys :one
+SELECT COUNT(*)
+FROM "api_keys"
+WHERE user_id = $1::UUID
+  AND revoked_at IS NULL
+  AND (expires_at IS NULL OR expires_at > NOW())
+`
+
+func (q *Queries) CountActiveAPIKeys(ctx context.Context, userID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countActiveAPIKeys, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countAuditEvents = `-- name: CountAuditEvents :one
+SELECT COUNT(*)
+FROM "audit_events"
+WHERE ($1::UUID IS NULL OR actor_id = $1::UUID)
+  AND ($2::TEXT IS NULL OR action = $2::TEXT)
+  AND ($3::TEXT IS NULL OR target_type = $3::TEXT)
+  AND ($4::TEXT IS NULL OR target_id = $4::TEXT)
+  AND ($5::TEXT IS NULL OR ip = $5::TEXT)
+  AND ($6::TIMESTAMPTZ IS NULL OR created_at >= $6::TIMESTAMPTZ)
+  AND ($7::TIMESTAMPTZ IS NULL OR created_at < $7::TIMESTAMPTZ)
+`
+
+type CountAuditEventsParams struct {
+	ActorID    *uuid.UUID
+	Action     *string
+	TargetType *string
+	TargetID   *string
+	Ip         *string
+	Since      *time.Time
+	Until      *time.Time
+}
+
+func (q *Queries) CountAuditEvents(ctx context.Context, arg CountAuditEventsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countAuditEvents,
+		arg.ActorID,
+		arg.Action,
+		arg.TargetType,
+		arg.TargetID,
+		arg.Ip,
+		arg.Since,
+		arg.Until,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countOrganizationOwners = `-- name: CountOrganizationOwners :one
+SELECT COUNT(*)
+FROM "organization_members"
+WHERE organization_id = $1::UUID
+  AND role = 'owner'
+`
+
+func (q *Queries) CountOrganizationOwners(ctx context.Context, organizationID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countOrganizationOwners, organizationID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countRecoveryCodes = `-- name: CountRecoveryCodes :one
+SELECT COUNT(*)
+FROM "user_recovery_codes"
+WHERE user_id = $1::UUID
+  AND used_at IS NULL
+`
+
+func (q *Queries) CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countRecoveryCodes, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUserIdentities = `-- name: CountUserIdentities :one
+SELECT COUNT(*)
+FROM "user_identities"
+WHERE user_id = $1::UUID
+`
+
+func (q *Queries) CountUserIdentities(ctx context.Context, userID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countUserIdentities, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUsers = `-- name: CountUsers :one
+SELECT COUNT(*)
+FROM "users" u
+LEFT JOIN "roles" r ON r.id = u.role_id
+WHERE ($1::TEXT IS NULL
+       OR u.email ILIKE '%' || $1::TEXT || '%'
+       OR u.full_name ILIKE '%' || $1::TEXT || '%')
+  AND ($2::TEXT IS NULL OR r.role_name = $2::TEXT)
+  AND ($3::BOOLEAN IS NULL OR u.is_active = $3::BOOLEAN)
+`
+
+type CountUsersParams struct {
+	Search   *string
+	RoleName *string
+	IsActive *bool
+}
+
+func (q *Queries) CountUsers(ctx context.Context, arg CountUsersParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countUsers, arg.Search, arg.RoleName, arg.IsActive)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUsersByRole = `-- name: CountUsersByRole :one
+SELECT COUNT(*)
+FROM "users"
+WHERE role_id = $1::INT
+`
+
+func (q *Queries) CountUsersByRole(ctx context.Context, roleID int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countUsersByRole, roleID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAPIKey = `-- name: CreateAPIKey :one
+INSERT INTO "api_keys" (user_id, name, prefix, secret_hash, scopes, allowed_ips, expires_at)
+VALUES (
+    $1::UUID,
+    $2::TEXT,
+    $3::TEXT,
+    $4::TEXT,
+    $5::TEXT[],
+    $6::TEXT[],
+    $7::TIMESTAMPTZ
+)
+RETURNING id, user_id, name, prefix, secret_hash, scopes, allowed_ips, expires_at, last_used_at, last_used_ip, revoked_at, created_at
+`
+
+type CreateAPIKeyParams struct {
+	UserID     uuid.UUID
+	Name       string
+	Prefix     string
+	SecretHash string
+	Scopes     []string
+	AllowedIps []string
+	ExpiresAt  *time.Time
+}
+
+func (q *Queries) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (ApiKey, error) {
+	row := q.db.QueryRow(ctx, createAPIKey,
+		arg.UserID,
+		arg.Name,
+		arg.Prefix,
+		arg.SecretHash,
+		arg.Scopes,
+		arg.AllowedIps,
+		arg.ExpiresAt,
+	)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Prefix,
+		&i.SecretHash,
+		&i.Scopes,
+		&i.AllowedIps,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.LastUsedIp,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createAuditEvent = `-- name: CreateAuditEvent :exec
+INSERT INTO "audit_events" (actor_id, action, target_type, target_id, ip, user_agent, request_id, metadata)
+VALUES (
+    $1::UUID,
+    $2::TEXT,
+    $3::TEXT,
+    $4::TEXT,
+    $5::TEXT,
+    $6::TEXT,
+    $7::TEXT,
+    $8::JSONB
+)
+`
+
+type CreateAuditEventParams struct {
+	ActorID    *uuid.UUID
+	Action     string
+	TargetType string
+	TargetID   string
+	Ip         string
+	UserAgent  string
+	RequestID  string
+	Metadata   []byte
+}
+
+func (q *Queries) CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) error {
+	_, err := q.db.Exec(ctx, createAuditEvent,
+		arg.ActorID,
+		arg.Action,
+		arg.TargetType,
+		arg.TargetID,
+		arg.Ip,
+		arg.UserAgent,
+		arg.RequestID,
+		arg.Metadata,
+	)
+	return err
+}
+
+const createOrganization = `-- name: CreateOrganization :one
+INSERT INTO "organizations" (name, created_by)
+VALUES (
+    $1::TEXT,
+    $2::UUID
+)
+RETURNING id, name, created_by, created_at, updated_at
+`
+
+type CreateOrganizationParams struct {
+	Name      string
+	CreatedBy *uuid.UUID
+}
+
+func (q *Queries) CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (Organization, error) {
+	row := q.db.QueryRow(ctx, createOrganization, arg.Name, arg.CreatedBy)
+	var i Organization
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createOrganizationInvitation = `-- name: CreateOrganizationInvitation :one
+INSERT INTO "organization_invitations" (organization_id, email, role, token_hash, invited_by, expires_at)
+VALUES (
+    $1::UUID,
+    $2::CITEXT,
+    $3::TEXT,
+    $4::TEXT,
+    $5::UUID,
+    $6::TIMESTAMPTZ
+)
+RETURNING id, organization_id, email, role, token_hash, invited_by, expires_at, accepted_at, created_at
+`
+
+type CreateOrganizationInvitationParams struct {
+	OrganizationID uuid.UUID
+	Email          string
+	Role           string
+	TokenHash      string
+	InvitedBy      *uuid.UUID
+	ExpiresAt      time.Time
+}
+
+func (q *Queries) CreateOrganizationInvitation(ctx context.Context, arg CreateOrganizationInvitationParams) (OrganizationInvitation, error) {
+	row := q.db.QueryRow(ctx, createOrganizationInvitation,
+		arg.OrganizationID,
+		arg.Email,
+		arg.Role,
+		arg.TokenHash,
+		arg.InvitedBy,
+		arg.ExpiresAt,
+	)
+	var i OrganizationInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.Email,
+		&i.Role,
+		&i.TokenHash,
+		&i.InvitedBy,
+		&i.ExpiresAt,
+		&i.AcceptedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createPermission = `-- name: CreatePermission :one
+INSERT INTO "permissions" (name, description)
+VALUES ($1::TEXT, $2::TEXT)
+RETURNING id, name, description, created_at
+`
+
+type CreatePermissionParams struct {
+	Name        string
+	Description string
+}
+
+func (q *Queries) CreatePermission(ctx context.Context, arg CreatePermissionParams) (Permission, error) {
+	row := q.db.QueryRow(ctx, createPermission, arg.Name, arg.Description)
+	var i Permission
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createRecoveryCodes = `-- name: CreateRecoveryCodes :exec
+INSERT INTO "user_recovery_codes" (user_id, code_hash)
+SELECT $1::UUID, unnest($2::TEXT[])
+`
+
+type CreateRecoveryCodesParams struct {
+	UserID     uuid.UUID
+	CodeHashes []string
+}
+
+func (q *Queries) CreateRecoveryCodes(ctx context.Context, arg CreateRecoveryCodesParams) error {
+	_, err := q.db.Exec(ctx, createRecoveryCodes, arg.UserID, arg.CodeHashes)
+	return err
+}
+
+const createRole = `-- name: CreateRole :one
+INSERT INTO "roles" (role_name)
+VALUES ($1::TEXT)
+RETURNING id, role_name, created_at, updated_at
+`
+
+func (q *Queries) CreateRole(ctx context.Context, roleName string) (Role, error) {
+	row := q.db.QueryRow(ctx, createRole, roleName)
+	var i Role
+	err := row.Scan(
+		&i.ID,
+		&i.RoleName,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const createUser = `-- name: CreateUser :one
 INSERT INTO "users" (email, full_name, password_hash, role_id)
 VALUES (
@@ -36,6 +539,335 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (uuid.UU
 	return id, err
 }
 
+const createUserIdentity = `-- name: CreateUserIdentity :one
+INSERT INTO "user_identities" (user_id, provider, subject, email)
+VALUES (
+    $1::UUID,
+    $2::TEXT,
+    $3::TEXT,
+    $4::TEXT
+)
+RETURNING id, user_id, provider, subject, email, created_at, last_login_at
+`
+
+type CreateUserIdentityParams struct {
+	UserID   uuid.UUID
+	Provider string
+	Subject  string
+	Email    *string
+}
+
+func (q *Queries) CreateUserIdentity(ctx context.Context, arg CreateUserIdentityParams) (UserIdentity, error) {
+	row := q.db.QueryRow(ctx, createUserIdentity,
+		arg.UserID,
+		arg.Provider,
+		arg.Subject,
+		arg.Email,
+	)
+	var i UserIdentity
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Provider,
+		&i.Subject,
+		&i.Email,
+		&i.CreatedAt,
+		&i.LastLoginAt,
+	)
+	return i, err
+}
+
+const createUserToken = `-- name: CreateUserToken :exec
+INSERT INTO "user_tokens" (user_id, purpose, token_hash, expires_at)
+VALUES (
+    $1::UUID,
+    $2::TEXT,
+    $3::TEXT,
+    $4::TIMESTAMPTZ
+)
+`
+
+type CreateUserTokenParams struct {
+	UserID    uuid.UUID
+	Purpose   string
+	TokenHash string
+	ExpiresAt time.Time
+}
+
+func (q *Queries) CreateUserToken(ctx context.Context, arg CreateUserTokenParams) error {
+	_, err := q.db.Exec(ctx, createUserToken,
+		arg.UserID,
+		arg.Purpose,
+		arg.TokenHash,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const deleteOrganization = `-- name: DeleteOrganization :execrows
+DELETE FROM "organizations"
+WHERE id = $1::UUID
+`
+
+func (q *Queries) DeleteOrganization(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOrganization, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteOrganizationInvitation = `-- name: DeleteOrganizationInvitation :execrows
+DELETE FROM "organization_invitations"
+WHERE id = $1::UUID
+  AND organization_id = $2::UUID
+  AND accepted_at IS NULL
+`
+
+type DeleteOrganizationInvitationParams struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+}
+
+func (q *Queries) DeleteOrganizationInvitation(ctx context.Context, arg DeleteOrganizationInvitationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOrganizationInvitation, arg.ID, arg.OrganizationID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteOrganizationMember = `-- name: DeleteOrganizationMember :execrows
+DELETE FROM "organization_members"
+WHERE organization_id = $1::UUID
+  AND user_id = $2::UUID
+`
+
+type DeleteOrganizationMemberParams struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+}
+
+func (q *Queries) DeleteOrganizationMember(ctx context.Context, arg DeleteOrganizationMemberParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOrganizationMember, arg.OrganizationID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deletePendingOrganizationInvitations = `-- name: DeletePendingOrganizationInvitations :exec
+DELETE FROM "organization_invitations"
+WHERE organization_id = $1::UUID
+  AND email = $2::CITEXT
+  AND accepted_at IS NULL
+`
+
+type DeletePendingOrganizationInvitationsParams struct {
+	OrganizationID uuid.UUID
+	Email          string
+}
+
+func (q *Queries) DeletePendingOrganizationInvitations(ctx context.Context, arg DeletePendingOrganizationInvitationsParams) error {
+	_, err := q.db.Exec(ctx, deletePendingOrganizationInvitations, arg.OrganizationID, arg.Email)
+	return err
+}
+
+const deleteRecoveryCodes = `-- name: DeleteRecoveryCodes :exec
+DELETE FROM "user_recovery_codes"
+WHERE user_id = $1::UUID
+`
+
+func (q *Queries) DeleteRecoveryCodes(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteRecoveryCodes, userID)
+	return err
+}
+
+const deleteRole = `-- name: DeleteRole :execrows
+DELETE FROM "roles"
+WHERE id = $1::INT
+`
+
+func (q *Queries) DeleteRole(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteRole, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteRolePermissions = `-- name: DeleteRolePermissions :exec
+DELETE FROM "role_permissions"
+WHERE role_id = $1::INT
+`
+
+func (q *Queries) DeleteRolePermissions(ctx context.Context, roleID int32) error {
+	_, err := q.db.Exec(ctx, deleteRolePermissions, roleID)
+	return err
+}
+
+const deleteScheduledUser = `-- name: DeleteScheduledUser :execrows
+DELETE FROM "users"
+WHERE id = $1::UUID
+  AND deletion_scheduled_at <= $2::TIMESTAMPTZ
+`
+
+type DeleteScheduledUserParams struct {
+	UserID uuid.UUID
+	Now    time.Time
+}
+
+func (q *Queries) DeleteScheduledUser(ctx context.Context, arg DeleteScheduledUserParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteScheduledUser, arg.UserID, arg.Now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUserIdentity = `-- name: DeleteUserIdentity :execrows
+DELETE FROM "user_identities"
+WHERE id = $1::UUID
+  AND user_id = $2::UUID
+`
+
+type DeleteUserIdentityParams struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+func (q *Queries) DeleteUserIdentity(ctx context.Context, arg DeleteUserIdentityParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUserIdentity, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const disableUserTOTP = `-- name: DisableUserTOTP :exec
+UPDATE "users"
+SET totp_secret = NULL,
+    totp_enabled_at = NULL
+WHERE id = $1::UUID
+`
+
+func (q *Queries) DisableUserTOTP(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, disableUserTOTP, userID)
+	return err
+}
+
+const enableAuditAnonymization = `-- name: EnableAuditAnonymization :exec
+SELECT set_config('audit.anonymize', 'on', true)
+`
+
+func (q *Queries) EnableAuditAnonymization(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, enableAuditAnonymization)
+	return err
+}
+
+const enableUserTOTP = `-- name: EnableUserTOTP :execrows
+UPDATE "users"
+SET totp_enabled_at = NOW()
+WHERE id = $1::UUID
+  AND totp_secret IS NOT NULL
+  AND totp_enabled_at IS NULL
+`
+
+func (q *Queries) EnableUserTOTP(ctx context.Context, userID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, enableUserTOTP, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getAPIKeyByPrefix = `-- name: GetAPIKeyByPrefix :one
+SELECT k.id, k.user_id, k.name, k.prefix, k.secret_hash, k.scopes, k.allowed_ips, k.expires_at, k.last_used_at, k.last_used_ip, k.revoked_at, k.created_at,
+       u.is_active, r.role_name
+FROM "api_keys" k
+JOIN "users" u ON u.id = k.user_id
+LEFT JOIN "roles" r ON r.id = u.role_id
+WHERE k.prefix = $1::TEXT
+LIMIT 1
+`
+
+type GetAPIKeyByPrefixRow struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Name       string
+	Prefix     string
+	SecretHash string
+	Scopes     []string
+	AllowedIps []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	LastUsedIp *string
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+	IsActive   bool
+	RoleName   *string
+}
+
+func (q *Queries) GetAPIKeyByPrefix(ctx context.Context, prefix string) (GetAPIKeyByPrefixRow, error) {
+	row := q.db.QueryRow(ctx, getAPIKeyByPrefix, prefix)
+	var i GetAPIKeyByPrefixRow
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Prefix,
+		&i.SecretHash,
+		&i.Scopes,
+		&i.AllowedIps,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.LastUsedIp,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.IsActive,
+		&i.RoleName,
+	)
+	return i, err
+}
+
+const getOrganization = `-- name: GetOrganization :one
+SELECT id, name, created_by, created_at, updated_at
+FROM "organizations"
+WHERE id = $1::UUID
+`
+
+func (q *Queries) GetOrganization(ctx context.Context, id uuid.UUID) (Organization, error) {
+	row := q.db.QueryRow(ctx, getOrganization, id)
+	var i Organization
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getOrganizationMemberRole = `-- name: GetOrganizationMemberRole :one
+SELECT role
+FROM "organization_members"
+WHERE organization_id = $1::UUID
+  AND user_id = $2::UUID
+`
+
+type GetOrganizationMemberRoleParams struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+}
+
+func (q *Queries) GetOrganizationMemberRole(ctx context.Context, arg GetOrganizationMemberRoleParams) (string, error) {
+	row := q.db.QueryRow(ctx, getOrganizationMemberRole, arg.OrganizationID, arg.UserID)
+	var role string
+	err := row.Scan(&role)
+	return role, err
+}
+
 const getPasswordByID = `-- name: GetPasswordByID :one
 SELECT password_hash
 FROM "users"
@@ -49,24 +881,85 @@ func (q *Queries) GetPasswordByID(ctx context.Context, userID uuid.UUID) (string
 	return password_hash, err
 }
 
+const getRoleByID = `-- name: GetRoleByID :one
+SELECT id, role_name, created_at, updated_at
+FROM "roles"
+WHERE id = $1::INT
+LIMIT 1
+`
+
+func (q *Queries) GetRoleByID(ctx context.Context, id int32) (Role, error) {
+	row := q.db.QueryRow(ctx, getRoleByID, id)
+	var i Role
+	err := row.Scan(
+		&i.ID,
+		&i.RoleName,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getUserAccount = `-- name: GetUserAccount :one
+SELECT u.id, u.email, u.full_name, r.role_name, u.is_active, u.password_reset_required, u.image_url, u.created_at, u.updated_at
+FROM "users" u
+LEFT JOIN "roles" r ON r.id = u.role_id
+WHERE u.id = $1::UUID
+LIMIT 1
+`
+
+type GetUserAccountRow struct {
+	ID                    uuid.UUID
+	Email                 *string
+	FullName              string
+	RoleName              *string
+	IsActive              bool
+	PasswordResetRequired bool
+	ImageUrl              *string
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+func (q *Queries) GetUserAccount(ctx context.Context, userID uuid.UUID) (GetUserAccountRow, error) {
+	row := q.db.QueryRow(ctx, getUserAccount, userID)
+	var i GetUserAccountRow
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.FullName,
+		&i.RoleName,
+		&i.IsActive,
+		&i.PasswordResetRequired,
+		&i.ImageUrl,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT u.id, u.email, u.full_name, r.role_name, u.password_hash, u.is_active, u.created_at, u.updated_at, u.image_url
+SELECT u.id, u.email, u.full_name, r.role_name, u.password_hash, u.is_active, u.created_at, u.updated_at, u.image_url, u.password_reset_required, u.email_verified_at, u.totp_enabled_at, u.phone, u.phone_verified_at
 FROM "users" u
 LEFT JOIN "roles" r ON r.id = u.role_id
 WHERE u.email = $1::TEXT
 LIMIT 1
 `
 
-type GetUserByEmailRow struct {
-	ID           uuid.UUID
-	Email        *string
-	FullName     string
-	RoleName     *string
-	PasswordHash string
-	IsActive     bool
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	ImageUrl     *string
+type GetUserByEmailRow struct {
+	ID                    uuid.UUID
+	Email                 *string
+	FullName              string
+	RoleName              *string
+	PasswordHash          string
+	IsActive              bool
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	ImageUrl              *string
+	PasswordResetRequired bool
+	EmailVerifiedAt       *time.Time
+	TotpEnabledAt         *time.Time
+	Phone                 *string
+	PhoneVerifiedAt       *time.Time
 }
 
 func (q *Queries) GetUserByEmail(ctx context.Context, email string) (GetUserByEmailRow, error) {
@@ -82,12 +975,17 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (GetUserByEm
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ImageUrl,
+		&i.PasswordResetRequired,
+		&i.EmailVerifiedAt,
+		&i.TotpEnabledAt,
+		&i.Phone,
+		&i.PhoneVerifiedAt,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT u.id, u.email, u.full_name, r.role_name, u.is_active, u.created_at, u.updated_at, u.image_url
+SELECT u.id, u.email, u.full_name, r.role_name, u.is_active, u.created_at, u.updated_at, u.image_url, u.email_verified_at, u.password_reset_required, u.totp_enabled_at, u.phone, u.phone_verified_at
 FROM "users" u
 LEFT JOIN "roles" r ON r.id = u.role_id
 WHERE u.id = $1::UUID
@@ -95,14 +993,19 @@ LIMIT 1
 `
 
 type GetUserByIDRow struct {
-	ID        uuid.UUID
-	Email     *string
-	FullName  string
-	RoleName  *string
-	IsActive  bool
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	ImageUrl  *string
+	ID                    uuid.UUID
+	Email                 *string
+	FullName              string
+	RoleName              *string
+	IsActive              bool
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+	ImageUrl              *string
+	EmailVerifiedAt       *time.Time
+	PasswordResetRequired bool
+	TotpEnabledAt         *time.Time
+	Phone                 *string
+	PhoneVerifiedAt       *time.Time
 }
 
 func (q *Queries) GetUserByID(ctx context.Context, userID uuid.UUID) (GetUserByIDRow, error) {
@@ -117,6 +1020,39 @@ func (q *Queries) GetUserByID(ctx context.Context, userID uuid.UUID) (GetUserByI
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.ImageUrl,
+		&i.EmailVerifiedAt,
+		&i.PasswordResetRequired,
+		&i.TotpEnabledAt,
+		&i.Phone,
+		&i.PhoneVerifiedAt,
+	)
+	return i, err
+}
+
+const getUserIdentity = `-- name: GetUserIdentity :one
+SELECT id, user_id, provider, subject, email, created_at, last_login_at
+FROM "user_identities"
+WHERE provider = $1::TEXT
+  AND subject = $2::TEXT
+LIMIT 1
+`
+
+type GetUserIdentityParams struct {
+	Provider string
+	Subject  string
+}
+
+func (q *Queries) GetUserIdentity(ctx context.Context, arg GetUserIdentityParams) (UserIdentity, error) {
+	row := q.db.QueryRow(ctx, getUserIdentity, arg.Provider, arg.Subject)
+	var i UserIdentity
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Provider,
+		&i.Subject,
+		&i.Email,
+		&i.CreatedAt,
+		&i.LastLoginAt,
 	)
 	return i, err
 }
@@ -142,6 +1078,982 @@ func (q *Queries) GetUserRole(ctx context.Context, arg GetUserRoleParams) (*stri
 	return role_name, err
 }
 
+const getUserTOTP = `-- name: GetUserTOTP :one
+SELECT totp_secret, totp_enabled_at
+FROM "users"
+WHERE id = $1::UUID
+`
+
+type GetUserTOTPRow struct {
+	TotpSecret    *string
+	TotpEnabledAt *time.Time
+}
+
+func (q *Queries) GetUserTOTP(ctx context.Context, userID uuid.UUID) (GetUserTOTPRow, error) {
+	row := q.db.QueryRow(ctx, getUserTOTP, userID)
+	var i GetUserTOTPRow
+	err := row.Scan(&i.TotpSecret, &i.TotpEnabledAt)
+	return i, err
+}
+
+const listAPIKeysByUser = `-- name: ListAPIKeysByUser :many
+SELECT id, user_id, name, prefix, secret_hash, scopes, allowed_ips, expires_at, last_used_at, last_used_ip, revoked_at, created_at
+FROM "api_keys"
+WHERE user_id = $1::UUID
+  AND revoked_at IS NULL
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListAPIKeysByUser(ctx context.Context, userID uuid.UUID) ([]ApiKey, error) {
+	rows, err := q.db.Query(ctx, listAPIKeysByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ApiKey
+	for rows.Next() {
+		var i ApiKey
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Prefix,
+			&i.SecretHash,
+			&i.Scopes,
+			&i.AllowedIps,
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.LastUsedIp,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAuditEvents = `-- name: ListAuditEvents :many
+SELECT id, actor_id, action, target_type, target_id, ip, user_agent, request_id, metadata, created_at
+FROM "audit_events"
+WHERE ($1::UUID IS NULL OR actor_id = $1::UUID)
+  AND ($2::TEXT IS NULL OR action = $2::TEXT)
+  AND ($3::TEXT IS NULL OR target_type = $3::TEXT)
+  AND ($4::TEXT IS NULL OR target_id = $4::TEXT)
+  AND ($5::TEXT IS NULL OR ip = $5::TEXT)
+  AND ($6::TIMESTAMPTZ IS NULL OR created_at >= $6::TIMESTAMPTZ)
+  AND ($7::TIMESTAMPTZ IS NULL OR created_at < $7::TIMESTAMPTZ)
+ORDER BY created_at DESC, id
+LIMIT $8::INT
+OFFSET $9::INT
+`
+
+type ListAuditEventsParams struct {
+	ActorID    *uuid.UUID
+	Action     *string
+	TargetType *string
+	TargetID   *string
+	Ip         *string
+	Since      *time.Time
+	Until      *time.Time
+	LimitRows  int32
+	OffsetRows int32
+}
+
+func (q *Queries) ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditEvent, error) {
+	rows, err := q.db.Query(ctx, listAuditEvents,
+		arg.ActorID,
+		arg.Action,
+		arg.TargetType,
+		arg.TargetID,
+		arg.Ip,
+		arg.Since,
+		arg.Until,
+		arg.LimitRows,
+		arg.OffsetRows,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditEvent
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.ActorID,
+			&i.Action,
+			&i.TargetType,
+			&i.TargetID,
+			&i.Ip,
+			&i.UserAgent,
+			&i.RequestID,
+			&i.Metadata,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrganizationInvitations = `-- name: ListOrganizationInvitations :many
+SELECT id, organization_id, email, role, token_hash, invited_by, expires_at, accepted_at, created_at
+FROM "organization_invitations"
+WHERE organization_id = $1::UUID
+  AND accepted_at IS NULL
+  AND expires_at > NOW()
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListOrganizationInvitations(ctx context.Context, organizationID uuid.UUID) ([]OrganizationInvitation, error) {
+	rows, err := q.db.Query(ctx, listOrganizationInvitations, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OrganizationInvitation
+	for rows.Next() {
+		var i OrganizationInvitation
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.Email,
+			&i.Role,
+			&i.TokenHash,
+			&i.InvitedBy,
+			&i.ExpiresAt,
+			&i.AcceptedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrganizationMembers = `-- name: ListOrganizationMembers :many
+SELECT m.user_id, u.email, u.full_name, m.role, m.created_at
+FROM "organization_members" m
+JOIN "users" u ON u.id = m.user_id
+WHERE m.organization_id = $1::UUID
+ORDER BY m.created_at, m.user_id
+`
+
+type ListOrganizationMembersRow struct {
+	UserID    uuid.UUID
+	Email     *string
+	FullName  string
+	Role      string
+	CreatedAt time.Time
+}
+
+func (q *Queries) ListOrganizationMembers(ctx context.Context, organizationID uuid.UUID) ([]ListOrganizationMembersRow, error) {
+	rows, err := q.db.Query(ctx, listOrganizationMembers, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListOrganizationMembersRow
+	for rows.Next() {
+		var i ListOrganizationMembersRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Email,
+			&i.FullName,
+			&i.Role,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOrganizationsNeedingOwner = `-- name: ListOrganizationsNeedingOwner :many
+SELECT o.id, o.name, o.created_by, o.created_at, o.updated_at
+FROM "organizations" o
+JOIN "organization_members" m ON m.organization_id = o.id
+WHERE m.user_id = $1::UUID
+  AND m.role = 'owner'
+  AND NOT EXISTS (
+      SELECT 1
+      FROM "organization_members" x
+      WHERE x.organization_id = o.id
+        AND x.user_id <> m.user_id
+        AND x.role = 'owner'
+  )
+  AND EXISTS (
+      SELECT 1
+      FROM "organization_members" x
+      WHERE x.organization_id = o.id
+        AND x.user_id <> m.user_id
+  )
+ORDER BY o.name, o.id
+`
+
+func (q *Queries) ListOrganizationsNeedingOwner(ctx context.Context, userID uuid.UUID) ([]Organization, error) {
+	rows, err := q.db.Query(ctx, listOrganizationsNeedingOwner, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Organization
+	for rows.Next() {
+		var i Organization
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPermissions = `-- name: ListPermissions :many
+SELECT id, name, description, created_at
+FROM "permissions"
+ORDER BY name
+`
+
+func (q *Queries) ListPermissions(ctx context.Context) ([]Permission, error) {
+	rows, err := q.db.Query(ctx, listPermissions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Permission
+	for rows.Next() {
+		var i Permission
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPermissionsByRole = `-- name: ListPermissionsByRole :many
+SELECT p.name
+FROM "role_permissions" rp
+JOIN "permissions" p ON p.id = rp.permission_id
+WHERE rp.role_id = $1::INT
+ORDER BY p.name
+`
+
+func (q *Queries) ListPermissionsByRole(ctx context.Context, roleID int32) ([]string, error) {
+	rows, err := q.db.Query(ctx, listPermissionsByRole, roleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPermissionsByRoleName = `-- name: ListPermissionsByRoleName :many
+SELECT p.name
+FROM "roles" r
+JOIN "role_permissions" rp ON rp.role_id = r.id
+JOIN "permissions" p ON p.id = rp.permission_id
+WHERE r.role_name = $1::TEXT
+ORDER BY p.name
+`
+
+func (q *Queries) ListPermissionsByRoleName(ctx context.Context, roleName string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listPermissionsByRoleName, roleName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRolePermissions = `-- name: ListRolePermissions :many
+SELECT rp.role_id, p.name
+FROM "role_permissions" rp
+JOIN "permissions" p ON p.id = rp.permission_id
+ORDER BY rp.role_id, p.name
+`
+
+type ListRolePermissionsRow struct {
+	RoleID int32
+	Name   string
+}
+
+func (q *Queries) ListRolePermissions(ctx context.Context) ([]ListRolePermissionsRow, error) {
+	rows, err := q.db.Query(ctx, listRolePermissions)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRolePermissionsRow
+	for rows.Next() {
+		var i ListRolePermissionsRow
+		if err := rows.Scan(&i.RoleID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRoles = `-- name: ListRoles :many
+SELECT id, role_name, created_at, updated_at
+FROM "roles"
+ORDER BY id
+`
+
+func (q *Queries) ListRoles(ctx context.Context) ([]Role, error) {
+	rows, err := q.db.Query(ctx, listRoles)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Role
+	for rows.Next() {
+		var i Role
+		if err := rows.Scan(
+			&i.ID,
+			&i.RoleName,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSoleMemberOrganizations = `-- name: ListSoleMemberOrganizations :many
+SELECT m.organization_id
+FROM "organization_members" m
+WHERE m.user_id = $1::UUID
+  AND NOT EXISTS (
+      SELECT 1
+      FROM "organization_members" o
+      WHERE o.organization_id = m.organization_id
+        AND o.user_id <> m.user_id
+  )
+ORDER BY m.organization_id
+`
+
+func (q *Queries) ListSoleMemberOrganizations(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listSoleMemberOrganizations, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var organization_id uuid.UUID
+		if err := rows.Scan(&organization_id); err != nil {
+			return nil, err
+		}
+		items = append(items, organization_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserIdentities = `-- name: ListUserIdentities :many
+SELECT id, user_id, provider, subject, email, created_at, last_login_at
+FROM "user_identities"
+WHERE user_id = $1::UUID
+ORDER BY created_at
+`
+
+func (q *Queries) ListUserIdentities(ctx context.Context, userID uuid.UUID) ([]UserIdentity, error) {
+	rows, err := q.db.Query(ctx, listUserIdentities, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserIdentity
+	for rows.Next() {
+		var i UserIdentity
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Provider,
+			&i.Subject,
+			&i.Email,
+			&i.CreatedAt,
+			&i.LastLoginAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserOrganizations = `-- name: ListUserOrganizations :many
+SELECT o.id, o.name, m.role, m.created_at
+FROM "organization_members" m
+JOIN "organizations" o ON o.id = m.organization_id
+WHERE m.user_id = $1::UUID
+ORDER BY m.created_at, o.id
+`
+
+type ListUserOrganizationsRow struct {
+	ID        uuid.UUID
+	Name      string
+	Role      string
+	CreatedAt time.Time
+}
+
+func (q *Queries) ListUserOrganizations(ctx context.Context, userID uuid.UUID) ([]ListUserOrganizationsRow, error) {
+	rows, err := q.db.Query(ctx, listUserOrganizations, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUserOrganizationsRow
+	for rows.Next() {
+		var i ListUserOrganizationsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Role,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserPermissions = `-- name: ListUserPermissions :many
+SELECT p.name
+FROM "users" u
+JOIN "role_permissions" rp ON rp.role_id = u.role_id
+JOIN "permissions" p ON p.id = rp.permission_id
+WHERE u.id = $1::UUID
+  AND u.is_active
+ORDER BY p.name
+`
+
+func (q *Queries) ListUserPermissions(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	rows, err := q.db.Query(ctx, listUserPermissions, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsers = `-- name: ListUsers :many
+SELECT u.id, u.email, u.full_name, r.role_name, u.is_active, u.password_reset_required, u.image_url, u.created_at, u.updated_at
+FROM "users" u
+LEFT JOIN "roles" r ON r.id = u.role_id
+WHERE ($1::TEXT IS NULL
+       OR u.email ILIKE '%' || $1::TEXT || '%'
+       OR u.full_name ILIKE '%' || $1::TEXT || '%')
+  AND ($2::TEXT IS NULL OR r.role_name = $2::TEXT)
+  AND ($3::BOOLEAN IS NULL OR u.is_active = $3::BOOLEAN)
+ORDER BY u.created_at DESC, u.id
+LIMIT $4::INT
+OFFSET $5::INT
+`
+
+type ListUsersParams struct {
+	Search     *string
+	RoleName   *string
+	IsActive   *bool
+	LimitRows  int32
+	OffsetRows int32
+}
+
+type ListUsersRow struct {
+	ID                    uuid.UUID
+	Email                 *string
+	FullName              string
+	RoleName              *string
+	IsActive              bool
+	PasswordResetRequired bool
+	ImageUrl              *string
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]ListUsersRow, error) {
+	rows, err := q.db.Query(ctx, listUsers,
+		arg.Search,
+		arg.RoleName,
+		arg.IsActive,
+		arg.LimitRows,
+		arg.OffsetRows,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUsersRow
+	for rows.Next() {
+		var i ListUsersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.FullName,
+			&i.RoleName,
+			&i.IsActive,
+			&i.PasswordResetRequired,
+			&i.ImageUrl,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersDueForDeletion = `-- name: ListUsersDueForDeletion :many
+SELECT id
+FROM "users"
+WHERE deletion_scheduled_at <= $1::TIMESTAMPTZ
+ORDER BY deletion_scheduled_at
+LIMIT $2::INT
+`
+
+type ListUsersDueForDeletionParams struct {
+	Now        time.Time
+	LimitCount int32
+}
+
+func (q *Queries) ListUsersDueForDeletion(ctx context.Context, arg ListUsersDueForDeletionParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, listUsersDueForDeletion, arg.Now, arg.LimitCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockScheduledUser = `-- name: LockScheduledUser :one
+SELECT email
+FROM "users"
+WHERE id = $1::UUID
+  AND deletion_scheduled_at <= $2::TIMESTAMPTZ
+FOR NO KEY UPDATE
+`
+
+type LockScheduledUserParams struct {
+	UserID uuid.UUID
+	Now    time.Time
+}
+
+func (q *Queries) LockScheduledUser(ctx context.Context, arg LockScheduledUserParams) (*string, error) {
+	row := q.db.QueryRow(ctx, lockScheduledUser, arg.UserID, arg.Now)
+	var email *string
+	err := row.Scan(&email)
+	return email, err
+}
+
+const markEmailVerified = `-- name: MarkEmailVerified :exec
+UPDATE "users"
+SET email_verified_at = COALESCE(email_verified_at, NOW())
+WHERE id = $1::UUID
+`
+
+func (q *Queries) MarkEmailVerified(ctx context.Context, userID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, markEmailVerified, userID)
+	return err
+}
+
+const markPhoneVerified = `-- name: MarkPhoneVerified :execrows
+UPDATE "users"
+SET phone_verified_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1::UUID
+  AND phone = $2::TEXT
+`
+
+type MarkPhoneVerifiedParams struct {
+	UserID uuid.UUID
+	Phone  string
+}
+
+func (q *Queries) MarkPhoneVerified(ctx context.Context, arg MarkPhoneVerifiedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markPhoneVerified, arg.UserID, arg.Phone)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const renameOrganization = `-- name: RenameOrganization :execrows
+UPDATE "organizations"
+SET name = $1::TEXT
+WHERE id = $2::UUID
+`
+
+type RenameOrganizationParams struct {
+	Name string
+	ID   uuid.UUID
+}
+
+func (q *Queries) RenameOrganization(ctx context.Context, arg RenameOrganizationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, renameOrganization, arg.Name, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const resetPasswordByID = `-- name: ResetPasswordByID :exec
+UPDATE "users"
+SET password_hash = $1::TEXT,
+    password_reset_required = FALSE
+WHERE id = $2::UUID
+`
+
+type ResetPasswordByIDParams struct {
+	PasswordHash string
+	UserID       uuid.UUID
+}
+
+func (q *Queries) ResetPasswordByID(ctx context.Context, arg ResetPasswordByIDParams) error {
+	_, err := q.db.Exec(ctx, resetPasswordByID, arg.PasswordHash, arg.UserID)
+	return err
+}
+
+const revokeAPIKey = `-- name: RevokeAPIKey :execrows
+UPDATE "api_keys"
+SET revoked_at = NOW()
+WHERE id = $1::UUID
+  AND user_id = $2::UUID
+  AND revoked_at IS NULL
+`
+
+type RevokeAPIKeyParams struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+}
+
+func (q *Queries) RevokeAPIKey(ctx context.Context, arg RevokeAPIKeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeAPIKey, arg.ID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const revokeUserAPIKeys = `-- name: RevokeUserAPIKeys :execrows
+UPDATE "api_keys"
+SET revoked_at = NOW()
+WHERE user_id = $1::UUID
+  AND revoked_at IS NULL
+`
+
+func (q *Queries) RevokeUserAPIKeys(ctx context.Context, userID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeUserAPIKeys, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const revokeUserTokens = `-- name: RevokeUserTokens :exec
+UPDATE "user_tokens"
+SET used_at = NOW()
+WHERE user_id = $1::UUID
+  AND purpose = $2::TEXT
+  AND used_at IS NULL
+`
+
+type RevokeUserTokensParams struct {
+	UserID  uuid.UUID
+	Purpose string
+}
+
+func (q *Queries) RevokeUserTokens(ctx context.Context, arg RevokeUserTokensParams) error {
+	_, err := q.db.Exec(ctx, revokeUserTokens, arg.UserID, arg.Purpose)
+	return err
+}
+
+const scheduleUserDeletion = `-- name: ScheduleUserDeletion :execrows
+UPDATE "users"
+SET deletion_scheduled_at = $1::TIMESTAMPTZ
+WHERE id = $2::UUID
+`
+
+type ScheduleUserDeletionParams struct {
+	DeletionScheduledAt time.Time
+	UserID              uuid.UUID
+}
+
+func (q *Queries) ScheduleUserDeletion(ctx context.Context, arg ScheduleUserDeletionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, scheduleUserDeletion, arg.DeletionScheduledAt, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setPasswordResetRequired = `-- name: SetPasswordResetRequired :execrows
+UPDATE "users"
+SET password_reset_required = $1::BOOLEAN
+WHERE id = $2::UUID
+`
+
+type SetPasswordResetRequiredParams struct {
+	Required bool
+	UserID   uuid.UUID
+}
+
+func (q *Queries) SetPasswordResetRequired(ctx context.Context, arg SetPasswordResetRequiredParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setPasswordResetRequired, arg.Required, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setUserActive = `-- name: SetUserActive :execrows
+UPDATE "users"
+SET is_active = $1::BOOLEAN
+WHERE id = $2::UUID
+`
+
+type SetUserActiveParams struct {
+	IsActive bool
+	UserID   uuid.UUID
+}
+
+func (q *Queries) SetUserActive(ctx context.Context, arg SetUserActiveParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setUserActive, arg.IsActive, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setUserPhone = `-- name: SetUserPhone :execrows
+UPDATE "users"
+SET phone_verified_at = CASE WHEN phone IS NOT DISTINCT FROM $1::TEXT THEN phone_verified_at END,
+    phone = $1::TEXT,
+    updated_at = NOW()
+WHERE id = $2::UUID
+`
+
+type SetUserPhoneParams struct {
+	Phone  *string
+	UserID uuid.UUID
+}
+
+func (q *Queries) SetUserPhone(ctx context.Context, arg SetUserPhoneParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setUserPhone, arg.Phone, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setUserRole = `-- name: SetUserRole :execrows
+UPDATE "users" u
+SET role_id = r.id
+FROM "roles" r
+WHERE r.role_name = $1::TEXT
+  AND u.id = $2::UUID
+`
+
+type SetUserRoleParams struct {
+	RoleName string
+	UserID   uuid.UUID
+}
+
+func (q *Queries) SetUserRole(ctx context.Context, arg SetUserRoleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setUserRole, arg.RoleName, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setUserTOTPSecret = `-- name: SetUserTOTPSecret :execrows
+UPDATE "users"
+SET totp_secret = $1::TEXT
+WHERE id = $2::UUID
+  AND totp_enabled_at IS NULL
+`
+
+type SetUserTOTPSecretParams struct {
+	TotpSecret string
+	UserID     uuid.UUID
+}
+
+func (q *Queries) SetUserTOTPSecret(ctx context.Context, arg SetUserTOTPSecretParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setUserTOTPSecret, arg.TotpSecret, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const touchAPIKey = `-- name: TouchAPIKey :exec
+UPDATE "api_keys"
+SET last_used_at = NOW(),
+    last_used_ip = $1::TEXT
+WHERE id = $2::UUID
+  AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute')
+`
+
+type TouchAPIKeyParams struct {
+	Ip string
+	ID uuid.UUID
+}
+
+func (q *Queries) TouchAPIKey(ctx context.Context, arg TouchAPIKeyParams) error {
+	_, err := q.db.Exec(ctx, touchAPIKey, arg.Ip, arg.ID)
+	return err
+}
+
+const touchRole = `-- name: TouchRole :exec
+UPDATE "roles"
+SET updated_at = NOW()
+WHERE id = $1::INT
+`
+
+func (q *Queries) TouchRole(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, touchRole, id)
+	return err
+}
+
+const touchUserIdentity = `-- name: TouchUserIdentity :exec
+UPDATE "user_identities"
+SET last_login_at = NOW(),
+    email = $1::TEXT
+WHERE id = $2::UUID
+`
+
+type TouchUserIdentityParams struct {
+	Email *string
+	ID    uuid.UUID
+}
+
+func (q *Queries) TouchUserIdentity(ctx context.Context, arg TouchUserIdentityParams) error {
+	_, err := q.db.Exec(ctx, touchUserIdentity, arg.Email, arg.ID)
+	return err
+}
+
+const updateOrganizationMemberRole = `-- name: UpdateOrganizationMemberRole :execrows
+UPDATE "organization_members"
+SET role = $1::TEXT
+WHERE organization_id = $2::UUID
+  AND user_id = $3::UUID
+`
+
+type UpdateOrganizationMemberRoleParams struct {
+	Role           string
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+}
+
+func (q *Queries) UpdateOrganizationMemberRole(ctx context.Context, arg UpdateOrganizationMemberRoleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateOrganizationMemberRole, arg.Role, arg.OrganizationID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updatePasswordByID = `-- name: UpdatePasswordByID :exec
 UPDATE "users"
 SET password_hash = $1::TEXT
@@ -161,6 +2073,7 @@ func (q *Queries) UpdatePasswordByID(ctx context.Context, arg UpdatePasswordByID
 const updateUser = `-- name: UpdateUser :exec
 UPDATE "users"
 SET full_name = $1::TEXT,
+    email_verified_at = CASE WHEN lower(email) = lower($2::TEXT) THEN email_verified_at END,
     email = $2::TEXT,
     password_hash = $3::TEXT,
     image_url = $4::TEXT,
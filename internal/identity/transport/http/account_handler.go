@@ -0,0 +1,202 @@
+package identityhttp
+
+import (
+	"fmt"
+	authapp "go-ai/internal/identity/application/auth"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/rs/zerolog"
+)
+
+type AccountHandler struct {
+	ForgotPasswordUseCase        *authapp.ForgotPasswordUseCase
+	ResetPasswordUseCase         *authapp.ResetPasswordUseCase
+	VerifyEmailUseCase           *authapp.VerifyEmailUseCase
+	SendVerificationEmailUseCase *authapp.SendVerificationEmailUseCase
+	DeleteAccountUseCase         *authapp.DeleteAccountUseCase
+	ExportDataUseCase            *authapp.ExportDataUseCase
+	Logger                       zerolog.Logger
+}
+
+func NewAccountHandler(
+	forgotPasswordUseCase *authapp.ForgotPasswordUseCase,
+	resetPasswordUseCase *authapp.ResetPasswordUseCase,
+	verifyEmailUseCase *authapp.VerifyEmailUseCase,
+	sendVerificationEmailUseCase *authapp.SendVerificationEmailUseCase,
+	deleteAccountUseCase *authapp.DeleteAccountUseCase,
+	exportDataUseCase *authapp.ExportDataUseCase,
+	logger zerolog.Logger,
+) *AccountHandler {
+	return &AccountHandler{
+		ForgotPasswordUseCase:        forgotPasswordUseCase,
+		ResetPasswordUseCase:         resetPasswordUseCase,
+		VerifyEmailUseCase:           verifyEmailUseCase,
+		SendVerificationEmailUseCase: sendVerificationEmailUseCase,
+		DeleteAccountUseCase:         deleteAccountUseCase,
+		ExportDataUseCase:            exportDataUseCase,
+		Logger:                       logger.With().Str("component", "AccountHandler").Logger(),
+	}
+}
+
+// ForgotPassword godoc
+// @Summary Request a password reset
+// @Description Email a password reset link. The response is the same whether or not the account exists. Requests are limited per email and per IP (429)
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body authapp.ForgotPasswordRequest true "Account email"
+// @Success 200 {object} authapp.ForgotPasswordSuccessResponseDoc "Password reset email sent"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/forgot-password [post]
+func (h *AccountHandler) ForgotPassword(c *echo.Context) error {
+	var in authapp.ForgotPasswordRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	if err := h.ForgotPasswordUseCase.Execute(c.Request().Context(), in, clientInfo(c)); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to send password reset email")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "If the account exists, a password reset email has been sent")
+}
+
+// ResetPassword godoc
+// @Summary Reset password
+// @Description Set a new password with a reset token. Every session of the user is signed out
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body authapp.ResetPasswordRequest true "Reset token and new password"
+// @Success 200 {object} authapp.ResetPasswordSuccessResponseDoc "Password reset successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/reset-password [post]
+func (h *AccountHandler) ResetPassword(c *echo.Context) error {
+	var in authapp.ResetPasswordRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	if err := h.ResetPasswordUseCase.Execute(c.Request().Context(), in); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to reset password")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Password reset successfully")
+}
+
+// VerifyEmail godoc
+// @Summary Verify email
+// @Description Confirm the account email with a verification token
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body authapp.VerifyEmailRequest true "Verification token"
+// @Success 200 {object} authapp.VerifyEmailSuccessResponseDoc "Email verified successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/verify-email [post]
+func (h *AccountHandler) VerifyEmail(c *echo.Context) error {
+	var in authapp.VerifyEmailRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	if err := h.VerifyEmailUseCase.Execute(c.Request().Context(), in); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to verify email")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Email verified successfully")
+}
+
+// ResendVerification godoc
+// @Summary Resend verification email
+// @Description Email a new verification link to the authenticated user
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} authapp.ResendVerificationSuccessResponseDoc "Verification email sent"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/resend-verification [post]
+func (h *AccountHandler) ResendVerification(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := h.SendVerificationEmailUseCase.Execute(c.Request().Context(), userID); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to send verification email")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Verification email sent")
+}
+
+// DeleteAccount godoc
+// @Summary Delete my account
+// @Description Schedule the account for permanent deletion after the grace period and sign it out everywhere. Needs the password, and a two-factor code when two-factor is enabled. Signing in again before the date cancels the deletion
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body authapp.DeleteAccountRequest true "Password and, with two-factor enabled, a code"
+// @Success 200 {object} authapp.DeleteAccountSuccessResponseDoc "Account deletion scheduled"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/account [delete]
+func (h *AccountHandler) DeleteAccount(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	var in authapp.DeleteAccountRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	result, err := h.DeleteAccountUseCase.Execute(c.Request().Context(), userID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to schedule account deletion")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Account deletion scheduled")
+}
+
+// ExportData godoc
+// @Summary Export my data
+// @Description Download everything kept about the account: profile, sessions, linked providers, API keys, organizations, uploads, models and child profiles
+// @Tags Auth
+// @Produce json
+// @Produce application/zip
+// @Param format query string false "json (default) or zip"
+// @Success 200 {file} file "Data export"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/export [get]
+func (h *AccountHandler) ExportData(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	var in authapp.ExportDataRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	file, err := h.ExportDataUseCase.Execute(c.Request().Context(), userID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to export account data")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", file.Name))
+	return c.Blob(http.StatusOK, file.ContentType, file.Body)
+}
@@ -0,0 +1,115 @@
+package identityhttp
+
+import (
+	apikeyapp "go-ai/internal/identity/application/apikey"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/rs/zerolog"
+)
+
+type APIKeyHandler struct {
+	CreateAPIKeyUseCase *apikeyapp.CreateAPIKeyUseCase
+	ListAPIKeysUseCase  *apikeyapp.ListAPIKeysUseCase
+	RevokeAPIKeyUseCase *apikeyapp.RevokeAPIKeyUseCase
+	Logger              zerolog.Logger
+}
+
+func NewAPIKeyHandler(
+	createAPIKeyUseCase *apikeyapp.CreateAPIKeyUseCase,
+	listAPIKeysUseCase *apikeyapp.ListAPIKeysUseCase,
+	revokeAPIKeyUseCase *apikeyapp.RevokeAPIKeyUseCase,
+	logger zerolog.Logger,
+) *APIKeyHandler {
+	return &APIKeyHandler{
+		CreateAPIKeyUseCase: createAPIKeyUseCase,
+		ListAPIKeysUseCase:  listAPIKeysUseCase,
+		RevokeAPIKeyUseCase: revokeAPIKeyUseCase,
+		Logger:              logger.With().Str("component", "APIKeyHandler").Logger(),
+	}
+}
+
+// CreateAPIKey godoc
+// @Summary Create an API key
+// @Description Create a scoped API key for the X-API-Key header. The full key is only returned once
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body apikeyapp.CreateAPIKeyRequest true "Name, scopes, optional IP allowlist and expiry"
+// @Success 200 {object} apikeyapp.CreateAPIKeySuccessResponseDoc "API key created successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/api-keys [post]
+func (h *APIKeyHandler) CreateAPIKey(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	var in apikeyapp.CreateAPIKeyRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	result, err := h.CreateAPIKeyUseCase.Execute(c.Request().Context(), userID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to create api key")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "API key created successfully")
+}
+
+// ListAPIKeys godoc
+// @Summary List my API keys
+// @Description List the authenticated user's active API keys, newest first. Secrets are never returned
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} apikeyapp.ListAPIKeysSuccessResponseDoc "API keys retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/api-keys [get]
+func (h *APIKeyHandler) ListAPIKeys(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	result, err := h.ListAPIKeysUseCase.Execute(c.Request().Context(), userID)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to list api keys")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "API keys retrieved successfully")
+}
+
+// RevokeAPIKey godoc
+// @Summary Revoke an API key
+// @Description Revoke one of the authenticated user's API keys. It stops working immediately
+// @Tags Auth
+// @Produce json
+// @Param id path string true "API key ID"
+// @Success 200 {object} apikeyapp.RevokeAPIKeySuccessResponseDoc "API key revoked successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/api-keys/{id} [delete]
+func (h *APIKeyHandler) RevokeAPIKey(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid API key ID")
+	}
+	if err := h.RevokeAPIKeyUseCase.Execute(c.Request().Context(), userID, id); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to revoke api key")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "API key revoked successfully")
+}
@@ -0,0 +1,56 @@
+package identityhttp
+
+import (
+	auditapp "go-ai/internal/identity/application/audit"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"net/http"
+
+	"github.com/labstack/echo/v5"
+	"github.com/rs/zerolog"
+)
+
+type AuditHandler struct {
+	ListAuditEventsUseCase *auditapp.ListAuditEventsUseCase
+	Logger                 zerolog.Logger
+}
+
+func NewAuditHandler(listAuditEventsUseCase *auditapp.ListAuditEventsUseCase, logger zerolog.Logger) *AuditHandler {
+	return &AuditHandler{
+		ListAuditEventsUseCase: listAuditEventsUseCase,
+		Logger:                 logger.With().Str("component", "AuditHandler").Logger(),
+	}
+}
+
+// ListAuditEvents godoc
+// @Summary Query the audit log
+// @Description List security audit events, newest first
+// @Tags Admin
+// @Produce json
+// @Param actor_id query string false "User who acted"
+// @Param action query string false "Action, such as auth.login"
+// @Param target_type query string false "Target type, such as user"
+// @Param target_id query string false "Target ID"
+// @Param ip query string false "Client IP"
+// @Param since query string false "RFC 3339 start time (inclusive)"
+// @Param until query string false "RFC 3339 end time (exclusive)"
+// @Param page query int false "Page number"
+// @Param limit query int false "Page size (max 100)"
+// @Success 200 {object} auditapp.ListAuditEventsSuccessResponseDoc "Audit events retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/admin/audit-events [get]
+func (h *AuditHandler) ListAuditEvents(c *echo.Context) error {
+	var in auditapp.ListAuditEventsRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid query parameters")
+	}
+	events, err := h.ListAuditEventsUseCase.Execute(c.Request().Context(), in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to list audit events")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, events, "Audit events retrieved successfully")
+}
@@ -2,6 +2,7 @@ package identityhttp
 
 import (
 	authapp "go-ai/internal/identity/application/auth"
+	"go-ai/internal/identity/domain/auth"
 	domainerr "go-ai/pkg/domain_err"
 	"go-ai/pkg/response"
 	"net/http"
@@ -85,7 +86,7 @@ func (h *AuthHandler) Login(c *echo.Context) error {
 	if err := c.Bind(&in); err != nil {
 		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
 	}
-	responseData, err := h.LoginUseCase.Execute(c.Request().Context(), in)
+	responseData, err := h.LoginUseCase.Execute(c.Request().Context(), in, clientInfo(c))
 	if err != nil {
 		h.Logger.Error().Err(err).Msg("failed to login user")
 		if ae, ok := err.(domainerr.AppError); ok {
@@ -93,6 +94,9 @@ func (h *AuthHandler) Login(c *echo.Context) error {
 		}
 		return response.Error(c, http.StatusInternalServerError, "Internal server error")
 	}
+	if responseData.MFARequired {
+		return response.Success(c, responseData, "Two-factor authentication required")
+	}
 	if responseData.AccessToken == "" || responseData.RefreshToken == "" {
 		h.Logger.Error().Msg("Failed to login user: invalid credentials")
 		return response.Error(c, http.StatusBadRequest, "Invalid email or password")
@@ -117,7 +121,7 @@ func (h *AuthHandler) RefreshToken(c *echo.Context) error {
 		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
 	}
 
-	responseData, err := h.RefreshTokenUseCase.Execute(c.Request().Context(), in)
+	responseData, err := h.RefreshTokenUseCase.Execute(c.Request().Context(), in, clientInfo(c))
 	if err != nil {
 		if ae, ok := err.(domainerr.AppError); ok {
 			return response.Error(c, ae.Status, ae.Msg)
@@ -147,7 +151,8 @@ func (h *AuthHandler) GetProfile(c *echo.Context) error {
 		h.Logger.Error().Msg("failed to get profile: invalid user ID type")
 		return response.Error(c, http.StatusInternalServerError, "Internal server error")
 	}
-	profile, err := h.ProfileUseCase.Execute(c.Request().Context(), userUUID)
+	sid, _ := c.Get("sid").(string)
+	profile, err := h.ProfileUseCase.Execute(c.Request().Context(), userUUID, sid)
 	if err != nil {
 		if ae, ok := err.(domainerr.AppError); ok {
 			return response.Error(c, ae.Status, ae.Msg)
@@ -155,14 +160,7 @@ func (h *AuthHandler) GetProfile(c *echo.Context) error {
 		h.Logger.Error().Err(err).Msg("failed to get profile")
 		return response.Error(c, http.StatusInternalServerError, "Internal server error")
 	}
-	resp := &authapp.GetProfileResponse{
-		Email:    profile.Email,
-		FullName: profile.FullName,
-		Role:     profile.Role,
-		IsActive: profile.IsActive,
-		ImageUrl: profile.ImageUrl,
-	}
-	return response.Success(c, resp, "Profile retrieved successfully")
+	return response.Success(c, profile, "Profile retrieved successfully")
 }
 
 // UpdateProfile godoc
@@ -202,7 +200,7 @@ func (h *AuthHandler) UpdateProfile(c *echo.Context) error {
 
 // ChangePassword godoc
 // @Summary Change user password
-// @Description Allows the authenticated user to change their password
+// @Description Allows the authenticated user to change their password. All other sessions are signed out.
 // @Tags Auth
 // @Accept json
 // @Produce json
@@ -225,7 +223,8 @@ func (h *AuthHandler) ChangePassword(c *echo.Context) error {
 		h.Logger.Error().Msg("failed to get profile: invalid user ID type")
 		return response.Error(c, http.StatusInternalServerError, "Internal server error")
 	}
-	err := h.ChangePasswordUseCase.Execute(c.Request().Context(), in, userUUID)
+	sid, _ := c.Get("sid").(string)
+	err := h.ChangePasswordUseCase.Execute(c.Request().Context(), in, userUUID, sid)
 	if err != nil {
 		if ae, ok := err.(domainerr.AppError); ok {
 			return response.Error(c, ae.Status, ae.Msg)
@@ -255,10 +254,23 @@ func (h *AuthHandler) Logout(c *echo.Context) error {
 		h.Logger.Error().Msg("failed to get profile: invalid user ID type")
 		return response.Error(c, http.StatusInternalServerError, "Internal server error")
 	}
-	err := h.LogoutUseCase.Execute(c.Request().Context(), userUUID)
+	sid, _ := c.Get("sid").(string)
+	err := h.LogoutUseCase.Execute(c.Request().Context(), userUUID, sid)
 	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
 		h.Logger.Error().Err(err).Msg("Delete cache error")
 		return response.Error(c, http.StatusInternalServerError, "Internal server error")
 	}
 	return response.Success[any](c, nil, "Log out successfully")
 }
+
+// clientInfo feeds the per-IP login lockout. RealIP only believes
+// X-Forwarded-For from TRUSTED_PROXIES, so clients cannot rotate it.
+func clientInfo(c *echo.Context) auth.ClientInfo {
+	return auth.ClientInfo{
+		UserAgent: c.Request().UserAgent(),
+		IP:        c.RealIP(),
+	}
+}
@@ -0,0 +1,30 @@
+package identityhttp
+
+import (
+	"go-ai/pkg/jwtkeys"
+	"net/http"
+
+	"github.com/labstack/echo/v5"
+)
+
+type JWKSHandler struct {
+	Tokens jwtkeys.Issuer
+}
+
+func NewJWKSHandler(tokens jwtkeys.Issuer) *JWKSHandler {
+	return &JWKSHandler{Tokens: tokens}
+}
+
+// JWKS godoc
+// @Summary JSON Web Key Set
+// @Description Public keys that verify access tokens, selected by the token's kid header. Empty while tokens are signed with HS256
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} jwtkeys.JWKS "Key set"
+// @Router /.well-known/jwks.json [get]
+func (h *JWKSHandler) JWKS(c *echo.Context) error {
+	// Verifiers may cache the set briefly; a new key is published before it
+	// starts signing, so a short TTL is enough for rotation.
+	c.Response().Header().Set("Cache-Control", "public, max-age=300")
+	return c.JSON(http.StatusOK, h.Tokens.JWKS())
+}
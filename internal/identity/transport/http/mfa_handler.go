@@ -0,0 +1,205 @@
+package identityhttp
+
+import (
+	authapp "go-ai/internal/identity/application/auth"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/rs/zerolog"
+)
+
+type MFAHandler struct {
+	LoginMFAUseCase                *authapp.LoginMFAUseCase
+	MFAStatusUseCase               *authapp.MFAStatusUseCase
+	EnrollMFAUseCase               *authapp.EnrollMFAUseCase
+	ConfirmMFAUseCase              *authapp.ConfirmMFAUseCase
+	DisableMFAUseCase              *authapp.DisableMFAUseCase
+	RegenerateRecoveryCodesUseCase *authapp.RegenerateRecoveryCodesUseCase
+	Logger                         zerolog.Logger
+}
+
+func NewMFAHandler(
+	loginMFAUseCase *authapp.LoginMFAUseCase,
+	mfaStatusUseCase *authapp.MFAStatusUseCase,
+	enrollMFAUseCase *authapp.EnrollMFAUseCase,
+	confirmMFAUseCase *authapp.ConfirmMFAUseCase,
+	disableMFAUseCase *authapp.DisableMFAUseCase,
+	regenerateRecoveryCodesUseCase *authapp.RegenerateRecoveryCodesUseCase,
+	logger zerolog.Logger,
+) *MFAHandler {
+	return &MFAHandler{
+		LoginMFAUseCase:                loginMFAUseCase,
+		MFAStatusUseCase:               mfaStatusUseCase,
+		EnrollMFAUseCase:               enrollMFAUseCase,
+		ConfirmMFAUseCase:              confirmMFAUseCase,
+		DisableMFAUseCase:              disableMFAUseCase,
+		RegenerateRecoveryCodesUseCase: regenerateRecoveryCodesUseCase,
+		Logger:                         logger.With().Str("component", "MFAHandler").Logger(),
+	}
+}
+
+// LoginMFA godoc
+// @Summary Complete two-factor login
+// @Description Exchange the challenge token returned by login and a TOTP or recovery code for access and refresh tokens
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body authapp.LoginMFARequest true "Challenge token and code"
+// @Success 200 {object} authapp.LoginSuccessResponseDoc "Login success"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/login/2fa [post]
+func (h *MFAHandler) LoginMFA(c *echo.Context) error {
+	var in authapp.LoginMFARequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	result, err := h.LoginMFAUseCase.Execute(c.Request().Context(), in, clientInfo(c))
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to complete two-factor login")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Login success")
+}
+
+// Status godoc
+// @Summary Two-factor status
+// @Description Show whether two-factor authentication is enabled and how many recovery codes are left
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} authapp.MFAStatusSuccessResponseDoc "Two-factor status retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/2fa [get]
+func (h *MFAHandler) Status(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	result, err := h.MFAStatusUseCase.Execute(c.Request().Context(), userID)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to get two-factor status")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Two-factor status retrieved successfully")
+}
+
+// Enroll godoc
+// @Summary Start two-factor enrolment
+// @Description Generate a TOTP secret and otpauth URI for an authenticator app. Two-factor stays off until confirmed
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} authapp.EnrollMFASuccessResponseDoc "Two-factor enrolment started"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/2fa/enroll [post]
+func (h *MFAHandler) Enroll(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	result, err := h.EnrollMFAUseCase.Execute(c.Request().Context(), userID)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to start two-factor enrolment")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Two-factor enrolment started")
+}
+
+// Confirm godoc
+// @Summary Confirm two-factor enrolment
+// @Description Enable two-factor with a first code from the authenticator app and return one-time recovery codes
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body authapp.MFACodeRequest true "Authenticator code"
+// @Success 200 {object} authapp.RecoveryCodesSuccessResponseDoc "Two-factor authentication enabled"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/2fa/confirm [post]
+func (h *MFAHandler) Confirm(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	var in authapp.MFACodeRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	result, err := h.ConfirmMFAUseCase.Execute(c.Request().Context(), userID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to confirm two-factor enrolment")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Two-factor authentication enabled")
+}
+
+// Disable godoc
+// @Summary Disable two-factor authentication
+// @Description Turn two-factor off with the password and a TOTP or recovery code
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body authapp.DisableMFARequest true "Password and code"
+// @Success 200 {object} authapp.DisableMFASuccessResponseDoc "Two-factor authentication disabled"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/2fa/disable [post]
+func (h *MFAHandler) Disable(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	var in authapp.DisableMFARequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	if err := h.DisableMFAUseCase.Execute(c.Request().Context(), userID, in); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to disable two-factor")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Two-factor authentication disabled")
+}
+
+// RegenerateRecoveryCodes godoc
+// @Summary Regenerate recovery codes
+// @Description Replace every recovery code. Requires a current authenticator code
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body authapp.MFACodeRequest true "Authenticator code"
+// @Success 200 {object} authapp.RecoveryCodesSuccessResponseDoc "Recovery codes regenerated"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/2fa/recovery-codes [post]
+func (h *MFAHandler) RegenerateRecoveryCodes(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	var in authapp.MFACodeRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	result, err := h.RegenerateRecoveryCodesUseCase.Execute(c.Request().Context(), userID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to regenerate recovery codes")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Recovery codes regenerated")
+}
@@ -0,0 +1,205 @@
+package identityhttp
+
+import (
+	authapp "go-ai/internal/identity/application/auth"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/rs/zerolog"
+)
+
+type OAuthHandler struct {
+	Providers             authapp.OAuthProviders
+	StartOAuthUseCase     *authapp.StartOAuthUseCase
+	OAuthLoginUseCase     *authapp.OAuthLoginUseCase
+	LinkOAuthUseCase      *authapp.LinkOAuthUseCase
+	ListIdentitiesUseCase *authapp.ListIdentitiesUseCase
+	UnlinkIdentityUseCase *authapp.UnlinkIdentityUseCase
+	Logger                zerolog.Logger
+}
+
+func NewOAuthHandler(
+	providers authapp.OAuthProviders,
+	startOAuthUseCase *authapp.StartOAuthUseCase,
+	oauthLoginUseCase *authapp.OAuthLoginUseCase,
+	linkOAuthUseCase *authapp.LinkOAuthUseCase,
+	listIdentitiesUseCase *authapp.ListIdentitiesUseCase,
+	unlinkIdentityUseCase *authapp.UnlinkIdentityUseCase,
+	logger zerolog.Logger,
+) *OAuthHandler {
+	return &OAuthHandler{
+		Providers:             providers,
+		StartOAuthUseCase:     startOAuthUseCase,
+		OAuthLoginUseCase:     oauthLoginUseCase,
+		LinkOAuthUseCase:      linkOAuthUseCase,
+		ListIdentitiesUseCase: listIdentitiesUseCase,
+		UnlinkIdentityUseCase: unlinkIdentityUseCase,
+		Logger:                logger.With().Str("component", "OAuthHandler").Logger(),
+	}
+}
+
+// ListProviders godoc
+// @Summary List login providers
+// @Description Names of the configured social login providers
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} authapp.OAuthProvidersSuccessResponseDoc "Login providers retrieved successfully"
+// @Router /api/auth/oauth/providers [get]
+func (h *OAuthHandler) ListProviders(c *echo.Context) error {
+	return response.Success(c, h.Providers.Names(), "Login providers retrieved successfully")
+}
+
+// StartLogin godoc
+// @Summary Start social login
+// @Description Get the provider URL to send the browser to. The provider redirects back to the frontend with code and state
+// @Tags Auth
+// @Produce json
+// @Param provider path string true "Provider name"
+// @Success 200 {object} authapp.OAuthStartSuccessResponseDoc "Login started"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/oauth/{provider}/start [get]
+func (h *OAuthHandler) StartLogin(c *echo.Context) error {
+	return h.start(c, uuid.Nil)
+}
+
+// CompleteLogin godoc
+// @Summary Complete social login
+// @Description Exchange the code and state from the provider redirect for access and refresh tokens, or a two-factor challenge
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param provider path string true "Provider name"
+// @Param body body authapp.OAuthCallbackRequest true "Code and state"
+// @Success 200 {object} authapp.LoginSuccessResponseDoc "Login success"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/oauth/{provider}/callback [post]
+func (h *OAuthHandler) CompleteLogin(c *echo.Context) error {
+	var in authapp.OAuthCallbackRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	result, err := h.OAuthLoginUseCase.Execute(c.Request().Context(), c.Param("provider"), in, clientInfo(c))
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Str("provider", c.Param("provider")).Msg("failed to complete social login")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	if result.MFARequired {
+		return response.Success(c, result, "Two-factor authentication required")
+	}
+	return response.Success(c, result, "Login success")
+}
+
+// ListIdentities godoc
+// @Summary List linked providers
+// @Description List the social login accounts linked to the authenticated user
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} authapp.ListIdentitiesSuccessResponseDoc "Linked accounts retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/identities [get]
+func (h *OAuthHandler) ListIdentities(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	result, err := h.ListIdentitiesUseCase.Execute(c.Request().Context(), userID)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to list identities")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Linked accounts retrieved successfully")
+}
+
+// StartLink godoc
+// @Summary Start linking a provider
+// @Description Get the provider URL to link a social login account to the authenticated user
+// @Tags Auth
+// @Produce json
+// @Param provider path string true "Provider name"
+// @Success 200 {object} authapp.OAuthStartSuccessResponseDoc "Login started"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/identities/{provider}/start [post]
+func (h *OAuthHandler) StartLink(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	return h.start(c, userID)
+}
+
+// CompleteLink godoc
+// @Summary Complete linking a provider
+// @Description Exchange the code and state from the provider redirect and link the account
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param provider path string true "Provider name"
+// @Param body body authapp.OAuthCallbackRequest true "Code and state"
+// @Success 200 {object} authapp.IdentitySuccessResponseDoc "Account linked successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/identities/{provider}/callback [post]
+func (h *OAuthHandler) CompleteLink(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	var in authapp.OAuthCallbackRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	result, err := h.LinkOAuthUseCase.Execute(c.Request().Context(), userID, c.Param("provider"), in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Str("provider", c.Param("provider")).Msg("failed to link identity")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Account linked successfully")
+}
+
+// UnlinkIdentity godoc
+// @Summary Unlink a provider
+// @Description Remove a linked social login account. The last one can only be removed once a password is set
+// @Tags Auth
+// @Produce json
+// @Param id path string true "Linked account ID"
+// @Success 200 {object} authapp.UnlinkIdentitySuccessResponseDoc "Account unlinked successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/identities/{id} [delete]
+func (h *OAuthHandler) UnlinkIdentity(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid linked account ID")
+	}
+	if err := h.UnlinkIdentityUseCase.Execute(c.Request().Context(), userID, id); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to unlink identity")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Account unlinked successfully")
+}
+
+func (h *OAuthHandler) start(c *echo.Context, linkUserID uuid.UUID) error {
+	result, err := h.StartOAuthUseCase.Execute(c.Request().Context(), c.Param("provider"), linkUserID)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Str("provider", c.Param("provider")).Msg("failed to start social login")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Login started")
+}
@@ -0,0 +1,405 @@
+package identityhttp
+
+import (
+	orgapp "go-ai/internal/identity/application/org"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/rs/zerolog"
+)
+
+type OrgHandler struct {
+	CreateOrganizationUseCase *orgapp.CreateOrganizationUseCase
+	ListOrganizationsUseCase  *orgapp.ListOrganizationsUseCase
+	RenameOrganizationUseCase *orgapp.RenameOrganizationUseCase
+	SwitchOrganizationUseCase *orgapp.SwitchOrganizationUseCase
+	ListMembersUseCase        *orgapp.ListMembersUseCase
+	ChangeMemberRoleUseCase   *orgapp.ChangeMemberRoleUseCase
+	RemoveMemberUseCase       *orgapp.RemoveMemberUseCase
+	InviteMemberUseCase       *orgapp.InviteMemberUseCase
+	ListInvitationsUseCase    *orgapp.ListInvitationsUseCase
+	RevokeInvitationUseCase   *orgapp.RevokeInvitationUseCase
+	AcceptInvitationUseCase   *orgapp.AcceptInvitationUseCase
+	Logger                    zerolog.Logger
+}
+
+func NewOrgHandler(
+	createOrganizationUseCase *orgapp.CreateOrganizationUseCase,
+	listOrganizationsUseCase *orgapp.ListOrganizationsUseCase,
+	renameOrganizationUseCase *orgapp.RenameOrganizationUseCase,
+	switchOrganizationUseCase *orgapp.SwitchOrganizationUseCase,
+	listMembersUseCase *orgapp.ListMembersUseCase,
+	changeMemberRoleUseCase *orgapp.ChangeMemberRoleUseCase,
+	removeMemberUseCase *orgapp.RemoveMemberUseCase,
+	inviteMemberUseCase *orgapp.InviteMemberUseCase,
+	listInvitationsUseCase *orgapp.ListInvitationsUseCase,
+	revokeInvitationUseCase *orgapp.RevokeInvitationUseCase,
+	acceptInvitationUseCase *orgapp.AcceptInvitationUseCase,
+	logger zerolog.Logger,
+) *OrgHandler {
+	return &OrgHandler{
+		CreateOrganizationUseCase: createOrganizationUseCase,
+		ListOrganizationsUseCase:  listOrganizationsUseCase,
+		RenameOrganizationUseCase: renameOrganizationUseCase,
+		SwitchOrganizationUseCase: switchOrganizationUseCase,
+		ListMembersUseCase:        listMembersUseCase,
+		ChangeMemberRoleUseCase:   changeMemberRoleUseCase,
+		RemoveMemberUseCase:       removeMemberUseCase,
+		InviteMemberUseCase:       inviteMemberUseCase,
+		ListInvitationsUseCase:    listInvitationsUseCase,
+		RevokeInvitationUseCase:   revokeInvitationUseCase,
+		AcceptInvitationUseCase:   acceptInvitationUseCase,
+		Logger:                    logger.With().Str("component", "OrgHandler").Logger(),
+	}
+}
+
+// CreateOrganization godoc
+// @Summary Create an organization
+// @Description Create an organization with the authenticated user as its owner
+// @Tags Organizations
+// @Accept json
+// @Produce json
+// @Param body body orgapp.CreateOrganizationRequest true "Organization name"
+// @Success 200 {object} orgapp.OrganizationSuccessResponseDoc "Organization created successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/orgs [post]
+func (h *OrgHandler) CreateOrganization(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	var in orgapp.CreateOrganizationRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	result, err := h.CreateOrganizationUseCase.Execute(c.Request().Context(), userID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to create organization")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Organization created successfully")
+}
+
+// ListOrganizations godoc
+// @Summary List my organizations
+// @Description List the organizations the authenticated user belongs to. The one requests act in is flagged active
+// @Tags Organizations
+// @Produce json
+// @Success 200 {object} orgapp.ListOrganizationsSuccessResponseDoc "Organizations retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/orgs [get]
+func (h *OrgHandler) ListOrganizations(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	sid, _ := c.Get("sid").(string)
+	result, err := h.ListOrganizationsUseCase.Execute(c.Request().Context(), userID, sid)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to list organizations")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Organizations retrieved successfully")
+}
+
+// RenameOrganization godoc
+// @Summary Rename an organization
+// @Description Rename an organization. Owners only
+// @Tags Organizations
+// @Accept json
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Param body body orgapp.RenameOrganizationRequest true "New name"
+// @Success 200 {object} orgapp.OrganizationSuccessResponseDoc "Organization renamed successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/orgs/{id} [patch]
+func (h *OrgHandler) RenameOrganization(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid organization ID")
+	}
+	var in orgapp.RenameOrganizationRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	result, err := h.RenameOrganizationUseCase.Execute(c.Request().Context(), userID, orgID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to rename organization")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Organization renamed successfully")
+}
+
+// SwitchOrganization godoc
+// @Summary Switch the active organization
+// @Description Make the organization the default for this session. The X-Organization-ID header still overrides it per request
+// @Tags Organizations
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Success 200 {object} orgapp.OrganizationSuccessResponseDoc "Organization switched successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/orgs/{id}/switch [post]
+func (h *OrgHandler) SwitchOrganization(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	sid, _ := c.Get("sid").(string)
+	orgID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid organization ID")
+	}
+	result, err := h.SwitchOrganizationUseCase.Execute(c.Request().Context(), userID, sid, orgID)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to switch organization")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Organization switched successfully")
+}
+
+// ListMembers godoc
+// @Summary List organization members
+// @Description List the members of an organization the authenticated user belongs to
+// @Tags Organizations
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Success 200 {object} orgapp.ListMembersSuccessResponseDoc "Members retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/orgs/{id}/members [get]
+func (h *OrgHandler) ListMembers(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid organization ID")
+	}
+	result, err := h.ListMembersUseCase.Execute(c.Request().Context(), userID, orgID)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to list organization members")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Members retrieved successfully")
+}
+
+// ChangeMemberRole godoc
+// @Summary Change a member's role
+// @Description Change a member's organization role. Managers can only move staff and managers below owner
+// @Tags Organizations
+// @Accept json
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Param user_id path string true "Member user ID"
+// @Param body body orgapp.ChangeMemberRoleRequest true "owner, manager or staff"
+// @Success 200 {object} response.SuccessBaseDoc "Member role updated successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/orgs/{id}/members/{user_id} [patch]
+func (h *OrgHandler) ChangeMemberRole(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid organization ID")
+	}
+	memberID, err := uuid.Parse(c.Param("user_id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid user ID")
+	}
+	var in orgapp.ChangeMemberRoleRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	if err := h.ChangeMemberRoleUseCase.Execute(c.Request().Context(), userID, orgID, memberID, in); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to change organization member role")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Member role updated successfully")
+}
+
+// RemoveMember godoc
+// @Summary Remove a member
+// @Description Remove a member from an organization, or leave it by passing your own user ID. The last owner cannot leave
+// @Tags Organizations
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Param user_id path string true "Member user ID"
+// @Success 200 {object} response.SuccessBaseDoc "Member removed successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/orgs/{id}/members/{user_id} [delete]
+func (h *OrgHandler) RemoveMember(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid organization ID")
+	}
+	memberID, err := uuid.Parse(c.Param("user_id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid user ID")
+	}
+	if err := h.RemoveMemberUseCase.Execute(c.Request().Context(), userID, orgID, memberID); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to remove organization member")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Member removed successfully")
+}
+
+// InviteMember godoc
+// @Summary Invite a member
+// @Description Email an expiring invitation link. Managers can invite staff and managers, owners can invite any role
+// @Tags Organizations
+// @Accept json
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Param body body orgapp.InviteMemberRequest true "Email and role"
+// @Success 200 {object} orgapp.InvitationSuccessResponseDoc "Invitation sent successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/orgs/{id}/invitations [post]
+func (h *OrgHandler) InviteMember(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid organization ID")
+	}
+	var in orgapp.InviteMemberRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	result, err := h.InviteMemberUseCase.Execute(c.Request().Context(), userID, orgID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to invite organization member")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Invitation sent successfully")
+}
+
+// ListInvitations godoc
+// @Summary List pending invitations
+// @Description List an organization's pending invitations. Managers and owners only
+// @Tags Organizations
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Success 200 {object} orgapp.ListInvitationsSuccessResponseDoc "Invitations retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/orgs/{id}/invitations [get]
+func (h *OrgHandler) ListInvitations(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid organization ID")
+	}
+	result, err := h.ListInvitationsUseCase.Execute(c.Request().Context(), userID, orgID)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to list organization invitations")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Invitations retrieved successfully")
+}
+
+// RevokeInvitation godoc
+// @Summary Revoke an invitation
+// @Description Revoke a pending invitation. Managers and owners only
+// @Tags Organizations
+// @Produce json
+// @Param id path string true "Organization ID"
+// @Param invitation_id path string true "Invitation ID"
+// @Success 200 {object} response.SuccessBaseDoc "Invitation revoked successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/orgs/{id}/invitations/{invitation_id} [delete]
+func (h *OrgHandler) RevokeInvitation(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid organization ID")
+	}
+	invitationID, err := uuid.Parse(c.Param("invitation_id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid invitation ID")
+	}
+	if err := h.RevokeInvitationUseCase.Execute(c.Request().Context(), userID, orgID, invitationID); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to revoke organization invitation")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Invitation revoked successfully")
+}
+
+// AcceptInvitation godoc
+// @Summary Accept an invitation
+// @Description Join an organization with the token from an invitation email. The signed-in account must own the invited address
+// @Tags Organizations
+// @Accept json
+// @Produce json
+// @Param body body orgapp.AcceptInvitationRequest true "Invitation token"
+// @Success 200 {object} orgapp.OrganizationSuccessResponseDoc "Invitation accepted successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/orgs/invitations/accept [post]
+func (h *OrgHandler) AcceptInvitation(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	var in orgapp.AcceptInvitationRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	result, err := h.AcceptInvitationUseCase.Execute(c.Request().Context(), userID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to accept organization invitation")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Invitation accepted successfully")
+}
@@ -0,0 +1,109 @@
+package identityhttp
+
+import (
+	authapp "go-ai/internal/identity/application/auth"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/rs/zerolog"
+)
+
+type PhoneHandler struct {
+	SendPhoneCodeUseCase *authapp.SendPhoneCodeUseCase
+	VerifyPhoneUseCase   *authapp.VerifyPhoneUseCase
+	RemovePhoneUseCase   *authapp.RemovePhoneUseCase
+	Logger               zerolog.Logger
+}
+
+func NewPhoneHandler(
+	sendPhoneCodeUseCase *authapp.SendPhoneCodeUseCase,
+	verifyPhoneUseCase *authapp.VerifyPhoneUseCase,
+	removePhoneUseCase *authapp.RemovePhoneUseCase,
+	logger zerolog.Logger,
+) *PhoneHandler {
+	return &PhoneHandler{
+		SendPhoneCodeUseCase: sendPhoneCodeUseCase,
+		VerifyPhoneUseCase:   verifyPhoneUseCase,
+		RemovePhoneUseCase:   removePhoneUseCase,
+		Logger:               logger.With().Str("component", "PhoneHandler").Logger(),
+	}
+}
+
+// SendCode godoc
+// @Summary Send phone verification code
+// @Description Text a one-time code to the phone number on the profile. Codes are rate limited per user and per number
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} authapp.SendPhoneCodeSuccessResponseDoc "Verification code sent"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/phone/send-code [post]
+func (h *PhoneHandler) SendCode(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	result, err := h.SendPhoneCodeUseCase.Execute(c.Request().Context(), userID)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to send phone verification code")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Verification code sent")
+}
+
+// Verify godoc
+// @Summary Verify phone number
+// @Description Confirm the phone number on the profile with the code sent to it
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param body body authapp.VerifyPhoneRequest true "Verification code"
+// @Success 200 {object} authapp.VerifyPhoneSuccessResponseDoc "Phone number verified"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/phone/verify [post]
+func (h *PhoneHandler) Verify(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	var in authapp.VerifyPhoneRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	if err := h.VerifyPhoneUseCase.Execute(c.Request().Context(), userID, in); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to verify phone number")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Phone number verified")
+}
+
+// Remove godoc
+// @Summary Remove phone number
+// @Description Remove the phone number from the profile, verified or not
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} authapp.RemovePhoneSuccessResponseDoc "Phone number removed"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/phone [delete]
+func (h *PhoneHandler) Remove(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	if err := h.RemovePhoneUseCase.Execute(c.Request().Context(), userID); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to remove phone number")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Phone number removed")
+}
@@ -0,0 +1,198 @@
+package identityhttp
+
+import (
+	rbacapp "go-ai/internal/identity/application/rbac"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/rs/zerolog"
+)
+
+type RbacHandler struct {
+	ListRolesUseCase          *rbacapp.ListRolesUseCase
+	CreateRoleUseCase         *rbacapp.CreateRoleUseCase
+	DeleteRoleUseCase         *rbacapp.DeleteRoleUseCase
+	SetRolePermissionsUseCase *rbacapp.SetRolePermissionsUseCase
+	ListPermissionsUseCase    *rbacapp.ListPermissionsUseCase
+	CreatePermissionUseCase   *rbacapp.CreatePermissionUseCase
+	Logger                    zerolog.Logger
+}
+
+func NewRbacHandler(
+	listRolesUseCase *rbacapp.ListRolesUseCase,
+	createRoleUseCase *rbacapp.CreateRoleUseCase,
+	deleteRoleUseCase *rbacapp.DeleteRoleUseCase,
+	setRolePermissionsUseCase *rbacapp.SetRolePermissionsUseCase,
+	listPermissionsUseCase *rbacapp.ListPermissionsUseCase,
+	createPermissionUseCase *rbacapp.CreatePermissionUseCase,
+	logger zerolog.Logger,
+) *RbacHandler {
+	return &RbacHandler{
+		ListRolesUseCase:          listRolesUseCase,
+		CreateRoleUseCase:         createRoleUseCase,
+		DeleteRoleUseCase:         deleteRoleUseCase,
+		SetRolePermissionsUseCase: setRolePermissionsUseCase,
+		ListPermissionsUseCase:    listPermissionsUseCase,
+		CreatePermissionUseCase:   createPermissionUseCase,
+		Logger:                    logger.With().Str("component", "RbacHandler").Logger(),
+	}
+}
+
+// ListRoles godoc
+// @Summary List roles
+// @Description List roles with their permission grants
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} rbacapp.ListRolesSuccessResponseDoc "Roles retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/admin/roles [get]
+func (h *RbacHandler) ListRoles(c *echo.Context) error {
+	roles, err := h.ListRolesUseCase.Execute(c.Request().Context())
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to list roles")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, roles, "Roles retrieved successfully")
+}
+
+// CreateRole godoc
+// @Summary Create a role
+// @Description Create a custom role, optionally with initial permission grants. The caller must hold every grant
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param body body rbacapp.CreateRoleRequest true "Role payload"
+// @Success 200 {object} rbacapp.RoleSuccessResponseDoc "Role created successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/admin/roles [post]
+func (h *RbacHandler) CreateRole(c *echo.Context) error {
+	actorID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	var in rbacapp.CreateRoleRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	role, err := h.CreateRoleUseCase.Execute(c.Request().Context(), actorID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to create role")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, role, "Role created successfully")
+}
+
+// DeleteRole godoc
+// @Summary Delete a role
+// @Description Delete a custom role that is not assigned to any user
+// @Tags Admin
+// @Produce json
+// @Param id path int true "Role ID"
+// @Success 200 {object} rbacapp.DeleteRoleSuccessResponseDoc "Role deleted successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/admin/roles/{id} [delete]
+func (h *RbacHandler) DeleteRole(c *echo.Context) error {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid role ID")
+	}
+	if err := h.DeleteRoleUseCase.Execute(c.Request().Context(), id); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to delete role")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Role deleted successfully")
+}
+
+// SetRolePermissions godoc
+// @Summary Replace the permissions of a role
+// @Description Replace every grant of a role. Wildcards such as models:* are allowed. The caller must hold both the old and the new grants.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Role ID"
+// @Param body body rbacapp.SetRolePermissionsRequest true "Permission names"
+// @Success 200 {object} rbacapp.RoleSuccessResponseDoc "Role permissions updated successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/admin/roles/{id}/permissions [put]
+func (h *RbacHandler) SetRolePermissions(c *echo.Context) error {
+	actorID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid role ID")
+	}
+	var in rbacapp.SetRolePermissionsRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	role, err := h.SetRolePermissionsUseCase.Execute(c.Request().Context(), actorID, id, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to set role permissions")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, role, "Role permissions updated successfully")
+}
+
+// ListPermissions godoc
+// @Summary List permissions
+// @Description List the permission catalogue
+// @Tags Admin
+// @Produce json
+// @Success 200 {object} rbacapp.ListPermissionsSuccessResponseDoc "Permissions retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/admin/permissions [get]
+func (h *RbacHandler) ListPermissions(c *echo.Context) error {
+	perms, err := h.ListPermissionsUseCase.Execute(c.Request().Context())
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to list permissions")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, perms, "Permissions retrieved successfully")
+}
+
+// CreatePermission godoc
+// @Summary Create a permission
+// @Description Add a permission to the catalogue so that it can be granted to roles
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param body body rbacapp.CreatePermissionRequest true "Permission payload"
+// @Success 200 {object} rbacapp.PermissionSuccessResponseDoc "Permission created successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/admin/permissions [post]
+func (h *RbacHandler) CreatePermission(c *echo.Context) error {
+	var in rbacapp.CreatePermissionRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	perm, err := h.CreatePermissionUseCase.Execute(c.Request().Context(), in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to create permission")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, perm, "Permission created successfully")
+}
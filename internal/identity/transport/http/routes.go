@@ -1,6 +1,7 @@
 package identityhttp
 
 import (
+	"go-ai/internal/identity/domain/rbac"
 	middlewares "go-ai/internal/identity/transport/middlewares"
 
 	"github.com/labstack/echo/v5"
@@ -15,8 +16,123 @@ func RegisterIdentityRoutes(api *echo.Group, h *AuthHandler, m *middlewares.Iden
 	auth.POST("/refresh-token", h.RefreshToken)
 
 	// Protected
-	auth.GET("/profile", h.GetProfile, m.Handler)
-	auth.PATCH("/profile", h.UpdateProfile, m.Handler)
-	auth.PATCH("/change-password", h.ChangePassword, m.Handler)
-	auth.POST("/logout", h.Logout, m.Handler)
+	auth.GET("/profile", h.GetProfile, m.SessionOnly)
+	auth.PATCH("/profile", h.UpdateProfile, m.SessionOnly)
+	auth.PATCH("/change-password", h.ChangePassword, m.SessionOnly)
+	auth.POST("/logout", h.Logout, m.SessionOnly)
+}
+
+func RegisterSessionRoutes(api *echo.Group, h *SessionHandler, m *middlewares.IdentityMiddleware) {
+	auth := api.Group("/auth", m.SessionOnly)
+
+	auth.GET("/sessions", h.ListSessions)
+	auth.DELETE("/sessions/:sid", h.RevokeSession)
+	auth.POST("/logout-all", h.LogoutAll)
+	auth.POST("/stream-ticket", h.IssueStreamTicket, m.Organization)
+}
+
+func RegisterAccountRoutes(api *echo.Group, h *AccountHandler, m *middlewares.IdentityMiddleware) {
+	auth := api.Group("/auth")
+
+	// Public
+	auth.POST("/forgot-password", h.ForgotPassword)
+	auth.POST("/reset-password", h.ResetPassword)
+	auth.POST("/verify-email", h.VerifyEmail)
+
+	// Protected
+	auth.POST("/resend-verification", h.ResendVerification, m.SessionOnly)
+	auth.DELETE("/account", h.DeleteAccount, m.SessionOnly)
+	auth.GET("/export", h.ExportData, m.SessionOnly)
+}
+
+func RegisterPhoneRoutes(api *echo.Group, h *PhoneHandler, m *middlewares.IdentityMiddleware) {
+	phone := api.Group("/auth/phone", m.SessionOnly)
+
+	phone.POST("/send-code", h.SendCode)
+	phone.POST("/verify", h.Verify)
+	phone.DELETE("", h.Remove)
+}
+
+func RegisterMFARoutes(api *echo.Group, h *MFAHandler, m *middlewares.IdentityMiddleware) {
+	// Public: second step of the login
+	api.POST("/auth/login/2fa", h.LoginMFA)
+
+	mfa := api.Group("/auth/2fa", m.SessionOnly)
+	mfa.GET("", h.Status)
+	mfa.POST("/enroll", h.Enroll)
+	mfa.POST("/confirm", h.Confirm)
+	mfa.POST("/disable", h.Disable)
+	mfa.POST("/recovery-codes", h.RegenerateRecoveryCodes)
+}
+
+func RegisterAPIKeyRoutes(api *echo.Group, h *APIKeyHandler, m *middlewares.IdentityMiddleware) {
+	keys := api.Group("/auth/api-keys", m.SessionOnly)
+
+	keys.POST("", h.CreateAPIKey)
+	keys.GET("", h.ListAPIKeys)
+	keys.DELETE("/:id", h.RevokeAPIKey)
+}
+
+func RegisterOAuthRoutes(api *echo.Group, h *OAuthHandler, m *middlewares.IdentityMiddleware) {
+	// Public
+	api.GET("/auth/oauth/providers", h.ListProviders)
+	api.GET("/auth/oauth/:provider/start", h.StartLogin)
+	api.POST("/auth/oauth/:provider/callback", h.CompleteLogin)
+
+	identities := api.Group("/auth/identities", m.SessionOnly)
+	identities.GET("", h.ListIdentities)
+	identities.POST("/:provider/start", h.StartLink)
+	identities.POST("/:provider/callback", h.CompleteLink)
+	identities.DELETE("/:id", h.UnlinkIdentity)
+}
+
+func RegisterRbacRoutes(api *echo.Group, h *RbacHandler, m *middlewares.IdentityMiddleware, rbacService rbac.Service) {
+	admin := api.Group("/admin", m.Handler, middlewares.RequirePermission(rbacService, rbac.RolesManage))
+
+	admin.GET("/roles", h.ListRoles)
+	admin.POST("/roles", h.CreateRole)
+	admin.DELETE("/roles/:id", h.DeleteRole)
+	admin.PUT("/roles/:id/permissions", h.SetRolePermissions)
+
+	admin.GET("/permissions", h.ListPermissions)
+	admin.POST("/permissions", h.CreatePermission)
+}
+
+func RegisterUserAdminRoutes(api *echo.Group, h *UserHandler, m *middlewares.IdentityMiddleware, rbacService rbac.Service) {
+	users := api.Group("/admin/users", m.Handler)
+	read := middlewares.RequirePermission(rbacService, rbac.UsersRead)
+	manage := middlewares.RequirePermission(rbacService, rbac.UsersManage)
+
+	users.GET("", h.ListUsers, read)
+	users.GET("/:id", h.GetUser, read)
+	users.PATCH("/:id/role", h.ChangeUserRole, manage)
+	users.PATCH("/:id/status", h.SetUserStatus, manage)
+	users.POST("/:id/force-password-reset", h.ForcePasswordReset, manage)
+	users.POST("/:id/unlock", h.UnlockUser, manage)
+}
+
+func RegisterAuditRoutes(api *echo.Group, h *AuditHandler, m *middlewares.IdentityMiddleware, rbacService rbac.Service) {
+	api.GET("/admin/audit-events", h.ListAuditEvents, m.Handler, middlewares.RequirePermission(rbacService, rbac.AuditRead))
+}
+
+// RegisterJWKSRoutes serves the key set at the root, where JWT libraries
+// expect it, rather than under /api.
+func RegisterJWKSRoutes(e *echo.Echo, h *JWKSHandler) {
+	e.GET("/.well-known/jwks.json", h.JWKS)
+}
+
+func RegisterOrgRoutes(api *echo.Group, h *OrgHandler, m *middlewares.IdentityMiddleware) {
+	orgs := api.Group("/orgs", m.SessionOnly)
+
+	orgs.GET("", h.ListOrganizations)
+	orgs.POST("", h.CreateOrganization)
+	orgs.POST("/invitations/accept", h.AcceptInvitation)
+	orgs.PATCH("/:id", h.RenameOrganization)
+	orgs.POST("/:id/switch", h.SwitchOrganization)
+	orgs.GET("/:id/members", h.ListMembers)
+	orgs.PATCH("/:id/members/:user_id", h.ChangeMemberRole)
+	orgs.DELETE("/:id/members/:user_id", h.RemoveMember)
+	orgs.GET("/:id/invitations", h.ListInvitations)
+	orgs.POST("/:id/invitations", h.InviteMember)
+	orgs.DELETE("/:id/invitations/:invitation_id", h.RevokeInvitation)
 }
@@ -0,0 +1,140 @@
+package identityhttp
+
+import (
+	authapp "go-ai/internal/identity/application/auth"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/rs/zerolog"
+)
+
+type SessionHandler struct {
+	ListSessionsUseCase  *authapp.ListSessionsUseCase
+	RevokeSessionUseCase *authapp.RevokeSessionUseCase
+	LogoutAllUseCase     *authapp.LogoutAllUseCase
+	StreamTicketUseCase  *authapp.IssueStreamTicketUseCase
+	Logger               zerolog.Logger
+}
+
+func NewSessionHandler(
+	listSessionsUseCase *authapp.ListSessionsUseCase,
+	revokeSessionUseCase *authapp.RevokeSessionUseCase,
+	logoutAllUseCase *authapp.LogoutAllUseCase,
+	streamTicketUseCase *authapp.IssueStreamTicketUseCase,
+	logger zerolog.Logger,
+) *SessionHandler {
+	return &SessionHandler{
+		ListSessionsUseCase:  listSessionsUseCase,
+		RevokeSessionUseCase: revokeSessionUseCase,
+		LogoutAllUseCase:     logoutAllUseCase,
+		StreamTicketUseCase:  streamTicketUseCase,
+		Logger:               logger.With().Str("component", "SessionHandler").Logger(),
+	}
+}
+
+// ListSessions godoc
+// @Summary List my sessions
+// @Description List the signed-in devices of the authenticated user, most recently active first
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} authapp.ListSessionsSuccessResponseDoc "Sessions retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/sessions [get]
+func (h *SessionHandler) ListSessions(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	sid, _ := c.Get("sid").(string)
+	sessions, err := h.ListSessionsUseCase.Execute(c.Request().Context(), userID, sid)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to list sessions")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, sessions, "Sessions retrieved successfully")
+}
+
+// RevokeSession godoc
+// @Summary Revoke a session
+// @Description Sign out one of the authenticated user's devices
+// @Tags Auth
+// @Produce json
+// @Param sid path string true "Session ID"
+// @Success 200 {object} authapp.RevokeSessionSuccessResponseDoc "Session revoked successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/sessions/{sid} [delete]
+func (h *SessionHandler) RevokeSession(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	err := h.RevokeSessionUseCase.Execute(c.Request().Context(), userID, c.Param("sid"))
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to revoke session")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Session revoked successfully")
+}
+
+// LogoutAll godoc
+// @Summary Log out everywhere
+// @Description Revoke every session of the authenticated user, including the current one
+// @Tags Auth
+// @Produce json
+// @Success 200 {object} authapp.LogoutAllSuccessResponseDoc "Logged out from all devices"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/logout-all [post]
+func (h *SessionHandler) LogoutAll(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	result, err := h.LogoutAllUseCase.Execute(c.Request().Context(), userID)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to log out all sessions")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Logged out from all devices")
+}
+
+// IssueStreamTicket godoc
+// @Summary Get a stream ticket
+// @Description Issue a single-use ticket that opens GET /api/stream?ticket=... for the current session, for clients such as EventSource that cannot send an Authorization header. The stream acts in the organization resolved for this request
+// @Tags Stream
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Success 200 {object} authapp.StreamTicketSuccessResponseDoc "Stream ticket issued"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/auth/stream-ticket [post]
+func (h *SessionHandler) IssueStreamTicket(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	sid, _ := c.Get("sid").(string)
+	ticket, err := h.StreamTicketUseCase.Execute(c.Request().Context(), userID, sid, orgID)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to issue stream ticket")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, ticket, "Stream ticket issued")
+}
@@ -1,19 +1,223 @@
 package identityhttp
 
-// import (
-// 	"go-ai/internal/domain/user"
-
-// 	"github.com/go-playground/validator/v10"
-// )
-
-// type UserHandler struct {
-// 	svc      *user.Service
-// 	validate *validator.Validate
-// }
-
-// func NewUserHandler(svc *user.Service, v *validator.Validate) *UserHandler {
-// 	return &UserHandler{
-// 		svc:      svc,
-// 		validate: v,
-// 	}
-// }
+import (
+	userapp "go-ai/internal/identity/application/user"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/rs/zerolog"
+)
+
+type UserHandler struct {
+	ListUsersUseCase          *userapp.ListUsersUseCase
+	GetUserUseCase            *userapp.GetUserUseCase
+	ChangeUserRoleUseCase     *userapp.ChangeUserRoleUseCase
+	SetUserStatusUseCase      *userapp.SetUserStatusUseCase
+	ForcePasswordResetUseCase *userapp.ForcePasswordResetUseCase
+	UnlockUserUseCase         *userapp.UnlockUserUseCase
+	Logger                    zerolog.Logger
+}
+
+func NewUserHandler(
+	listUsersUseCase *userapp.ListUsersUseCase,
+	getUserUseCase *userapp.GetUserUseCase,
+	changeUserRoleUseCase *userapp.ChangeUserRoleUseCase,
+	setUserStatusUseCase *userapp.SetUserStatusUseCase,
+	forcePasswordResetUseCase *userapp.ForcePasswordResetUseCase,
+	unlockUserUseCase *userapp.UnlockUserUseCase,
+	logger zerolog.Logger,
+) *UserHandler {
+	return &UserHandler{
+		ListUsersUseCase:          listUsersUseCase,
+		GetUserUseCase:            getUserUseCase,
+		ChangeUserRoleUseCase:     changeUserRoleUseCase,
+		SetUserStatusUseCase:      setUserStatusUseCase,
+		ForcePasswordResetUseCase: forcePasswordResetUseCase,
+		UnlockUserUseCase:         unlockUserUseCase,
+		Logger:                    logger.With().Str("component", "UserHandler").Logger(),
+	}
+}
+
+// ListUsers godoc
+// @Summary List users
+// @Description List user accounts, newest first
+// @Tags Admin
+// @Produce json
+// @Param search query string false "Matches email or full name"
+// @Param role query string false "Role name"
+// @Param is_active query bool false "Account status"
+// @Param page query int false "Page number"
+// @Param limit query int false "Page size (max 100)"
+// @Success 200 {object} userapp.ListUsersSuccessResponseDoc "Users retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/admin/users [get]
+func (h *UserHandler) ListUsers(c *echo.Context) error {
+	var in userapp.ListUsersRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid query parameters")
+	}
+	users, err := h.ListUsersUseCase.Execute(c.Request().Context(), in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to list users")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, users, "Users retrieved successfully")
+}
+
+// GetUser godoc
+// @Summary Get a user
+// @Description Get a user account
+// @Tags Admin
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} userapp.UserSuccessResponseDoc "User retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/admin/users/{id} [get]
+func (h *UserHandler) GetUser(c *echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid user ID")
+	}
+	u, err := h.GetUserUseCase.Execute(c.Request().Context(), id)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to get user")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, u, "User retrieved successfully")
+}
+
+// ChangeUserRole godoc
+// @Summary Change a user's role
+// @Description Assign a role to a user. Assigning or removing the owner role requires the * permission.
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param body body userapp.ChangeUserRoleRequest true "Role name"
+// @Success 200 {object} userapp.UserSuccessResponseDoc "User role updated successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/admin/users/{id}/role [patch]
+func (h *UserHandler) ChangeUserRole(c *echo.Context) error {
+	actorID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid user ID")
+	}
+	var in userapp.ChangeUserRoleRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	u, err := h.ChangeUserRoleUseCase.Execute(c.Request().Context(), actorID, id, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to change user role")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, u, "User role updated successfully")
+}
+
+// SetUserStatus godoc
+// @Summary Activate or deactivate a user
+// @Description Deactivating a user revokes all of their sessions immediately
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "User ID"
+// @Param body body userapp.SetUserStatusRequest true "Account status"
+// @Success 200 {object} userapp.UserSuccessResponseDoc "User status updated successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/admin/users/{id}/status [patch]
+func (h *UserHandler) SetUserStatus(c *echo.Context) error {
+	actorID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid user ID")
+	}
+	var in userapp.SetUserStatusRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	u, err := h.SetUserStatusUseCase.Execute(c.Request().Context(), actorID, id, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to set user status")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, u, "User status updated successfully")
+}
+
+// ForcePasswordReset godoc
+// @Summary Force a password reset
+// @Description Sign the user out everywhere and refuse login until the password is reset
+// @Tags Admin
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} userapp.UserSuccessResponseDoc "Password reset required"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/admin/users/{id}/force-password-reset [post]
+func (h *UserHandler) ForcePasswordReset(c *echo.Context) error {
+	actorID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid user ID")
+	}
+	u, err := h.ForcePasswordResetUseCase.Execute(c.Request().Context(), actorID, id)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to force password reset")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, u, "Password reset required")
+}
+
+// UnlockUser godoc
+// @Summary Unlock a user's login
+// @Description Lift a login lockout caused by failed attempts and reset the failure counter
+// @Tags Admin
+// @Produce json
+// @Param id path string true "User ID"
+// @Success 200 {object} userapp.UnlockUserSuccessResponseDoc "User unlocked successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/admin/users/{id}/unlock [post]
+func (h *UserHandler) UnlockUser(c *echo.Context) error {
+	actorID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid user ID")
+	}
+	if err := h.UnlockUserUseCase.Execute(c.Request().Context(), actorID, id); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to unlock user")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "User unlocked successfully")
+}
@@ -2,62 +2,143 @@ package middlewares
 
 import (
 	"fmt"
+	"go-ai/internal/identity/domain/apikey"
+	"go-ai/internal/identity/domain/auth"
+	"go-ai/internal/identity/domain/org"
 	"go-ai/internal/identity/infrastructure/cache"
 	"go-ai/internal/platform/config"
-	"go-ai/pkg/helpers"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/jwtkeys"
 	"go-ai/pkg/response"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/labstack/echo/v5"
 )
 
+const APIKeyHeader = "X-API-Key"
+
 type IdentityMiddleware struct {
-	Cache  *cache.AuthCache
-	Config *config.Config
+	Cache   *cache.AuthCache
+	APIKeys apikey.Service
+	Orgs    org.Repository
+	Tokens  jwtkeys.Issuer
+	Config  *config.Config
 }
 
-func NewIdentityMiddleware(cache *cache.AuthCache, config *config.Config) *IdentityMiddleware {
+func NewIdentityMiddleware(cache *cache.AuthCache, apiKeys apikey.Service, orgs org.Repository, tokens jwtkeys.Issuer, config *config.Config) *IdentityMiddleware {
 	return &IdentityMiddleware{
-		Cache:  cache,
-		Config: config,
+		Cache:   cache,
+		APIKeys: apiKeys,
+		Orgs:    orgs,
+		Tokens:  tokens,
+		Config:  config,
 	}
 }
 
+// Handler authenticates a Bearer access token or an X-API-Key. Requests made
+// with a key carry its scopes, which RequirePermission enforces.
 func (m *IdentityMiddleware) Handler(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c *echo.Context) error {
-		// Implement authentication logic here
-		authHeader := c.Request().Header.Get("Authorization")
-		if authHeader == "" {
-			return response.Error(c, 401, "Missing Authorization header")
-		}
-		scheme, token, ok := strings.Cut(authHeader, " ")
-		token = strings.TrimSpace(token)
-		if !ok || scheme != "Bearer" || token == "" {
-			return response.Error(c, 401, "Invalid Authorization header format")
+		if raw := c.Request().Header.Get(APIKeyHeader); raw != "" {
+			return m.authenticateAPIKey(c, raw, next)
 		}
-		claims, err := helpers.VerifyToken(token, m.Config.JwtAccessSecret)
-		if err != nil || claims == nil {
-			return response.Error(c, 401, "Invalid token")
+		return m.authenticateSession(c, next)
+	}
+}
+
+// SessionOnly authenticates a Bearer access token and rejects API keys. It
+// guards account management, which keys must never reach.
+func (m *IdentityMiddleware) SessionOnly(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c *echo.Context) error {
+		if c.Request().Header.Get(APIKeyHeader) != "" {
+			return response.Error(c, http.StatusForbidden, "API keys cannot access this endpoint")
 		}
-		exp := claims.ExpiresAt
-		if exp == nil {
-			return response.Error(c, 401, "Token has expired")
+		return m.authenticateSession(c, next)
+	}
+}
+
+// StreamTicket authenticates the single-use ticket in the ticket query
+// parameter, for clients such as EventSource that cannot send headers, and
+// falls back to SessionOnly without one. The ticket carries the organization
+// it was issued for, so Organization picks it like a switched organization.
+func (m *IdentityMiddleware) StreamTicket(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c *echo.Context) error {
+		raw := c.QueryParam("ticket")
+		if raw == "" {
+			return m.SessionOnly(next)(c)
 		}
-		if time.Now().After(exp.Time) {
-			return response.Error(c, 401, "Token has expired")
+		ctx := c.Request().Context()
+		ticket, err := m.Cache.TakeStreamTicket(ctx, auth.HashToken(raw))
+		if err != nil {
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
 		}
-		sid := claims.Sid
-		if sid == "" {
-			return response.Error(c, 401, "Unauthorized access")
+		if ticket == nil {
+			return response.Error(c, http.StatusUnauthorized, "Invalid or expired stream ticket")
 		}
-		keyAuth := fmt.Sprintf("session_%s", sid)
-		userData, err := m.Cache.GetAuthCache(c.Request().Context(), keyAuth)
-		if err != nil || userData == nil {
-			return response.Error(c, 401, "Unauthorized access")
+		// The session that asked for the ticket must still be signed in.
+		userData, err := m.Cache.GetAuthCache(ctx, fmt.Sprintf("session_%s", ticket.Sid))
+		if err != nil || userData == nil || userData.UserID != ticket.UserID {
+			return response.Error(c, http.StatusUnauthorized, "Unauthorized access")
 		}
-		c.Set("user_id", userData.UserID)
+		c.Set("user_id", ticket.UserID)
+		c.Set("sid", ticket.Sid)
 		c.Set("role", userData.Role)
+		c.Set("active_org_id", ticket.OrgID)
 		return next(c)
 	}
 }
+
+func (m *IdentityMiddleware) authenticateSession(c *echo.Context, next echo.HandlerFunc) error {
+	authHeader := c.Request().Header.Get("Authorization")
+	if authHeader == "" {
+		return response.Error(c, 401, "Missing Authorization header")
+	}
+	scheme, token, ok := strings.Cut(authHeader, " ")
+	token = strings.TrimSpace(token)
+	if !ok || scheme != "Bearer" || token == "" {
+		return response.Error(c, 401, "Invalid Authorization header format")
+	}
+	claims, err := m.Tokens.Verify(token, jwtkeys.UseAccess)
+	if err != nil || claims == nil {
+		return response.Error(c, 401, "Invalid token")
+	}
+	exp := claims.ExpiresAt
+	if exp == nil {
+		return response.Error(c, 401, "Token has expired")
+	}
+	if time.Now().After(exp.Time) {
+		return response.Error(c, 401, "Token has expired")
+	}
+	sid := claims.Sid
+	if sid == "" {
+		return response.Error(c, 401, "Unauthorized access")
+	}
+	keyAuth := fmt.Sprintf("session_%s", sid)
+	userData, err := m.Cache.GetAuthCache(c.Request().Context(), keyAuth)
+	if err != nil || userData == nil {
+		return response.Error(c, 401, "Unauthorized access")
+	}
+	_ = m.Cache.TouchSession(c.Request().Context(), userData.UserID, sid, time.Now())
+	c.Set("user_id", userData.UserID)
+	c.Set("sid", sid)
+	c.Set("role", userData.Role)
+	c.Set("active_org_id", userData.OrgID)
+	return next(c)
+}
+
+func (m *IdentityMiddleware) authenticateAPIKey(c *echo.Context, raw string, next echo.HandlerFunc) error {
+	principal, err := m.APIKeys.Authenticate(c.Request().Context(), raw, c.RealIP())
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	c.Set("user_id", principal.UserID)
+	c.Set("role", principal.Role)
+	c.Set("api_key_id", principal.KeyID)
+	c.Set("api_key_scopes", principal.Scopes)
+	return next(c)
+}
@@ -0,0 +1,70 @@
+package middlewares
+
+import (
+	"context"
+	"go-ai/internal/identity/domain/apikey"
+	transportmw "go-ai/internal/transport/middlewares"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+type keyRepo struct {
+	apikey.Repository
+	cred *apikey.Credential
+}
+
+func (r keyRepo) FindByPrefix(context.Context, string) (*apikey.Credential, error) {
+	return r.cred, nil
+}
+
+func (r keyRepo) Touch(context.Context, uuid.UUID, string) error { return nil }
+
+func TestAPIKeyAllowlistIgnoresForgedForwardedFor(t *testing.T) {
+	raw, _, hash, err := apikey.Generate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cred := &apikey.Credential{
+		Key:         apikey.Entity{ID: uuid.New(), UserID: uuid.New(), SecretHash: hash, AllowedIPs: []string{"198.51.100.7/32"}},
+		OwnerRole:   "user",
+		OwnerActive: true,
+	}
+	m := &IdentityMiddleware{APIKeys: apikey.Service{Repo: keyRepo{cred: cred}}}
+
+	e := echo.New()
+	extractor, err := transportmw.TrustedProxyIPExtractor("172.18.0.0/16")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.IPExtractor = extractor
+	e.GET("/", func(c *echo.Context) error { return c.NoContent(http.StatusOK) }, m.Handler)
+
+	cases := []struct {
+		name   string
+		peer   string
+		xff    string
+		status int
+	}{
+		{"forged header from the internet", "203.0.113.9:5000", "198.51.100.7", http.StatusForbidden},
+		{"forged entry before the proxy's", "172.18.0.2:5000", "198.51.100.7, 203.0.113.9", http.StatusForbidden},
+		{"allowlisted client via proxy", "172.18.0.2:5000", "198.51.100.7", http.StatusOK},
+		{"allowlisted client direct", "198.51.100.7:5000", "", http.StatusOK},
+	}
+	for _, tc := range cases {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = tc.peer
+		req.Header.Set(APIKeyHeader, raw)
+		if tc.xff != "" {
+			req.Header.Set(echo.HeaderXForwardedFor, tc.xff)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		if rec.Code != tc.status {
+			t.Errorf("%s: status = %d, want %d", tc.name, rec.Code, tc.status)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package middlewares
+
+import (
+	"errors"
+	"go-ai/internal/identity/domain/org"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+)
+
+// Organization resolves the organization a request acts in and sets
+// "org_id" and "org_role". It must run after Handler or SessionOnly.
+//
+// The X-Organization-ID header wins, then the organization the session
+// switched to, then the user's oldest membership.
+func (m *IdentityMiddleware) Organization(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c *echo.Context) error {
+		userID, ok := c.Get("user_id").(uuid.UUID)
+		if !ok {
+			return response.Error(c, http.StatusUnauthorized, "Unauthorized access")
+		}
+		orgID, role, err := m.resolveOrganization(c, userID)
+		if err != nil {
+			if ae, ok := err.(domainerr.AppError); ok {
+				return response.Error(c, ae.Status, ae.Msg)
+			}
+			return response.Error(c, http.StatusInternalServerError, "Internal server error")
+		}
+		c.Set("org_id", orgID)
+		c.Set("org_role", role)
+		return next(c)
+	}
+}
+
+func (m *IdentityMiddleware) resolveOrganization(c *echo.Context, userID uuid.UUID) (uuid.UUID, org.Role, error) {
+	ctx := c.Request().Context()
+	if raw := c.Request().Header.Get(org.Header); raw != "" {
+		orgID, err := uuid.Parse(raw)
+		if err != nil {
+			return uuid.Nil, "", org.ErrInvalidOrganization
+		}
+		role, err := m.Orgs.MemberRole(ctx, orgID, userID)
+		if err != nil {
+			return uuid.Nil, "", err
+		}
+		return orgID, role, nil
+	}
+	if active, ok := c.Get("active_org_id").(uuid.UUID); ok && active != uuid.Nil {
+		role, err := m.Orgs.MemberRole(ctx, active, userID)
+		if err == nil {
+			return active, role, nil
+		}
+		if !errors.Is(err, org.ErrNotMember) {
+			return uuid.Nil, "", err
+		}
+	}
+	memberships, err := m.Orgs.ListForUser(ctx, userID)
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+	if len(memberships) == 0 {
+		return uuid.Nil, "", org.ErrNoOrganization
+	}
+	return memberships[0].OrganizationID, memberships[0].Role, nil
+}
@@ -1,6 +1,7 @@
 package middlewares
 
 import (
+	"go-ai/internal/identity/domain/audit"
 	"go-ai/internal/identity/domain/rbac"
 	"go-ai/pkg/response"
 	"net/http"
@@ -9,6 +10,8 @@ import (
 	"github.com/labstack/echo/v5"
 )
 
+// RequirePermission checks perm against the user's role. Requests made with an
+// API key also need a key scope covering perm.
 func RequirePermission(s rbac.Service, perm string) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c *echo.Context) error {
@@ -24,10 +27,25 @@ func RequirePermission(s rbac.Service, perm string) echo.MiddlewareFunc {
 			if err != nil {
 				return response.Error(c, http.StatusInternalServerError, "Internal server error")
 			}
+			if scopes, ok := c.Get("api_key_scopes").([]string); ok {
+				has = has && rbac.PermissionSet(scopes).Allows(perm)
+			}
 			if has {
 				return next(c)
 			}
-			c.Set("role", perm)
+			if s.Audit != nil {
+				e := audit.Event{
+					ActorID:    userUUID,
+					Action:     audit.ActionPermissionDenied,
+					TargetType: audit.TargetPermission,
+					TargetID:   perm,
+					Metadata:   map[string]any{"method": c.Request().Method, "path": c.Path()},
+				}
+				if keyID, ok := c.Get("api_key_id").(uuid.UUID); ok {
+					e.Metadata["api_key_id"] = keyID.String()
+				}
+				s.Audit.Log(c.Request().Context(), e)
+			}
 			return response.Error(c, http.StatusForbidden, "Permission denied")
 		}
 	}
@@ -0,0 +1,50 @@
+package jobapp
+
+import (
+	"context"
+	"encoding/json"
+	"go-ai/internal/jobs/domain/job"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateJobRequest struct {
+	Name       string          `json:"name"`
+	Type       string          `json:"type"`
+	Schedule   string          `json:"schedule"`
+	Payload    json.RawMessage `json:"payload" swaggertype:"object"`
+	MaxRetries *int            `json:"max_retries"`
+}
+
+type CreateJobUseCase struct {
+	Repo     job.Repository
+	Handlers Handlers
+}
+
+func NewCreateJobUseCase(repo job.Repository, handlers Handlers) *CreateJobUseCase {
+	return &CreateJobUseCase{
+		Repo:     repo,
+		Handlers: handlers,
+	}
+}
+
+func (uc *CreateJobUseCase) Execute(ctx context.Context, orgID uuid.UUID, req CreateJobRequest) (*JobResponse, error) {
+	if _, ok := uc.Handlers[req.Type]; !ok {
+		return nil, job.ErrUnknownType
+	}
+	maxRetries := 3
+	if req.MaxRetries != nil {
+		maxRetries = *req.MaxRetries
+	}
+
+	entity, err := job.NewJob(orgID, req.Name, req.Type, req.Schedule, req.Payload, maxRetries, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.Repo.Create(ctx, entity); err != nil {
+		return nil, err
+	}
+	resp := toJobResponse(*entity)
+	return &resp, nil
+}
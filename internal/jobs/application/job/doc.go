@@ -0,0 +1,23 @@
+package jobapp
+
+import (
+	"go-ai/pkg/response"
+)
+
+type JobSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *JobResponse `json:"data,omitempty"`
+}
+
+type ListJobsSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data []JobResponse `json:"data,omitempty"`
+}
+
+type ListJobRunsSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *struct {
+		response.PaginatedResponseDoc
+		Items []JobRunResponse `json:"items"`
+	} `json:"data,omitempty"`
+}
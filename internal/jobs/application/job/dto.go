@@ -0,0 +1,59 @@
+package jobapp
+
+import (
+	"encoding/json"
+	"go-ai/internal/jobs/domain/job"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type JobResponse struct {
+	ID         uuid.UUID       `json:"id"`
+	Name       string          `json:"name"`
+	Type       string          `json:"type"`
+	Schedule   string          `json:"schedule"`
+	Payload    json.RawMessage `json:"payload" swaggertype:"object"`
+	Enabled    bool            `json:"enabled"`
+	MaxRetries int             `json:"max_retries"`
+	NextRunAt  *time.Time      `json:"next_run_at,omitempty"`
+	LastRunAt  *time.Time      `json:"last_run_at,omitempty"`
+}
+
+type JobRunResponse struct {
+	ID         uuid.UUID       `json:"id"`
+	Attempt    int             `json:"attempt"`
+	Status     string          `json:"status"`
+	Instance   string          `json:"instance"`
+	Error      string          `json:"error,omitempty"`
+	Output     json.RawMessage `json:"output,omitempty" swaggertype:"object"`
+	StartedAt  time.Time       `json:"started_at"`
+	FinishedAt *time.Time      `json:"finished_at,omitempty"`
+}
+
+func toJobResponse(j job.Entity) JobResponse {
+	return JobResponse{
+		ID:         j.ID,
+		Name:       j.Name,
+		Type:       j.Type,
+		Schedule:   j.Schedule,
+		Payload:    j.Payload,
+		Enabled:    j.Enabled,
+		MaxRetries: j.MaxRetries,
+		NextRunAt:  j.NextRunAt,
+		LastRunAt:  j.LastRunAt,
+	}
+}
+
+func toJobRunResponse(r job.Run) JobRunResponse {
+	return JobRunResponse{
+		ID:         r.ID,
+		Attempt:    r.Attempt,
+		Status:     string(r.Status),
+		Instance:   r.Instance,
+		Error:      r.Error,
+		Output:     r.Output,
+		StartedAt:  r.StartedAt,
+		FinishedAt: r.FinishedAt,
+	}
+}
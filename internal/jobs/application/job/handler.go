@@ -0,0 +1,23 @@
+package jobapp
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+)
+
+// Handler executes one job type on behalf of the organization that owns the
+// job. The returned output is stored with the run.
+type Handler interface {
+	Run(ctx context.Context, orgID uuid.UUID, payload json.RawMessage) (json.RawMessage, error)
+}
+
+type HandlerFunc func(ctx context.Context, orgID uuid.UUID, payload json.RawMessage) (json.RawMessage, error)
+
+func (f HandlerFunc) Run(ctx context.Context, orgID uuid.UUID, payload json.RawMessage) (json.RawMessage, error) {
+	return f(ctx, orgID, payload)
+}
+
+// Handlers maps a job type to the handler that runs it.
+type Handlers map[string]Handler
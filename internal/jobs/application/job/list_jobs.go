@@ -0,0 +1,30 @@
+package jobapp
+
+import (
+	"context"
+	"go-ai/internal/jobs/domain/job"
+
+	"github.com/google/uuid"
+)
+
+type ListJobsUseCase struct {
+	Repo job.Repository
+}
+
+func NewListJobsUseCase(repo job.Repository) *ListJobsUseCase {
+	return &ListJobsUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *ListJobsUseCase) Execute(ctx context.Context, orgID uuid.UUID) ([]JobResponse, error) {
+	jobs, err := uc.Repo.List(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]JobResponse, 0, len(jobs))
+	for _, j := range jobs {
+		out = append(out, toJobResponse(j))
+	}
+	return out, nil
+}
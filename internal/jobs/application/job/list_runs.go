@@ -0,0 +1,47 @@
+package jobapp
+
+import (
+	"context"
+	"go-ai/internal/jobs/domain/job"
+	"go-ai/pkg/response"
+
+	"github.com/google/uuid"
+)
+
+type ListJobRunsRequest struct {
+	Page  *int32 `query:"page"`
+	Limit *int32 `query:"limit"`
+}
+
+type ListJobRunsUseCase struct {
+	Repo job.Repository
+}
+
+func NewListJobRunsUseCase(repo job.Repository) *ListJobRunsUseCase {
+	return &ListJobRunsUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *ListJobRunsUseCase) Execute(ctx context.Context, orgID, jobID uuid.UUID, req ListJobRunsRequest) (*response.PaginatedResponse[[]JobRunResponse], error) {
+	if _, err := uc.Repo.GetByID(ctx, orgID, jobID); err != nil {
+		return nil, err
+	}
+	page, limit, offset := response.ApplyDefaultPaginated(req.Page, req.Limit)
+	runs, total, err := uc.Repo.ListRuns(ctx, jobID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]JobRunResponse, 0, len(runs))
+	for _, r := range runs {
+		items = append(items, toJobRunResponse(r))
+	}
+	return &response.PaginatedResponse[[]JobRunResponse]{
+		Page:       page,
+		Limit:      limit,
+		TotalItems: total,
+		TotalPages: response.CalculateTotalPages(total, int64(limit)),
+		Items:      items,
+	}, nil
+}
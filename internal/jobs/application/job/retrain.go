@@ -0,0 +1,208 @@
+package jobapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go-ai/internal/coinai"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// ModelStore holds the model currently serving an organization's
+// symbol/interval.
+type ModelStore interface {
+	coinai.ModelStore
+	// Register records model as a new version and returns its version number.
+	Register(ctx context.Context, orgID uuid.UUID, model *coinai.SavedModel, metrics coinai.ValidationMetrics, promote bool) (int, error)
+}
+
+// EventPublisher announces training outcomes to the organization's
+// webhooks.
+type EventPublisher interface {
+	Publish(ctx context.Context, orgID uuid.UUID, eventType string, data any) error
+}
+
+type RetrainPayload struct {
+	Symbol         string  `json:"symbol"`
+	Interval       string  `json:"interval"`
+	Limit          int     `json:"limit"`
+	TrainRatio     float64 `json:"train_ratio"`
+	Epochs         int     `json:"epochs"`
+	LearningRate   float64 `json:"learning_rate"`
+	L2             float64 `json:"l2"`
+	Seed           uint64  `json:"seed"`
+	LongThreshold  float64 `json:"long_threshold"`
+	ShortThreshold float64 `json:"short_threshold"`
+	FeeBPS         float64 `json:"fee_bps"`
+}
+
+type RetrainResult struct {
+	Symbol    string                    `json:"symbol"`
+	Interval  string                    `json:"interval"`
+	Candles   int                       `json:"candles"`
+	Version   int                       `json:"version"`
+	Lineage   string                    `json:"lineage_hash"`
+	Candidate coinai.ValidationMetrics  `json:"candidate"`
+	Current   *coinai.ValidationMetrics `json:"current,omitempty"`
+	Promoted  bool                      `json:"promoted"`
+	Reason    string                    `json:"reason"`
+	Manifest  *coinai.RunManifest       `json:"manifest"`
+}
+
+// RetrainHandler retrains a model for one symbol/interval, registers it as a
+// new version and promotes it when it beats the current model on the same
+// validation window. Every registered version is announced as
+// model.trained.
+type RetrainHandler struct {
+	Fetcher coinai.CandleFetcher
+	Store   ModelStore
+	Events  EventPublisher
+	Logger  zerolog.Logger
+}
+
+func NewRetrainHandler(fetcher coinai.CandleFetcher, store ModelStore, events EventPublisher, logger zerolog.Logger) *RetrainHandler {
+	return &RetrainHandler{
+		Fetcher: fetcher,
+		Store:   store,
+		Events:  events,
+		Logger:  logger.With().Str("component", "RetrainHandler").Logger(),
+	}
+}
+
+func (h *RetrainHandler) Run(ctx context.Context, orgID uuid.UUID, raw json.RawMessage) (json.RawMessage, error) {
+	var payload RetrainPayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, fmt.Errorf("decode retrain payload: %w", err)
+	}
+	if err := payload.normalize(); err != nil {
+		return nil, err
+	}
+
+	candles, err := h.Fetcher.FetchKlines(ctx, payload.Symbol, payload.Interval, payload.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("fetch candles: %w", err)
+	}
+
+	pipelineCfg := coinai.PipelineConfig{
+		TrainRatio: payload.TrainRatio,
+		Train: coinai.TrainConfig{
+			Epochs:       payload.Epochs,
+			LearningRate: payload.LearningRate,
+			L2:           payload.L2,
+			Seed:         payload.Seed,
+		},
+		Backtest: coinai.BacktestConfig{
+			LongThreshold:  payload.LongThreshold,
+			ShortThreshold: payload.ShortThreshold,
+			FeeRate:        payload.FeeBPS / 10000,
+		},
+	}
+	result, err := coinai.RunPipeline(candles, pipelineCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	out := RetrainResult{
+		Symbol:    payload.Symbol,
+		Interval:  payload.Interval,
+		Candles:   result.Candles,
+		Candidate: result.ValidationMetrics(),
+	}
+
+	current, err := h.Store.Current(ctx, orgID, payload.Symbol, payload.Interval)
+	if err != nil {
+		return nil, fmt.Errorf("load current model: %w", err)
+	}
+	switch {
+	case current == nil:
+		out.Promoted = true
+		out.Reason = "no current model"
+	default:
+		metrics, err := current.Validate(result.TestSamples)
+		if err != nil {
+			out.Promoted = true
+			out.Reason = fmt.Sprintf("current model cannot be validated: %v", err)
+			break
+		}
+		out.Current = &metrics
+		if out.Candidate.MSE < metrics.MSE {
+			out.Promoted = true
+			out.Reason = "candidate has lower validation mse"
+		} else {
+			out.Reason = "current model has lower or equal validation mse"
+		}
+	}
+
+	saved := result.SavedModel("coin", "binance", payload.Symbol, payload.Interval)
+	version, err := h.Store.Register(ctx, orgID, saved, out.Candidate, out.Promoted)
+	if err != nil {
+		return nil, fmt.Errorf("register model: %w", err)
+	}
+	out.Version = version
+	out.Lineage = saved.LineageHash
+	out.Manifest = coinai.NewRunManifest(coinai.ManifestData{
+		Market:     saved.Market,
+		DataSource: saved.DataSource,
+		Symbol:     payload.Symbol,
+		Interval:   payload.Interval,
+		Limit:      payload.Limit,
+	}, candles, pipelineCfg, result)
+
+	h.publish(ctx, orgID, out)
+	return json.Marshal(out)
+}
+
+// publish announces the new version. A failure is only logged: the model is
+// registered either way.
+func (h *RetrainHandler) publish(ctx context.Context, orgID uuid.UUID, out RetrainResult) {
+	err := h.Events.Publish(ctx, orgID, "model.trained", map[string]any{
+		"symbol":       out.Symbol,
+		"interval":     out.Interval,
+		"version":      out.Version,
+		"lineage_hash": out.Lineage,
+		"promoted":     out.Promoted,
+		"reason":       out.Reason,
+		"candidate":    out.Candidate,
+		"current":      out.Current,
+	})
+	if err != nil {
+		h.Logger.Warn().Err(err).Str("symbol", out.Symbol).Str("interval", out.Interval).Msg("failed to publish model.trained")
+	}
+}
+
+func (p *RetrainPayload) normalize() error {
+	p.Symbol = strings.ToUpper(strings.TrimSpace(p.Symbol))
+	p.Interval = strings.TrimSpace(p.Interval)
+	if p.Symbol == "" {
+		return fmt.Errorf("symbol is required")
+	}
+	if p.Interval == "" {
+		return fmt.Errorf("interval is required")
+	}
+	if p.Limit <= 0 {
+		p.Limit = 500
+	}
+	if p.TrainRatio <= 0 {
+		p.TrainRatio = 0.7
+	}
+	if p.Epochs <= 0 {
+		p.Epochs = 800
+	}
+	if p.LearningRate <= 0 {
+		p.LearningRate = 0.03
+	}
+	if p.L2 <= 0 {
+		p.L2 = 0.001
+	}
+	if p.LongThreshold == 0 && p.ShortThreshold == 0 {
+		p.LongThreshold = coinai.DefaultLongThreshold
+		p.ShortThreshold = coinai.DefaultShortThreshold
+	}
+	if p.FeeBPS < 0 {
+		return fmt.Errorf("fee_bps cannot be negative")
+	}
+	return nil
+}
@@ -0,0 +1,255 @@
+package jobapp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go-ai/internal/jobs/domain/job"
+	"go-ai/pkg/lock"
+	"go-ai/pkg/worker"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// SchedulerConfig holds job runner settings
+type SchedulerConfig struct {
+	Workers      int
+	PollInterval time.Duration
+	LockTTL      time.Duration
+	RetryBackoff time.Duration
+	MaxBackoff   time.Duration
+	Instance     string
+}
+
+// DefaultSchedulerConfig returns default job runner configuration
+func DefaultSchedulerConfig() SchedulerConfig {
+	return SchedulerConfig{
+		Workers:      2,
+		PollInterval: 15 * time.Second,
+		LockTTL:      time.Minute,
+		RetryBackoff: 30 * time.Second,
+		MaxBackoff:   10 * time.Minute,
+		Instance:     defaultInstance(),
+	}
+}
+
+// Scheduler polls Postgres for due jobs and runs them on a bounded worker pool.
+// A Redis lock per job guarantees that only one replica executes a job at a time.
+type Scheduler struct {
+	repo     job.Repository
+	locker   *lock.Locker
+	handlers Handlers
+	cfg      SchedulerConfig
+	logger   zerolog.Logger
+
+	slots chan struct{}
+	group *worker.Group
+}
+
+func NewScheduler(repo job.Repository, locker *lock.Locker, handlers Handlers, cfg SchedulerConfig, logger zerolog.Logger) *Scheduler {
+	defaults := DefaultSchedulerConfig()
+	if cfg.Workers <= 0 {
+		cfg.Workers = defaults.Workers
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaults.PollInterval
+	}
+	if cfg.LockTTL <= 0 {
+		cfg.LockTTL = defaults.LockTTL
+	}
+	if cfg.RetryBackoff <= 0 {
+		cfg.RetryBackoff = defaults.RetryBackoff
+	}
+	if cfg.MaxBackoff < cfg.RetryBackoff {
+		cfg.MaxBackoff = cfg.RetryBackoff
+	}
+	if cfg.Instance == "" {
+		cfg.Instance = defaults.Instance
+	}
+	logger = logger.With().Str("component", "JobScheduler").Str("instance", cfg.Instance).Logger()
+	return &Scheduler{
+		repo:     repo,
+		locker:   locker,
+		handlers: handlers,
+		cfg:      cfg,
+		logger:   logger,
+		group:    worker.New("job scheduler", logger),
+		slots:    make(chan struct{}, cfg.Workers),
+	}
+}
+
+// Start launches the polling loop. It returns immediately.
+func (s *Scheduler) Start(ctx context.Context) {
+	s.group.Start(ctx, s.loop)
+	s.logger.Info().Int("workers", s.cfg.Workers).Dur("poll_interval", s.cfg.PollInterval).Msg("job scheduler started")
+}
+
+// Shutdown stops polling, cancels running jobs and waits for them to release
+// their locks, giving up when ctx expires.
+func (s *Scheduler) Shutdown(ctx context.Context) error {
+	return s.group.Shutdown(ctx)
+}
+
+func (s *Scheduler) loop(ctx context.Context) {
+	worker.Every(ctx, s.cfg.PollInterval, s.poll)
+}
+
+func (s *Scheduler) poll(ctx context.Context) {
+	now := time.Now().UTC()
+	due, err := s.repo.ListDue(ctx, now)
+	if err != nil {
+		if ctx.Err() == nil {
+			s.logger.Error().Err(err).Msg("list due jobs")
+		}
+		return
+	}
+
+	for _, j := range due {
+		select {
+		case s.slots <- struct{}{}:
+		case <-ctx.Done():
+			return
+		}
+		if !s.dispatch(ctx, j, now) {
+			<-s.slots
+		}
+	}
+}
+
+// dispatch claims the job and starts it on a worker slot. It reports false
+// when the job was not started, in which case the caller frees the slot.
+func (s *Scheduler) dispatch(ctx context.Context, j job.Entity, now time.Time) bool {
+	logger := s.logger.With().Str("job", j.Name).Str("job_id", j.ID.String()).Logger()
+
+	lk, err := s.locker.TryAcquire(ctx, j.ID.String(), s.cfg.LockTTL)
+	if err != nil {
+		logger.Error().Err(err).Msg("acquire job lock")
+		return false
+	}
+	if lk == nil {
+		return false
+	}
+
+	sched, err := job.ParseSchedule(j.Schedule)
+	if err != nil {
+		logger.Error().Err(err).Str("schedule", j.Schedule).Msg("invalid schedule, disabling job")
+		_ = s.repo.SetEnabled(ctx, j.OrganizationID, j.ID, false)
+		s.release(lk, logger)
+		return false
+	}
+
+	next := sched.Next(now)
+	if next.IsZero() {
+		logger.Warn().Str("schedule", j.Schedule).Msg("schedule never fires again, disabling job")
+		_ = s.repo.SetEnabled(ctx, j.OrganizationID, j.ID, false)
+		s.release(lk, logger)
+		return false
+	}
+
+	claimed, err := s.repo.ClaimNextRun(ctx, j.ID, *j.NextRunAt, next)
+	if err != nil || !claimed {
+		if err != nil {
+			logger.Error().Err(err).Msg("claim job run")
+		}
+		s.release(lk, logger)
+		return false
+	}
+
+	s.group.Go(func() {
+		defer func() { <-s.slots }()
+		defer s.release(lk, logger)
+		s.execute(ctx, j, lk, logger)
+	})
+	return true
+}
+
+func (s *Scheduler) execute(ctx context.Context, j job.Entity, lk *lock.Lock, logger zerolog.Logger) {
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go lk.KeepAlive(runCtx, func(err error) {
+		logger.Error().Err(err).Msg("job lock lost, cancelling run")
+		cancel()
+	})
+
+	handler, ok := s.handlers[j.Type]
+	for attempt := 1; attempt <= j.MaxRetries+1; attempt++ {
+		runID, err := s.repo.CreateRun(runCtx, j.ID, attempt, s.cfg.Instance)
+		if err != nil {
+			logger.Error().Err(err).Msg("record job run")
+			return
+		}
+
+		var output json.RawMessage
+		if ok {
+			output, err = runHandler(runCtx, handler, j.OrganizationID, j.Payload)
+		} else {
+			err = fmt.Errorf("no handler registered for job type %q", j.Type)
+		}
+
+		status := job.RunStatusSucceeded
+		errMsg := ""
+		if err != nil {
+			status = job.RunStatusFailed
+			errMsg = err.Error()
+		}
+		finishCtx, finishCancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+		if ferr := s.repo.FinishRun(finishCtx, runID, status, errMsg, output); ferr != nil {
+			logger.Error().Err(ferr).Msg("finish job run")
+		}
+		finishCancel()
+
+		if err == nil {
+			logger.Info().Int("attempt", attempt).Msg("job succeeded")
+			return
+		}
+		logger.Warn().Err(err).Int("attempt", attempt).Msg("job attempt failed")
+		if !ok || attempt > j.MaxRetries {
+			return
+		}
+
+		select {
+		case <-runCtx.Done():
+			return
+		case <-time.After(s.backoff(attempt)):
+		}
+	}
+}
+
+func (s *Scheduler) backoff(attempt int) time.Duration {
+	d := s.cfg.RetryBackoff
+	for i := 1; i < attempt && d < s.cfg.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > s.cfg.MaxBackoff {
+		d = s.cfg.MaxBackoff
+	}
+	return d
+}
+
+func (s *Scheduler) release(lk *lock.Lock, logger zerolog.Logger) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := lk.Release(ctx); err != nil {
+		logger.Warn().Err(err).Msg("release job lock")
+	}
+}
+
+func runHandler(ctx context.Context, h Handler, orgID uuid.UUID, payload json.RawMessage) (output json.RawMessage, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+	return h.Run(ctx, orgID, payload)
+}
+
+func defaultInstance() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s-%s", host, uuid.NewString()[:8])
+}
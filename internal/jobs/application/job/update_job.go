@@ -0,0 +1,34 @@
+package jobapp
+
+import (
+	"context"
+	"go-ai/internal/jobs/domain/job"
+
+	"github.com/google/uuid"
+)
+
+type UpdateJobRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+type UpdateJobUseCase struct {
+	Repo job.Repository
+}
+
+func NewUpdateJobUseCase(repo job.Repository) *UpdateJobUseCase {
+	return &UpdateJobUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *UpdateJobUseCase) Execute(ctx context.Context, orgID, id uuid.UUID, req UpdateJobRequest) (*JobResponse, error) {
+	if err := uc.Repo.SetEnabled(ctx, orgID, id, req.Enabled); err != nil {
+		return nil, err
+	}
+	updated, err := uc.Repo.GetByID(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := toJobResponse(*updated)
+	return &resp, nil
+}
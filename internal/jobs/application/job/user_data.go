@@ -0,0 +1,29 @@
+package jobapp
+
+import (
+	"context"
+	"go-ai/internal/jobs/domain/job"
+
+	"github.com/google/uuid"
+)
+
+// UserData purges the jobs of organizations removed along with an account,
+// so the scheduler stops running them.
+type UserData struct {
+	Repo job.Repository
+}
+
+func NewUserData(repo job.Repository) *UserData {
+	return &UserData{
+		Repo: repo,
+	}
+}
+
+func (d *UserData) PurgeUserData(ctx context.Context, userID uuid.UUID, orgIDs []uuid.UUID) error {
+	for _, orgID := range orgIDs {
+		if _, err := d.Repo.DeleteByOrganization(ctx, orgID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package job
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	TypeRetrainModel = "retrain_model"
+)
+
+type RunStatus string
+
+const (
+	RunStatusRunning   RunStatus = "running"
+	RunStatusSucceeded RunStatus = "succeeded"
+	RunStatusFailed    RunStatus = "failed"
+)
+
+// Entity is a schedule owned by one organization. Its handler runs on
+// behalf of that organization.
+type Entity struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Name           string
+	Type           string
+	Schedule       string
+	Payload        json.RawMessage
+	Enabled        bool
+	MaxRetries     int
+	NextRunAt      *time.Time
+	LastRunAt      *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type Run struct {
+	ID         uuid.UUID
+	JobID      uuid.UUID
+	Attempt    int
+	Status     RunStatus
+	Instance   string
+	Error      string
+	Output     json.RawMessage
+	StartedAt  time.Time
+	FinishedAt *time.Time
+}
+
+func NewJob(orgID uuid.UUID, name, jobType, schedule string, payload json.RawMessage, maxRetries int, now time.Time) (*Entity, error) {
+	if orgID == uuid.Nil {
+		return nil, ErrOrganizationRequired
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrNameRequired
+	}
+	if strings.TrimSpace(jobType) == "" {
+		return nil, ErrTypeRequired
+	}
+	if maxRetries < 0 {
+		return nil, ErrInvalidMaxRetries
+	}
+	sched, err := ParseSchedule(schedule)
+	if err != nil {
+		return nil, err
+	}
+	if len(payload) == 0 {
+		payload = json.RawMessage("{}")
+	}
+	if !json.Valid(payload) {
+		return nil, ErrInvalidPayload
+	}
+
+	next := sched.Next(now)
+	if next.IsZero() {
+		return nil, ErrInvalidSchedule
+	}
+	return &Entity{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		Name:           name,
+		Type:           jobType,
+		Schedule:       sched.String(),
+		Payload:        payload,
+		Enabled:        true,
+		MaxRetries:     maxRetries,
+		NextRunAt:      &next,
+	}, nil
+}
@@ -0,0 +1,18 @@
+package job
+
+import (
+	domainerr "go-ai/pkg/domain_err"
+	"net/http"
+)
+
+var (
+	ErrJobNotFound          = domainerr.New(http.StatusNotFound, "Job not found")
+	ErrNameRequired         = domainerr.New(http.StatusBadRequest, "Job name is required")
+	ErrTypeRequired         = domainerr.New(http.StatusBadRequest, "Job type is required")
+	ErrUnknownType          = domainerr.New(http.StatusBadRequest, "Unknown job type")
+	ErrNameAlreadyExists    = domainerr.New(http.StatusConflict, "Job name already exists")
+	ErrOrganizationRequired = domainerr.New(http.StatusBadRequest, "Job must belong to an organization")
+	ErrInvalidSchedule      = domainerr.New(http.StatusBadRequest, "Invalid cron schedule")
+	ErrInvalidPayload       = domainerr.New(http.StatusBadRequest, "Job payload must be valid JSON")
+	ErrInvalidMaxRetries    = domainerr.New(http.StatusBadRequest, "Max retries cannot be negative")
+)
@@ -0,0 +1,27 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, j *Entity) (uuid.UUID, error)
+	// GetByID returns ErrJobNotFound for jobs of other organizations.
+	GetByID(ctx context.Context, orgID, id uuid.UUID) (*Entity, error)
+	List(ctx context.Context, orgID uuid.UUID) ([]Entity, error)
+	// ListDue returns due jobs of every organization.
+	ListDue(ctx context.Context, now time.Time) ([]Entity, error)
+	SetEnabled(ctx context.Context, orgID, id uuid.UUID, enabled bool) error
+	DeleteByOrganization(ctx context.Context, orgID uuid.UUID) (int64, error)
+	// ClaimNextRun moves next_run_at forward only if it still equals expected,
+	// so a replica that lost the lock race cannot reschedule the job twice.
+	ClaimNextRun(ctx context.Context, id uuid.UUID, expected, next time.Time) (bool, error)
+
+	CreateRun(ctx context.Context, jobID uuid.UUID, attempt int, instance string) (uuid.UUID, error)
+	FinishRun(ctx context.Context, runID uuid.UUID, status RunStatus, errMsg string, output json.RawMessage) error
+	ListRuns(ctx context.Context, jobID uuid.UUID, limit, offset int32) ([]Run, int64, error)
+}
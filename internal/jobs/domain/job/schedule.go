@@ -0,0 +1,186 @@
+package job
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron expression. It supports the classic five fields
+// (minute hour day-of-month month day-of-week) with *, lists, ranges and
+// steps, plus the @hourly/@daily/@weekly/@monthly shortcuts and @every <duration>.
+type Schedule struct {
+	expr    string
+	every   time.Duration
+	minute  uint64
+	hour    uint64
+	dom     uint64
+	month   uint64
+	dow     uint64
+	domStar bool
+	dowStar bool
+}
+
+type cronField struct {
+	min, max int
+}
+
+var (
+	minuteField = cronField{0, 59}
+	hourField   = cronField{0, 23}
+	domField    = cronField{1, 31}
+	monthField  = cronField{1, 12}
+	dowField    = cronField{0, 7}
+)
+
+var scheduleShortcuts = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+}
+
+func ParseSchedule(expr string) (Schedule, error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return Schedule{}, ErrInvalidSchedule
+	}
+
+	if rest, ok := strings.CutPrefix(expr, "@every "); ok {
+		d, err := time.ParseDuration(strings.TrimSpace(rest))
+		if err != nil || d < time.Minute {
+			return Schedule{}, ErrInvalidSchedule
+		}
+		return Schedule{expr: expr, every: d}, nil
+	}
+	if full, ok := scheduleShortcuts[expr]; ok {
+		s, err := ParseSchedule(full)
+		s.expr = expr
+		return s, err
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return Schedule{}, ErrInvalidSchedule
+	}
+
+	s := Schedule{expr: expr}
+	var err error
+	if s.minute, err = parseCronField(fields[0], minuteField); err != nil {
+		return Schedule{}, err
+	}
+	if s.hour, err = parseCronField(fields[1], hourField); err != nil {
+		return Schedule{}, err
+	}
+	if s.dom, err = parseCronField(fields[2], domField); err != nil {
+		return Schedule{}, err
+	}
+	if s.month, err = parseCronField(fields[3], monthField); err != nil {
+		return Schedule{}, err
+	}
+	if s.dow, err = parseCronField(fields[4], dowField); err != nil {
+		return Schedule{}, err
+	}
+	if has(s.dow, 7) {
+		s.dow |= 1 // both 0 and 7 mean Sunday
+	}
+	s.domStar = fields[2] == "*"
+	s.dowStar = fields[4] == "*"
+	return s, nil
+}
+
+func (s Schedule) String() string {
+	return s.expr
+}
+
+// Next returns the first activation strictly after t, truncated to the minute.
+// It returns the zero time when no activation exists within five years.
+func (s Schedule) Next(t time.Time) time.Time {
+	if s.every > 0 {
+		return t.Add(s.every).Truncate(time.Second)
+	}
+
+	next := t.Truncate(time.Minute).Add(time.Minute)
+	limit := next.AddDate(5, 0, 0)
+	for next.Before(limit) {
+		if !has(s.month, int(next.Month())) {
+			next = time.Date(next.Year(), next.Month()+1, 1, 0, 0, 0, 0, next.Location())
+			continue
+		}
+		if !s.matchDay(next) {
+			next = time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, next.Location())
+			continue
+		}
+		if !has(s.hour, next.Hour()) {
+			next = next.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if !has(s.minute, next.Minute()) {
+			next = next.Add(time.Minute)
+			continue
+		}
+		return next
+	}
+	return time.Time{}
+}
+
+// matchDay follows cron semantics: when both day fields are restricted,
+// a day matches if either of them does.
+func (s Schedule) matchDay(t time.Time) bool {
+	domMatch := has(s.dom, t.Day())
+	dowMatch := has(s.dow, int(t.Weekday()))
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+func parseCronField(expr string, f cronField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(expr, ",") {
+		rangeExpr, stepExpr, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			n, err := strconv.Atoi(stepExpr)
+			if err != nil || n <= 0 {
+				return 0, ErrInvalidSchedule
+			}
+			step = n
+		}
+
+		lo, hi := f.min, f.max
+		switch {
+		case rangeExpr == "*":
+		case strings.Contains(rangeExpr, "-"):
+			a, b, _ := strings.Cut(rangeExpr, "-")
+			var err error
+			if lo, err = strconv.Atoi(a); err != nil {
+				return 0, ErrInvalidSchedule
+			}
+			if hi, err = strconv.Atoi(b); err != nil {
+				return 0, ErrInvalidSchedule
+			}
+		default:
+			n, err := strconv.Atoi(rangeExpr)
+			if err != nil {
+				return 0, ErrInvalidSchedule
+			}
+			lo = n
+			if !hasStep {
+				hi = n
+			}
+		}
+		if lo < f.min || hi > f.max || lo > hi {
+			return 0, ErrInvalidSchedule
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+func has(bits uint64, v int) bool {
+	return bits&(1<<uint(v)) != 0
+}
@@ -0,0 +1,72 @@
+package job
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseScheduleNext(t *testing.T) {
+	base := time.Date(2024, 1, 15, 10, 7, 30, 0, time.UTC) // Monday
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"*/15 * * * *", time.Date(2024, 1, 15, 10, 15, 0, 0, time.UTC)},
+		{"0 * * * *", time.Date(2024, 1, 15, 11, 0, 0, 0, time.UTC)},
+		{"30 2 * * *", time.Date(2024, 1, 16, 2, 30, 0, 0, time.UTC)},
+		{"0 9-17/4 * * 1-5", time.Date(2024, 1, 15, 13, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2024, 1, 21, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 31 2 *", time.Time{}},
+		{"@daily", time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2024, 1, 21, 0, 0, 0, 0, time.UTC)},
+		{"@every 90m", time.Date(2024, 1, 15, 11, 37, 30, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		s, err := ParseSchedule(tt.expr)
+		if err != nil {
+			t.Fatalf("ParseSchedule(%q) error: %v", tt.expr, err)
+		}
+		if got := s.Next(base); !got.Equal(tt.want) {
+			t.Fatalf("%q Next = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestParseScheduleDayOfMonthOrWeek(t *testing.T) {
+	// Both day fields restricted: either may match.
+	s, err := ParseSchedule("0 0 20 * 3")
+	if err != nil {
+		t.Fatalf("ParseSchedule error: %v", err)
+	}
+	base := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	want := time.Date(2024, 1, 17, 0, 0, 0, 0, time.UTC) // Wednesday before the 20th
+	if got := s.Next(base); !got.Equal(want) {
+		t.Fatalf("Next = %v, want %v", got, want)
+	}
+}
+
+func TestParseScheduleInvalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"@every 10s",
+		"@every soon",
+		"@yearly",
+	} {
+		if _, err := ParseSchedule(expr); !errors.Is(err, ErrInvalidSchedule) {
+			t.Fatalf("ParseSchedule(%q) error = %v, want ErrInvalidSchedule", expr, err)
+		}
+	}
+}
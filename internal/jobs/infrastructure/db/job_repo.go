@@ -0,0 +1,189 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"go-ai/internal/jobs/domain/job"
+	sqlc "go-ai/internal/jobs/infrastructure/sqlc/job"
+	"go-ai/pkg/pgerr"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type JobRepo struct {
+	queries *sqlc.Queries
+}
+
+func NewJobRepo(pool *pgxpool.Pool) *JobRepo {
+	return &JobRepo{
+		queries: sqlc.New(pool),
+	}
+}
+
+func (r *JobRepo) Create(ctx context.Context, j *job.Entity) (uuid.UUID, error) {
+	id, err := r.queries.CreateJob(ctx, sqlc.CreateJobParams{
+		ID:             j.ID,
+		OrganizationID: j.OrganizationID,
+		Name:           j.Name,
+		JobType:        j.Type,
+		Schedule:       j.Schedule,
+		Payload:        j.Payload,
+		Enabled:        j.Enabled,
+		MaxRetries:     int32(j.MaxRetries),
+		NextRunAt:      j.NextRunAt,
+	})
+	if err != nil {
+		if pgerr.IsUniqueViolation(err, "uq_jobs_name") {
+			return uuid.Nil, job.ErrNameAlreadyExists
+		}
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+func (r *JobRepo) GetByID(ctx context.Context, orgID, id uuid.UUID) (*job.Entity, error) {
+	row, err := r.queries.GetJobByID(ctx, sqlc.GetJobByIDParams{
+		ID:             id,
+		OrganizationID: orgID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, job.ErrJobNotFound
+		}
+		return nil, err
+	}
+	j := toEntity(row)
+	return &j, nil
+}
+
+func (r *JobRepo) List(ctx context.Context, orgID uuid.UUID) ([]job.Entity, error) {
+	rows, err := r.queries.ListJobs(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return toEntities(rows), nil
+}
+
+func (r *JobRepo) ListDue(ctx context.Context, now time.Time) ([]job.Entity, error) {
+	rows, err := r.queries.ListDueJobs(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	return toEntities(rows), nil
+}
+
+func (r *JobRepo) SetEnabled(ctx context.Context, orgID, id uuid.UUID, enabled bool) error {
+	affected, err := r.queries.SetJobEnabled(ctx, sqlc.SetJobEnabledParams{
+		Enabled:        enabled,
+		ID:             id,
+		OrganizationID: orgID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return job.ErrJobNotFound
+	}
+	return nil
+}
+
+func (r *JobRepo) DeleteByOrganization(ctx context.Context, orgID uuid.UUID) (int64, error) {
+	return r.queries.DeleteOrganizationJobs(ctx, orgID)
+}
+
+func (r *JobRepo) ClaimNextRun(ctx context.Context, id uuid.UUID, expected, next time.Time) (bool, error) {
+	affected, err := r.queries.ClaimJobNextRun(ctx, sqlc.ClaimJobNextRunParams{
+		NextRunAt: next,
+		ID:        id,
+		Expected:  expected,
+	})
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (r *JobRepo) CreateRun(ctx context.Context, jobID uuid.UUID, attempt int, instance string) (uuid.UUID, error) {
+	return r.queries.CreateJobRun(ctx, sqlc.CreateJobRunParams{
+		JobID:    jobID,
+		Attempt:  int32(attempt),
+		Instance: instance,
+	})
+}
+
+func (r *JobRepo) FinishRun(ctx context.Context, runID uuid.UUID, status job.RunStatus, errMsg string, output json.RawMessage) error {
+	var errPtr *string
+	if errMsg != "" {
+		errPtr = &errMsg
+	}
+	return r.queries.FinishJobRun(ctx, sqlc.FinishJobRunParams{
+		Status: string(status),
+		Error:  errPtr,
+		Output: output,
+		ID:     runID,
+	})
+}
+
+func (r *JobRepo) ListRuns(ctx context.Context, jobID uuid.UUID, limit, offset int32) ([]job.Run, int64, error) {
+	total, err := r.queries.CountJobRuns(ctx, jobID)
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, err := r.queries.ListJobRuns(ctx, sqlc.ListJobRunsParams{
+		JobID:      jobID,
+		LimitRows:  limit,
+		OffsetRows: offset,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	runs := make([]job.Run, 0, len(rows))
+	for _, row := range rows {
+		errMsg := ""
+		if row.Error != nil {
+			errMsg = *row.Error
+		}
+		runs = append(runs, job.Run{
+			ID:         row.ID,
+			JobID:      row.JobID,
+			Attempt:    int(row.Attempt),
+			Status:     job.RunStatus(row.Status),
+			Instance:   row.Instance,
+			Error:      errMsg,
+			Output:     row.Output,
+			StartedAt:  row.StartedAt,
+			FinishedAt: row.FinishedAt,
+		})
+	}
+	return runs, total, nil
+}
+
+func toEntities(rows []sqlc.Job) []job.Entity {
+	out := make([]job.Entity, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, toEntity(row))
+	}
+	return out
+}
+
+func toEntity(row sqlc.Job) job.Entity {
+	return job.Entity{
+		ID:             row.ID,
+		OrganizationID: row.OrganizationID,
+		Name:           row.Name,
+		Type:           row.JobType,
+		Schedule:       row.Schedule,
+		Payload:        row.Payload,
+		Enabled:        row.Enabled,
+		MaxRetries:     int(row.MaxRetries),
+		NextRunAt:      row.NextRunAt,
+		LastRunAt:      row.LastRunAt,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}
+}
@@ -0,0 +1,161 @@
+package jobshttp
+
+import (
+	jobapp "go-ai/internal/jobs/application/job"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/rs/zerolog"
+)
+
+type JobHandler struct {
+	CreateJobUseCase   *jobapp.CreateJobUseCase
+	ListJobsUseCase    *jobapp.ListJobsUseCase
+	UpdateJobUseCase   *jobapp.UpdateJobUseCase
+	ListJobRunsUseCase *jobapp.ListJobRunsUseCase
+	Logger             zerolog.Logger
+}
+
+func NewJobHandler(
+	createJobUseCase *jobapp.CreateJobUseCase,
+	listJobsUseCase *jobapp.ListJobsUseCase,
+	updateJobUseCase *jobapp.UpdateJobUseCase,
+	listJobRunsUseCase *jobapp.ListJobRunsUseCase,
+	logger zerolog.Logger,
+) *JobHandler {
+	return &JobHandler{
+		CreateJobUseCase:   createJobUseCase,
+		ListJobsUseCase:    listJobsUseCase,
+		UpdateJobUseCase:   updateJobUseCase,
+		ListJobRunsUseCase: listJobRunsUseCase,
+		Logger:             logger.With().Str("component", "JobHandler").Logger(),
+	}
+}
+
+// ListJobs godoc
+// @Summary List scheduled jobs
+// @Description List every background job with its schedule and next run time
+// @Tags Jobs
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Success 200 {object} jobapp.ListJobsSuccessResponseDoc "Jobs retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/jobs [get]
+func (h *JobHandler) ListJobs(c *echo.Context) error {
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	jobs, err := h.ListJobsUseCase.Execute(c.Request().Context(), orgID)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to list jobs")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, jobs, "Jobs retrieved successfully")
+}
+
+// CreateJob godoc
+// @Summary Create a scheduled job
+// @Description Create a background job with a cron schedule (e.g. "0 * * * *", "@daily", "@every 30m")
+// @Tags Jobs
+// @Accept json
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param body body jobapp.CreateJobRequest true "Job definition"
+// @Success 200 {object} jobapp.JobSuccessResponseDoc "Job created successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/jobs [post]
+func (h *JobHandler) CreateJob(c *echo.Context) error {
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	var in jobapp.CreateJobRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	created, err := h.CreateJobUseCase.Execute(c.Request().Context(), orgID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to create job")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, created, "Job created successfully")
+}
+
+// UpdateJob godoc
+// @Summary Enable or disable a job
+// @Description Toggle whether the scheduler runs the job
+// @Tags Jobs
+// @Accept json
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Job ID"
+// @Param body body jobapp.UpdateJobRequest true "Job update payload"
+// @Success 200 {object} jobapp.JobSuccessResponseDoc "Job updated successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/jobs/{id} [patch]
+func (h *JobHandler) UpdateJob(c *echo.Context) error {
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid job ID")
+	}
+	var in jobapp.UpdateJobRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	updated, err := h.UpdateJobUseCase.Execute(c.Request().Context(), orgID, id, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to update job")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, updated, "Job updated successfully")
+}
+
+// ListJobRuns godoc
+// @Summary List job run history
+// @Description List past attempts of a job, newest first
+// @Tags Jobs
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Job ID"
+// @Param page query int false "Page number"
+// @Param limit query int false "Page size (max 100)"
+// @Success 200 {object} jobapp.ListJobRunsSuccessResponseDoc "Job runs retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/jobs/{id}/runs [get]
+func (h *JobHandler) ListJobRuns(c *echo.Context) error {
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid job ID")
+	}
+	var in jobapp.ListJobRunsRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid query parameters")
+	}
+	runs, err := h.ListJobRunsUseCase.Execute(c.Request().Context(), orgID, id, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to list job runs")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, runs, "Job runs retrieved successfully")
+}
@@ -0,0 +1,19 @@
+package jobshttp
+
+import (
+	"go-ai/internal/identity/domain/rbac"
+	middlewares "go-ai/internal/identity/transport/middlewares"
+
+	"github.com/labstack/echo/v5"
+)
+
+func RegisterJobRoutes(api *echo.Group, h *JobHandler, m *middlewares.IdentityMiddleware, rbacService rbac.Service) {
+	jobs := api.Group("/jobs", m.Handler, m.Organization)
+	read := middlewares.RequirePermission(rbacService, rbac.JobsRead)
+	manage := middlewares.RequirePermission(rbacService, rbac.JobsManage)
+
+	jobs.GET("", h.ListJobs, read)
+	jobs.POST("", h.CreateJob, manage)
+	jobs.PATCH("/:id", h.UpdateJob, manage)
+	jobs.GET("/:id/runs", h.ListJobRuns, read)
+}
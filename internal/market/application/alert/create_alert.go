@@ -0,0 +1,59 @@
+package alertapp
+
+import (
+	"context"
+	"go-ai/internal/market/domain/alert"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateAlertRequest struct {
+	Name      string          `json:"name"`
+	Symbol    string          `json:"symbol"`
+	Interval  string          `json:"interval"`
+	Kind      alert.Kind      `json:"kind"`
+	Condition alert.Condition `json:"condition"`
+	// Channels defaults to the in-app feed.
+	Channels   []string `json:"channels"`
+	WebhookURL string   `json:"webhook_url"`
+	// CooldownSeconds defaults to the configured cooldown when omitted.
+	CooldownSeconds *int64 `json:"cooldown_seconds"`
+}
+
+type CreateAlertUseCase struct {
+	Repo            alert.Repository
+	DefaultCooldown time.Duration
+}
+
+func NewCreateAlertUseCase(repo alert.Repository, defaultCooldown time.Duration) *CreateAlertUseCase {
+	return &CreateAlertUseCase{
+		Repo:            repo,
+		DefaultCooldown: defaultCooldown,
+	}
+}
+
+// Execute creates an enabled alert. The evaluator takes its baseline on the
+// next pass, so it only fires on candles that close after that.
+func (uc *CreateAlertUseCase) Execute(ctx context.Context, orgID, userID uuid.UUID, req CreateAlertRequest) (*AlertResponse, error) {
+	cooldown := uc.DefaultCooldown
+	if req.CooldownSeconds != nil {
+		cooldown = time.Duration(*req.CooldownSeconds) * time.Second
+	}
+	a, err := alert.NewAlert(orgID, userID, req.Name, req.Symbol, req.Interval, req.Kind, req.Condition, req.Channels, req.WebhookURL, cooldown)
+	if err != nil {
+		return nil, err
+	}
+	count, err := uc.Repo.CountByOwner(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= alert.MaxPerOwner {
+		return nil, alert.ErrTooManyAlerts
+	}
+	if err := uc.Repo.Create(ctx, a); err != nil {
+		return nil, err
+	}
+	resp := toAlertResponse(*a)
+	return &resp, nil
+}
@@ -0,0 +1,23 @@
+package alertapp
+
+import (
+	"go-ai/pkg/response"
+)
+
+type AlertSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *AlertResponse `json:"data,omitempty"`
+}
+
+type ListAlertsSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data []AlertResponse `json:"data,omitempty"`
+}
+
+type ListAlertEventsSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *struct {
+		response.PaginatedResponseDoc
+		Items []AlertEventResponse `json:"items"`
+	} `json:"data,omitempty"`
+}
@@ -0,0 +1,90 @@
+package alertapp
+
+import (
+	"go-ai/internal/coinai"
+	"go-ai/internal/market/domain/alert"
+	"go-ai/pkg/notify"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AlertResponse struct {
+	ID              uuid.UUID       `json:"id"`
+	OrganizationID  uuid.UUID       `json:"organization_id"`
+	Name            string          `json:"name"`
+	Symbol          string          `json:"symbol"`
+	Interval        string          `json:"interval"`
+	Kind            alert.Kind      `json:"kind"`
+	Condition       alert.Condition `json:"condition"`
+	Channels        []string        `json:"channels"`
+	WebhookURL      string          `json:"webhook_url,omitempty"`
+	CooldownSeconds int64           `json:"cooldown_seconds"`
+	Enabled         bool            `json:"enabled"`
+	LastSignal      coinai.Signal   `json:"last_signal,omitempty"`
+	LastTriggeredAt *time.Time      `json:"last_triggered_at,omitempty"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+}
+
+type AlertEventResponse struct {
+	ID              uuid.UUID         `json:"id"`
+	AlertID         uuid.UUID         `json:"alert_id"`
+	Kind            alert.Kind        `json:"kind"`
+	Symbol          string            `json:"symbol"`
+	Interval        string            `json:"interval"`
+	Message         string            `json:"message"`
+	Price           float64           `json:"price"`
+	Details         map[string]any    `json:"details,omitempty"`
+	Deliveries      []notify.Delivery `json:"deliveries"`
+	CandleCloseTime time.Time         `json:"candle_close_time"`
+	TriggeredAt     time.Time         `json:"triggered_at"`
+}
+
+func toAlertResponse(a alert.Entity) AlertResponse {
+	return AlertResponse{
+		ID:              a.ID,
+		OrganizationID:  a.OrganizationID,
+		Name:            a.Name,
+		Symbol:          a.Symbol,
+		Interval:        a.Interval,
+		Kind:            a.Kind,
+		Condition:       a.Condition,
+		Channels:        a.Channels,
+		WebhookURL:      a.WebhookURL,
+		CooldownSeconds: int64(a.Cooldown / time.Second),
+		Enabled:         a.Enabled,
+		LastSignal:      a.LastSignal,
+		LastTriggeredAt: a.LastTriggeredAt,
+		CreatedAt:       a.CreatedAt,
+		UpdatedAt:       a.UpdatedAt,
+	}
+}
+
+func toAlertResponses(alerts []alert.Entity) []AlertResponse {
+	out := make([]AlertResponse, 0, len(alerts))
+	for _, a := range alerts {
+		out = append(out, toAlertResponse(a))
+	}
+	return out
+}
+
+func toAlertEventResponse(e alert.Event) AlertEventResponse {
+	deliveries := e.Deliveries
+	if deliveries == nil {
+		deliveries = []notify.Delivery{}
+	}
+	return AlertEventResponse{
+		ID:              e.ID,
+		AlertID:         e.AlertID,
+		Kind:            e.Kind,
+		Symbol:          e.Symbol,
+		Interval:        e.Interval,
+		Message:         e.Message,
+		Price:           e.Price,
+		Details:         e.Details,
+		Deliveries:      deliveries,
+		CandleCloseTime: e.CandleCloseTime,
+		TriggeredAt:     e.TriggeredAt,
+	}
+}
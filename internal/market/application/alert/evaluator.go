@@ -0,0 +1,301 @@
+package alertapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/coinai"
+	"go-ai/internal/market/domain/alert"
+	"go-ai/pkg/lock"
+	"go-ai/pkg/notify"
+	"go-ai/pkg/worker"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// evalCandles covers the longest percent-move window and the feature
+	// window of a signal prediction, plus the still-open candle.
+	evalCandles = alert.MaxWindow + 4
+	// evalLockKey lets one replica evaluate per pass.
+	evalLockKey = "pass"
+)
+
+// Notifier delivers a triggered alert on its channels.
+type Notifier interface {
+	Dispatch(ctx context.Context, channels []string, msg notify.Message) []notify.Delivery
+}
+
+// EventPublisher announces fired alerts and signal flips to webhooks and
+// open streams.
+type EventPublisher interface {
+	Publish(ctx context.Context, orgID uuid.UUID, eventType string, data any) error
+	PublishToUser(ctx context.Context, orgID, userID uuid.UUID, eventType string, data any) error
+}
+
+// EventPublishers publishes every event to each of its publishers, so one
+// failing does not starve the others.
+type EventPublishers []EventPublisher
+
+func (ps EventPublishers) Publish(ctx context.Context, orgID uuid.UUID, eventType string, data any) error {
+	var errs []error
+	for _, p := range ps {
+		errs = append(errs, p.Publish(ctx, orgID, eventType, data))
+	}
+	return errors.Join(errs...)
+}
+
+func (ps EventPublishers) PublishToUser(ctx context.Context, orgID, userID uuid.UUID, eventType string, data any) error {
+	var errs []error
+	for _, p := range ps {
+		errs = append(errs, p.PublishToUser(ctx, orgID, userID, eventType, data))
+	}
+	return errors.Join(errs...)
+}
+
+// Evaluator checks every enabled alert against the latest closed candles on
+// a fixed interval and notifies the owner when one fires. Candles are
+// fetched once per symbol/interval and predictions once per organization's
+// model, however many alerts share them. A signal flip seen by any signal
+// alert is also published once per organization as signal.changed.
+type Evaluator struct {
+	repo           alert.Repository
+	fetcher        coinai.CandleFetcher
+	models         coinai.ModelStore
+	notifier       Notifier
+	events         EventPublisher
+	locker         *lock.Locker
+	interval       time.Duration
+	longThreshold  float64
+	shortThreshold float64
+	logger         zerolog.Logger
+
+	group *worker.Group
+}
+
+func NewEvaluator(
+	repo alert.Repository,
+	fetcher coinai.CandleFetcher,
+	models coinai.ModelStore,
+	notifier Notifier,
+	events EventPublisher,
+	locker *lock.Locker,
+	interval time.Duration,
+	logger zerolog.Logger,
+) *Evaluator {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	logger = logger.With().Str("component", "AlertEvaluator").Logger()
+	return &Evaluator{
+		repo:           repo,
+		fetcher:        fetcher,
+		models:         models,
+		notifier:       notifier,
+		events:         events,
+		locker:         locker,
+		interval:       interval,
+		longThreshold:  coinai.DefaultLongThreshold,
+		shortThreshold: coinai.DefaultShortThreshold,
+		logger:         logger,
+		group:          worker.New("alert evaluator", logger),
+	}
+}
+
+// Start launches the evaluation loop. It returns immediately.
+func (e *Evaluator) Start(ctx context.Context) {
+	e.group.Start(ctx, e.loop)
+	e.logger.Info().Dur("interval", e.interval).Msg("alert evaluator started")
+}
+
+// Shutdown stops the loop and waits for the current pass to finish, giving
+// up when ctx expires.
+func (e *Evaluator) Shutdown(ctx context.Context) error {
+	return e.group.Shutdown(ctx)
+}
+
+func (e *Evaluator) loop(ctx context.Context) {
+	worker.Every(ctx, e.interval, func(ctx context.Context) {
+		worker.Exclusive(ctx, e.locker, evalLockKey, e.interval, e.logger, e.pass)
+	})
+}
+
+type seriesKey struct {
+	symbol   string
+	interval string
+}
+
+type signalKey struct {
+	orgID uuid.UUID
+	seriesKey
+}
+
+// pass evaluates every enabled alert once. It runs under the pass lock, so
+// other replicas do not send the same notifications.
+func (e *Evaluator) pass(ctx context.Context) {
+	alerts, err := e.repo.ListEnabled(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			e.logger.Error().Err(err).Msg("failed to list enabled alerts")
+		}
+		return
+	}
+
+	groups := make(map[seriesKey][]alert.Entity)
+	for _, a := range alerts {
+		key := seriesKey{symbol: a.Symbol, interval: a.Interval}
+		groups[key] = append(groups[key], a)
+	}
+
+	now := time.Now().UTC()
+	signals := make(map[signalKey]coinai.Signal)
+	flipped := make(map[signalKey]bool)
+	for key, group := range groups {
+		if ctx.Err() != nil {
+			return
+		}
+		candles, err := e.fetcher.FetchKlines(ctx, key.symbol, key.interval, evalCandles)
+		if err != nil {
+			e.logger.Warn().Err(err).Str("symbol", key.symbol).Str("interval", key.interval).Msg("failed to fetch candles")
+			continue
+		}
+		closed := coinai.ClosedCandles(candles, now)
+		if len(closed) == 0 {
+			continue
+		}
+		for i := range group {
+			e.evaluate(ctx, &group[i], closed, signals, flipped, now)
+		}
+	}
+}
+
+func (e *Evaluator) evaluate(ctx context.Context, a *alert.Entity, closed []coinai.Candle, signals map[signalKey]coinai.Signal, flipped map[signalKey]bool, now time.Time) {
+	logger := e.logger.With().Str("alert_id", a.ID.String()).Logger()
+
+	var signal coinai.Signal
+	if a.NeedsSignal() {
+		key := signalKey{orgID: a.OrganizationID, seriesKey: seriesKey{symbol: a.Symbol, interval: a.Interval}}
+		cached, ok := signals[key]
+		if !ok {
+			cached = e.signal(ctx, a.OrganizationID, a.Symbol, a.Interval, closed)
+			signals[key] = cached
+		}
+		// Without a signal the state stays as it is, so the alert compares
+		// against the last signal it did see once the model is back.
+		if cached == "" {
+			return
+		}
+		signal = cached
+		if a.LastSignal != "" && a.LastSignal != signal && !flipped[key] {
+			flipped[key] = true
+			e.publishSignalChange(ctx, a, a.LastSignal, signal, closed[len(closed)-1])
+		}
+	}
+
+	before := *a
+	trigger := a.Evaluate(closed, signal, now)
+	if trigger != nil {
+		e.fire(ctx, a, trigger, logger)
+	}
+	if trigger != nil || stateChanged(before, *a) {
+		if err := e.repo.SaveState(ctx, a); err != nil {
+			logger.Error().Err(err).Msg("failed to save alert state")
+		}
+	}
+}
+
+// signal predicts on the last closed candle with the organization's
+// production model. It returns "" when there is no usable model.
+func (e *Evaluator) signal(ctx context.Context, orgID uuid.UUID, symbol, interval string, closed []coinai.Candle) coinai.Signal {
+	model, err := e.models.Current(ctx, orgID, symbol, interval)
+	if err != nil {
+		e.logger.Error().Err(err).Str("symbol", symbol).Str("interval", interval).Msg("failed to load production model")
+		return ""
+	}
+	if model == nil {
+		return ""
+	}
+	features, err := coinai.BuildLatestFeatures(closed)
+	if err != nil {
+		return ""
+	}
+	pred, err := model.Predict(features)
+	if err != nil {
+		e.logger.Error().Err(err).Str("symbol", symbol).Str("interval", interval).Msg("failed to predict")
+		return ""
+	}
+	return coinai.SignalFromPrediction(pred, e.longThreshold, e.shortThreshold)
+}
+
+func (e *Evaluator) fire(ctx context.Context, a *alert.Entity, t *alert.Trigger, logger zerolog.Logger) {
+	data := map[string]any{
+		"alert_id":          a.ID,
+		"alert_kind":        a.Kind,
+		"symbol":            a.Symbol,
+		"interval":          a.Interval,
+		"price":             t.Price,
+		"candle_close_time": t.CandleCloseTime,
+		"details":           t.Details,
+	}
+	deliveries := e.notifier.Dispatch(ctx, a.Channels, notify.Message{
+		OrganizationID: a.OrganizationID,
+		UserID:         a.UserID,
+		Kind:           "alert.triggered",
+		Title:          "Alert: " + a.Name,
+		Body:           t.Message,
+		Data:           data,
+		WebhookURL:     a.WebhookURL,
+		CreatedAt:      *a.LastTriggeredAt,
+	})
+
+	event := &alert.Event{
+		ID:              uuid.New(),
+		AlertID:         a.ID,
+		OrganizationID:  a.OrganizationID,
+		UserID:          a.UserID,
+		Kind:            a.Kind,
+		Symbol:          a.Symbol,
+		Interval:        a.Interval,
+		Message:         t.Message,
+		Price:           t.Price,
+		Details:         t.Details,
+		Deliveries:      deliveries,
+		CandleCloseTime: t.CandleCloseTime,
+		TriggeredAt:     *a.LastTriggeredAt,
+	}
+	if err := e.repo.CreateEvent(ctx, event); err != nil {
+		logger.Error().Err(err).Msg("failed to record alert event")
+	}
+	data["alert_name"] = a.Name
+	data["message"] = t.Message
+	data["triggered_at"] = event.TriggeredAt
+	if err := e.events.PublishToUser(ctx, a.OrganizationID, a.UserID, "alert.triggered", data); err != nil {
+		logger.Warn().Err(err).Msg("failed to publish alert.triggered")
+	}
+	logger.Info().Str("symbol", a.Symbol).Str("interval", a.Interval).Int("deliveries", len(deliveries)).Msg("alert triggered")
+}
+
+func (e *Evaluator) publishSignalChange(ctx context.Context, a *alert.Entity, previous, signal coinai.Signal, last coinai.Candle) {
+	err := e.events.Publish(ctx, a.OrganizationID, "signal.changed", map[string]any{
+		"symbol":            a.Symbol,
+		"interval":          a.Interval,
+		"previous_signal":   previous,
+		"signal":            signal,
+		"price":             last.Close,
+		"candle_close_time": last.CloseTime,
+	})
+	if err != nil {
+		e.logger.Warn().Err(err).Str("symbol", a.Symbol).Str("interval", a.Interval).Msg("failed to publish signal.changed")
+	}
+}
+
+func stateChanged(before, after alert.Entity) bool {
+	if before.LastSignal != after.LastSignal {
+		return true
+	}
+	if (before.LastCandleAt == nil) != (after.LastCandleAt == nil) {
+		return true
+	}
+	return before.LastCandleAt != nil && !before.LastCandleAt.Equal(*after.LastCandleAt)
+}
@@ -0,0 +1,45 @@
+package alertapp
+
+import (
+	"context"
+	"go-ai/internal/market/domain/alert"
+
+	"github.com/google/uuid"
+)
+
+type ListAlertsUseCase struct {
+	Repo alert.Repository
+}
+
+func NewListAlertsUseCase(repo alert.Repository) *ListAlertsUseCase {
+	return &ListAlertsUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *ListAlertsUseCase) Execute(ctx context.Context, orgID, userID uuid.UUID) ([]AlertResponse, error) {
+	alerts, err := uc.Repo.List(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toAlertResponses(alerts), nil
+}
+
+type GetAlertUseCase struct {
+	Repo alert.Repository
+}
+
+func NewGetAlertUseCase(repo alert.Repository) *GetAlertUseCase {
+	return &GetAlertUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *GetAlertUseCase) Execute(ctx context.Context, orgID, userID, id uuid.UUID) (*AlertResponse, error) {
+	a, err := uc.Repo.GetByID(ctx, orgID, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := toAlertResponse(*a)
+	return &resp, nil
+}
@@ -0,0 +1,48 @@
+package alertapp
+
+import (
+	"context"
+	"go-ai/internal/market/domain/alert"
+	"go-ai/pkg/response"
+
+	"github.com/google/uuid"
+)
+
+type ListAlertEventsRequest struct {
+	Page  *int32 `query:"page"`
+	Limit *int32 `query:"limit"`
+}
+
+type ListAlertEventsUseCase struct {
+	Repo alert.Repository
+}
+
+func NewListAlertEventsUseCase(repo alert.Repository) *ListAlertEventsUseCase {
+	return &ListAlertEventsUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute returns the alert's trigger history, newest first.
+func (uc *ListAlertEventsUseCase) Execute(ctx context.Context, orgID, userID, alertID uuid.UUID, req ListAlertEventsRequest) (*response.PaginatedResponse[[]AlertEventResponse], error) {
+	if _, err := uc.Repo.GetByID(ctx, orgID, userID, alertID); err != nil {
+		return nil, err
+	}
+	page, limit, offset := response.ApplyDefaultPaginated(req.Page, req.Limit)
+	events, total, err := uc.Repo.ListEvents(ctx, alertID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]AlertEventResponse, 0, len(events))
+	for _, e := range events {
+		items = append(items, toAlertEventResponse(e))
+	}
+	return &response.PaginatedResponse[[]AlertEventResponse]{
+		Page:       page,
+		Limit:      limit,
+		TotalItems: total,
+		TotalPages: response.CalculateTotalPages(total, int64(limit)),
+		Items:      items,
+	}, nil
+}
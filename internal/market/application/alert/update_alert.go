@@ -0,0 +1,85 @@
+package alertapp
+
+import (
+	"context"
+	"go-ai/internal/market/domain/alert"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UpdateAlertRequest changes only the fields that are set. The symbol,
+// interval and rule are fixed; create a new alert to change them.
+type UpdateAlertRequest struct {
+	Name            *string   `json:"name"`
+	Enabled         *bool     `json:"enabled"`
+	CooldownSeconds *int64    `json:"cooldown_seconds"`
+	Channels        *[]string `json:"channels"`
+	WebhookURL      *string   `json:"webhook_url"`
+}
+
+type UpdateAlertUseCase struct {
+	Repo alert.Repository
+}
+
+func NewUpdateAlertUseCase(repo alert.Repository) *UpdateAlertUseCase {
+	return &UpdateAlertUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *UpdateAlertUseCase) Execute(ctx context.Context, orgID, userID, id uuid.UUID, req UpdateAlertRequest) (*AlertResponse, error) {
+	a, err := uc.Repo.GetByID(ctx, orgID, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if req.Name != nil {
+		if err := a.Rename(*req.Name); err != nil {
+			return nil, err
+		}
+	}
+	if req.Channels != nil || req.WebhookURL != nil {
+		channels, webhookURL := a.Channels, a.WebhookURL
+		if req.Channels != nil {
+			channels = *req.Channels
+		}
+		if req.WebhookURL != nil {
+			webhookURL = *req.WebhookURL
+		}
+		if err := a.SetDelivery(channels, webhookURL); err != nil {
+			return nil, err
+		}
+	}
+	if req.CooldownSeconds != nil {
+		if err := a.SetCooldown(time.Duration(*req.CooldownSeconds) * time.Second); err != nil {
+			return nil, err
+		}
+	}
+	if req.Enabled != nil {
+		a.SetEnabled(*req.Enabled)
+	}
+	if err := uc.Repo.Update(ctx, a); err != nil {
+		return nil, err
+	}
+	updated, err := uc.Repo.GetByID(ctx, orgID, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := toAlertResponse(*updated)
+	return &resp, nil
+}
+
+type DeleteAlertUseCase struct {
+	Repo alert.Repository
+}
+
+func NewDeleteAlertUseCase(repo alert.Repository) *DeleteAlertUseCase {
+	return &DeleteAlertUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute deletes an alert and its history.
+func (uc *DeleteAlertUseCase) Execute(ctx context.Context, orgID, userID, id uuid.UUID) error {
+	return uc.Repo.Delete(ctx, orgID, userID, id)
+}
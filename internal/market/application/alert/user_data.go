@@ -0,0 +1,66 @@
+package alertapp
+
+import (
+	"context"
+	"go-ai/internal/market/domain/alert"
+
+	"github.com/google/uuid"
+)
+
+const exportPageSize = 200
+
+// UserData exports and purges a user's alerts and their history.
+type UserData struct {
+	Repo alert.Repository
+}
+
+func NewUserData(repo alert.Repository) *UserData {
+	return &UserData{
+		Repo: repo,
+	}
+}
+
+type userDataExport struct {
+	Alerts []AlertResponse      `json:"alerts"`
+	Events []AlertEventResponse `json:"events"`
+}
+
+// ExportUserData returns the user's alerts and trigger history in every
+// organization.
+func (d *UserData) ExportUserData(ctx context.Context, userID uuid.UUID, orgIDs []uuid.UUID) (any, error) {
+	alerts, err := d.Repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	out := userDataExport{
+		Alerts: toAlertResponses(alerts),
+		Events: []AlertEventResponse{},
+	}
+	for offset := int32(0); ; offset += exportPageSize {
+		events, total, err := d.Repo.ListEventsByUser(ctx, userID, exportPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range events {
+			out.Events = append(out.Events, toAlertEventResponse(e))
+		}
+		if len(events) == 0 || int64(offset)+exportPageSize >= total {
+			break
+		}
+	}
+	return out, nil
+}
+
+// PurgeUserData deletes the user's own alerts and every alert in the
+// organizations removed along with the account. History goes with them.
+func (d *UserData) PurgeUserData(ctx context.Context, userID uuid.UUID, orgIDs []uuid.UUID) error {
+	if _, err := d.Repo.DeleteByUser(ctx, userID); err != nil {
+		return err
+	}
+	for _, orgID := range orgIDs {
+		if _, err := d.Repo.DeleteByOrganization(ctx, orgID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package portfolioapp
+
+import (
+	"context"
+	"go-ai/internal/market/domain/portfolio"
+
+	"github.com/google/uuid"
+)
+
+type CreatePortfolioRequest struct {
+	Name string `json:"name"`
+	// Currency labels the quote currency, USDT by default.
+	Currency string `json:"currency"`
+}
+
+type CreatePortfolioUseCase struct {
+	Repo portfolio.Repository
+}
+
+func NewCreatePortfolioUseCase(repo portfolio.Repository) *CreatePortfolioUseCase {
+	return &CreatePortfolioUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *CreatePortfolioUseCase) Execute(ctx context.Context, orgID, userID uuid.UUID, req CreatePortfolioRequest) (*PortfolioResponse, error) {
+	p, err := portfolio.NewPortfolio(orgID, userID, req.Name, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+	count, err := uc.Repo.CountByOwner(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= portfolio.MaxPerOwner {
+		return nil, portfolio.ErrTooManyPortfolios
+	}
+	if err := uc.Repo.Create(ctx, p); err != nil {
+		return nil, err
+	}
+	resp := toPortfolioResponse(*p)
+	return &resp, nil
+}
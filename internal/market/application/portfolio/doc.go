@@ -0,0 +1,38 @@
+package portfolioapp
+
+import (
+	"go-ai/pkg/response"
+)
+
+type PortfolioSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *PortfolioResponse `json:"data,omitempty"`
+}
+
+type ListPortfoliosSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data []PortfolioResponse `json:"data,omitempty"`
+}
+
+type TradeSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *TradeResponse `json:"data,omitempty"`
+}
+
+type ListTradesSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *struct {
+		response.PaginatedResponseDoc
+		Items []TradeResponse `json:"items"`
+	} `json:"data,omitempty"`
+}
+
+type PositionsSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *PositionsResponse `json:"data,omitempty"`
+}
+
+type PerformanceSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *PerformanceResponse `json:"data,omitempty"`
+}
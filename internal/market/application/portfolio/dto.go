@@ -0,0 +1,107 @@
+package portfolioapp
+
+import (
+	"go-ai/internal/coinai"
+	"go-ai/internal/market/domain/portfolio"
+	"go-ai/pkg/helpers"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PortfolioResponse struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Name           string    `json:"name"`
+	Currency       string    `json:"currency"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// TradeResponse carries amounts as decimal strings, e.g. "0.015".
+type TradeResponse struct {
+	ID           uuid.UUID       `json:"id"`
+	Symbol       string          `json:"symbol"`
+	Market       string          `json:"market"`
+	Side         string          `json:"side"`
+	Quantity     helpers.Decimal `json:"quantity" swaggertype:"string"`
+	Price        helpers.Decimal `json:"price" swaggertype:"string"`
+	Fee          helpers.Decimal `json:"fee" swaggertype:"string"`
+	ExecutedAt   time.Time       `json:"executed_at"`
+	Source       string          `json:"source"`
+	Signal       coinai.Signal   `json:"signal,omitempty"`
+	Interval     string          `json:"interval,omitempty"`
+	ModelVersion int             `json:"model_version,omitempty"`
+	Note         string          `json:"note,omitempty"`
+	CreatedAt    time.Time       `json:"created_at"`
+}
+
+// ValuationResponse totals a portfolio. Open positions without a price are
+// counted in Unpriced and valued at cost.
+type ValuationResponse struct {
+	CostBasis     helpers.Decimal `json:"cost_basis" swaggertype:"string"`
+	MarketValue   helpers.Decimal `json:"market_value" swaggertype:"string"`
+	RealizedPnL   helpers.Decimal `json:"realized_pnl" swaggertype:"string"`
+	UnrealizedPnL helpers.Decimal `json:"unrealized_pnl" swaggertype:"string"`
+	TotalPnL      helpers.Decimal `json:"total_pnl" swaggertype:"string"`
+	Fees          helpers.Decimal `json:"fees" swaggertype:"string"`
+	Unpriced      int             `json:"unpriced"`
+}
+
+func toPortfolioResponse(p portfolio.Entity) PortfolioResponse {
+	return PortfolioResponse{
+		ID:             p.ID,
+		OrganizationID: p.OrganizationID,
+		Name:           p.Name,
+		Currency:       p.Currency,
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.UpdatedAt,
+	}
+}
+
+func toPortfolioResponses(list []portfolio.Entity) []PortfolioResponse {
+	out := make([]PortfolioResponse, 0, len(list))
+	for _, p := range list {
+		out = append(out, toPortfolioResponse(p))
+	}
+	return out
+}
+
+func toTradeResponse(t portfolio.Trade) TradeResponse {
+	return TradeResponse{
+		ID:           t.ID,
+		Symbol:       t.Symbol,
+		Market:       t.Market,
+		Side:         t.Side,
+		Quantity:     t.Quantity,
+		Price:        t.Price,
+		Fee:          t.Fee,
+		ExecutedAt:   t.ExecutedAt,
+		Source:       t.Source,
+		Signal:       t.Signal,
+		Interval:     t.Interval,
+		ModelVersion: t.ModelVersion,
+		Note:         t.Note,
+		CreatedAt:    t.CreatedAt,
+	}
+}
+
+func toTradeResponses(trades []portfolio.Trade) []TradeResponse {
+	out := make([]TradeResponse, 0, len(trades))
+	for _, t := range trades {
+		out = append(out, toTradeResponse(t))
+	}
+	return out
+}
+
+func toValuationResponse(v portfolio.Valuation) ValuationResponse {
+	return ValuationResponse{
+		CostBasis:     v.CostBasis,
+		MarketValue:   v.MarketValue,
+		RealizedPnL:   v.RealizedPnL,
+		UnrealizedPnL: v.UnrealizedPnL,
+		TotalPnL:      v.TotalPnL,
+		Fees:          v.Fees,
+		Unpriced:      v.Unpriced,
+	}
+}
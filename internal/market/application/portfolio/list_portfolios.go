@@ -0,0 +1,45 @@
+package portfolioapp
+
+import (
+	"context"
+	"go-ai/internal/market/domain/portfolio"
+
+	"github.com/google/uuid"
+)
+
+type ListPortfoliosUseCase struct {
+	Repo portfolio.Repository
+}
+
+func NewListPortfoliosUseCase(repo portfolio.Repository) *ListPortfoliosUseCase {
+	return &ListPortfoliosUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *ListPortfoliosUseCase) Execute(ctx context.Context, orgID, userID uuid.UUID) ([]PortfolioResponse, error) {
+	list, err := uc.Repo.List(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toPortfolioResponses(list), nil
+}
+
+type GetPortfolioUseCase struct {
+	Repo portfolio.Repository
+}
+
+func NewGetPortfolioUseCase(repo portfolio.Repository) *GetPortfolioUseCase {
+	return &GetPortfolioUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *GetPortfolioUseCase) Execute(ctx context.Context, orgID, userID, id uuid.UUID) (*PortfolioResponse, error) {
+	p, err := uc.Repo.GetByID(ctx, orgID, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := toPortfolioResponse(*p)
+	return &resp, nil
+}
@@ -0,0 +1,180 @@
+package portfolioapp
+
+import (
+	"context"
+	"go-ai/internal/coinai"
+	"go-ai/internal/market/domain/portfolio"
+	"go-ai/pkg/helpers"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+const (
+	defaultPerformanceInterval = "1d"
+	defaultPerformancePoints   = 30
+	maxPerformancePoints       = 365
+)
+
+type PerformanceRequest struct {
+	// Interval is the candle interval between points, 1d by default.
+	Interval string `query:"interval"`
+	// Limit is the number of points, 30 by default and at most 365.
+	Limit *int32 `query:"limit"`
+}
+
+type PerformanceResponse struct {
+	ID       uuid.UUID          `json:"id"`
+	Currency string             `json:"currency"`
+	Interval string             `json:"interval"`
+	Points   []PerformancePoint `json:"points"`
+	// Errors names the symbols whose candles could not be loaded; their
+	// positions are valued at cost.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// PerformancePoint values the portfolio as it stood at a candle close.
+type PerformancePoint struct {
+	Time time.Time `json:"time"`
+	ValuationResponse
+}
+
+type PerformanceUseCase struct {
+	Repo    portfolio.Repository
+	Fetcher coinai.CandleFetcher
+	Logger  zerolog.Logger
+}
+
+func NewPerformanceUseCase(repo portfolio.Repository, fetcher coinai.CandleFetcher, logger zerolog.Logger) *PerformanceUseCase {
+	return &PerformanceUseCase{
+		Repo:    repo,
+		Fetcher: fetcher,
+		Logger:  logger.With().Str("component", "PerformanceUseCase").Logger(),
+	}
+}
+
+// Execute rebuilds the portfolio's value at each of the last closed candles
+// of the interval: the positions held at that close, priced at that close.
+// Points start at the first trade.
+func (uc *PerformanceUseCase) Execute(ctx context.Context, orgID, userID, id uuid.UUID, req PerformanceRequest) (*PerformanceResponse, error) {
+	p, err := uc.Repo.GetByID(ctx, orgID, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	interval := strings.TrimSpace(req.Interval)
+	if interval == "" {
+		interval = defaultPerformanceInterval
+	}
+	if !coinai.IsBinanceInterval(interval) {
+		return nil, portfolio.ErrInvalidInterval
+	}
+	limit := defaultPerformancePoints
+	if req.Limit != nil && *req.Limit > 0 {
+		limit = min(int(*req.Limit), maxPerformancePoints)
+	}
+
+	trades, err := uc.Repo.ListTrades(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := &PerformanceResponse{
+		ID:       p.ID,
+		Currency: p.Currency,
+		Interval: interval,
+		Points:   []PerformancePoint{},
+	}
+	if len(trades) == 0 {
+		return resp, nil
+	}
+
+	history, failed := uc.fetchHistory(ctx, trades, interval, limit)
+	resp.Errors = failed
+
+	closeTimes := make(map[time.Time]struct{})
+	for _, candles := range history {
+		for _, c := range candles {
+			closeTimes[c.CloseTime] = struct{}{}
+		}
+	}
+	first := trades[0].ExecutedAt
+	times := make([]time.Time, 0, len(closeTimes))
+	for t := range closeTimes {
+		if !t.Before(first) {
+			times = append(times, t)
+		}
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	if len(times) > limit {
+		times = times[len(times)-limit:]
+	}
+
+	for _, at := range times {
+		positions, err := portfolio.BuildPositions(trades, at)
+		if err != nil {
+			return nil, err
+		}
+		prices := make(map[string]helpers.Decimal, len(history))
+		for symbol, candles := range history {
+			if c, ok := closeAt(candles, at); ok {
+				prices[portfolio.PositionKey(portfolio.MarketCoin, symbol)] = helpers.DecimalFromFloat(c.Close)
+			}
+		}
+		resp.Points = append(resp.Points, PerformancePoint{
+			Time:              at,
+			ValuationResponse: toValuationResponse(portfolio.Value(positions, prices)),
+		})
+	}
+	return resp, nil
+}
+
+// fetchHistory loads the last closed candles of every coin symbol traded,
+// returning them by symbol and the symbols that failed.
+func (uc *PerformanceUseCase) fetchHistory(ctx context.Context, trades []portfolio.Trade, interval string, limit int) (map[string][]coinai.Candle, []string) {
+	symbols := make(map[string]struct{})
+	for _, t := range trades {
+		if t.Market == portfolio.MarketCoin {
+			symbols[t.Symbol] = struct{}{}
+		}
+	}
+
+	now := time.Now()
+	history := make(map[string][]coinai.Candle, len(symbols))
+	var failed []string
+	var mu sync.Mutex
+	sem := make(chan struct{}, priceConcurrency)
+	var wg sync.WaitGroup
+	for symbol := range symbols {
+		wg.Add(1)
+		go func(symbol string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			// One extra candle replaces the still-open one.
+			candles, err := uc.Fetcher.FetchKlines(ctx, symbol, interval, limit+1)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				uc.Logger.Warn().Err(err).Str("symbol", symbol).Str("interval", interval).Msg("failed to fetch candles")
+				failed = append(failed, symbol)
+				return
+			}
+			history[symbol] = coinai.ClosedCandles(candles, now)
+		}(symbol)
+	}
+	wg.Wait()
+	sort.Strings(failed)
+	return history, failed
+}
+
+// closeAt returns the last candle that closed at or before at.
+func closeAt(candles []coinai.Candle, at time.Time) (coinai.Candle, bool) {
+	i := sort.Search(len(candles), func(i int) bool { return candles[i].CloseTime.After(at) })
+	if i == 0 {
+		return coinai.Candle{}, false
+	}
+	return candles[i-1], true
+}
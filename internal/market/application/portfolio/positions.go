@@ -0,0 +1,143 @@
+package portfolioapp
+
+import (
+	"context"
+	"go-ai/internal/coinai"
+	"go-ai/internal/market/domain/portfolio"
+	"go-ai/pkg/helpers"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// priceInterval is the candle the latest price is read from.
+	priceInterval = "1m"
+	// priceConcurrency bounds the candle requests one valuation makes at once.
+	priceConcurrency = 8
+)
+
+type PositionsResponse struct {
+	ID       uuid.UUID          `json:"id"`
+	Currency string             `json:"currency"`
+	ValuedAt time.Time          `json:"valued_at"`
+	Summary  ValuationResponse  `json:"summary"`
+	Items    []PositionResponse `json:"items"`
+}
+
+// PositionResponse is one symbol's holding. Closed positions are listed for
+// their realised PnL. A failed price affects only its own position and is
+// reported in Error.
+type PositionResponse struct {
+	Symbol      string          `json:"symbol"`
+	Market      string          `json:"market"`
+	Quantity    helpers.Decimal `json:"quantity" swaggertype:"string"`
+	AverageCost helpers.Decimal `json:"average_cost" swaggertype:"string"`
+	CostBasis   helpers.Decimal `json:"cost_basis" swaggertype:"string"`
+	RealizedPnL helpers.Decimal `json:"realized_pnl" swaggertype:"string"`
+	Fees        helpers.Decimal `json:"fees" swaggertype:"string"`
+	Trades      int             `json:"trades"`
+	OpenedAt    *time.Time      `json:"opened_at,omitempty"`
+	// Price is the close of the latest 1m candle, which may still be open.
+	Price         *helpers.Decimal `json:"price,omitempty" swaggertype:"string"`
+	MarketValue   *helpers.Decimal `json:"market_value,omitempty" swaggertype:"string"`
+	UnrealizedPnL *helpers.Decimal `json:"unrealized_pnl,omitempty" swaggertype:"string"`
+	Error         string           `json:"error,omitempty"`
+}
+
+type PositionsUseCase struct {
+	Repo    portfolio.Repository
+	Fetcher coinai.CandleFetcher
+	Logger  zerolog.Logger
+}
+
+func NewPositionsUseCase(repo portfolio.Repository, fetcher coinai.CandleFetcher, logger zerolog.Logger) *PositionsUseCase {
+	return &PositionsUseCase{
+		Repo:    repo,
+		Fetcher: fetcher,
+		Logger:  logger.With().Str("component", "PositionsUseCase").Logger(),
+	}
+}
+
+// Execute replays the journal into positions and values the open coin
+// positions at the latest Binance price.
+func (uc *PositionsUseCase) Execute(ctx context.Context, orgID, userID, id uuid.UUID) (*PositionsResponse, error) {
+	p, err := uc.Repo.GetByID(ctx, orgID, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	trades, err := uc.Repo.ListTrades(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	positions, err := portfolio.BuildPositions(trades, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]PositionResponse, len(positions))
+	prices := make(map[string]helpers.Decimal)
+	var mu sync.Mutex
+	sem := make(chan struct{}, priceConcurrency)
+	var wg sync.WaitGroup
+	for i, pos := range positions {
+		items[i] = toPositionResponse(pos)
+		if pos.Quantity.IsZero() {
+			continue
+		}
+		if pos.Market != portfolio.MarketCoin {
+			items[i].Error = "Live prices are only available for coin markets"
+			continue
+		}
+		wg.Add(1)
+		go func(i int, pos portfolio.Position) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			candles, err := uc.Fetcher.FetchKlines(ctx, pos.Symbol, priceInterval, 1)
+			if err != nil || len(candles) == 0 {
+				uc.Logger.Warn().Err(err).Str("symbol", pos.Symbol).Msg("failed to fetch price")
+				items[i].Error = "Price unavailable"
+				return
+			}
+			price := helpers.DecimalFromFloat(candles[len(candles)-1].Close)
+			value := pos.Quantity.Mul(price)
+			unrealized := value.Sub(pos.CostBasis)
+			items[i].Price = &price
+			items[i].MarketValue = &value
+			items[i].UnrealizedPnL = &unrealized
+			mu.Lock()
+			prices[pos.Key()] = price
+			mu.Unlock()
+		}(i, pos)
+	}
+	wg.Wait()
+
+	return &PositionsResponse{
+		ID:       p.ID,
+		Currency: p.Currency,
+		ValuedAt: time.Now().UTC(),
+		Summary:  toValuationResponse(portfolio.Value(positions, prices)),
+		Items:    items,
+	}, nil
+}
+
+func toPositionResponse(p portfolio.Position) PositionResponse {
+	resp := PositionResponse{
+		Symbol:      p.Symbol,
+		Market:      p.Market,
+		Quantity:    p.Quantity,
+		AverageCost: p.AverageCost(),
+		CostBasis:   p.CostBasis,
+		RealizedPnL: p.RealizedPnL,
+		Fees:        p.Fees,
+		Trades:      p.Trades,
+	}
+	if !p.Quantity.IsZero() {
+		opened := p.OpenedAt
+		resp.OpenedAt = &opened
+	}
+	return resp
+}
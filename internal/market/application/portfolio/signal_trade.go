@@ -0,0 +1,96 @@
+package portfolioapp
+
+import (
+	"context"
+	"go-ai/internal/coinai"
+	"go-ai/internal/market/domain/portfolio"
+	"go-ai/pkg/helpers"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// signalCandles is enough history for the feature window plus the
+// still-open candle that gets dropped.
+const signalCandles = 50
+
+type SignalTradeRequest struct {
+	Symbol   string          `json:"symbol"`
+	Interval string          `json:"interval"`
+	Quantity helpers.Decimal `json:"quantity" swaggertype:"string"`
+	Fee      helpers.Decimal `json:"fee" swaggertype:"string"`
+}
+
+type SignalTradeUseCase struct {
+	Repo           portfolio.Repository
+	Fetcher        coinai.CandleFetcher
+	Models         coinai.ModelStore
+	LongThreshold  float64
+	ShortThreshold float64
+	Logger         zerolog.Logger
+}
+
+func NewSignalTradeUseCase(repo portfolio.Repository, fetcher coinai.CandleFetcher, models coinai.ModelStore, logger zerolog.Logger) *SignalTradeUseCase {
+	return &SignalTradeUseCase{
+		Repo:           repo,
+		Fetcher:        fetcher,
+		Models:         models,
+		LongThreshold:  coinai.DefaultLongThreshold,
+		ShortThreshold: coinai.DefaultShortThreshold,
+		Logger:         logger.With().Str("component", "SignalTradeUseCase").Logger(),
+	}
+}
+
+// Execute predicts on the last closed candle with the organization's
+// production model and records the trade the signal calls for at that
+// candle's close: BUY buys, SELL sells, HOLD records nothing.
+func (uc *SignalTradeUseCase) Execute(ctx context.Context, orgID, userID, id uuid.UUID, req SignalTradeRequest) (*TradeResponse, error) {
+	if _, err := uc.Repo.GetByID(ctx, orgID, userID, id); err != nil {
+		return nil, err
+	}
+	symbol := strings.ToUpper(strings.TrimSpace(req.Symbol))
+	if symbol == "" {
+		return nil, portfolio.ErrSymbolRequired
+	}
+	interval := strings.TrimSpace(req.Interval)
+	if !coinai.IsBinanceInterval(interval) {
+		return nil, portfolio.ErrInvalidInterval
+	}
+
+	model, err := uc.Models.Current(ctx, orgID, symbol, interval)
+	if err != nil {
+		return nil, err
+	}
+	if model == nil {
+		return nil, portfolio.ErrNoProductionModel
+	}
+	now := time.Now().UTC()
+	candles, err := uc.Fetcher.FetchKlines(ctx, symbol, interval, signalCandles)
+	if err != nil {
+		uc.Logger.Warn().Err(err).Str("symbol", symbol).Str("interval", interval).Msg("failed to fetch candles")
+		return nil, portfolio.ErrPriceUnavailable
+	}
+	closed := coinai.ClosedCandles(candles, now)
+	features, err := coinai.BuildLatestFeatures(closed)
+	if err != nil {
+		return nil, portfolio.ErrPriceUnavailable
+	}
+	pred, err := model.Predict(features)
+	if err != nil {
+		return nil, err
+	}
+	signal := coinai.SignalFromPrediction(pred, uc.LongThreshold, uc.ShortThreshold)
+	price := helpers.DecimalFromFloat(closed[len(closed)-1].Close)
+
+	t, err := portfolio.NewSignalTrade(id, symbol, interval, signal, model.Version, req.Quantity, price, req.Fee, now)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.AddTrade(ctx, t); err != nil {
+		return nil, err
+	}
+	resp := toTradeResponse(*t)
+	return &resp, nil
+}
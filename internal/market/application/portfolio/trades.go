@@ -0,0 +1,109 @@
+package portfolioapp
+
+import (
+	"context"
+	"go-ai/internal/market/domain/portfolio"
+	"go-ai/pkg/helpers"
+	"go-ai/pkg/response"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ListTradesRequest struct {
+	Page  *int32 `query:"page"`
+	Limit *int32 `query:"limit"`
+}
+
+type ListTradesUseCase struct {
+	Repo portfolio.Repository
+}
+
+func NewListTradesUseCase(repo portfolio.Repository) *ListTradesUseCase {
+	return &ListTradesUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute returns the trade journal, newest first.
+func (uc *ListTradesUseCase) Execute(ctx context.Context, orgID, userID, id uuid.UUID, req ListTradesRequest) (*response.PaginatedResponse[[]TradeResponse], error) {
+	if _, err := uc.Repo.GetByID(ctx, orgID, userID, id); err != nil {
+		return nil, err
+	}
+	page, limit, offset := response.ApplyDefaultPaginated(req.Page, req.Limit)
+	trades, total, err := uc.Repo.ListTradesPage(ctx, id, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	return &response.PaginatedResponse[[]TradeResponse]{
+		Page:       page,
+		Limit:      limit,
+		TotalItems: total,
+		TotalPages: response.CalculateTotalPages(total, int64(limit)),
+		Items:      toTradeResponses(trades),
+	}, nil
+}
+
+// AddTradeRequest takes amounts as decimal strings or numbers; strings keep
+// every digit.
+type AddTradeRequest struct {
+	Symbol   string          `json:"symbol"`
+	Market   string          `json:"market"`
+	Side     string          `json:"side"`
+	Quantity helpers.Decimal `json:"quantity" swaggertype:"string"`
+	Price    helpers.Decimal `json:"price" swaggertype:"string"`
+	Fee      helpers.Decimal `json:"fee" swaggertype:"string"`
+	// ExecutedAt defaults to now. Back-dated trades are replayed in order.
+	ExecutedAt *time.Time `json:"executed_at"`
+	Note       string     `json:"note"`
+}
+
+type AddTradeUseCase struct {
+	Repo portfolio.Repository
+}
+
+func NewAddTradeUseCase(repo portfolio.Repository) *AddTradeUseCase {
+	return &AddTradeUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute records a manual trade. A sell may not exceed the quantity held
+// at its execution time, and no later sell may be left uncovered.
+func (uc *AddTradeUseCase) Execute(ctx context.Context, orgID, userID, id uuid.UUID, req AddTradeRequest) (*TradeResponse, error) {
+	if _, err := uc.Repo.GetByID(ctx, orgID, userID, id); err != nil {
+		return nil, err
+	}
+	var executedAt time.Time
+	if req.ExecutedAt != nil {
+		executedAt = *req.ExecutedAt
+	}
+	t, err := portfolio.NewTrade(id, req.Symbol, req.Market, req.Side, req.Quantity, req.Price, req.Fee, executedAt, req.Note, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.AddTrade(ctx, t); err != nil {
+		return nil, err
+	}
+	resp := toTradeResponse(*t)
+	return &resp, nil
+}
+
+type DeleteTradeUseCase struct {
+	Repo portfolio.Repository
+}
+
+func NewDeleteTradeUseCase(repo portfolio.Repository) *DeleteTradeUseCase {
+	return &DeleteTradeUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute removes a trade from the journal. Removing a buy that a later sell
+// depends on fails with ErrOversold.
+func (uc *DeleteTradeUseCase) Execute(ctx context.Context, orgID, userID, id, tradeID uuid.UUID) error {
+	if _, err := uc.Repo.GetByID(ctx, orgID, userID, id); err != nil {
+		return err
+	}
+	return uc.Repo.DeleteTrade(ctx, id, tradeID)
+}
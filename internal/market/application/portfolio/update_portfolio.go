@@ -0,0 +1,54 @@
+package portfolioapp
+
+import (
+	"context"
+	"go-ai/internal/market/domain/portfolio"
+
+	"github.com/google/uuid"
+)
+
+type UpdatePortfolioRequest struct {
+	Name string `json:"name"`
+}
+
+type UpdatePortfolioUseCase struct {
+	Repo portfolio.Repository
+}
+
+func NewUpdatePortfolioUseCase(repo portfolio.Repository) *UpdatePortfolioUseCase {
+	return &UpdatePortfolioUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute renames a portfolio.
+func (uc *UpdatePortfolioUseCase) Execute(ctx context.Context, orgID, userID, id uuid.UUID, req UpdatePortfolioRequest) (*PortfolioResponse, error) {
+	name, err := portfolio.NormalizeName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.Rename(ctx, orgID, userID, id, name); err != nil {
+		return nil, err
+	}
+	p, err := uc.Repo.GetByID(ctx, orgID, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := toPortfolioResponse(*p)
+	return &resp, nil
+}
+
+type DeletePortfolioUseCase struct {
+	Repo portfolio.Repository
+}
+
+func NewDeletePortfolioUseCase(repo portfolio.Repository) *DeletePortfolioUseCase {
+	return &DeletePortfolioUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute deletes a portfolio and its trade journal.
+func (uc *DeletePortfolioUseCase) Execute(ctx context.Context, orgID, userID, id uuid.UUID) error {
+	return uc.Repo.Delete(ctx, orgID, userID, id)
+}
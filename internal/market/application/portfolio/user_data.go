@@ -0,0 +1,59 @@
+package portfolioapp
+
+import (
+	"context"
+	"go-ai/internal/market/domain/portfolio"
+
+	"github.com/google/uuid"
+)
+
+// UserData exports and purges a user's portfolios and their trades.
+type UserData struct {
+	Repo portfolio.Repository
+}
+
+func NewUserData(repo portfolio.Repository) *UserData {
+	return &UserData{
+		Repo: repo,
+	}
+}
+
+type portfolioExport struct {
+	PortfolioResponse
+	Trades []TradeResponse `json:"trades"`
+}
+
+// ExportUserData returns the user's portfolios in every organization, each
+// with its full trade journal.
+func (d *UserData) ExportUserData(ctx context.Context, userID uuid.UUID, orgIDs []uuid.UUID) (any, error) {
+	list, err := d.Repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]portfolioExport, 0, len(list))
+	for _, p := range list {
+		trades, err := d.Repo.ListTrades(ctx, p.ID)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, portfolioExport{
+			PortfolioResponse: toPortfolioResponse(p),
+			Trades:            toTradeResponses(trades),
+		})
+	}
+	return out, nil
+}
+
+// PurgeUserData deletes the user's own portfolios and every portfolio in the
+// organizations removed along with the account.
+func (d *UserData) PurgeUserData(ctx context.Context, userID uuid.UUID, orgIDs []uuid.UUID) error {
+	if _, err := d.Repo.DeleteByUser(ctx, userID); err != nil {
+		return err
+	}
+	for _, orgID := range orgIDs {
+		if _, err := d.Repo.DeleteByOrganization(ctx, orgID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
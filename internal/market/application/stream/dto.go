@@ -0,0 +1,35 @@
+package streamapp
+
+import (
+	"go-ai/internal/coinai"
+	"time"
+)
+
+// CandleData is the data of a candle event, sent once per closed candle.
+type CandleData struct {
+	Symbol    string    `json:"symbol"`
+	Interval  string    `json:"interval"`
+	OpenTime  time.Time `json:"open_time"`
+	CloseTime time.Time `json:"close_time"`
+	Open      float64   `json:"open"`
+	High      float64   `json:"high"`
+	Low       float64   `json:"low"`
+	Close     float64   `json:"close"`
+	Volume    float64   `json:"volume"`
+}
+
+// PredictionData is the data of a prediction event: the organization's
+// production model run on the candle that just closed.
+type PredictionData struct {
+	Symbol          string        `json:"symbol"`
+	Interval        string        `json:"interval"`
+	ModelVersion    int           `json:"model_version"`
+	PredictedReturn float64       `json:"predicted_return"`
+	Signal          coinai.Signal `json:"signal"`
+	Price           float64       `json:"price"`
+	CandleCloseTime time.Time     `json:"candle_close_time"`
+}
+
+type HeartbeatData struct {
+	Time time.Time `json:"time"`
+}
@@ -0,0 +1,273 @@
+package streamapp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"go-ai/internal/market/domain/stream"
+	"go-ai/pkg/worker"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// ErrDropped ends a stream the hub closed, because the client fell behind
+// or the server is shutting down. The client reconnects with Last-Event-ID
+// and catches up from the log.
+var ErrDropped = errors.New("stream: dropped")
+
+const (
+	resubscribeMin = time.Second
+	resubscribeMax = 30 * time.Second
+	// leaseTimeout bounds the Redis calls made when a connection closes.
+	leaseTimeout = 5 * time.Second
+)
+
+// HubConfig holds stream hub settings
+type HubConfig struct {
+	// Heartbeat is how often every connection gets a heartbeat event. Its
+	// connection lease and watched series are renewed at the same time and
+	// last three heartbeats.
+	Heartbeat             time.Duration
+	MaxConnectionsPerUser int
+	// ReplayLimit caps the events sent to a client resuming with
+	// Last-Event-ID.
+	ReplayLimit int64
+	// Buffer is how many events a connection may fall behind before it is
+	// closed.
+	Buffer int
+}
+
+// DefaultHubConfig returns default stream hub configuration
+func DefaultHubConfig() HubConfig {
+	return HubConfig{
+		Heartbeat:             15 * time.Second,
+		MaxConnectionsPerUser: 5,
+		ReplayLimit:           500,
+		Buffer:                64,
+	}
+}
+
+// Client is one open stream. Replay holds the events it missed since the
+// Last-Event-ID it resumed from.
+type Client struct {
+	ID           uuid.UUID
+	Subscription stream.Subscription
+	Replay       []stream.Event
+	lastEventID  string
+
+	events   chan stream.Event
+	dropped  chan struct{}
+	dropOnce sync.Once
+}
+
+func (c *Client) drop() {
+	c.dropOnce.Do(func() { close(c.dropped) })
+}
+
+// Hub fans events out to the streams open on this replica. Each replica
+// subscribes to the broker once, so events published anywhere reach every
+// matching client.
+type Hub struct {
+	broker stream.Broker
+	cfg    HubConfig
+	logger zerolog.Logger
+
+	mu      sync.RWMutex
+	clients map[*Client]struct{}
+
+	group *worker.Group
+}
+
+func NewHub(broker stream.Broker, cfg HubConfig, logger zerolog.Logger) *Hub {
+	defaults := DefaultHubConfig()
+	if cfg.Heartbeat <= 0 {
+		cfg.Heartbeat = defaults.Heartbeat
+	}
+	if cfg.MaxConnectionsPerUser <= 0 {
+		cfg.MaxConnectionsPerUser = defaults.MaxConnectionsPerUser
+	}
+	if cfg.ReplayLimit <= 0 {
+		cfg.ReplayLimit = defaults.ReplayLimit
+	}
+	if cfg.Buffer <= 0 {
+		cfg.Buffer = defaults.Buffer
+	}
+	logger = logger.With().Str("component", "StreamHub").Logger()
+	return &Hub{
+		broker:  broker,
+		cfg:     cfg,
+		clients: make(map[*Client]struct{}),
+		logger:  logger,
+		group:   worker.New("stream hub", logger),
+	}
+}
+
+// Start subscribes to the broker and keeps resubscribing until Shutdown. It
+// returns immediately.
+func (h *Hub) Start(ctx context.Context) {
+	h.group.Start(ctx, h.loop)
+	h.logger.Info().Dur("heartbeat", h.cfg.Heartbeat).Msg("stream hub started")
+}
+
+// Shutdown unsubscribes and ends every open stream, giving up when ctx
+// expires.
+func (h *Hub) Shutdown(ctx context.Context) error {
+	h.Drain()
+	return h.group.Shutdown(ctx)
+}
+
+// Drain ends every stream open on this replica. The HTTP server calls it
+// when shutting down, since it waits for open requests and streams never
+// finish on their own. Clients reconnect to another replica.
+func (h *Hub) Drain() {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for c := range h.clients {
+		c.drop()
+	}
+}
+
+func (h *Hub) loop(ctx context.Context) {
+	wait := resubscribeMin
+	for {
+		err := h.broker.Subscribe(ctx, func() { wait = resubscribeMin }, h.dispatch)
+		if ctx.Err() != nil {
+			return
+		}
+		h.logger.Error().Err(err).Dur("retry_in", wait).Msg("stream subscription lost")
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+		wait = min(wait*2, resubscribeMax)
+	}
+}
+
+// dispatch hands e to every matching client without blocking. A client
+// whose buffer is full is dropped.
+func (h *Hub) dispatch(e stream.Event) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for c := range h.clients {
+		if !c.Subscription.Matches(e) {
+			continue
+		}
+		select {
+		case c.events <- e:
+		default:
+			c.drop()
+		}
+	}
+}
+
+// Open registers a stream for sub. It returns ErrTooManyConnections when the
+// user already has the maximum open across replicas. A non-empty
+// lastEventID loads the logged events after it into Replay.
+func (h *Hub) Open(ctx context.Context, sub stream.Subscription, lastEventID string) (*Client, error) {
+	if lastEventID != "" {
+		if _, _, err := stream.ParseEventID(lastEventID); err != nil {
+			return nil, err
+		}
+	}
+	c := &Client{
+		ID:           uuid.New(),
+		Subscription: sub,
+		lastEventID:  lastEventID,
+		events:       make(chan stream.Event, h.cfg.Buffer),
+		dropped:      make(chan struct{}),
+	}
+	if err := h.lease(ctx, c); err != nil {
+		return nil, err
+	}
+
+	// Register before reading the log so nothing published in between is
+	// missed; Serve skips what the replay already covered.
+	h.mu.Lock()
+	h.clients[c] = struct{}{}
+	h.mu.Unlock()
+
+	if lastEventID != "" {
+		missed, err := h.broker.Since(ctx, lastEventID, h.cfg.ReplayLimit)
+		if err != nil {
+			h.Close(ctx, c)
+			return nil, err
+		}
+		for _, e := range missed {
+			if sub.Matches(e) {
+				c.Replay = append(c.Replay, e)
+			}
+		}
+	}
+	return c, nil
+}
+
+// Close unregisters c and frees its connection slot.
+func (h *Hub) Close(ctx context.Context, c *Client) {
+	h.mu.Lock()
+	delete(h.clients, c)
+	h.mu.Unlock()
+	c.drop()
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), leaseTimeout)
+	defer cancel()
+	if err := h.broker.Disconnect(ctx, c.Subscription.UserID, c.ID); err != nil {
+		h.logger.Warn().Err(err).Str("connection_id", c.ID.String()).Msg("failed to release stream connection")
+	}
+}
+
+// Serve passes c's replay and then its live events to send, with a
+// heartbeat event every Heartbeat. It returns when ctx ends, send fails, the
+// lease cannot be renewed or the hub drops c.
+func (h *Hub) Serve(ctx context.Context, c *Client, send func(stream.Event) error) error {
+	last := c.lastEventID
+	for _, e := range c.Replay {
+		if err := send(e); err != nil {
+			return err
+		}
+		last = e.ID
+	}
+
+	ticker := time.NewTicker(h.cfg.Heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-c.dropped:
+			return ErrDropped
+		case e := <-c.events:
+			if last != "" && !stream.After(e.ID, last) {
+				continue
+			}
+			if err := send(e); err != nil {
+				return err
+			}
+			last = e.ID
+		case now := <-ticker.C:
+			if err := h.lease(ctx, c); err != nil {
+				return err
+			}
+			if err := send(heartbeat(now)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// lease claims or renews c's connection slot and the series it watches.
+func (h *Hub) lease(ctx context.Context, c *Client) error {
+	expiresAt := time.Now().Add(3 * h.cfg.Heartbeat)
+	if err := h.broker.Connect(ctx, c.Subscription.UserID, c.ID, h.cfg.MaxConnectionsPerUser, expiresAt); err != nil {
+		return err
+	}
+	return h.broker.Watch(ctx, c.Subscription.Watches(), expiresAt)
+}
+
+func heartbeat(now time.Time) stream.Event {
+	data, _ := json.Marshal(HeartbeatData{Time: now.UTC()})
+	return stream.Event{Type: stream.EventHeartbeat, Data: data, CreatedAt: now.UTC()}
+}
@@ -0,0 +1,188 @@
+package streamapp
+
+import (
+	"context"
+	"encoding/json"
+	"go-ai/internal/coinai"
+	"go-ai/internal/market/domain/stream"
+	"go-ai/pkg/lock"
+	"go-ai/pkg/worker"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// pollCandles is enough history for the feature window plus the
+	// still-open candle that gets dropped.
+	pollCandles = 50
+	// pollLockKey lets one replica poll per pass.
+	pollLockKey = "pass"
+)
+
+// Poller publishes each newly closed candle of the series some stream
+// watches, followed by a prediction for every organization watching it.
+// Candles are fetched once per series however many streams share it.
+type Poller struct {
+	broker         stream.Broker
+	fetcher        coinai.CandleFetcher
+	models         coinai.ModelStore
+	locker         *lock.Locker
+	interval       time.Duration
+	longThreshold  float64
+	shortThreshold float64
+	logger         zerolog.Logger
+
+	group *worker.Group
+}
+
+func NewPoller(broker stream.Broker, fetcher coinai.CandleFetcher, models coinai.ModelStore, locker *lock.Locker, interval time.Duration, logger zerolog.Logger) *Poller {
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	logger = logger.With().Str("component", "StreamPoller").Logger()
+	return &Poller{
+		broker:         broker,
+		fetcher:        fetcher,
+		models:         models,
+		locker:         locker,
+		interval:       interval,
+		longThreshold:  coinai.DefaultLongThreshold,
+		shortThreshold: coinai.DefaultShortThreshold,
+		logger:         logger,
+		group:          worker.New("stream poller", logger),
+	}
+}
+
+// Start launches the polling loop. It returns immediately.
+func (p *Poller) Start(ctx context.Context) {
+	p.group.Start(ctx, p.loop)
+	p.logger.Info().Dur("interval", p.interval).Msg("stream poller started")
+}
+
+// Shutdown stops the loop and waits for the current pass to finish, giving
+// up when ctx expires.
+func (p *Poller) Shutdown(ctx context.Context) error {
+	return p.group.Shutdown(ctx)
+}
+
+func (p *Poller) loop(ctx context.Context) {
+	worker.Every(ctx, p.interval, func(ctx context.Context) {
+		worker.Exclusive(ctx, p.locker, pollLockKey, p.interval, p.logger, p.pass)
+	})
+}
+
+type seriesKey struct {
+	symbol   string
+	interval string
+}
+
+// pass checks every watched series once, under the pass lock. MarkClosed
+// still keeps a candle from being published twice if the lock expires
+// mid-pass.
+func (p *Poller) pass(ctx context.Context) {
+	now := time.Now().UTC()
+	watches, err := p.broker.Watched(ctx, now)
+	if err != nil {
+		if ctx.Err() == nil {
+			p.logger.Error().Err(err).Msg("failed to list watched series")
+		}
+		return
+	}
+
+	groups := make(map[seriesKey][]uuid.UUID)
+	for _, w := range watches {
+		key := seriesKey{symbol: w.Symbol, interval: w.Interval}
+		groups[key] = append(groups[key], w.OrganizationID)
+	}
+	for key, orgs := range groups {
+		if ctx.Err() != nil {
+			return
+		}
+		p.poll(ctx, key, orgs, now)
+	}
+}
+
+func (p *Poller) poll(ctx context.Context, key seriesKey, orgs []uuid.UUID, now time.Time) {
+	logger := p.logger.With().Str("symbol", key.symbol).Str("interval", key.interval).Logger()
+
+	candles, err := p.fetcher.FetchKlines(ctx, key.symbol, key.interval, pollCandles)
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to fetch candles")
+		return
+	}
+	closed := coinai.ClosedCandles(candles, now)
+	if len(closed) == 0 {
+		return
+	}
+	last := closed[len(closed)-1]
+	fresh, err := p.broker.MarkClosed(ctx, key.symbol, key.interval, last.CloseTime)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to mark closed candle")
+		return
+	}
+	if !fresh {
+		return
+	}
+
+	p.publish(ctx, stream.EventCandle, nil, key, CandleData{
+		Symbol:    key.symbol,
+		Interval:  key.interval,
+		OpenTime:  last.OpenTime,
+		CloseTime: last.CloseTime,
+		Open:      last.Open,
+		High:      last.High,
+		Low:       last.Low,
+		Close:     last.Close,
+		Volume:    last.Volume,
+	})
+
+	features, err := coinai.BuildLatestFeatures(closed)
+	if err != nil {
+		return
+	}
+	for _, orgID := range orgs {
+		model, err := p.models.Current(ctx, orgID, key.symbol, key.interval)
+		if err != nil {
+			logger.Error().Err(err).Str("org_id", orgID.String()).Msg("failed to load production model")
+			continue
+		}
+		if model == nil {
+			continue
+		}
+		pred, err := model.Predict(features)
+		if err != nil {
+			logger.Error().Err(err).Str("org_id", orgID.String()).Msg("failed to predict")
+			continue
+		}
+		p.publish(ctx, stream.EventPrediction, &orgID, key, PredictionData{
+			Symbol:          key.symbol,
+			Interval:        key.interval,
+			ModelVersion:    model.Version,
+			PredictedReturn: pred,
+			Signal:          coinai.SignalFromPrediction(pred, p.longThreshold, p.shortThreshold),
+			Price:           last.Close,
+			CandleCloseTime: last.CloseTime,
+		})
+	}
+}
+
+func (p *Poller) publish(ctx context.Context, eventType string, orgID *uuid.UUID, key seriesKey, data any) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		p.logger.Error().Err(err).Str("event", eventType).Msg("failed to encode stream event")
+		return
+	}
+	err = p.broker.Publish(ctx, &stream.Event{
+		Type:           eventType,
+		OrganizationID: orgID,
+		Symbol:         key.symbol,
+		Interval:       key.interval,
+		Data:           body,
+		CreatedAt:      time.Now().UTC(),
+	})
+	if err != nil && ctx.Err() == nil {
+		p.logger.Warn().Err(err).Str("event", eventType).Str("symbol", key.symbol).Str("interval", key.interval).Msg("failed to publish stream event")
+	}
+}
@@ -0,0 +1,54 @@
+package streamapp
+
+import (
+	"context"
+	"encoding/json"
+	"go-ai/internal/market/domain/stream"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Publisher forwards the alert evaluator's events to open streams. It has
+// the same methods as the webhook publisher, so the evaluator feeds both.
+type Publisher struct {
+	Broker stream.Broker
+}
+
+func NewPublisher(broker stream.Broker) *Publisher {
+	return &Publisher{Broker: broker}
+}
+
+// Publish sends an event to the organization's streams.
+func (p *Publisher) Publish(ctx context.Context, orgID uuid.UUID, eventType string, data any) error {
+	return p.publish(ctx, eventType, &orgID, nil, data)
+}
+
+// PublishToUser sends an event to the user's streams in the organization.
+func (p *Publisher) PublishToUser(ctx context.Context, orgID, userID uuid.UUID, eventType string, data any) error {
+	return p.publish(ctx, eventType, &orgID, &userID, data)
+}
+
+// publish takes the symbol and interval from data so only streams of that
+// series get the event. Alerts reach streams of their symbol at any
+// interval, since a user usually streams one interval but alerts on several.
+func (p *Publisher) publish(ctx context.Context, eventType string, orgID, userID *uuid.UUID, data any) error {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	e := &stream.Event{
+		Type:           eventType,
+		OrganizationID: orgID,
+		UserID:         userID,
+		Data:           body,
+		CreatedAt:      time.Now().UTC(),
+	}
+	if fields, ok := data.(map[string]any); ok {
+		e.Symbol, _ = fields["symbol"].(string)
+		if eventType != stream.EventAlertTriggered {
+			e.Interval, _ = fields["interval"].(string)
+		}
+	}
+	return p.Broker.Publish(ctx, e)
+}
@@ -0,0 +1,35 @@
+package watchlistapp
+
+import (
+	"context"
+	"go-ai/internal/market/domain/watchlist"
+
+	"github.com/google/uuid"
+)
+
+type CreateWatchlistRequest struct {
+	Name string `json:"name"`
+}
+
+type CreateWatchlistUseCase struct {
+	Repo watchlist.Repository
+}
+
+func NewCreateWatchlistUseCase(repo watchlist.Repository) *CreateWatchlistUseCase {
+	return &CreateWatchlistUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute creates an empty list after the user's existing lists.
+func (uc *CreateWatchlistUseCase) Execute(ctx context.Context, orgID, userID uuid.UUID, req CreateWatchlistRequest) (*WatchlistResponse, error) {
+	entity, err := watchlist.NewWatchlist(orgID, userID, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.Create(ctx, entity); err != nil {
+		return nil, err
+	}
+	resp := toWatchlistResponse(*entity)
+	return &resp, nil
+}
@@ -0,0 +1,20 @@
+package watchlistapp
+
+import (
+	"go-ai/pkg/response"
+)
+
+type WatchlistSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *WatchlistResponse `json:"data,omitempty"`
+}
+
+type ListWatchlistsSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data []WatchlistResponse `json:"data,omitempty"`
+}
+
+type WatchlistQuotesSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *WatchlistQuotesResponse `json:"data,omitempty"`
+}
@@ -0,0 +1,65 @@
+package watchlistapp
+
+import (
+	"go-ai/internal/market/domain/watchlist"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WatchlistResponse struct {
+	ID             uuid.UUID      `json:"id"`
+	OrganizationID uuid.UUID      `json:"organization_id"`
+	Name           string         `json:"name"`
+	Position       int            `json:"position"`
+	Items          []ItemResponse `json:"items"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+}
+
+type ItemResponse struct {
+	ID       uuid.UUID `json:"id"`
+	Symbol   string    `json:"symbol"`
+	Market   string    `json:"market"`
+	Interval string    `json:"interval"`
+	Position int       `json:"position"`
+}
+
+// ReorderRequest lists every ID in the new order.
+type ReorderRequest struct {
+	IDs []uuid.UUID `json:"ids"`
+}
+
+func toWatchlistResponse(w watchlist.Entity) WatchlistResponse {
+	items := make([]ItemResponse, 0, len(w.Items))
+	for _, it := range w.Items {
+		items = append(items, toItemResponse(it))
+	}
+	return WatchlistResponse{
+		ID:             w.ID,
+		OrganizationID: w.OrganizationID,
+		Name:           w.Name,
+		Position:       w.Position,
+		Items:          items,
+		CreatedAt:      w.CreatedAt,
+		UpdatedAt:      w.UpdatedAt,
+	}
+}
+
+func toWatchlistResponses(lists []watchlist.Entity) []WatchlistResponse {
+	out := make([]WatchlistResponse, 0, len(lists))
+	for _, w := range lists {
+		out = append(out, toWatchlistResponse(w))
+	}
+	return out
+}
+
+func toItemResponse(it watchlist.Item) ItemResponse {
+	return ItemResponse{
+		ID:       it.ID,
+		Symbol:   it.Symbol,
+		Market:   it.Market,
+		Interval: it.Interval,
+		Position: it.Position,
+	}
+}
@@ -0,0 +1,63 @@
+package watchlistapp
+
+import (
+	"context"
+	"go-ai/internal/market/domain/watchlist"
+
+	"github.com/google/uuid"
+)
+
+type AddItemRequest struct {
+	Symbol   string `json:"symbol"`
+	Market   string `json:"market"`
+	Interval string `json:"interval"`
+}
+
+type AddItemUseCase struct {
+	Repo watchlist.Repository
+}
+
+func NewAddItemUseCase(repo watchlist.Repository) *AddItemUseCase {
+	return &AddItemUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute appends a symbol to the end of the list.
+func (uc *AddItemUseCase) Execute(ctx context.Context, orgID, userID, watchlistID uuid.UUID, req AddItemRequest) (*WatchlistResponse, error) {
+	w, err := uc.Repo.GetByID(ctx, orgID, userID, watchlistID)
+	if err != nil {
+		return nil, err
+	}
+	item, err := watchlist.NewItem(w.ID, req.Symbol, req.Market, req.Interval)
+	if err != nil {
+		return nil, err
+	}
+	if err := w.CanAdd(item); err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.AddItem(ctx, item); err != nil {
+		return nil, err
+	}
+	w.Items = append(w.Items, *item)
+	resp := toWatchlistResponse(*w)
+	return &resp, nil
+}
+
+type RemoveItemUseCase struct {
+	Repo watchlist.Repository
+}
+
+func NewRemoveItemUseCase(repo watchlist.Repository) *RemoveItemUseCase {
+	return &RemoveItemUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *RemoveItemUseCase) Execute(ctx context.Context, orgID, userID, watchlistID, itemID uuid.UUID) error {
+	w, err := uc.Repo.GetByID(ctx, orgID, userID, watchlistID)
+	if err != nil {
+		return err
+	}
+	return uc.Repo.RemoveItem(ctx, w.ID, itemID)
+}
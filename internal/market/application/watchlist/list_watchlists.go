@@ -0,0 +1,45 @@
+package watchlistapp
+
+import (
+	"context"
+	"go-ai/internal/market/domain/watchlist"
+
+	"github.com/google/uuid"
+)
+
+type ListWatchlistsUseCase struct {
+	Repo watchlist.Repository
+}
+
+func NewListWatchlistsUseCase(repo watchlist.Repository) *ListWatchlistsUseCase {
+	return &ListWatchlistsUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *ListWatchlistsUseCase) Execute(ctx context.Context, orgID, userID uuid.UUID) ([]WatchlistResponse, error) {
+	lists, err := uc.Repo.List(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toWatchlistResponses(lists), nil
+}
+
+type GetWatchlistUseCase struct {
+	Repo watchlist.Repository
+}
+
+func NewGetWatchlistUseCase(repo watchlist.Repository) *GetWatchlistUseCase {
+	return &GetWatchlistUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *GetWatchlistUseCase) Execute(ctx context.Context, orgID, userID, id uuid.UUID) (*WatchlistResponse, error) {
+	w, err := uc.Repo.GetByID(ctx, orgID, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := toWatchlistResponse(*w)
+	return &resp, nil
+}
@@ -0,0 +1,139 @@
+package watchlistapp
+
+import (
+	"context"
+	"go-ai/internal/coinai"
+	"go-ai/internal/market/domain/watchlist"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// quoteCandles is enough history for the feature window plus the
+	// still-open candle that gets dropped.
+	quoteCandles = 50
+	// quoteConcurrency bounds the candle requests one quote makes at once.
+	quoteConcurrency = 8
+)
+
+type WatchlistQuotesResponse struct {
+	ID       uuid.UUID   `json:"id"`
+	Name     string      `json:"name"`
+	QuotedAt time.Time   `json:"quoted_at"`
+	Items    []ItemQuote `json:"items"`
+}
+
+// ItemQuote is one item with its latest price and model signal. A failure
+// affects only its own item and is reported in Error.
+type ItemQuote struct {
+	ItemResponse
+	// Price is the close of the latest candle, which may still be open.
+	Price           *float64      `json:"price,omitempty"`
+	CandleCloseTime *time.Time    `json:"candle_close_time,omitempty"`
+	ModelVersion    int           `json:"model_version,omitempty"`
+	PredictedReturn *float64      `json:"predicted_return,omitempty"`
+	Signal          coinai.Signal `json:"signal,omitempty"`
+	Error           string        `json:"error,omitempty"`
+}
+
+type QuoteWatchlistUseCase struct {
+	Repo           watchlist.Repository
+	Fetcher        coinai.CandleFetcher
+	Models         coinai.ModelStore
+	LongThreshold  float64
+	ShortThreshold float64
+	Logger         zerolog.Logger
+}
+
+func NewQuoteWatchlistUseCase(repo watchlist.Repository, fetcher coinai.CandleFetcher, models coinai.ModelStore, logger zerolog.Logger) *QuoteWatchlistUseCase {
+	return &QuoteWatchlistUseCase{
+		Repo:           repo,
+		Fetcher:        fetcher,
+		Models:         models,
+		LongThreshold:  coinai.DefaultLongThreshold,
+		ShortThreshold: coinai.DefaultShortThreshold,
+		Logger:         logger.With().Str("component", "QuoteWatchlistUseCase").Logger(),
+	}
+}
+
+// Execute quotes every item of the list. Coin items get the latest Binance
+// price; the signal comes from the organization's production model for the
+// item's symbol/interval, predicted on the last closed candle.
+func (uc *QuoteWatchlistUseCase) Execute(ctx context.Context, orgID, userID, id uuid.UUID) (*WatchlistQuotesResponse, error) {
+	w, err := uc.Repo.GetByID(ctx, orgID, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	quotes := make([]ItemQuote, len(w.Items))
+	sem := make(chan struct{}, quoteConcurrency)
+	var wg sync.WaitGroup
+	for i, item := range w.Items {
+		wg.Add(1)
+		go func(i int, item watchlist.Item) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			quotes[i] = uc.quote(ctx, orgID, item, now)
+		}(i, item)
+	}
+	wg.Wait()
+
+	return &WatchlistQuotesResponse{
+		ID:       w.ID,
+		Name:     w.Name,
+		QuotedAt: now.UTC(),
+		Items:    quotes,
+	}, nil
+}
+
+func (uc *QuoteWatchlistUseCase) quote(ctx context.Context, orgID uuid.UUID, item watchlist.Item, now time.Time) ItemQuote {
+	q := ItemQuote{ItemResponse: toItemResponse(item)}
+	if item.Market != watchlist.MarketCoin {
+		q.Error = "Live prices are only available for coin markets"
+		return q
+	}
+
+	candles, err := uc.Fetcher.FetchKlines(ctx, item.Symbol, item.Interval, quoteCandles)
+	if err != nil || len(candles) == 0 {
+		uc.Logger.Warn().Err(err).Str("symbol", item.Symbol).Str("interval", item.Interval).Msg("failed to fetch candles")
+		q.Error = "Price unavailable"
+		return q
+	}
+	price := candles[len(candles)-1].Close
+	q.Price = &price
+
+	model, err := uc.Models.Current(ctx, orgID, item.Symbol, item.Interval)
+	if err != nil {
+		uc.Logger.Error().Err(err).Str("symbol", item.Symbol).Str("interval", item.Interval).Msg("failed to load production model")
+		q.Error = "Signal unavailable"
+		return q
+	}
+	if model == nil {
+		q.Error = "No production model"
+		return q
+	}
+
+	closed := coinai.ClosedCandles(candles, now)
+	features, err := coinai.BuildLatestFeatures(closed)
+	if err != nil {
+		q.Error = "Not enough candles for a signal"
+		return q
+	}
+	pred, err := model.Predict(features)
+	if err != nil {
+		uc.Logger.Error().Err(err).Str("symbol", item.Symbol).Str("interval", item.Interval).Msg("failed to predict")
+		q.Error = "Signal unavailable"
+		return q
+	}
+	closeTime := closed[len(closed)-1].CloseTime
+	q.CandleCloseTime = &closeTime
+	q.ModelVersion = model.Version
+	q.PredictedReturn = &pred
+	q.Signal = coinai.SignalFromPrediction(pred, uc.LongThreshold, uc.ShortThreshold)
+	return q
+}
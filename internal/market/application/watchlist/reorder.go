@@ -0,0 +1,71 @@
+package watchlistapp
+
+import (
+	"context"
+	"go-ai/internal/market/domain/watchlist"
+
+	"github.com/google/uuid"
+)
+
+type ReorderWatchlistsUseCase struct {
+	Repo watchlist.Repository
+}
+
+func NewReorderWatchlistsUseCase(repo watchlist.Repository) *ReorderWatchlistsUseCase {
+	return &ReorderWatchlistsUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute reorders the user's lists. req must name every list once.
+func (uc *ReorderWatchlistsUseCase) Execute(ctx context.Context, orgID, userID uuid.UUID, req ReorderRequest) ([]WatchlistResponse, error) {
+	lists, err := uc.Repo.List(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	current := make([]uuid.UUID, 0, len(lists))
+	for _, w := range lists {
+		current = append(current, w.ID)
+	}
+	if err := watchlist.CheckOrder(current, req.IDs); err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.Reorder(ctx, orgID, userID, req.IDs); err != nil {
+		return nil, err
+	}
+	lists, err = uc.Repo.List(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toWatchlistResponses(lists), nil
+}
+
+type ReorderItemsUseCase struct {
+	Repo watchlist.Repository
+}
+
+func NewReorderItemsUseCase(repo watchlist.Repository) *ReorderItemsUseCase {
+	return &ReorderItemsUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute reorders the items of one list. req must name every item once.
+func (uc *ReorderItemsUseCase) Execute(ctx context.Context, orgID, userID, watchlistID uuid.UUID, req ReorderRequest) (*WatchlistResponse, error) {
+	w, err := uc.Repo.GetByID(ctx, orgID, userID, watchlistID)
+	if err != nil {
+		return nil, err
+	}
+	if err := watchlist.CheckOrder(w.ItemIDs(), req.IDs); err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.ReorderItems(ctx, w.ID, req.IDs); err != nil {
+		return nil, err
+	}
+	w, err = uc.Repo.GetByID(ctx, orgID, userID, watchlistID)
+	if err != nil {
+		return nil, err
+	}
+	resp := toWatchlistResponse(*w)
+	return &resp, nil
+}
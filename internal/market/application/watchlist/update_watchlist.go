@@ -0,0 +1,54 @@
+package watchlistapp
+
+import (
+	"context"
+	"go-ai/internal/market/domain/watchlist"
+
+	"github.com/google/uuid"
+)
+
+type UpdateWatchlistRequest struct {
+	Name string `json:"name"`
+}
+
+type UpdateWatchlistUseCase struct {
+	Repo watchlist.Repository
+}
+
+func NewUpdateWatchlistUseCase(repo watchlist.Repository) *UpdateWatchlistUseCase {
+	return &UpdateWatchlistUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute renames a list.
+func (uc *UpdateWatchlistUseCase) Execute(ctx context.Context, orgID, userID, id uuid.UUID, req UpdateWatchlistRequest) (*WatchlistResponse, error) {
+	name, err := watchlist.NormalizeName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.Rename(ctx, orgID, userID, id, name); err != nil {
+		return nil, err
+	}
+	w, err := uc.Repo.GetByID(ctx, orgID, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := toWatchlistResponse(*w)
+	return &resp, nil
+}
+
+type DeleteWatchlistUseCase struct {
+	Repo watchlist.Repository
+}
+
+func NewDeleteWatchlistUseCase(repo watchlist.Repository) *DeleteWatchlistUseCase {
+	return &DeleteWatchlistUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute deletes a list and its items.
+func (uc *DeleteWatchlistUseCase) Execute(ctx context.Context, orgID, userID, id uuid.UUID) error {
+	return uc.Repo.Delete(ctx, orgID, userID, id)
+}
@@ -0,0 +1,42 @@
+package watchlistapp
+
+import (
+	"context"
+	"go-ai/internal/market/domain/watchlist"
+
+	"github.com/google/uuid"
+)
+
+// UserData exports and purges a user's watchlists.
+type UserData struct {
+	Repo watchlist.Repository
+}
+
+func NewUserData(repo watchlist.Repository) *UserData {
+	return &UserData{
+		Repo: repo,
+	}
+}
+
+// ExportUserData returns the user's lists in every organization.
+func (d *UserData) ExportUserData(ctx context.Context, userID uuid.UUID, orgIDs []uuid.UUID) (any, error) {
+	lists, err := d.Repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toWatchlistResponses(lists), nil
+}
+
+// PurgeUserData deletes the user's own lists and every list in the
+// organizations removed along with the account.
+func (d *UserData) PurgeUserData(ctx context.Context, userID uuid.UUID, orgIDs []uuid.UUID) error {
+	if _, err := d.Repo.DeleteByUser(ctx, userID); err != nil {
+		return err
+	}
+	for _, orgID := range orgIDs {
+		if _, err := d.Repo.DeleteByOrganization(ctx, orgID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
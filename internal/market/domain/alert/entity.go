@@ -0,0 +1,363 @@
+package alert
+
+import (
+	"errors"
+	"fmt"
+	"go-ai/internal/coinai"
+	"go-ai/pkg/notify"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+type Kind string
+
+const (
+	// KindPriceCross fires when a candle closes across Condition.Level.
+	KindPriceCross Kind = "price_cross"
+	// KindPercentMove fires when the close moved by Condition.Percent over
+	// the last Condition.Window candles.
+	KindPercentMove Kind = "percent_move"
+	// KindSignalChange fires when the production model's signal flips.
+	KindSignalChange Kind = "signal_change"
+)
+
+const (
+	DirectionAbove = "above"
+	DirectionBelow = "below"
+	DirectionUp    = "up"
+	DirectionDown  = "down"
+	DirectionAny   = "any"
+)
+
+const (
+	MaxNameLength = 100
+	// MaxPerOwner caps the alerts one user keeps in one organization.
+	MaxPerOwner = 100
+	// MaxWindow leaves room in the candles the evaluator fetches.
+	MaxWindow   = 96
+	MaxCooldown = 7 * 24 * time.Hour
+)
+
+// Condition holds the parameters of one alert kind; the others stay zero.
+type Condition struct {
+	Level     float64       `json:"level,omitempty"`
+	Direction string        `json:"direction,omitempty"`
+	Percent   float64       `json:"percent,omitempty"`
+	Window    int           `json:"window,omitempty"`
+	Signal    coinai.Signal `json:"signal,omitempty"`
+}
+
+// Entity is a rule over one coin symbol/interval, owned by one user in one
+// organization. The Last* fields are evaluator state: the newest candle
+// already looked at, the model signal seen then, and the last time the
+// alert fired.
+type Entity struct {
+	ID              uuid.UUID
+	OrganizationID  uuid.UUID
+	UserID          uuid.UUID
+	Name            string
+	Symbol          string
+	Interval        string
+	Kind            Kind
+	Condition       Condition
+	Channels        []string
+	WebhookURL      string
+	Cooldown        time.Duration
+	Enabled         bool
+	LastCandleAt    *time.Time
+	LastSignal      coinai.Signal
+	LastTriggeredAt *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Event is one firing of an alert, with the outcome of every delivery.
+type Event struct {
+	ID              uuid.UUID
+	AlertID         uuid.UUID
+	OrganizationID  uuid.UUID
+	UserID          uuid.UUID
+	Kind            Kind
+	Symbol          string
+	Interval        string
+	Message         string
+	Price           float64
+	Details         map[string]any
+	Deliveries      []notify.Delivery
+	CandleCloseTime time.Time
+	TriggeredAt     time.Time
+}
+
+// Trigger describes why an alert fired.
+type Trigger struct {
+	Price           float64
+	CandleCloseTime time.Time
+	Message         string
+	Details         map[string]any
+}
+
+// NewAlert validates a new alert. An empty name is derived from the rule;
+// no channels means the in-app feed only.
+func NewAlert(orgID, userID uuid.UUID, name, symbol, interval string, kind Kind, cond Condition, channels []string, webhookURL string, cooldown time.Duration) (*Entity, error) {
+	if orgID == uuid.Nil {
+		return nil, ErrOrganizationRequired
+	}
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	if symbol == "" {
+		return nil, ErrSymbolRequired
+	}
+	interval = strings.TrimSpace(interval)
+	if !coinai.IsBinanceInterval(interval) {
+		return nil, ErrInvalidInterval
+	}
+	cond, err := normalizeCondition(kind, cond)
+	if err != nil {
+		return nil, err
+	}
+	a := &Entity{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		UserID:         userID,
+		Symbol:         symbol,
+		Interval:       interval,
+		Kind:           kind,
+		Condition:      cond,
+		Enabled:        true,
+	}
+	if err := a.Rename(name); err != nil {
+		return nil, err
+	}
+	if err := a.SetDelivery(channels, webhookURL); err != nil {
+		return nil, err
+	}
+	if err := a.SetCooldown(cooldown); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func normalizeCondition(kind Kind, c Condition) (Condition, error) {
+	c.Direction = strings.ToLower(strings.TrimSpace(c.Direction))
+	if c.Direction == "" {
+		c.Direction = DirectionAny
+	}
+	switch kind {
+	case KindPriceCross:
+		if c.Level <= 0 {
+			return c, ErrInvalidLevel
+		}
+		if c.Direction != DirectionAbove && c.Direction != DirectionBelow && c.Direction != DirectionAny {
+			return c, ErrInvalidDirection
+		}
+		return Condition{Level: c.Level, Direction: c.Direction}, nil
+	case KindPercentMove:
+		if c.Percent <= 0 || c.Percent > 1000 {
+			return c, ErrInvalidPercent
+		}
+		if c.Window < 1 || c.Window > MaxWindow {
+			return c, ErrInvalidWindow
+		}
+		if c.Direction != DirectionUp && c.Direction != DirectionDown && c.Direction != DirectionAny {
+			return c, ErrInvalidDirection
+		}
+		return Condition{Percent: c.Percent, Window: c.Window, Direction: c.Direction}, nil
+	case KindSignalChange:
+		signal := coinai.Signal(strings.ToUpper(strings.TrimSpace(string(c.Signal))))
+		switch signal {
+		case "", coinai.SignalBuy, coinai.SignalSell, coinai.SignalHold:
+		default:
+			return c, ErrInvalidSignal
+		}
+		return Condition{Signal: signal}, nil
+	}
+	return c, ErrInvalidKind
+}
+
+func (a *Entity) Rename(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = a.describe()
+	}
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		return ErrNameTooLong
+	}
+	a.Name = name
+	return nil
+}
+
+// SetDelivery validates the channels and the webhook URL they need. The URL
+// must be outside the server's own network.
+func (a *Entity) SetDelivery(channels []string, webhookURL string) error {
+	if len(channels) == 0 {
+		channels = []string{notify.ChannelInApp}
+	}
+	out := make([]string, 0, len(channels))
+	for _, ch := range channels {
+		ch = strings.ToLower(strings.TrimSpace(ch))
+		switch ch {
+		case notify.ChannelInApp, notify.ChannelEmail, notify.ChannelWebhook:
+		default:
+			return ErrInvalidChannel
+		}
+		if !slices.Contains(out, ch) {
+			out = append(out, ch)
+		}
+	}
+	webhookURL = strings.TrimSpace(webhookURL)
+	if slices.Contains(out, notify.ChannelWebhook) && webhookURL == "" {
+		return ErrWebhookURLRequired
+	}
+	if webhookURL != "" {
+		if err := notify.CheckWebhookURL(webhookURL); err != nil {
+			if errors.Is(err, notify.ErrWebhookURLPrivate) {
+				return ErrPrivateWebhookURL
+			}
+			return ErrInvalidWebhookURL
+		}
+	}
+	a.Channels = out
+	a.WebhookURL = webhookURL
+	return nil
+}
+
+func (a *Entity) SetCooldown(d time.Duration) error {
+	if d < 0 || d > MaxCooldown {
+		return ErrInvalidCooldown
+	}
+	a.Cooldown = d
+	return nil
+}
+
+// SetEnabled switches evaluation on or off. Re-enabling drops the evaluator
+// state so the alert does not fire on candles from while it was off.
+func (a *Entity) SetEnabled(enabled bool) {
+	if enabled && !a.Enabled {
+		a.LastCandleAt = nil
+		a.LastSignal = ""
+	}
+	a.Enabled = enabled
+}
+
+// NeedsSignal reports whether evaluating the alert needs a model prediction.
+func (a *Entity) NeedsSignal() bool {
+	return a.Kind == KindSignalChange
+}
+
+// Evaluate checks the alert against closed candles, oldest first, and the
+// model signal on the newest one. Each candle is looked at once: the first
+// call only records a baseline, and later calls return nil until a newer
+// candle closes. A match inside the cooldown is dropped. Evaluate updates
+// the Last* state, which the caller persists whether or not it fired.
+func (a *Entity) Evaluate(candles []coinai.Candle, signal coinai.Signal, now time.Time) *Trigger {
+	if len(candles) == 0 {
+		return nil
+	}
+	latest := candles[len(candles)-1]
+	if a.LastCandleAt != nil && !latest.CloseTime.After(*a.LastCandleAt) {
+		return nil
+	}
+	baseline := a.LastCandleAt == nil
+	previousSignal := a.LastSignal
+
+	closeTime := latest.CloseTime
+	a.LastCandleAt = &closeTime
+	if signal != "" {
+		a.LastSignal = signal
+	}
+	if baseline {
+		return nil
+	}
+
+	t := a.match(candles, previousSignal, signal)
+	if t == nil {
+		return nil
+	}
+	if a.LastTriggeredAt != nil && now.Sub(*a.LastTriggeredAt) < a.Cooldown {
+		return nil
+	}
+	triggered := now
+	a.LastTriggeredAt = &triggered
+	t.Price = latest.Close
+	t.CandleCloseTime = latest.CloseTime
+	return t
+}
+
+func (a *Entity) match(candles []coinai.Candle, previousSignal, signal coinai.Signal) *Trigger {
+	last := len(candles) - 1
+	price := candles[last].Close
+	c := a.Condition
+
+	switch a.Kind {
+	case KindPriceCross:
+		if last < 1 {
+			return nil
+		}
+		prev := candles[last-1].Close
+		up := prev < c.Level && price >= c.Level
+		down := prev > c.Level && price <= c.Level
+		if (up && c.Direction != DirectionBelow) || (down && c.Direction != DirectionAbove) {
+			dir := DirectionAbove
+			if down {
+				dir = DirectionBelow
+			}
+			return &Trigger{
+				Message: fmt.Sprintf("%s %s closed at %s, crossing %s %s", a.Symbol, a.Interval, formatPrice(price), dir, formatPrice(c.Level)),
+				Details: map[string]any{"level": c.Level, "direction": dir, "previous_close": prev},
+			}
+		}
+	case KindPercentMove:
+		if last < c.Window {
+			return nil
+		}
+		base := candles[last-c.Window].Close
+		if base == 0 {
+			return nil
+		}
+		change := (price - base) / base * 100
+		hit := (change >= c.Percent && c.Direction != DirectionDown) ||
+			(change <= -c.Percent && c.Direction != DirectionUp)
+		if hit {
+			return &Trigger{
+				Message: fmt.Sprintf("%s %s moved %+.2f%% over %d candles to %s", a.Symbol, a.Interval, change, c.Window, formatPrice(price)),
+				Details: map[string]any{"percent": change, "window": c.Window, "base_close": base},
+			}
+		}
+	case KindSignalChange:
+		if previousSignal == "" || signal == "" || signal == previousSignal {
+			return nil
+		}
+		if c.Signal != "" && signal != c.Signal {
+			return nil
+		}
+		return &Trigger{
+			Message: fmt.Sprintf("%s %s signal changed from %s to %s", a.Symbol, a.Interval, previousSignal, signal),
+			Details: map[string]any{"previous_signal": previousSignal, "signal": signal},
+		}
+	}
+	return nil
+}
+
+// describe names an alert after its rule, e.g. "BTCUSDT 1h crosses above 65000".
+func (a *Entity) describe() string {
+	c := a.Condition
+	switch a.Kind {
+	case KindPriceCross:
+		return fmt.Sprintf("%s %s crosses %s %s", a.Symbol, a.Interval, c.Direction, formatPrice(c.Level))
+	case KindPercentMove:
+		return fmt.Sprintf("%s %s moves %s %g%% in %d candles", a.Symbol, a.Interval, c.Direction, c.Percent, c.Window)
+	case KindSignalChange:
+		if c.Signal != "" {
+			return fmt.Sprintf("%s %s signal turns %s", a.Symbol, a.Interval, c.Signal)
+		}
+		return fmt.Sprintf("%s %s signal changes", a.Symbol, a.Interval)
+	}
+	return a.Symbol
+}
+
+func formatPrice(v float64) string {
+	return fmt.Sprintf("%g", v)
+}
@@ -0,0 +1,183 @@
+package alert
+
+import (
+	"errors"
+	"go-ai/internal/coinai"
+	"go-ai/pkg/notify"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var t0 = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// candles returns hourly closed candles with the given closes, the last one
+// closing at end.
+func candles(end time.Time, closes ...float64) []coinai.Candle {
+	out := make([]coinai.Candle, len(closes))
+	for i, c := range closes {
+		closeTime := end.Add(time.Duration(i-len(closes)+1) * time.Hour)
+		out[i] = coinai.Candle{OpenTime: closeTime.Add(-time.Hour), CloseTime: closeTime, Close: c}
+	}
+	return out
+}
+
+func newAlert(t *testing.T, kind Kind, cond Condition) *Entity {
+	t.Helper()
+	a, err := NewAlert(uuid.New(), uuid.New(), "", "btcusdt", "1h", kind, cond, nil, "", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func TestNewAlertDefaults(t *testing.T) {
+	a := newAlert(t, KindPriceCross, Condition{Level: 65000, Direction: "ABOVE"})
+	if a.Symbol != "BTCUSDT" || !a.Enabled {
+		t.Fatalf("alert = %+v", a)
+	}
+	if a.Name != "BTCUSDT 1h crosses above 65000" {
+		t.Fatalf("Name = %q", a.Name)
+	}
+	if len(a.Channels) != 1 || a.Channels[0] != notify.ChannelInApp {
+		t.Fatalf("Channels = %v", a.Channels)
+	}
+}
+
+func TestNewAlertValidation(t *testing.T) {
+	org, user := uuid.New(), uuid.New()
+	cases := []struct {
+		name     string
+		interval string
+		kind     Kind
+		cond     Condition
+		channels []string
+		webhook  string
+		cooldown time.Duration
+		want     error
+	}{
+		{"interval", "7m", KindPriceCross, Condition{Level: 1}, nil, "", 0, ErrInvalidInterval},
+		{"kind", "1h", "volume", Condition{}, nil, "", 0, ErrInvalidKind},
+		{"level", "1h", KindPriceCross, Condition{}, nil, "", 0, ErrInvalidLevel},
+		{"cross direction", "1h", KindPriceCross, Condition{Level: 1, Direction: "up"}, nil, "", 0, ErrInvalidDirection},
+		{"percent", "1h", KindPercentMove, Condition{Percent: 0, Window: 3}, nil, "", 0, ErrInvalidPercent},
+		{"window", "1h", KindPercentMove, Condition{Percent: 5, Window: MaxWindow + 1}, nil, "", 0, ErrInvalidWindow},
+		{"move direction", "1h", KindPercentMove, Condition{Percent: 5, Window: 3, Direction: "above"}, nil, "", 0, ErrInvalidDirection},
+		{"signal", "1h", KindSignalChange, Condition{Signal: "STRONG_BUY"}, nil, "", 0, ErrInvalidSignal},
+		{"channel", "1h", KindSignalChange, Condition{}, []string{"sms"}, "", 0, ErrInvalidChannel},
+		{"webhook missing", "1h", KindSignalChange, Condition{}, []string{"webhook"}, "", 0, ErrWebhookURLRequired},
+		{"webhook scheme", "1h", KindSignalChange, Condition{}, []string{"webhook"}, "ftp://example.com", 0, ErrInvalidWebhookURL},
+		{"webhook loopback", "1h", KindSignalChange, Condition{}, []string{"webhook"}, "http://127.0.0.1:8080/hook", 0, ErrPrivateWebhookURL},
+		{"webhook metadata", "1h", KindSignalChange, Condition{}, []string{"webhook"}, "http://169.254.169.254/latest/meta-data/", 0, ErrPrivateWebhookURL},
+		{"cooldown", "1h", KindSignalChange, Condition{}, nil, "", -time.Second, ErrInvalidCooldown},
+	}
+	for _, c := range cases {
+		_, err := NewAlert(org, user, "", "BTCUSDT", c.interval, c.kind, c.cond, c.channels, c.webhook, c.cooldown)
+		if !errors.Is(err, c.want) {
+			t.Fatalf("%s: err = %v, want %v", c.name, err, c.want)
+		}
+	}
+	if _, err := NewAlert(uuid.Nil, user, "", "BTCUSDT", "1h", KindSignalChange, Condition{}, nil, "", 0); !errors.Is(err, ErrOrganizationRequired) {
+		t.Fatalf("err = %v, want ErrOrganizationRequired", err)
+	}
+}
+
+func TestEvaluateRecordsBaselineFirst(t *testing.T) {
+	a := newAlert(t, KindPriceCross, Condition{Level: 100})
+	if got := a.Evaluate(candles(t0, 90, 110), "", t0); got != nil {
+		t.Fatalf("baseline fired: %+v", got)
+	}
+	if a.LastCandleAt == nil || !a.LastCandleAt.Equal(t0) {
+		t.Fatalf("LastCandleAt = %v", a.LastCandleAt)
+	}
+	// The same candle again is ignored.
+	if got := a.Evaluate(candles(t0, 90, 110), "", t0); got != nil {
+		t.Fatalf("same candle fired: %+v", got)
+	}
+}
+
+func TestEvaluatePriceCross(t *testing.T) {
+	a := newAlert(t, KindPriceCross, Condition{Level: 100, Direction: DirectionAbove})
+	a.Evaluate(candles(t0, 90, 95), "", t0)
+
+	t1 := t0.Add(time.Hour)
+	got := a.Evaluate(candles(t1, 95, 101), "", t1)
+	if got == nil {
+		t.Fatal("cross above did not fire")
+	}
+	if got.Price != 101 || !got.CandleCloseTime.Equal(t1) {
+		t.Fatalf("trigger = %+v", got)
+	}
+
+	// Crossing back below does not match an "above" alert.
+	t2 := t1.Add(2 * time.Hour)
+	if got := a.Evaluate(candles(t2, 101, 99), "", t2); got != nil {
+		t.Fatalf("cross below fired: %+v", got)
+	}
+}
+
+func TestEvaluatePercentMove(t *testing.T) {
+	a := newAlert(t, KindPercentMove, Condition{Percent: 5, Window: 2, Direction: DirectionDown})
+	a.Evaluate(candles(t0, 100, 100, 100), "", t0)
+
+	t1 := t0.Add(time.Hour)
+	if got := a.Evaluate(candles(t1, 100, 100, 106), "", t1); got != nil {
+		t.Fatalf("rise fired a down alert: %+v", got)
+	}
+	t2 := t1.Add(time.Hour)
+	got := a.Evaluate(candles(t2, 100, 106, 94), "", t2)
+	if got == nil {
+		t.Fatal("6% drop did not fire")
+	}
+	if got.Details["percent"].(float64) != -6 {
+		t.Fatalf("details = %v", got.Details)
+	}
+}
+
+func TestEvaluateSignalChange(t *testing.T) {
+	a := newAlert(t, KindSignalChange, Condition{Signal: coinai.SignalBuy})
+	a.Evaluate(candles(t0, 1), coinai.SignalHold, t0)
+
+	t1 := t0.Add(time.Hour)
+	if got := a.Evaluate(candles(t1, 1), coinai.SignalSell, t1); got != nil {
+		t.Fatalf("flip to SELL fired a BUY alert: %+v", got)
+	}
+	t2 := t1.Add(time.Hour)
+	got := a.Evaluate(candles(t2, 1), coinai.SignalBuy, t2)
+	if got == nil {
+		t.Fatal("flip to BUY did not fire")
+	}
+	if got.Message != "BTCUSDT 1h signal changed from SELL to BUY" {
+		t.Fatalf("Message = %q", got.Message)
+	}
+}
+
+func TestEvaluateCooldown(t *testing.T) {
+	a := newAlert(t, KindSignalChange, Condition{})
+	a.Evaluate(candles(t0, 1), coinai.SignalHold, t0)
+
+	t1 := t0.Add(time.Hour)
+	if a.Evaluate(candles(t1, 1), coinai.SignalBuy, t1) == nil {
+		t.Fatal("first flip did not fire")
+	}
+	// Inside the one hour cooldown.
+	t2 := t1.Add(30 * time.Minute)
+	if got := a.Evaluate(candles(t2, 1), coinai.SignalSell, t2); got != nil {
+		t.Fatalf("fired inside cooldown: %+v", got)
+	}
+	t3 := t1.Add(time.Hour)
+	if a.Evaluate(candles(t3, 1), coinai.SignalBuy, t3) == nil {
+		t.Fatal("flip after cooldown did not fire")
+	}
+}
+
+func TestSetEnabledResetsState(t *testing.T) {
+	a := newAlert(t, KindSignalChange, Condition{})
+	a.Evaluate(candles(t0, 1), coinai.SignalHold, t0)
+	a.SetEnabled(false)
+	a.SetEnabled(true)
+	if a.LastCandleAt != nil || a.LastSignal != "" {
+		t.Fatalf("state kept: %v %q", a.LastCandleAt, a.LastSignal)
+	}
+}
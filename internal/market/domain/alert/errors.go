@@ -0,0 +1,26 @@
+package alert
+
+import (
+	domainerr "go-ai/pkg/domain_err"
+	"net/http"
+)
+
+var (
+	ErrAlertNotFound        = domainerr.New(http.StatusNotFound, "Alert not found")
+	ErrOrganizationRequired = domainerr.New(http.StatusBadRequest, "Alert must belong to an organization")
+	ErrNameTooLong          = domainerr.New(http.StatusBadRequest, "Alert name is too long")
+	ErrSymbolRequired       = domainerr.New(http.StatusBadRequest, "Symbol is required")
+	ErrInvalidInterval      = domainerr.New(http.StatusBadRequest, "Unsupported interval")
+	ErrInvalidKind          = domainerr.New(http.StatusBadRequest, "Alert kind must be price_cross, percent_move or signal_change")
+	ErrInvalidLevel         = domainerr.New(http.StatusBadRequest, "Price level must be positive")
+	ErrInvalidDirection     = domainerr.New(http.StatusBadRequest, "Invalid direction for this alert kind")
+	ErrInvalidPercent       = domainerr.New(http.StatusBadRequest, "Percent must be between 0 and 1000")
+	ErrInvalidWindow        = domainerr.New(http.StatusBadRequest, "Window must be between 1 and 96 candles")
+	ErrInvalidSignal        = domainerr.New(http.StatusBadRequest, "Signal must be BUY, SELL or HOLD")
+	ErrInvalidChannel       = domainerr.New(http.StatusBadRequest, "Channels must be in_app, email or webhook")
+	ErrWebhookURLRequired   = domainerr.New(http.StatusBadRequest, "A webhook URL is required for the webhook channel")
+	ErrInvalidWebhookURL    = domainerr.New(http.StatusBadRequest, "Webhook URL must be an absolute http or https URL")
+	ErrPrivateWebhookURL    = domainerr.New(http.StatusBadRequest, "Webhook URL must point to a public host")
+	ErrInvalidCooldown      = domainerr.New(http.StatusBadRequest, "Cooldown must be between 0 and 7 days")
+	ErrTooManyAlerts        = domainerr.New(http.StatusBadRequest, "Alert limit reached")
+)
@@ -0,0 +1,34 @@
+package alert
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository methods that take an organization and user return
+// ErrAlertNotFound for alerts owned by anyone else.
+type Repository interface {
+	Create(ctx context.Context, a *Entity) error
+	CountByOwner(ctx context.Context, orgID, userID uuid.UUID) (int64, error)
+	GetByID(ctx context.Context, orgID, userID, id uuid.UUID) (*Entity, error)
+	List(ctx context.Context, orgID, userID uuid.UUID) ([]Entity, error)
+	// ListByUser returns the user's alerts across every organization.
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]Entity, error)
+	// ListEnabled returns enabled alerts of every organization.
+	ListEnabled(ctx context.Context) ([]Entity, error)
+	// Update saves the name, delivery, cooldown and enabled flag, and the
+	// evaluator state.
+	Update(ctx context.Context, a *Entity) error
+	// SaveState saves only the evaluator state.
+	SaveState(ctx context.Context, a *Entity) error
+	Delete(ctx context.Context, orgID, userID, id uuid.UUID) error
+	DeleteByOrganization(ctx context.Context, orgID uuid.UUID) (int64, error)
+	DeleteByUser(ctx context.Context, userID uuid.UUID) (int64, error)
+
+	CreateEvent(ctx context.Context, e *Event) error
+	// ListEvents returns an alert's history, newest first, and the total count.
+	ListEvents(ctx context.Context, alertID uuid.UUID, limit, offset int32) ([]Event, int64, error)
+	// ListEventsByUser returns the user's history across every organization.
+	ListEventsByUser(ctx context.Context, userID uuid.UUID, limit, offset int32) ([]Event, int64, error)
+}
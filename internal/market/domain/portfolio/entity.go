@@ -0,0 +1,200 @@
+package portfolio
+
+import (
+	"go-ai/internal/coinai"
+	"go-ai/pkg/helpers"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MarketCoin  = "coin"
+	MarketStock = "stock"
+
+	SideBuy  = "buy"
+	SideSell = "sell"
+
+	// SourceManual trades are entered by hand; SourceSignal trades follow the
+	// production model's signal at the latest close.
+	SourceManual = "manual"
+	SourceSignal = "signal"
+
+	DefaultCurrency = "USDT"
+	MaxNameLength   = 100
+	MaxNoteLength   = 500
+	// MaxPerOwner caps the portfolios one user keeps in one organization.
+	MaxPerOwner = 20
+	// MaxTrades caps the journal of one portfolio, which is replayed in full
+	// to compute positions.
+	MaxTrades = 5000
+)
+
+// maxAmount bounds quantities, prices and fees so sums stay far inside the
+// NUMERIC(38,8) columns.
+var maxAmount = helpers.NewDecimal(1_000_000_000_000)
+
+var currencyRegex = regexp.MustCompile(`^[A-Z0-9]{2,10}$`)
+
+// Entity is a virtual portfolio of one user in one organization. It holds no
+// cash; positions and PnL are derived from its trade journal.
+type Entity struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	Name           string
+	// Currency labels the quote currency of every price in the portfolio.
+	Currency  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Trade is one journal entry. Amounts are exact decimals in the portfolio
+// currency; Fee is the total fee of the trade.
+type Trade struct {
+	ID          uuid.UUID
+	PortfolioID uuid.UUID
+	Symbol      string
+	Market      string
+	Side        string
+	Quantity    helpers.Decimal
+	Price       helpers.Decimal
+	Fee         helpers.Decimal
+	ExecutedAt  time.Time
+	Source      string
+	// Signal, Interval and ModelVersion record the prediction a
+	// SourceSignal trade followed.
+	Signal       coinai.Signal
+	Interval     string
+	ModelVersion int
+	Note         string
+	CreatedAt    time.Time
+}
+
+func NewPortfolio(orgID, userID uuid.UUID, name, currency string) (*Entity, error) {
+	if orgID == uuid.Nil {
+		return nil, ErrOrganizationRequired
+	}
+	name, err := NormalizeName(name)
+	if err != nil {
+		return nil, err
+	}
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+	if !currencyRegex.MatchString(currency) {
+		return nil, ErrInvalidCurrency
+	}
+	return &Entity{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		UserID:         userID,
+		Name:           name,
+		Currency:       currency,
+	}, nil
+}
+
+func NormalizeName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", ErrNameRequired
+	}
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		return "", ErrNameTooLong
+	}
+	return name, nil
+}
+
+// NewTrade validates a manual trade. A zero executedAt means now.
+func NewTrade(portfolioID uuid.UUID, symbol, market, side string, quantity, price, fee helpers.Decimal, executedAt time.Time, note string, now time.Time) (*Trade, error) {
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	if symbol == "" {
+		return nil, ErrSymbolRequired
+	}
+	market = strings.ToLower(strings.TrimSpace(market))
+	if market == "" {
+		market = MarketCoin
+	}
+	if market != MarketCoin && market != MarketStock {
+		return nil, ErrInvalidMarket
+	}
+	side = strings.ToLower(strings.TrimSpace(side))
+	if side != SideBuy && side != SideSell {
+		return nil, ErrInvalidSide
+	}
+	if quantity.Sign() <= 0 {
+		return nil, ErrInvalidQuantity
+	}
+	if price.Sign() <= 0 {
+		return nil, ErrInvalidPrice
+	}
+	if fee.Sign() < 0 {
+		return nil, ErrInvalidFee
+	}
+	if quantity.Cmp(maxAmount) > 0 || price.Cmp(maxAmount) > 0 || fee.Cmp(maxAmount) > 0 {
+		return nil, ErrAmountTooLarge
+	}
+	if executedAt.IsZero() {
+		executedAt = now
+	}
+	// A minute of slack absorbs clock skew between client and server.
+	if executedAt.After(now.Add(time.Minute)) {
+		return nil, ErrExecutedInFuture
+	}
+	note = strings.TrimSpace(note)
+	if utf8.RuneCountInString(note) > MaxNoteLength {
+		return nil, ErrNoteTooLong
+	}
+	return &Trade{
+		ID:          uuid.New(),
+		PortfolioID: portfolioID,
+		Symbol:      symbol,
+		Market:      market,
+		Side:        side,
+		Quantity:    quantity,
+		Price:       price,
+		Fee:         fee,
+		ExecutedAt:  executedAt.UTC(),
+		Source:      SourceManual,
+		Note:        note,
+	}, nil
+}
+
+// NewSignalTrade records a coin trade following a model signal: BUY buys and
+// SELL sells at price, the close the prediction was made on.
+func NewSignalTrade(portfolioID uuid.UUID, symbol, interval string, signal coinai.Signal, modelVersion int, quantity, price, fee helpers.Decimal, now time.Time) (*Trade, error) {
+	var side string
+	switch signal {
+	case coinai.SignalBuy:
+		side = SideBuy
+	case coinai.SignalSell:
+		side = SideSell
+	default:
+		return nil, ErrSignalHold
+	}
+	t, err := NewTrade(portfolioID, symbol, MarketCoin, side, quantity, price, fee, now, "", now)
+	if err != nil {
+		return nil, err
+	}
+	t.Source = SourceSignal
+	t.Signal = signal
+	t.Interval = interval
+	t.ModelVersion = modelVersion
+	return t, nil
+}
+
+// SortTrades orders trades the way they are replayed: by execution time,
+// then by entry time.
+func SortTrades(trades []Trade) {
+	sort.SliceStable(trades, func(i, j int) bool {
+		if !trades[i].ExecutedAt.Equal(trades[j].ExecutedAt) {
+			return trades[i].ExecutedAt.Before(trades[j].ExecutedAt)
+		}
+		return trades[i].CreatedAt.Before(trades[j].CreatedAt)
+	})
+}
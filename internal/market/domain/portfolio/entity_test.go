@@ -0,0 +1,167 @@
+package portfolio
+
+import (
+	"errors"
+	"go-ai/internal/coinai"
+	"go-ai/pkg/helpers"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func dec(t *testing.T, s string) helpers.Decimal {
+	t.Helper()
+	d, err := helpers.ParseDecimal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func trade(t *testing.T, side, symbol, qty, price, fee string, at time.Time) Trade {
+	t.Helper()
+	tr, err := NewTrade(uuid.Nil, symbol, MarketCoin, side, dec(t, qty), dec(t, price), dec(t, fee), at, "", at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *tr
+}
+
+func TestNewPortfolio(t *testing.T) {
+	p, err := NewPortfolio(uuid.New(), uuid.New(), " Swing ", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "Swing" || p.Currency != DefaultCurrency {
+		t.Fatalf("portfolio = %+v", p)
+	}
+	if _, err := NewPortfolio(uuid.New(), uuid.New(), "Swing", "us dollar"); !errors.Is(err, ErrInvalidCurrency) {
+		t.Fatalf("err = %v, want ErrInvalidCurrency", err)
+	}
+	if _, err := NewPortfolio(uuid.Nil, uuid.New(), "Swing", ""); !errors.Is(err, ErrOrganizationRequired) {
+		t.Fatalf("err = %v, want ErrOrganizationRequired", err)
+	}
+}
+
+func TestNewTradeValidation(t *testing.T) {
+	now := time.Now()
+	one := helpers.NewDecimal(1)
+	cases := []struct {
+		side       string
+		qty, price helpers.Decimal
+		fee        helpers.Decimal
+		at         time.Time
+		want       error
+	}{
+		{"hold", one, one, helpers.Decimal{}, now, ErrInvalidSide},
+		{"buy", helpers.Decimal{}, one, helpers.Decimal{}, now, ErrInvalidQuantity},
+		{"buy", one, one.Neg(), helpers.Decimal{}, now, ErrInvalidPrice},
+		{"buy", one, one, one.Neg(), now, ErrInvalidFee},
+		{"buy", helpers.NewDecimal(2_000_000_000_000), one, helpers.Decimal{}, now, ErrAmountTooLarge},
+		{"buy", one, one, helpers.Decimal{}, now.Add(time.Hour), ErrExecutedInFuture},
+	}
+	for _, c := range cases {
+		if _, err := NewTrade(uuid.Nil, "BTCUSDT", "", c.side, c.qty, c.price, c.fee, c.at, "", now); !errors.Is(err, c.want) {
+			t.Errorf("err = %v, want %v", err, c.want)
+		}
+	}
+
+	tr, err := NewTrade(uuid.Nil, " ethusdt ", "", "BUY", one, one, helpers.Decimal{}, time.Time{}, "", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tr.Symbol != "ETHUSDT" || tr.Market != MarketCoin || tr.Side != SideBuy || !tr.ExecutedAt.Equal(now) {
+		t.Fatalf("trade = %+v", tr)
+	}
+}
+
+func TestNewSignalTrade(t *testing.T) {
+	now := time.Now()
+	one := helpers.NewDecimal(1)
+	tr, err := NewSignalTrade(uuid.Nil, "BTCUSDT", "1h", coinai.SignalSell, 3, one, one, helpers.Decimal{}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tr.Side != SideSell || tr.Source != SourceSignal || tr.ModelVersion != 3 || tr.Interval != "1h" {
+		t.Fatalf("trade = %+v", tr)
+	}
+	if _, err := NewSignalTrade(uuid.Nil, "BTCUSDT", "1h", coinai.SignalHold, 3, one, one, helpers.Decimal{}, now); !errors.Is(err, ErrSignalHold) {
+		t.Fatalf("err = %v, want ErrSignalHold", err)
+	}
+}
+
+func TestBuildPositionsAverageCost(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	trades := []Trade{
+		// Entered out of order; replay follows execution time.
+		trade(t, SideSell, "BTCUSDT", "1.5", "130", "1.5", t0.Add(3*time.Hour)),
+		trade(t, SideBuy, "BTCUSDT", "1", "100", "1", t0),
+		trade(t, SideBuy, "BTCUSDT", "1", "120", "1", t0.Add(time.Hour)),
+		trade(t, SideBuy, "ETHUSDT", "2", "10", "0", t0.Add(2*time.Hour)),
+	}
+	positions, err := BuildPositions(trades, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(positions) != 2 || positions[0].Symbol != "BTCUSDT" {
+		t.Fatalf("positions = %+v", positions)
+	}
+	btc := positions[0]
+	// Cost 222 for 2 units; selling 1.5 removes 166.5 and realises
+	// 195 - 1.5 - 166.5 = 27.
+	checks := map[string][2]helpers.Decimal{
+		"quantity":     {btc.Quantity, dec(t, "0.5")},
+		"cost basis":   {btc.CostBasis, dec(t, "55.5")},
+		"average cost": {btc.AverageCost(), dec(t, "111")},
+		"realised":     {btc.RealizedPnL, dec(t, "27")},
+		"fees":         {btc.Fees, dec(t, "3.5")},
+	}
+	for name, c := range checks {
+		if c[0].Cmp(c[1]) != 0 {
+			t.Errorf("%s = %s, want %s", name, c[0], c[1])
+		}
+	}
+
+	early, err := BuildPositions(trades, t0.Add(90*time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(early) != 1 || early[0].Quantity.Cmp(helpers.NewDecimal(2)) != 0 {
+		t.Fatalf("positions until 01:30 = %+v", early)
+	}
+}
+
+func TestBuildPositionsOversold(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	trades := []Trade{
+		trade(t, SideBuy, "BTCUSDT", "1", "100", "0", t0.Add(time.Hour)),
+		trade(t, SideSell, "BTCUSDT", "1", "110", "0", t0),
+	}
+	if _, err := BuildPositions(trades, time.Time{}); !errors.Is(err, ErrOversold) {
+		t.Fatalf("err = %v, want ErrOversold", err)
+	}
+}
+
+func TestValue(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	trades := []Trade{
+		trade(t, SideBuy, "BTCUSDT", "2", "100", "0", t0),
+		trade(t, SideSell, "BTCUSDT", "1", "150", "0", t0.Add(time.Hour)),
+		trade(t, SideBuy, "ETHUSDT", "1", "10", "0", t0),
+	}
+	positions, err := BuildPositions(trades, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := Value(positions, map[string]helpers.Decimal{
+		PositionKey(MarketCoin, "BTCUSDT"): dec(t, "90"),
+	})
+	if v.Unpriced != 1 {
+		t.Fatalf("Unpriced = %d, want 1", v.Unpriced)
+	}
+	// BTC: realised 50, unrealised 90 - 100 = -10. ETH is valued at cost.
+	if v.MarketValue.Cmp(dec(t, "100")) != 0 || v.UnrealizedPnL.Cmp(dec(t, "-10")) != 0 || v.TotalPnL.Cmp(dec(t, "40")) != 0 {
+		t.Fatalf("valuation = %+v", v)
+	}
+}
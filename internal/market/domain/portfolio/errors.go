@@ -0,0 +1,32 @@
+package portfolio
+
+import (
+	domainerr "go-ai/pkg/domain_err"
+	"net/http"
+)
+
+var (
+	ErrPortfolioNotFound    = domainerr.New(http.StatusNotFound, "Portfolio not found")
+	ErrTradeNotFound        = domainerr.New(http.StatusNotFound, "Trade not found")
+	ErrOrganizationRequired = domainerr.New(http.StatusBadRequest, "Portfolio must belong to an organization")
+	ErrNameRequired         = domainerr.New(http.StatusBadRequest, "Portfolio name is required")
+	ErrNameTooLong          = domainerr.New(http.StatusBadRequest, "Portfolio name is too long")
+	ErrNameAlreadyExists    = domainerr.New(http.StatusConflict, "Portfolio name already exists")
+	ErrInvalidCurrency      = domainerr.New(http.StatusBadRequest, "Currency must be 2 to 10 letters or digits")
+	ErrTooManyPortfolios    = domainerr.New(http.StatusBadRequest, "Portfolio limit reached")
+	ErrSymbolRequired       = domainerr.New(http.StatusBadRequest, "Symbol is required")
+	ErrInvalidInterval      = domainerr.New(http.StatusBadRequest, "Unsupported interval")
+	ErrInvalidMarket        = domainerr.New(http.StatusBadRequest, "Market must be coin or stock")
+	ErrInvalidSide          = domainerr.New(http.StatusBadRequest, "Side must be buy or sell")
+	ErrInvalidQuantity      = domainerr.New(http.StatusBadRequest, "Quantity must be positive")
+	ErrInvalidPrice         = domainerr.New(http.StatusBadRequest, "Price must be positive")
+	ErrInvalidFee           = domainerr.New(http.StatusBadRequest, "Fee must not be negative")
+	ErrAmountTooLarge       = domainerr.New(http.StatusBadRequest, "Quantity, price or fee is too large")
+	ErrExecutedInFuture     = domainerr.New(http.StatusBadRequest, "Trade time must not be in the future")
+	ErrNoteTooLong          = domainerr.New(http.StatusBadRequest, "Note is too long")
+	ErrTooManyTrades        = domainerr.New(http.StatusBadRequest, "Trade limit reached for this portfolio")
+	ErrOversold             = domainerr.New(http.StatusBadRequest, "Trade would sell more than the position holds")
+	ErrSignalHold           = domainerr.New(http.StatusBadRequest, "The model signal is HOLD, no trade was recorded")
+	ErrNoProductionModel    = domainerr.New(http.StatusNotFound, "No production model for this symbol and interval")
+	ErrPriceUnavailable     = domainerr.New(http.StatusBadGateway, "Market price is unavailable")
+)
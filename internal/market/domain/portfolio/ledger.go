@@ -0,0 +1,120 @@
+package portfolio
+
+import (
+	"go-ai/pkg/helpers"
+	"time"
+)
+
+// Position is the holding of one symbol, valued at average cost: buys add
+// their cost and fee to the cost basis, and a sell removes the share of the
+// cost basis it sold. A position sold down to zero keeps its realised PnL.
+type Position struct {
+	Symbol      string
+	Market      string
+	Quantity    helpers.Decimal
+	CostBasis   helpers.Decimal
+	RealizedPnL helpers.Decimal
+	Fees        helpers.Decimal
+	Trades      int
+	OpenedAt    time.Time
+}
+
+// AverageCost is the cost basis per unit held, or 0 for a closed position.
+func (p Position) AverageCost() helpers.Decimal {
+	return p.CostBasis.Div(p.Quantity)
+}
+
+// Key identifies the position's symbol on its market.
+func (p Position) Key() string {
+	return PositionKey(p.Market, p.Symbol)
+}
+
+func PositionKey(market, symbol string) string {
+	return market + ":" + symbol
+}
+
+// BuildPositions replays trades in execution order, ignoring those executed
+// after until unless until is zero. It fails with ErrOversold if any sell
+// exceeds the quantity held at that point. Positions come back in the order
+// they were first opened.
+func BuildPositions(trades []Trade, until time.Time) ([]Position, error) {
+	sorted := make([]Trade, len(trades))
+	copy(sorted, trades)
+	SortTrades(sorted)
+
+	index := make(map[string]int)
+	var positions []Position
+	for _, t := range sorted {
+		if !until.IsZero() && t.ExecutedAt.After(until) {
+			break
+		}
+		key := PositionKey(t.Market, t.Symbol)
+		i, ok := index[key]
+		if !ok {
+			i = len(positions)
+			index[key] = i
+			positions = append(positions, Position{Symbol: t.Symbol, Market: t.Market})
+		}
+		p := &positions[i]
+		if p.Quantity.IsZero() {
+			p.OpenedAt = t.ExecutedAt
+		}
+		p.Trades++
+		p.Fees = p.Fees.Add(t.Fee)
+
+		switch t.Side {
+		case SideBuy:
+			p.CostBasis = p.CostBasis.Add(t.Quantity.Mul(t.Price)).Add(t.Fee)
+			p.Quantity = p.Quantity.Add(t.Quantity)
+		case SideSell:
+			if t.Quantity.Cmp(p.Quantity) > 0 {
+				return nil, ErrOversold
+			}
+			soldCost := p.CostBasis.Mul(t.Quantity).Div(p.Quantity)
+			if t.Quantity.Cmp(p.Quantity) == 0 {
+				soldCost = p.CostBasis
+			}
+			proceeds := t.Quantity.Mul(t.Price).Sub(t.Fee)
+			p.RealizedPnL = p.RealizedPnL.Add(proceeds.Sub(soldCost))
+			p.CostBasis = p.CostBasis.Sub(soldCost)
+			p.Quantity = p.Quantity.Sub(t.Quantity)
+		}
+	}
+	return positions, nil
+}
+
+// Valuation is a portfolio's worth at a set of prices.
+type Valuation struct {
+	CostBasis     helpers.Decimal
+	MarketValue   helpers.Decimal
+	RealizedPnL   helpers.Decimal
+	UnrealizedPnL helpers.Decimal
+	TotalPnL      helpers.Decimal
+	Fees          helpers.Decimal
+	// Unpriced counts open positions with no price, valued at cost.
+	Unpriced int
+}
+
+// Value totals positions at prices keyed by PositionKey.
+func Value(positions []Position, prices map[string]helpers.Decimal) Valuation {
+	var v Valuation
+	for _, p := range positions {
+		v.CostBasis = v.CostBasis.Add(p.CostBasis)
+		v.RealizedPnL = v.RealizedPnL.Add(p.RealizedPnL)
+		v.Fees = v.Fees.Add(p.Fees)
+		if p.Quantity.IsZero() {
+			continue
+		}
+		price, ok := prices[p.Key()]
+		if !ok {
+			v.Unpriced++
+			v.MarketValue = v.MarketValue.Add(p.CostBasis)
+			continue
+		}
+		value := p.Quantity.Mul(price)
+		v.MarketValue = v.MarketValue.Add(value)
+		v.UnrealizedPnL = v.UnrealizedPnL.Add(value.Sub(p.CostBasis))
+	}
+	v.TotalPnL = v.RealizedPnL.Add(v.UnrealizedPnL)
+	return v
+}
@@ -0,0 +1,32 @@
+package portfolio
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository methods that take an organization and user return
+// ErrPortfolioNotFound for portfolios owned by anyone else.
+type Repository interface {
+	Create(ctx context.Context, p *Entity) error
+	CountByOwner(ctx context.Context, orgID, userID uuid.UUID) (int64, error)
+	GetByID(ctx context.Context, orgID, userID, id uuid.UUID) (*Entity, error)
+	List(ctx context.Context, orgID, userID uuid.UUID) ([]Entity, error)
+	// ListByUser returns the user's portfolios across every organization.
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]Entity, error)
+	Rename(ctx context.Context, orgID, userID, id uuid.UUID, name string) error
+	Delete(ctx context.Context, orgID, userID, id uuid.UUID) error
+	DeleteByOrganization(ctx context.Context, orgID uuid.UUID) (int64, error)
+	DeleteByUser(ctx context.Context, userID uuid.UUID) (int64, error)
+
+	// ListTrades returns the whole journal of a portfolio in replay order.
+	ListTrades(ctx context.Context, portfolioID uuid.UUID) ([]Trade, error)
+	// ListTradesPage returns the journal newest first, and the total count.
+	ListTradesPage(ctx context.Context, portfolioID uuid.UUID, limit, offset int32) ([]Trade, int64, error)
+	// AddTrade and DeleteTrade lock the portfolio and replay the changed
+	// journal, failing with ErrOversold or ErrTooManyTrades instead of
+	// leaving it inconsistent.
+	AddTrade(ctx context.Context, t *Trade) error
+	DeleteTrade(ctx context.Context, portfolioID, tradeID uuid.UUID) error
+}
@@ -0,0 +1,168 @@
+package stream
+
+import (
+	"encoding/json"
+	"go-ai/internal/coinai"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Event types sent on a stream.
+const (
+	EventCandle         = "candle"
+	EventPrediction     = "prediction"
+	EventSignalChanged  = "signal.changed"
+	EventAlertTriggered = "alert.triggered"
+	// EventHeartbeat is written by each connection and never published.
+	EventHeartbeat = "heartbeat"
+)
+
+const (
+	// MaxSymbols caps one subscription; the poller fetches candles once per
+	// watched symbol.
+	MaxSymbols      = 20
+	DefaultInterval = "1h"
+	maxSymbolLength = 20
+)
+
+// Event is one message on the stream. OrganizationID and UserID narrow who
+// receives it: a candle has neither, a prediction only the organization and
+// an alert only its owner. Symbol and Interval, when set, must match the
+// subscription.
+//
+// ID is assigned when the event is published. IDs grow with time, so a
+// client resumes by sending the last one it saw.
+type Event struct {
+	ID             string
+	Type           string
+	OrganizationID *uuid.UUID
+	UserID         *uuid.UUID
+	Symbol         string
+	Interval       string
+	Data           json.RawMessage
+	CreatedAt      time.Time
+}
+
+// Subscription is what one connection asked to receive.
+type Subscription struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	Symbols        []string
+	Interval       string
+}
+
+// NewSubscription parses a comma-separated symbol list. Symbols are
+// upper-cased and de-duplicated; an empty interval means DefaultInterval.
+func NewSubscription(orgID, userID uuid.UUID, symbols, interval string) (*Subscription, error) {
+	s := &Subscription{
+		OrganizationID: orgID,
+		UserID:         userID,
+	}
+	for _, raw := range strings.Split(symbols, ",") {
+		symbol := strings.ToUpper(strings.TrimSpace(raw))
+		if symbol == "" {
+			continue
+		}
+		if !validSymbol(symbol) {
+			return nil, ErrInvalidSymbol
+		}
+		if !slices.Contains(s.Symbols, symbol) {
+			s.Symbols = append(s.Symbols, symbol)
+		}
+	}
+	if len(s.Symbols) == 0 {
+		return nil, ErrSymbolsRequired
+	}
+	if len(s.Symbols) > MaxSymbols {
+		return nil, ErrTooManySymbols
+	}
+	interval = strings.TrimSpace(interval)
+	if interval == "" {
+		interval = DefaultInterval
+	}
+	if !coinai.IsBinanceInterval(interval) {
+		return nil, ErrInvalidInterval
+	}
+	s.Interval = interval
+	return s, nil
+}
+
+func validSymbol(symbol string) bool {
+	if len(symbol) > maxSymbolLength {
+		return false
+	}
+	for _, r := range symbol {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// Matches reports whether e should be sent on this subscription.
+func (s *Subscription) Matches(e Event) bool {
+	if e.OrganizationID != nil && *e.OrganizationID != s.OrganizationID {
+		return false
+	}
+	if e.UserID != nil && *e.UserID != s.UserID {
+		return false
+	}
+	if e.Symbol != "" && !slices.Contains(s.Symbols, e.Symbol) {
+		return false
+	}
+	return e.Interval == "" || e.Interval == s.Interval
+}
+
+// Watches lists the series the subscription needs candles and predictions
+// for.
+func (s *Subscription) Watches() []Watch {
+	out := make([]Watch, len(s.Symbols))
+	for i, symbol := range s.Symbols {
+		out[i] = Watch{OrganizationID: s.OrganizationID, Symbol: symbol, Interval: s.Interval}
+	}
+	return out
+}
+
+// Watch is a symbol/interval some connection of an organization listens to.
+type Watch struct {
+	OrganizationID uuid.UUID
+	Symbol         string
+	Interval       string
+}
+
+// ParseEventID validates an event ID of the form "<ms>-<seq>".
+func ParseEventID(id string) (ms, seq uint64, err error) {
+	msPart, seqPart, ok := strings.Cut(strings.TrimSpace(id), "-")
+	if !ok {
+		return 0, 0, ErrInvalidEventID
+	}
+	ms, err = strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return 0, 0, ErrInvalidEventID
+	}
+	seq, err = strconv.ParseUint(seqPart, 10, 64)
+	if err != nil {
+		return 0, 0, ErrInvalidEventID
+	}
+	return ms, seq, nil
+}
+
+// After reports whether event ID a was published after b. An invalid ID
+// sorts before every valid one.
+func After(a, b string) bool {
+	aMs, aSeq, aErr := ParseEventID(a)
+	bMs, bSeq, bErr := ParseEventID(b)
+	switch {
+	case aErr != nil:
+		return false
+	case bErr != nil:
+		return true
+	case aMs != bMs:
+		return aMs > bMs
+	}
+	return aSeq > bSeq
+}
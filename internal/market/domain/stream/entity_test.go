@@ -0,0 +1,101 @@
+package stream
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewSubscription(t *testing.T) {
+	s, err := NewSubscription(uuid.New(), uuid.New(), " btcusdt,ETHUSDT,,BTCUSDT ", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Symbols) != 2 || s.Symbols[0] != "BTCUSDT" || s.Symbols[1] != "ETHUSDT" {
+		t.Fatalf("Symbols = %v", s.Symbols)
+	}
+	if s.Interval != DefaultInterval {
+		t.Fatalf("Interval = %q, want %q", s.Interval, DefaultInterval)
+	}
+	watches := s.Watches()
+	if len(watches) != 2 || watches[1].Symbol != "ETHUSDT" || watches[1].OrganizationID != s.OrganizationID {
+		t.Fatalf("Watches = %+v", watches)
+	}
+}
+
+func TestNewSubscriptionValidation(t *testing.T) {
+	many := make([]string, MaxSymbols+1)
+	for i := range many {
+		many[i] = "COIN" + string(rune('A'+i))
+	}
+	cases := []struct {
+		name     string
+		symbols  string
+		interval string
+		want     error
+	}{
+		{"empty", " , ", "1h", ErrSymbolsRequired},
+		{"symbol", "BTC/USDT", "1h", ErrInvalidSymbol},
+		{"too many", strings.Join(many, ","), "1h", ErrTooManySymbols},
+		{"interval", "BTCUSDT", "7m", ErrInvalidInterval},
+	}
+	for _, tc := range cases {
+		if _, err := NewSubscription(uuid.New(), uuid.New(), tc.symbols, tc.interval); !errors.Is(err, tc.want) {
+			t.Errorf("%s: err = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+}
+
+func TestSubscriptionMatches(t *testing.T) {
+	org, user := uuid.New(), uuid.New()
+	other := uuid.New()
+	s, err := NewSubscription(org, user, "BTCUSDT", "1m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		name string
+		e    Event
+		want bool
+	}{
+		{"candle", Event{Type: EventCandle, Symbol: "BTCUSDT", Interval: "1m"}, true},
+		{"other symbol", Event{Type: EventCandle, Symbol: "ETHUSDT", Interval: "1m"}, false},
+		{"other interval", Event{Type: EventCandle, Symbol: "BTCUSDT", Interval: "1h"}, false},
+		{"own organization", Event{Type: EventPrediction, OrganizationID: &org, Symbol: "BTCUSDT", Interval: "1m"}, true},
+		{"other organization", Event{Type: EventPrediction, OrganizationID: &other, Symbol: "BTCUSDT", Interval: "1m"}, false},
+		{"own alert", Event{Type: EventAlertTriggered, OrganizationID: &org, UserID: &user, Symbol: "BTCUSDT"}, true},
+		{"own alert, other symbol", Event{Type: EventAlertTriggered, OrganizationID: &org, UserID: &user, Symbol: "ETHUSDT"}, false},
+		{"other user", Event{Type: EventAlertTriggered, OrganizationID: &org, UserID: &other}, false},
+	}
+	for _, tc := range cases {
+		if got := s.Matches(tc.e); got != tc.want {
+			t.Errorf("%s: Matches = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestEventIDs(t *testing.T) {
+	for _, id := range []string{"", "12", "a-1", "1-b", "-1"} {
+		if _, _, err := ParseEventID(id); !errors.Is(err, ErrInvalidEventID) {
+			t.Errorf("ParseEventID(%q) = %v, want ErrInvalidEventID", id, err)
+		}
+	}
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{"1700000000001-0", "1700000000000-5", true},
+		{"1700000000000-5", "1700000000000-4", true},
+		{"1700000000000-4", "1700000000000-4", false},
+		{"1700000000000-0", "1700000000001-0", false},
+		{"1-0", "", true},
+		{"", "1-0", false},
+	}
+	for _, tc := range cases {
+		if got := After(tc.a, tc.b); got != tc.want {
+			t.Errorf("After(%q, %q) = %v, want %v", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
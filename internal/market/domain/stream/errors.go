@@ -0,0 +1,15 @@
+package stream
+
+import (
+	domainerr "go-ai/pkg/domain_err"
+	"net/http"
+)
+
+var (
+	ErrSymbolsRequired    = domainerr.New(http.StatusBadRequest, "At least one symbol is required")
+	ErrTooManySymbols     = domainerr.New(http.StatusBadRequest, "Too many symbols")
+	ErrInvalidSymbol      = domainerr.New(http.StatusBadRequest, "Invalid symbol")
+	ErrInvalidInterval    = domainerr.New(http.StatusBadRequest, "Unsupported interval")
+	ErrInvalidEventID     = domainerr.New(http.StatusBadRequest, "Invalid Last-Event-ID")
+	ErrTooManyConnections = domainerr.New(http.StatusTooManyRequests, "Too many open streams")
+)
@@ -0,0 +1,36 @@
+package stream
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Broker carries events between replicas and keeps the latest ones so a
+// client can resume. It also tracks open connections and the series they
+// watch, which every replica shares.
+type Broker interface {
+	// Publish appends e to the log, sets e.ID and broadcasts it to every
+	// replica.
+	Publish(ctx context.Context, e *Event) error
+	// Subscribe calls ready once it listens and then fn with every event
+	// broadcast. It blocks until ctx ends or the connection fails.
+	Subscribe(ctx context.Context, ready func(), fn func(Event)) error
+	// Since returns up to limit logged events published after id, oldest
+	// first. Events past the log's length are gone.
+	Since(ctx context.Context, id string, limit int64) ([]Event, error)
+
+	// Connect registers or refreshes a connection of the user until
+	// expiresAt. It returns ErrTooManyConnections when the user already has
+	// limit other live connections.
+	Connect(ctx context.Context, userID, connID uuid.UUID, limit int, expiresAt time.Time) error
+	Disconnect(ctx context.Context, userID, connID uuid.UUID) error
+	// Watch marks series as wanted until expiresAt.
+	Watch(ctx context.Context, watches []Watch, expiresAt time.Time) error
+	// Watched returns the series still wanted at now.
+	Watched(ctx context.Context, now time.Time) ([]Watch, error)
+	// MarkClosed records closeTime as the latest candle published for the
+	// series. It reports false when that candle or a later one already was.
+	MarkClosed(ctx context.Context, symbol, interval string, closeTime time.Time) (bool, error)
+}
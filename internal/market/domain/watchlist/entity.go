@@ -0,0 +1,147 @@
+package watchlist
+
+import (
+	"go-ai/internal/coinai"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MarketCoin  = "coin"
+	MarketStock = "stock"
+)
+
+const (
+	MaxNameLength = 100
+	// MaxItems caps a list; quoting a list fetches candles once per item.
+	MaxItems = 50
+)
+
+// Entity is a named, ordered list of symbols. It belongs to one user inside
+// one organization and nobody else can see it.
+type Entity struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	Name           string
+	Position       int
+	Items          []Item
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type Item struct {
+	ID          uuid.UUID
+	WatchlistID uuid.UUID
+	Symbol      string
+	Market      string
+	Interval    string
+	Position    int
+	CreatedAt   time.Time
+}
+
+// NewWatchlist validates a new list. Its position is assigned by the
+// repository, after the owner's existing lists.
+func NewWatchlist(orgID, userID uuid.UUID, name string) (*Entity, error) {
+	if orgID == uuid.Nil {
+		return nil, ErrOrganizationRequired
+	}
+	name, err := NormalizeName(name)
+	if err != nil {
+		return nil, err
+	}
+	return &Entity{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		UserID:         userID,
+		Name:           name,
+		Items:          []Item{},
+	}, nil
+}
+
+func NormalizeName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", ErrNameRequired
+	}
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		return "", ErrNameTooLong
+	}
+	return name, nil
+}
+
+// NewItem validates a symbol for the list. Market defaults to coin. Coin
+// intervals must be ones Binance serves; stock intervals are free-form
+// because stock candles come from private CSV files.
+func NewItem(watchlistID uuid.UUID, symbol, market, interval string) (*Item, error) {
+	symbol = strings.ToUpper(strings.TrimSpace(symbol))
+	if symbol == "" {
+		return nil, ErrSymbolRequired
+	}
+	market = strings.ToLower(strings.TrimSpace(market))
+	if market == "" {
+		market = MarketCoin
+	}
+	if market != MarketCoin && market != MarketStock {
+		return nil, ErrInvalidMarket
+	}
+	interval = strings.TrimSpace(interval)
+	if interval == "" {
+		return nil, ErrIntervalRequired
+	}
+	if market == MarketCoin && !coinai.IsBinanceInterval(interval) {
+		return nil, ErrInvalidInterval
+	}
+	return &Item{
+		ID:          uuid.New(),
+		WatchlistID: watchlistID,
+		Symbol:      symbol,
+		Market:      market,
+		Interval:    interval,
+	}, nil
+}
+
+// CanAdd reports whether item fits on the list: the list is not full and
+// does not already hold the same symbol, market and interval.
+func (w *Entity) CanAdd(item *Item) error {
+	if len(w.Items) >= MaxItems {
+		return ErrTooManyItems
+	}
+	for _, it := range w.Items {
+		if it.Symbol == item.Symbol && it.Market == item.Market && it.Interval == item.Interval {
+			return ErrItemAlreadyExists
+		}
+	}
+	return nil
+}
+
+func (w *Entity) ItemIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(w.Items))
+	for _, it := range w.Items {
+		ids = append(ids, it.ID)
+	}
+	return ids
+}
+
+// CheckOrder ensures order is a permutation of current, so a reorder never
+// leaves a list or item without a position.
+func CheckOrder(current, order []uuid.UUID) error {
+	if len(current) != len(order) {
+		return ErrInvalidOrder
+	}
+	known := make(map[uuid.UUID]bool, len(current))
+	for _, id := range current {
+		known[id] = false
+	}
+	for _, id := range order {
+		seen, ok := known[id]
+		if !ok || seen {
+			return ErrInvalidOrder
+		}
+		known[id] = true
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package watchlist
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewWatchlist(t *testing.T) {
+	w, err := NewWatchlist(uuid.New(), uuid.New(), "  Morning  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Name != "Morning" {
+		t.Fatalf("Name = %q", w.Name)
+	}
+	if _, err := NewWatchlist(uuid.Nil, uuid.New(), "Morning"); !errors.Is(err, ErrOrganizationRequired) {
+		t.Fatalf("err = %v, want ErrOrganizationRequired", err)
+	}
+	if _, err := NewWatchlist(uuid.New(), uuid.New(), " "); !errors.Is(err, ErrNameRequired) {
+		t.Fatalf("err = %v, want ErrNameRequired", err)
+	}
+	if _, err := NewWatchlist(uuid.New(), uuid.New(), strings.Repeat("x", MaxNameLength+1)); !errors.Is(err, ErrNameTooLong) {
+		t.Fatalf("err = %v, want ErrNameTooLong", err)
+	}
+}
+
+func TestNewItem(t *testing.T) {
+	item, err := NewItem(uuid.New(), " btcusdt ", "", "1h")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.Symbol != "BTCUSDT" || item.Market != MarketCoin || item.Interval != "1h" {
+		t.Fatalf("item = %+v", item)
+	}
+
+	stock, err := NewItem(uuid.New(), "aapl", "STOCK", "1day")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stock.Market != MarketStock || stock.Interval != "1day" {
+		t.Fatalf("stock item = %+v", stock)
+	}
+
+	cases := []struct {
+		symbol, market, interval string
+		want                     error
+	}{
+		{"", "coin", "1h", ErrSymbolRequired},
+		{"BTCUSDT", "forex", "1h", ErrInvalidMarket},
+		{"BTCUSDT", "coin", "", ErrIntervalRequired},
+		{"BTCUSDT", "coin", "7m", ErrInvalidInterval},
+	}
+	for _, c := range cases {
+		if _, err := NewItem(uuid.New(), c.symbol, c.market, c.interval); !errors.Is(err, c.want) {
+			t.Fatalf("NewItem(%q, %q, %q) err = %v, want %v", c.symbol, c.market, c.interval, err, c.want)
+		}
+	}
+}
+
+func TestCanAdd(t *testing.T) {
+	w := &Entity{ID: uuid.New()}
+	first, _ := NewItem(w.ID, "BTCUSDT", "coin", "1h")
+	w.Items = append(w.Items, *first)
+
+	dup, _ := NewItem(w.ID, "btcusdt", "coin", "1h")
+	if err := w.CanAdd(dup); !errors.Is(err, ErrItemAlreadyExists) {
+		t.Fatalf("err = %v, want ErrItemAlreadyExists", err)
+	}
+	other, _ := NewItem(w.ID, "BTCUSDT", "coin", "4h")
+	if err := w.CanAdd(other); err != nil {
+		t.Fatalf("other interval rejected: %v", err)
+	}
+
+	for len(w.Items) < MaxItems {
+		w.Items = append(w.Items, Item{ID: uuid.New()})
+	}
+	if err := w.CanAdd(other); !errors.Is(err, ErrTooManyItems) {
+		t.Fatalf("err = %v, want ErrTooManyItems", err)
+	}
+}
+
+func TestCheckOrder(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	current := []uuid.UUID{a, b, c}
+
+	if err := CheckOrder(current, []uuid.UUID{c, a, b}); err != nil {
+		t.Fatalf("valid order rejected: %v", err)
+	}
+	for _, order := range [][]uuid.UUID{
+		{a, b},
+		{a, b, c, a},
+		{a, a, b},
+		{a, b, uuid.New()},
+	} {
+		if err := CheckOrder(current, order); !errors.Is(err, ErrInvalidOrder) {
+			t.Fatalf("CheckOrder(%v) err = %v, want ErrInvalidOrder", order, err)
+		}
+	}
+}
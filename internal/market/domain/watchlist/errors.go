@@ -0,0 +1,22 @@
+package watchlist
+
+import (
+	domainerr "go-ai/pkg/domain_err"
+	"net/http"
+)
+
+var (
+	ErrWatchlistNotFound    = domainerr.New(http.StatusNotFound, "Watchlist not found")
+	ErrItemNotFound         = domainerr.New(http.StatusNotFound, "Watchlist item not found")
+	ErrNameRequired         = domainerr.New(http.StatusBadRequest, "Watchlist name is required")
+	ErrNameTooLong          = domainerr.New(http.StatusBadRequest, "Watchlist name is too long")
+	ErrNameAlreadyExists    = domainerr.New(http.StatusConflict, "Watchlist name already exists")
+	ErrOrganizationRequired = domainerr.New(http.StatusBadRequest, "Watchlist must belong to an organization")
+	ErrSymbolRequired       = domainerr.New(http.StatusBadRequest, "Symbol is required")
+	ErrInvalidMarket        = domainerr.New(http.StatusBadRequest, "Market must be coin or stock")
+	ErrIntervalRequired     = domainerr.New(http.StatusBadRequest, "Interval is required")
+	ErrInvalidInterval      = domainerr.New(http.StatusBadRequest, "Unsupported interval")
+	ErrItemAlreadyExists    = domainerr.New(http.StatusConflict, "Symbol is already on this watchlist")
+	ErrTooManyItems         = domainerr.New(http.StatusBadRequest, "Watchlist is full")
+	ErrInvalidOrder         = domainerr.New(http.StatusBadRequest, "Order must list every ID exactly once")
+)
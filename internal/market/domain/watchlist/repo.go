@@ -0,0 +1,30 @@
+package watchlist
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository methods take the owner's organization and user, and return
+// ErrWatchlistNotFound for lists owned by anyone else.
+type Repository interface {
+	Create(ctx context.Context, w *Entity) error
+	// GetByID loads the list with its items in order.
+	GetByID(ctx context.Context, orgID, userID, id uuid.UUID) (*Entity, error)
+	// List returns the owner's lists, with items, in order.
+	List(ctx context.Context, orgID, userID uuid.UUID) ([]Entity, error)
+	// ListByUser returns the user's lists across every organization.
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]Entity, error)
+	Rename(ctx context.Context, orgID, userID, id uuid.UUID, name string) error
+	Delete(ctx context.Context, orgID, userID, id uuid.UUID) error
+	// Reorder sets each list's position to its index in ids.
+	Reorder(ctx context.Context, orgID, userID uuid.UUID, ids []uuid.UUID) error
+	DeleteByOrganization(ctx context.Context, orgID uuid.UUID) (int64, error)
+	DeleteByUser(ctx context.Context, userID uuid.UUID) (int64, error)
+
+	// AddItem appends item to the end of its list.
+	AddItem(ctx context.Context, item *Item) error
+	RemoveItem(ctx context.Context, watchlistID, itemID uuid.UUID) error
+	ReorderItems(ctx context.Context, watchlistID uuid.UUID, ids []uuid.UUID) error
+}
@@ -0,0 +1,227 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"go-ai/internal/market/domain/stream"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	streamLogKey     = "stream:log"
+	streamChannel    = "stream:live"
+	streamWatchKey   = "stream:watch"
+	streamCandlesKey = "stream:candles"
+	streamConnPrefix = "stream:conn:"
+)
+
+var (
+	// connectScript drops expired connections, then adds or refreshes
+	// ARGV[1] unless the user already has ARGV[3] others.
+	connectScript = redis.NewScript(`
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", ARGV[4])
+if not redis.call("ZSCORE", KEYS[1], ARGV[1]) and redis.call("ZCARD", KEYS[1]) >= tonumber(ARGV[3]) then
+	return 0
+end
+redis.call("ZADD", KEYS[1], ARGV[2], ARGV[1])
+redis.call("PEXPIREAT", KEYS[1], ARGV[2])
+return 1`)
+
+	// markClosedScript stores ARGV[2] for field ARGV[1] when it is newer.
+	markClosedScript = redis.NewScript(`
+local last = tonumber(redis.call("HGET", KEYS[1], ARGV[1]) or "0")
+if tonumber(ARGV[2]) <= last then
+	return 0
+end
+redis.call("HSET", KEYS[1], ARGV[1], ARGV[2])
+return 1`)
+)
+
+// eventRecord is how an event is stored in the log and broadcast.
+type eventRecord struct {
+	ID             string          `json:"id,omitempty"`
+	Type           string          `json:"type"`
+	OrganizationID *uuid.UUID      `json:"organization_id,omitempty"`
+	UserID         *uuid.UUID      `json:"user_id,omitempty"`
+	Symbol         string          `json:"symbol,omitempty"`
+	Interval       string          `json:"interval,omitempty"`
+	Data           json.RawMessage `json:"data"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+// StreamBroker keeps the event log in a Redis stream capped near maxLen
+// entries and broadcasts over pub/sub. The log gives events their IDs.
+type StreamBroker struct {
+	client *redis.Client
+	maxLen int64
+}
+
+func NewStreamBroker(client *redis.Client, maxLen int64) *StreamBroker {
+	return &StreamBroker{client: client, maxLen: maxLen}
+}
+
+func (b *StreamBroker) Publish(ctx context.Context, e *stream.Event) error {
+	rec := toRecord(*e)
+	body, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	id, err := b.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: streamLogKey,
+		MaxLen: b.maxLen,
+		Approx: true,
+		Values: map[string]any{"event": body},
+	}).Result()
+	if err != nil {
+		return err
+	}
+	e.ID = id
+	rec.ID = id
+	if body, err = json.Marshal(rec); err != nil {
+		return err
+	}
+	return b.client.Publish(ctx, streamChannel, body).Err()
+}
+
+func (b *StreamBroker) Subscribe(ctx context.Context, ready func(), fn func(stream.Event)) error {
+	sub := b.client.Subscribe(ctx, streamChannel)
+	defer sub.Close()
+	if _, err := sub.Receive(ctx); err != nil {
+		return err
+	}
+	ready()
+
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case msg, ok := <-ch:
+			if !ok {
+				return redis.ErrClosed
+			}
+			var rec eventRecord
+			if err := json.Unmarshal([]byte(msg.Payload), &rec); err != nil {
+				continue
+			}
+			fn(fromRecord(rec))
+		}
+	}
+}
+
+func (b *StreamBroker) Since(ctx context.Context, id string, limit int64) ([]stream.Event, error) {
+	msgs, err := b.client.XRangeN(ctx, streamLogKey, "("+id, "+", limit).Result()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]stream.Event, 0, len(msgs))
+	for _, msg := range msgs {
+		raw, _ := msg.Values["event"].(string)
+		var rec eventRecord
+		if err := json.Unmarshal([]byte(raw), &rec); err != nil {
+			continue
+		}
+		rec.ID = msg.ID
+		out = append(out, fromRecord(rec))
+	}
+	return out, nil
+}
+
+func (b *StreamBroker) Connect(ctx context.Context, userID, connID uuid.UUID, limit int, expiresAt time.Time) error {
+	ok, err := connectScript.Run(ctx, b.client, []string{streamConnPrefix + userID.String()},
+		connID.String(), expiresAt.UnixMilli(), limit, time.Now().UnixMilli()).Int()
+	if err != nil {
+		return err
+	}
+	if ok == 0 {
+		return stream.ErrTooManyConnections
+	}
+	return nil
+}
+
+func (b *StreamBroker) Disconnect(ctx context.Context, userID, connID uuid.UUID) error {
+	return b.client.ZRem(ctx, streamConnPrefix+userID.String(), connID.String()).Err()
+}
+
+func (b *StreamBroker) Watch(ctx context.Context, watches []stream.Watch, expiresAt time.Time) error {
+	if len(watches) == 0 {
+		return nil
+	}
+	members := make([]redis.Z, len(watches))
+	for i, w := range watches {
+		members[i] = redis.Z{Score: float64(expiresAt.UnixMilli()), Member: watchMember(w)}
+	}
+	return b.client.ZAddGT(ctx, streamWatchKey, members...).Err()
+}
+
+func (b *StreamBroker) Watched(ctx context.Context, now time.Time) ([]stream.Watch, error) {
+	if err := b.client.ZRemRangeByScore(ctx, streamWatchKey, "-inf", strconv.FormatInt(now.UnixMilli(), 10)).Err(); err != nil {
+		return nil, err
+	}
+	members, err := b.client.ZRange(ctx, streamWatchKey, 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+	out := make([]stream.Watch, 0, len(members))
+	for _, m := range members {
+		if w, ok := parseWatchMember(m); ok {
+			out = append(out, w)
+		}
+	}
+	return out, nil
+}
+
+func (b *StreamBroker) MarkClosed(ctx context.Context, symbol, interval string, closeTime time.Time) (bool, error) {
+	ok, err := markClosedScript.Run(ctx, b.client, []string{streamCandlesKey}, symbol+":"+interval, closeTime.UnixMilli()).Int()
+	if err != nil {
+		return false, err
+	}
+	return ok == 1, nil
+}
+
+func watchMember(w stream.Watch) string {
+	return w.OrganizationID.String() + ":" + w.Symbol + ":" + w.Interval
+}
+
+func parseWatchMember(m string) (stream.Watch, bool) {
+	parts := strings.SplitN(m, ":", 3)
+	if len(parts) != 3 {
+		return stream.Watch{}, false
+	}
+	orgID, err := uuid.Parse(parts[0])
+	if err != nil {
+		return stream.Watch{}, false
+	}
+	return stream.Watch{OrganizationID: orgID, Symbol: parts[1], Interval: parts[2]}, true
+}
+
+func toRecord(e stream.Event) eventRecord {
+	return eventRecord{
+		ID:             e.ID,
+		Type:           e.Type,
+		OrganizationID: e.OrganizationID,
+		UserID:         e.UserID,
+		Symbol:         e.Symbol,
+		Interval:       e.Interval,
+		Data:           e.Data,
+		CreatedAt:      e.CreatedAt,
+	}
+}
+
+func fromRecord(r eventRecord) stream.Event {
+	return stream.Event{
+		ID:             r.ID,
+		Type:           r.Type,
+		OrganizationID: r.OrganizationID,
+		UserID:         r.UserID,
+		Symbol:         r.Symbol,
+		Interval:       r.Interval,
+		Data:           r.Data,
+		CreatedAt:      r.CreatedAt,
+	}
+}
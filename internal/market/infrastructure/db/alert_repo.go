@@ -0,0 +1,285 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"go-ai/internal/coinai"
+	"go-ai/internal/market/domain/alert"
+	sqlc "go-ai/internal/market/infrastructure/sqlc/alert"
+	"go-ai/pkg/notify"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AlertRepo struct {
+	queries *sqlc.Queries
+}
+
+func NewAlertRepo(pool *pgxpool.Pool) *AlertRepo {
+	return &AlertRepo{
+		queries: sqlc.New(pool),
+	}
+}
+
+func (r *AlertRepo) Create(ctx context.Context, a *alert.Entity) error {
+	cond, err := json.Marshal(a.Condition)
+	if err != nil {
+		return err
+	}
+	row, err := r.queries.CreateAlert(ctx, sqlc.CreateAlertParams{
+		ID:              a.ID,
+		OrganizationID:  a.OrganizationID,
+		UserID:          a.UserID,
+		Name:            a.Name,
+		Symbol:          a.Symbol,
+		KlineInterval:   a.Interval,
+		Kind:            string(a.Kind),
+		Condition:       cond,
+		Channels:        a.Channels,
+		WebhookUrl:      optionalString(a.WebhookURL),
+		CooldownSeconds: int32(a.Cooldown / time.Second),
+		Enabled:         a.Enabled,
+	})
+	if err != nil {
+		return err
+	}
+	a.CreatedAt = row.CreatedAt
+	a.UpdatedAt = row.UpdatedAt
+	return nil
+}
+
+func (r *AlertRepo) CountByOwner(ctx context.Context, orgID, userID uuid.UUID) (int64, error) {
+	return r.queries.CountOwnerAlerts(ctx, sqlc.CountOwnerAlertsParams{
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+}
+
+func (r *AlertRepo) GetByID(ctx context.Context, orgID, userID, id uuid.UUID) (*alert.Entity, error) {
+	row, err := r.queries.GetAlert(ctx, sqlc.GetAlertParams{
+		ID:             id,
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, alert.ErrAlertNotFound
+		}
+		return nil, err
+	}
+	a := toAlert(row)
+	return &a, nil
+}
+
+func (r *AlertRepo) List(ctx context.Context, orgID, userID uuid.UUID) ([]alert.Entity, error) {
+	rows, err := r.queries.ListAlerts(ctx, sqlc.ListAlertsParams{
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toAlerts(rows), nil
+}
+
+func (r *AlertRepo) ListByUser(ctx context.Context, userID uuid.UUID) ([]alert.Entity, error) {
+	rows, err := r.queries.ListUserAlerts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toAlerts(rows), nil
+}
+
+func (r *AlertRepo) ListEnabled(ctx context.Context) ([]alert.Entity, error) {
+	rows, err := r.queries.ListEnabledAlerts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toAlerts(rows), nil
+}
+
+func (r *AlertRepo) Update(ctx context.Context, a *alert.Entity) error {
+	affected, err := r.queries.UpdateAlert(ctx, sqlc.UpdateAlertParams{
+		Name:            a.Name,
+		Channels:        a.Channels,
+		WebhookUrl:      optionalString(a.WebhookURL),
+		CooldownSeconds: int32(a.Cooldown / time.Second),
+		Enabled:         a.Enabled,
+		LastCandleAt:    a.LastCandleAt,
+		LastSignal:      optionalString(string(a.LastSignal)),
+		ID:              a.ID,
+		OrganizationID:  a.OrganizationID,
+		UserID:          a.UserID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return alert.ErrAlertNotFound
+	}
+	return nil
+}
+
+func (r *AlertRepo) SaveState(ctx context.Context, a *alert.Entity) error {
+	return r.queries.SaveAlertState(ctx, sqlc.SaveAlertStateParams{
+		LastCandleAt:    a.LastCandleAt,
+		LastSignal:      optionalString(string(a.LastSignal)),
+		LastTriggeredAt: a.LastTriggeredAt,
+		ID:              a.ID,
+	})
+}
+
+func (r *AlertRepo) Delete(ctx context.Context, orgID, userID, id uuid.UUID) error {
+	affected, err := r.queries.DeleteAlert(ctx, sqlc.DeleteAlertParams{
+		ID:             id,
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return alert.ErrAlertNotFound
+	}
+	return nil
+}
+
+func (r *AlertRepo) DeleteByOrganization(ctx context.Context, orgID uuid.UUID) (int64, error) {
+	return r.queries.DeleteOrganizationAlerts(ctx, orgID)
+}
+
+func (r *AlertRepo) DeleteByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return r.queries.DeleteUserAlerts(ctx, userID)
+}
+
+func (r *AlertRepo) CreateEvent(ctx context.Context, e *alert.Event) error {
+	details, err := json.Marshal(e.Details)
+	if err != nil {
+		return err
+	}
+	deliveries, err := json.Marshal(e.Deliveries)
+	if err != nil {
+		return err
+	}
+	return r.queries.CreateAlertEvent(ctx, sqlc.CreateAlertEventParams{
+		ID:              e.ID,
+		AlertID:         e.AlertID,
+		OrganizationID:  e.OrganizationID,
+		UserID:          e.UserID,
+		Kind:            string(e.Kind),
+		Symbol:          e.Symbol,
+		KlineInterval:   e.Interval,
+		Message:         e.Message,
+		Price:           e.Price,
+		Details:         details,
+		Deliveries:      deliveries,
+		CandleCloseTime: e.CandleCloseTime,
+		TriggeredAt:     e.TriggeredAt,
+	})
+}
+
+func (r *AlertRepo) ListEvents(ctx context.Context, alertID uuid.UUID, limit, offset int32) ([]alert.Event, int64, error) {
+	total, err := r.queries.CountAlertEvents(ctx, alertID)
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, err := r.queries.ListAlertEvents(ctx, sqlc.ListAlertEventsParams{
+		AlertID:    alertID,
+		LimitRows:  limit,
+		OffsetRows: offset,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return toEvents(rows), total, nil
+}
+
+func (r *AlertRepo) ListEventsByUser(ctx context.Context, userID uuid.UUID, limit, offset int32) ([]alert.Event, int64, error) {
+	total, err := r.queries.CountUserAlertEvents(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, err := r.queries.ListUserAlertEvents(ctx, sqlc.ListUserAlertEventsParams{
+		UserID:     userID,
+		LimitRows:  limit,
+		OffsetRows: offset,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return toEvents(rows), total, nil
+}
+
+func toAlert(row sqlc.Alert) alert.Entity {
+	var cond alert.Condition
+	// The condition was validated before it was stored.
+	_ = json.Unmarshal(row.Condition, &cond)
+	a := alert.Entity{
+		ID:              row.ID,
+		OrganizationID:  row.OrganizationID,
+		UserID:          row.UserID,
+		Name:            row.Name,
+		Symbol:          row.Symbol,
+		Interval:        row.KlineInterval,
+		Kind:            alert.Kind(row.Kind),
+		Condition:       cond,
+		Channels:        row.Channels,
+		Cooldown:        time.Duration(row.CooldownSeconds) * time.Second,
+		Enabled:         row.Enabled,
+		LastCandleAt:    row.LastCandleAt,
+		LastTriggeredAt: row.LastTriggeredAt,
+		CreatedAt:       row.CreatedAt,
+		UpdatedAt:       row.UpdatedAt,
+	}
+	if row.WebhookUrl != nil {
+		a.WebhookURL = *row.WebhookUrl
+	}
+	if row.LastSignal != nil {
+		a.LastSignal = coinai.Signal(*row.LastSignal)
+	}
+	return a
+}
+
+func toAlerts(rows []sqlc.Alert) []alert.Entity {
+	out := make([]alert.Entity, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, toAlert(row))
+	}
+	return out
+}
+
+func toEvents(rows []sqlc.AlertEvent) []alert.Event {
+	out := make([]alert.Event, 0, len(rows))
+	for _, row := range rows {
+		e := alert.Event{
+			ID:              row.ID,
+			AlertID:         row.AlertID,
+			OrganizationID:  row.OrganizationID,
+			UserID:          row.UserID,
+			Kind:            alert.Kind(row.Kind),
+			Symbol:          row.Symbol,
+			Interval:        row.KlineInterval,
+			Message:         row.Message,
+			Price:           row.Price,
+			CandleCloseTime: row.CandleCloseTime,
+			TriggeredAt:     row.TriggeredAt,
+		}
+		_ = json.Unmarshal(row.Details, &e.Details)
+		var deliveries []notify.Delivery
+		_ = json.Unmarshal(row.Deliveries, &deliveries)
+		e.Deliveries = deliveries
+		out = append(out, e)
+	}
+	return out
+}
+
+func optionalString(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,313 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/coinai"
+	"go-ai/internal/market/domain/portfolio"
+	sqlc "go-ai/internal/market/infrastructure/sqlc/portfolio"
+	"go-ai/pkg/helpers"
+	"go-ai/pkg/pgerr"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PortfolioRepo struct {
+	pool    *pgxpool.Pool
+	queries *sqlc.Queries
+}
+
+func NewPortfolioRepo(pool *pgxpool.Pool) *PortfolioRepo {
+	return &PortfolioRepo{
+		pool:    pool,
+		queries: sqlc.New(pool),
+	}
+}
+
+func (r *PortfolioRepo) Create(ctx context.Context, p *portfolio.Entity) error {
+	row, err := r.queries.CreatePortfolio(ctx, sqlc.CreatePortfolioParams{
+		ID:             p.ID,
+		OrganizationID: p.OrganizationID,
+		UserID:         p.UserID,
+		Name:           p.Name,
+		Currency:       p.Currency,
+	})
+	if err != nil {
+		if pgerr.IsUniqueViolation(err, "uq_portfolios_name") {
+			return portfolio.ErrNameAlreadyExists
+		}
+		return err
+	}
+	p.CreatedAt = row.CreatedAt
+	p.UpdatedAt = row.UpdatedAt
+	return nil
+}
+
+func (r *PortfolioRepo) CountByOwner(ctx context.Context, orgID, userID uuid.UUID) (int64, error) {
+	return r.queries.CountOwnerPortfolios(ctx, sqlc.CountOwnerPortfoliosParams{
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+}
+
+func (r *PortfolioRepo) GetByID(ctx context.Context, orgID, userID, id uuid.UUID) (*portfolio.Entity, error) {
+	row, err := r.queries.GetPortfolio(ctx, sqlc.GetPortfolioParams{
+		ID:             id,
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, portfolio.ErrPortfolioNotFound
+		}
+		return nil, err
+	}
+	p := toPortfolio(row)
+	return &p, nil
+}
+
+func (r *PortfolioRepo) List(ctx context.Context, orgID, userID uuid.UUID) ([]portfolio.Entity, error) {
+	rows, err := r.queries.ListPortfolios(ctx, sqlc.ListPortfoliosParams{
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toPortfolios(rows), nil
+}
+
+func (r *PortfolioRepo) ListByUser(ctx context.Context, userID uuid.UUID) ([]portfolio.Entity, error) {
+	rows, err := r.queries.ListUserPortfolios(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return toPortfolios(rows), nil
+}
+
+func (r *PortfolioRepo) Rename(ctx context.Context, orgID, userID, id uuid.UUID, name string) error {
+	affected, err := r.queries.RenamePortfolio(ctx, sqlc.RenamePortfolioParams{
+		Name:           name,
+		ID:             id,
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	if err != nil {
+		if pgerr.IsUniqueViolation(err, "uq_portfolios_name") {
+			return portfolio.ErrNameAlreadyExists
+		}
+		return err
+	}
+	if affected == 0 {
+		return portfolio.ErrPortfolioNotFound
+	}
+	return nil
+}
+
+func (r *PortfolioRepo) Delete(ctx context.Context, orgID, userID, id uuid.UUID) error {
+	affected, err := r.queries.DeletePortfolio(ctx, sqlc.DeletePortfolioParams{
+		ID:             id,
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return portfolio.ErrPortfolioNotFound
+	}
+	return nil
+}
+
+func (r *PortfolioRepo) DeleteByOrganization(ctx context.Context, orgID uuid.UUID) (int64, error) {
+	return r.queries.DeleteOrganizationPortfolios(ctx, orgID)
+}
+
+func (r *PortfolioRepo) DeleteByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return r.queries.DeleteUserPortfolios(ctx, userID)
+}
+
+func (r *PortfolioRepo) ListTrades(ctx context.Context, portfolioID uuid.UUID) ([]portfolio.Trade, error) {
+	rows, err := r.queries.ListPortfolioTrades(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+	return toTrades(rows)
+}
+
+func (r *PortfolioRepo) ListTradesPage(ctx context.Context, portfolioID uuid.UUID, limit, offset int32) ([]portfolio.Trade, int64, error) {
+	total, err := r.queries.CountPortfolioTrades(ctx, portfolioID)
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, err := r.queries.ListPortfolioTradesPage(ctx, sqlc.ListPortfolioTradesPageParams{
+		PortfolioID: portfolioID,
+		LimitRows:   limit,
+		OffsetRows:  offset,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	trades, err := toTrades(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return trades, total, nil
+}
+
+func (r *PortfolioRepo) AddTrade(ctx context.Context, t *portfolio.Trade) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	q := r.queries.WithTx(tx)
+
+	trades, err := lockTrades(ctx, q, t.PortfolioID)
+	if err != nil {
+		return err
+	}
+	if len(trades) >= portfolio.MaxTrades {
+		return portfolio.ErrTooManyTrades
+	}
+	pending := *t
+	pending.CreatedAt = time.Now()
+	if _, err := portfolio.BuildPositions(append(trades, pending), time.Time{}); err != nil {
+		return err
+	}
+
+	row, err := q.CreatePortfolioTrade(ctx, sqlc.CreatePortfolioTradeParams{
+		ID:            t.ID,
+		PortfolioID:   t.PortfolioID,
+		Symbol:        t.Symbol,
+		Market:        t.Market,
+		Side:          t.Side,
+		Quantity:      t.Quantity.Numeric(),
+		Price:         t.Price.Numeric(),
+		Fee:           t.Fee.Numeric(),
+		ExecutedAt:    t.ExecutedAt,
+		Source:        t.Source,
+		Signal:        string(t.Signal),
+		KlineInterval: t.Interval,
+		ModelVersion:  int32(t.ModelVersion),
+		Note:          t.Note,
+	})
+	if err != nil {
+		return err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	t.CreatedAt = row.CreatedAt
+	return nil
+}
+
+func (r *PortfolioRepo) DeleteTrade(ctx context.Context, portfolioID, tradeID uuid.UUID) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	q := r.queries.WithTx(tx)
+
+	trades, err := lockTrades(ctx, q, portfolioID)
+	if err != nil {
+		return err
+	}
+	remaining := make([]portfolio.Trade, 0, len(trades))
+	for _, t := range trades {
+		if t.ID != tradeID {
+			remaining = append(remaining, t)
+		}
+	}
+	if len(remaining) == len(trades) {
+		return portfolio.ErrTradeNotFound
+	}
+	if _, err := portfolio.BuildPositions(remaining, time.Time{}); err != nil {
+		return err
+	}
+
+	if _, err := q.DeletePortfolioTrade(ctx, sqlc.DeletePortfolioTradeParams{
+		ID:          tradeID,
+		PortfolioID: portfolioID,
+	}); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// lockTrades locks the portfolio row, so journal changes are checked one at
+// a time, and returns its trades.
+func lockTrades(ctx context.Context, q *sqlc.Queries, portfolioID uuid.UUID) ([]portfolio.Trade, error) {
+	n, err := q.LockPortfolio(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, portfolio.ErrPortfolioNotFound
+	}
+	rows, err := q.ListPortfolioTrades(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+	return toTrades(rows)
+}
+
+func toPortfolio(row sqlc.Portfolio) portfolio.Entity {
+	return portfolio.Entity{
+		ID:             row.ID,
+		OrganizationID: row.OrganizationID,
+		UserID:         row.UserID,
+		Name:           row.Name,
+		Currency:       row.Currency,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}
+}
+
+func toPortfolios(rows []sqlc.Portfolio) []portfolio.Entity {
+	out := make([]portfolio.Entity, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, toPortfolio(row))
+	}
+	return out
+}
+
+func toTrades(rows []sqlc.PortfolioTrade) ([]portfolio.Trade, error) {
+	out := make([]portfolio.Trade, 0, len(rows))
+	for _, row := range rows {
+		quantity, err := helpers.NumericToDecimal(row.Quantity)
+		if err != nil {
+			return nil, err
+		}
+		price, err := helpers.NumericToDecimal(row.Price)
+		if err != nil {
+			return nil, err
+		}
+		fee, err := helpers.NumericToDecimal(row.Fee)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, portfolio.Trade{
+			ID:           row.ID,
+			PortfolioID:  row.PortfolioID,
+			Symbol:       row.Symbol,
+			Market:       row.Market,
+			Side:         row.Side,
+			Quantity:     quantity,
+			Price:        price,
+			Fee:          fee,
+			ExecutedAt:   row.ExecutedAt,
+			Source:       row.Source,
+			Signal:       coinai.Signal(row.Signal),
+			Interval:     row.KlineInterval,
+			ModelVersion: int(row.ModelVersion),
+			Note:         row.Note,
+			CreatedAt:    row.CreatedAt,
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,214 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/market/domain/watchlist"
+	sqlc "go-ai/internal/market/infrastructure/sqlc/watchlist"
+	"go-ai/pkg/pgerr"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type WatchlistRepo struct {
+	queries *sqlc.Queries
+}
+
+func NewWatchlistRepo(pool *pgxpool.Pool) *WatchlistRepo {
+	return &WatchlistRepo{
+		queries: sqlc.New(pool),
+	}
+}
+
+func (r *WatchlistRepo) Create(ctx context.Context, w *watchlist.Entity) error {
+	row, err := r.queries.CreateWatchlist(ctx, sqlc.CreateWatchlistParams{
+		ID:             w.ID,
+		OrganizationID: w.OrganizationID,
+		UserID:         w.UserID,
+		Name:           w.Name,
+	})
+	if err != nil {
+		if pgerr.IsUniqueViolation(err, "uq_watchlists_name") {
+			return watchlist.ErrNameAlreadyExists
+		}
+		return err
+	}
+	w.Position = int(row.Position)
+	w.CreatedAt = row.CreatedAt
+	w.UpdatedAt = row.UpdatedAt
+	return nil
+}
+
+func (r *WatchlistRepo) GetByID(ctx context.Context, orgID, userID, id uuid.UUID) (*watchlist.Entity, error) {
+	row, err := r.queries.GetWatchlist(ctx, sqlc.GetWatchlistParams{
+		ID:             id,
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, watchlist.ErrWatchlistNotFound
+		}
+		return nil, err
+	}
+	lists, err := r.withItems(ctx, []sqlc.Watchlist{row})
+	if err != nil {
+		return nil, err
+	}
+	return &lists[0], nil
+}
+
+func (r *WatchlistRepo) List(ctx context.Context, orgID, userID uuid.UUID) ([]watchlist.Entity, error) {
+	rows, err := r.queries.ListWatchlists(ctx, sqlc.ListWatchlistsParams{
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.withItems(ctx, rows)
+}
+
+func (r *WatchlistRepo) ListByUser(ctx context.Context, userID uuid.UUID) ([]watchlist.Entity, error) {
+	rows, err := r.queries.ListUserWatchlists(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return r.withItems(ctx, rows)
+}
+
+func (r *WatchlistRepo) Rename(ctx context.Context, orgID, userID, id uuid.UUID, name string) error {
+	affected, err := r.queries.RenameWatchlist(ctx, sqlc.RenameWatchlistParams{
+		Name:           name,
+		ID:             id,
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	if err != nil {
+		if pgerr.IsUniqueViolation(err, "uq_watchlists_name") {
+			return watchlist.ErrNameAlreadyExists
+		}
+		return err
+	}
+	if affected == 0 {
+		return watchlist.ErrWatchlistNotFound
+	}
+	return nil
+}
+
+func (r *WatchlistRepo) Delete(ctx context.Context, orgID, userID, id uuid.UUID) error {
+	affected, err := r.queries.DeleteWatchlist(ctx, sqlc.DeleteWatchlistParams{
+		ID:             id,
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return watchlist.ErrWatchlistNotFound
+	}
+	return nil
+}
+
+func (r *WatchlistRepo) Reorder(ctx context.Context, orgID, userID uuid.UUID, ids []uuid.UUID) error {
+	_, err := r.queries.ReorderWatchlists(ctx, sqlc.ReorderWatchlistsParams{
+		Ids:            ids,
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	return err
+}
+
+func (r *WatchlistRepo) DeleteByOrganization(ctx context.Context, orgID uuid.UUID) (int64, error) {
+	return r.queries.DeleteOrganizationWatchlists(ctx, orgID)
+}
+
+func (r *WatchlistRepo) DeleteByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return r.queries.DeleteUserWatchlists(ctx, userID)
+}
+
+func (r *WatchlistRepo) AddItem(ctx context.Context, item *watchlist.Item) error {
+	row, err := r.queries.CreateWatchlistItem(ctx, sqlc.CreateWatchlistItemParams{
+		ID:            item.ID,
+		WatchlistID:   item.WatchlistID,
+		Symbol:        item.Symbol,
+		Market:        item.Market,
+		KlineInterval: item.Interval,
+	})
+	if err != nil {
+		if pgerr.IsUniqueViolation(err, "uq_watchlist_items_symbol") {
+			return watchlist.ErrItemAlreadyExists
+		}
+		return err
+	}
+	item.Position = int(row.Position)
+	item.CreatedAt = row.CreatedAt
+	return nil
+}
+
+func (r *WatchlistRepo) RemoveItem(ctx context.Context, watchlistID, itemID uuid.UUID) error {
+	affected, err := r.queries.DeleteWatchlistItem(ctx, sqlc.DeleteWatchlistItemParams{
+		ID:          itemID,
+		WatchlistID: watchlistID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return watchlist.ErrItemNotFound
+	}
+	return nil
+}
+
+func (r *WatchlistRepo) ReorderItems(ctx context.Context, watchlistID uuid.UUID, ids []uuid.UUID) error {
+	_, err := r.queries.ReorderWatchlistItems(ctx, sqlc.ReorderWatchlistItemsParams{
+		Ids:         ids,
+		WatchlistID: watchlistID,
+	})
+	return err
+}
+
+// withItems loads the items of every list in one query.
+func (r *WatchlistRepo) withItems(ctx context.Context, rows []sqlc.Watchlist) ([]watchlist.Entity, error) {
+	lists := make([]watchlist.Entity, 0, len(rows))
+	if len(rows) == 0 {
+		return lists, nil
+	}
+	ids := make([]uuid.UUID, 0, len(rows))
+	index := make(map[uuid.UUID]int, len(rows))
+	for i, row := range rows {
+		ids = append(ids, row.ID)
+		index[row.ID] = i
+		lists = append(lists, watchlist.Entity{
+			ID:             row.ID,
+			OrganizationID: row.OrganizationID,
+			UserID:         row.UserID,
+			Name:           row.Name,
+			Position:       int(row.Position),
+			Items:          []watchlist.Item{},
+			CreatedAt:      row.CreatedAt,
+			UpdatedAt:      row.UpdatedAt,
+		})
+	}
+
+	items, err := r.queries.ListWatchlistItems(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, it := range items {
+		i := index[it.WatchlistID]
+		lists[i].Items = append(lists[i].Items, watchlist.Item{
+			ID:          it.ID,
+			WatchlistID: it.WatchlistID,
+			Symbol:      it.Symbol,
+			Market:      it.Market,
+			Interval:    it.KlineInterval,
+			Position:    int(it.Position),
+			CreatedAt:   it.CreatedAt,
+		})
+	}
+	return lists, nil
+}
@@ -0,0 +1,250 @@
+package markethttp
+
+import (
+	alertapp "go-ai/internal/market/application/alert"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/rs/zerolog"
+)
+
+type AlertHandler struct {
+	ListAlertsUseCase      *alertapp.ListAlertsUseCase
+	CreateAlertUseCase     *alertapp.CreateAlertUseCase
+	GetAlertUseCase        *alertapp.GetAlertUseCase
+	UpdateAlertUseCase     *alertapp.UpdateAlertUseCase
+	DeleteAlertUseCase     *alertapp.DeleteAlertUseCase
+	ListAlertEventsUseCase *alertapp.ListAlertEventsUseCase
+	Logger                 zerolog.Logger
+}
+
+func NewAlertHandler(
+	listAlertsUseCase *alertapp.ListAlertsUseCase,
+	createAlertUseCase *alertapp.CreateAlertUseCase,
+	getAlertUseCase *alertapp.GetAlertUseCase,
+	updateAlertUseCase *alertapp.UpdateAlertUseCase,
+	deleteAlertUseCase *alertapp.DeleteAlertUseCase,
+	listAlertEventsUseCase *alertapp.ListAlertEventsUseCase,
+	logger zerolog.Logger,
+) *AlertHandler {
+	return &AlertHandler{
+		ListAlertsUseCase:      listAlertsUseCase,
+		CreateAlertUseCase:     createAlertUseCase,
+		GetAlertUseCase:        getAlertUseCase,
+		UpdateAlertUseCase:     updateAlertUseCase,
+		DeleteAlertUseCase:     deleteAlertUseCase,
+		ListAlertEventsUseCase: listAlertEventsUseCase,
+		Logger:                 logger.With().Str("component", "AlertHandler").Logger(),
+	}
+}
+
+// ListAlerts godoc
+// @Summary List alerts
+// @Description List the caller's alerts in the active organization
+// @Tags Alerts
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Success 200 {object} alertapp.ListAlertsSuccessResponseDoc "Alerts retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/alerts [get]
+func (h *AlertHandler) ListAlerts(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	alerts, err := h.ListAlertsUseCase.Execute(c.Request().Context(), orgID, userID)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to list alerts")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, alerts, "Alerts retrieved successfully")
+}
+
+// CreateAlert godoc
+// @Summary Create an alert
+// @Description Create a price_cross, percent_move or signal_change alert on a coin symbol/interval. It fires on candles that close after it was created
+// @Tags Alerts
+// @Accept json
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param body body alertapp.CreateAlertRequest true "Alert definition"
+// @Success 200 {object} alertapp.AlertSuccessResponseDoc "Alert created successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/alerts [post]
+func (h *AlertHandler) CreateAlert(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	var in alertapp.CreateAlertRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	created, err := h.CreateAlertUseCase.Execute(c.Request().Context(), orgID, userID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to create alert")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, created, "Alert created successfully")
+}
+
+// GetAlert godoc
+// @Summary Get an alert
+// @Description Get one alert with its delivery settings and last trigger time
+// @Tags Alerts
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Alert ID"
+// @Success 200 {object} alertapp.AlertSuccessResponseDoc "Alert retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/alerts/{id} [get]
+func (h *AlertHandler) GetAlert(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid alert ID")
+	}
+	result, err := h.GetAlertUseCase.Execute(c.Request().Context(), orgID, userID, id)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to get alert")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Alert retrieved successfully")
+}
+
+// UpdateAlert godoc
+// @Summary Update an alert
+// @Description Change the name, channels, webhook URL, cooldown or enabled flag. Re-enabling an alert takes a new baseline
+// @Tags Alerts
+// @Accept json
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Alert ID"
+// @Param body body alertapp.UpdateAlertRequest true "Fields to change"
+// @Success 200 {object} alertapp.AlertSuccessResponseDoc "Alert updated successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/alerts/{id} [patch]
+func (h *AlertHandler) UpdateAlert(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid alert ID")
+	}
+	var in alertapp.UpdateAlertRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	result, err := h.UpdateAlertUseCase.Execute(c.Request().Context(), orgID, userID, id, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to update alert")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Alert updated successfully")
+}
+
+// DeleteAlert godoc
+// @Summary Delete an alert
+// @Description Delete an alert and its trigger history
+// @Tags Alerts
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Alert ID"
+// @Success 200 {object} response.SuccessBaseDoc "Alert deleted successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/alerts/{id} [delete]
+func (h *AlertHandler) DeleteAlert(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid alert ID")
+	}
+	if err := h.DeleteAlertUseCase.Execute(c.Request().Context(), orgID, userID, id); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to delete alert")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Alert deleted successfully")
+}
+
+// ListAlertEvents godoc
+// @Summary List alert history
+// @Description List the times an alert fired, newest first, with the outcome of each delivery
+// @Tags Alerts
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Alert ID"
+// @Param page query int false "Page number"
+// @Param limit query int false "Page size (max 100)"
+// @Success 200 {object} alertapp.ListAlertEventsSuccessResponseDoc "Alert events retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/alerts/{id}/events [get]
+func (h *AlertHandler) ListAlertEvents(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid alert ID")
+	}
+	var in alertapp.ListAlertEventsRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid query parameters")
+	}
+	events, err := h.ListAlertEventsUseCase.Execute(c.Request().Context(), orgID, userID, id, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to list alert events")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, events, "Alert events retrieved successfully")
+}
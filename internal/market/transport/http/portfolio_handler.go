@@ -0,0 +1,457 @@
+package markethttp
+
+import (
+	portfolioapp "go-ai/internal/market/application/portfolio"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/rs/zerolog"
+)
+
+type PortfolioHandler struct {
+	ListPortfoliosUseCase  *portfolioapp.ListPortfoliosUseCase
+	CreatePortfolioUseCase *portfolioapp.CreatePortfolioUseCase
+	GetPortfolioUseCase    *portfolioapp.GetPortfolioUseCase
+	UpdatePortfolioUseCase *portfolioapp.UpdatePortfolioUseCase
+	DeletePortfolioUseCase *portfolioapp.DeletePortfolioUseCase
+	ListTradesUseCase      *portfolioapp.ListTradesUseCase
+	AddTradeUseCase        *portfolioapp.AddTradeUseCase
+	SignalTradeUseCase     *portfolioapp.SignalTradeUseCase
+	DeleteTradeUseCase     *portfolioapp.DeleteTradeUseCase
+	PositionsUseCase       *portfolioapp.PositionsUseCase
+	PerformanceUseCase     *portfolioapp.PerformanceUseCase
+	Logger                 zerolog.Logger
+}
+
+func NewPortfolioHandler(
+	listPortfoliosUseCase *portfolioapp.ListPortfoliosUseCase,
+	createPortfolioUseCase *portfolioapp.CreatePortfolioUseCase,
+	getPortfolioUseCase *portfolioapp.GetPortfolioUseCase,
+	updatePortfolioUseCase *portfolioapp.UpdatePortfolioUseCase,
+	deletePortfolioUseCase *portfolioapp.DeletePortfolioUseCase,
+	listTradesUseCase *portfolioapp.ListTradesUseCase,
+	addTradeUseCase *portfolioapp.AddTradeUseCase,
+	signalTradeUseCase *portfolioapp.SignalTradeUseCase,
+	deleteTradeUseCase *portfolioapp.DeleteTradeUseCase,
+	positionsUseCase *portfolioapp.PositionsUseCase,
+	performanceUseCase *portfolioapp.PerformanceUseCase,
+	logger zerolog.Logger,
+) *PortfolioHandler {
+	return &PortfolioHandler{
+		ListPortfoliosUseCase:  listPortfoliosUseCase,
+		CreatePortfolioUseCase: createPortfolioUseCase,
+		GetPortfolioUseCase:    getPortfolioUseCase,
+		UpdatePortfolioUseCase: updatePortfolioUseCase,
+		DeletePortfolioUseCase: deletePortfolioUseCase,
+		ListTradesUseCase:      listTradesUseCase,
+		AddTradeUseCase:        addTradeUseCase,
+		SignalTradeUseCase:     signalTradeUseCase,
+		DeleteTradeUseCase:     deleteTradeUseCase,
+		PositionsUseCase:       positionsUseCase,
+		PerformanceUseCase:     performanceUseCase,
+		Logger:                 logger.With().Str("component", "PortfolioHandler").Logger(),
+	}
+}
+
+// ListPortfolios godoc
+// @Summary List portfolios
+// @Description List the caller's portfolios in the active organization
+// @Tags Portfolios
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Success 200 {object} portfolioapp.ListPortfoliosSuccessResponseDoc "Portfolios retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/portfolios [get]
+func (h *PortfolioHandler) ListPortfolios(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	list, err := h.ListPortfoliosUseCase.Execute(c.Request().Context(), orgID, userID)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to list portfolios")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, list, "Portfolios retrieved successfully")
+}
+
+// CreatePortfolio godoc
+// @Summary Create a portfolio
+// @Description Create an empty virtual portfolio
+// @Tags Portfolios
+// @Accept json
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param body body portfolioapp.CreatePortfolioRequest true "Portfolio name and currency"
+// @Success 200 {object} portfolioapp.PortfolioSuccessResponseDoc "Portfolio created successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/portfolios [post]
+func (h *PortfolioHandler) CreatePortfolio(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	var in portfolioapp.CreatePortfolioRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	created, err := h.CreatePortfolioUseCase.Execute(c.Request().Context(), orgID, userID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to create portfolio")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, created, "Portfolio created successfully")
+}
+
+// GetPortfolio godoc
+// @Summary Get a portfolio
+// @Description Get one portfolio
+// @Tags Portfolios
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Portfolio ID"
+// @Success 200 {object} portfolioapp.PortfolioSuccessResponseDoc "Portfolio retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/portfolios/{id} [get]
+func (h *PortfolioHandler) GetPortfolio(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid portfolio ID")
+	}
+	result, err := h.GetPortfolioUseCase.Execute(c.Request().Context(), orgID, userID, id)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to get portfolio")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Portfolio retrieved successfully")
+}
+
+// UpdatePortfolio godoc
+// @Summary Rename a portfolio
+// @Description Change the name of a portfolio
+// @Tags Portfolios
+// @Accept json
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Portfolio ID"
+// @Param body body portfolioapp.UpdatePortfolioRequest true "New name"
+// @Success 200 {object} portfolioapp.PortfolioSuccessResponseDoc "Portfolio updated successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/portfolios/{id} [patch]
+func (h *PortfolioHandler) UpdatePortfolio(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid portfolio ID")
+	}
+	var in portfolioapp.UpdatePortfolioRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	result, err := h.UpdatePortfolioUseCase.Execute(c.Request().Context(), orgID, userID, id, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to update portfolio")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Portfolio updated successfully")
+}
+
+// DeletePortfolio godoc
+// @Summary Delete a portfolio
+// @Description Delete a portfolio and its trade journal
+// @Tags Portfolios
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Portfolio ID"
+// @Success 200 {object} response.SuccessBaseDoc "Portfolio deleted successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/portfolios/{id} [delete]
+func (h *PortfolioHandler) DeletePortfolio(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid portfolio ID")
+	}
+	if err := h.DeletePortfolioUseCase.Execute(c.Request().Context(), orgID, userID, id); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to delete portfolio")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Portfolio deleted successfully")
+}
+
+// ListTrades godoc
+// @Summary List portfolio trades
+// @Description List the trade journal, newest first
+// @Tags Portfolios
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Portfolio ID"
+// @Param page query int false "Page number"
+// @Param limit query int false "Page size (max 100)"
+// @Success 200 {object} portfolioapp.ListTradesSuccessResponseDoc "Trades retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/portfolios/{id}/trades [get]
+func (h *PortfolioHandler) ListTrades(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid portfolio ID")
+	}
+	var in portfolioapp.ListTradesRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid query parameters")
+	}
+	trades, err := h.ListTradesUseCase.Execute(c.Request().Context(), orgID, userID, id, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to list trades")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, trades, "Trades retrieved successfully")
+}
+
+// AddTrade godoc
+// @Summary Record a trade
+// @Description Add a manual buy or sell. Amounts are decimal strings; a sell may not exceed the quantity held at its execution time
+// @Tags Portfolios
+// @Accept json
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Portfolio ID"
+// @Param body body portfolioapp.AddTradeRequest true "Trade"
+// @Success 200 {object} portfolioapp.TradeSuccessResponseDoc "Trade recorded successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/portfolios/{id}/trades [post]
+func (h *PortfolioHandler) AddTrade(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid portfolio ID")
+	}
+	var in portfolioapp.AddTradeRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	trade, err := h.AddTradeUseCase.Execute(c.Request().Context(), orgID, userID, id, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to add trade")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, trade, "Trade recorded successfully")
+}
+
+// AddSignalTrade godoc
+// @Summary Record a trade from the model signal
+// @Description Predict with the production model for the symbol/interval and buy on BUY or sell on SELL at the last closed candle's price. HOLD records nothing
+// @Tags Portfolios
+// @Accept json
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Portfolio ID"
+// @Param body body portfolioapp.SignalTradeRequest true "Symbol, interval and quantity"
+// @Success 200 {object} portfolioapp.TradeSuccessResponseDoc "Trade recorded successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/portfolios/{id}/trades/signal [post]
+func (h *PortfolioHandler) AddSignalTrade(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid portfolio ID")
+	}
+	var in portfolioapp.SignalTradeRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	trade, err := h.SignalTradeUseCase.Execute(c.Request().Context(), orgID, userID, id, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to add signal trade")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, trade, "Trade recorded successfully")
+}
+
+// DeleteTrade godoc
+// @Summary Delete a trade
+// @Description Remove a trade from the journal. Fails if a later sell depends on it
+// @Tags Portfolios
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Portfolio ID"
+// @Param trade_id path string true "Trade ID"
+// @Success 200 {object} response.SuccessBaseDoc "Trade deleted successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/portfolios/{id}/trades/{trade_id} [delete]
+func (h *PortfolioHandler) DeleteTrade(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid portfolio ID")
+	}
+	tradeID, err := uuid.Parse(c.Param("trade_id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid trade ID")
+	}
+	if err := h.DeleteTradeUseCase.Execute(c.Request().Context(), orgID, userID, id, tradeID); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to delete trade")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Trade deleted successfully")
+}
+
+// ListPositions godoc
+// @Summary Get positions and PnL
+// @Description Positions at average cost with realised PnL, and unrealised PnL at the latest Binance price for coin positions
+// @Tags Portfolios
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Portfolio ID"
+// @Success 200 {object} portfolioapp.PositionsSuccessResponseDoc "Positions retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/portfolios/{id}/positions [get]
+func (h *PortfolioHandler) ListPositions(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid portfolio ID")
+	}
+	result, err := h.PositionsUseCase.Execute(c.Request().Context(), orgID, userID, id)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to list positions")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Positions retrieved successfully")
+}
+
+// GetPerformance godoc
+// @Summary Get performance history
+// @Description Portfolio value and PnL at each of the last closed candles of the interval, starting at the first trade
+// @Tags Portfolios
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Portfolio ID"
+// @Param interval query string false "Candle interval between points (default 1d)"
+// @Param limit query int false "Number of points (default 30, max 365)"
+// @Success 200 {object} portfolioapp.PerformanceSuccessResponseDoc "Performance retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/portfolios/{id}/performance [get]
+func (h *PortfolioHandler) GetPerformance(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid portfolio ID")
+	}
+	var in portfolioapp.PerformanceRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid query parameters")
+	}
+	result, err := h.PerformanceUseCase.Execute(c.Request().Context(), orgID, userID, id, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to get performance")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Performance retrieved successfully")
+}
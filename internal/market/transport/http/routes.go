@@ -0,0 +1,62 @@
+package markethttp
+
+import (
+	"go-ai/internal/identity/domain/rbac"
+	middlewares "go-ai/internal/identity/transport/middlewares"
+
+	"github.com/labstack/echo/v5"
+)
+
+func RegisterWatchlistRoutes(api *echo.Group, h *WatchlistHandler, m *middlewares.IdentityMiddleware, rbacService rbac.Service) {
+	// Editing lists needs a session. Quotes also accept API keys with
+	// models:predict, so scripts can poll them.
+	watchlists := api.Group("/watchlists", m.SessionOnly, m.Organization)
+	quotes := api.Group("/watchlists", m.Handler, m.Organization)
+	predict := middlewares.RequirePermission(rbacService, rbac.ModelsPredict)
+
+	watchlists.GET("", h.ListWatchlists)
+	watchlists.POST("", h.CreateWatchlist)
+	watchlists.PUT("/order", h.ReorderWatchlists)
+	watchlists.GET("/:id", h.GetWatchlist)
+	watchlists.PATCH("/:id", h.UpdateWatchlist)
+	watchlists.DELETE("/:id", h.DeleteWatchlist)
+	watchlists.POST("/:id/items", h.AddItem)
+	watchlists.PUT("/:id/items/order", h.ReorderItems)
+	watchlists.DELETE("/:id/items/:item_id", h.RemoveItem)
+	quotes.GET("/:id/quotes", h.QuoteWatchlist, predict)
+}
+
+func RegisterAlertRoutes(api *echo.Group, h *AlertHandler, m *middlewares.IdentityMiddleware) {
+	alerts := api.Group("/alerts", m.SessionOnly, m.Organization)
+
+	alerts.GET("", h.ListAlerts)
+	alerts.POST("", h.CreateAlert)
+	alerts.GET("/:id", h.GetAlert)
+	alerts.PATCH("/:id", h.UpdateAlert)
+	alerts.DELETE("/:id", h.DeleteAlert)
+	alerts.GET("/:id/events", h.ListAlertEvents)
+}
+
+func RegisterPortfolioRoutes(api *echo.Group, h *PortfolioHandler, m *middlewares.IdentityMiddleware) {
+	portfolios := api.Group("/portfolios", m.SessionOnly, m.Organization)
+
+	portfolios.GET("", h.ListPortfolios)
+	portfolios.POST("", h.CreatePortfolio)
+	portfolios.GET("/:id", h.GetPortfolio)
+	portfolios.PATCH("/:id", h.UpdatePortfolio)
+	portfolios.DELETE("/:id", h.DeletePortfolio)
+	portfolios.GET("/:id/trades", h.ListTrades)
+	portfolios.POST("/:id/trades", h.AddTrade)
+	portfolios.POST("/:id/trades/signal", h.AddSignalTrade)
+	portfolios.DELETE("/:id/trades/:trade_id", h.DeleteTrade)
+	portfolios.GET("/:id/positions", h.ListPositions)
+	portfolios.GET("/:id/performance", h.GetPerformance)
+}
+
+func RegisterStreamRoutes(api *echo.Group, h *StreamHandler, m *middlewares.IdentityMiddleware) {
+	// Streams are for the browser, so they take sessions only: a Bearer
+	// token, or a ticket from POST /api/auth/stream-ticket for EventSource.
+	streams := api.Group("/stream", m.StreamTicket, m.Organization)
+
+	streams.GET("", h.Stream)
+}
@@ -0,0 +1,113 @@
+package markethttp
+
+import (
+	"errors"
+	streamapp "go-ai/internal/market/application/stream"
+	"go-ai/internal/market/domain/stream"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"go-ai/pkg/sse"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/rs/zerolog"
+)
+
+// streamRetry tells browsers how long to wait before reconnecting.
+const streamRetry = 3 * time.Second
+
+type StreamHandler struct {
+	Hub    *streamapp.Hub
+	Logger zerolog.Logger
+}
+
+func NewStreamHandler(hub *streamapp.Hub, logger zerolog.Logger) *StreamHandler {
+	return &StreamHandler{
+		Hub:    hub,
+		Logger: logger.With().Str("component", "StreamHandler").Logger(),
+	}
+}
+
+// Stream godoc
+// @Summary Stream live prices and signals
+// @Description Server-sent events for the given symbols: candle when a candle closes, prediction from the organization's production model on that candle, signal.changed, the caller's alert.triggered and a heartbeat. Send Last-Event-ID to resume after a disconnect
+// @Tags Stream
+// @Produce text/event-stream
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param Last-Event-ID header string false "ID of the last event received, to resume from"
+// @Param ticket query string false "Single-use ticket from POST /api/auth/stream-ticket, instead of an Authorization header"
+// @Param last_event_id query string false "Same as Last-Event-ID, for clients that cannot send it"
+// @Param symbols query string true "Comma-separated symbols, e.g. BTCUSDT,ETHUSDT"
+// @Param interval query string false "Candle interval, defaults to 1h"
+// @Success 200 {string} string "Event stream"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/stream [get]
+func (h *StreamHandler) Stream(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	sub, err := stream.NewSubscription(orgID, userID, c.QueryParam("symbols"), c.QueryParam("interval"))
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		return response.Error(c, http.StatusBadRequest, "Invalid query parameters")
+	}
+
+	ctx := c.Request().Context()
+	// A browser reconnecting with a new ticket opens a new EventSource,
+	// which cannot set Last-Event-ID, so it may pass it as a parameter.
+	lastEventID := c.Request().Header.Get(sse.LastEventIDHeader)
+	if lastEventID == "" {
+		lastEventID = c.QueryParam("last_event_id")
+	}
+	client, err := h.Hub.Open(ctx, *sub, lastEventID)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to open stream")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	defer h.Hub.Close(ctx, client)
+
+	w := c.Response()
+	rc := http.NewResponseController(w)
+	// The server's read and write timeouts are sized for JSON responses; a
+	// stream stays open until the client leaves.
+	for _, setDeadline := range []func(time.Time) error{rc.SetReadDeadline, rc.SetWriteDeadline} {
+		if err := setDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			h.Logger.Warn().Err(err).Msg("failed to clear stream deadline")
+		}
+	}
+	w.Header().Set(echo.HeaderContentType, sse.ContentType)
+	w.Header().Set(echo.HeaderCacheControl, "no-cache")
+	w.Header().Set(echo.HeaderConnection, "keep-alive")
+	// Stops nginx from buffering the stream.
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if err := sse.Write(w, sse.Event{Retry: streamRetry}); err != nil {
+		return nil
+	}
+	if err := rc.Flush(); err != nil {
+		return nil
+	}
+	err = h.Hub.Serve(ctx, client, func(e stream.Event) error {
+		if err := sse.Write(w, sse.Event{ID: e.ID, Type: e.Type, Data: e.Data}); err != nil {
+			return err
+		}
+		return rc.Flush()
+	})
+	if err != nil && !errors.Is(err, streamapp.ErrDropped) && ctx.Err() == nil {
+		h.Logger.Warn().Err(err).Str("connection_id", client.ID.String()).Msg("stream ended")
+	}
+	return nil
+}
@@ -0,0 +1,409 @@
+package markethttp
+
+import (
+	watchlistapp "go-ai/internal/market/application/watchlist"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/rs/zerolog"
+)
+
+type WatchlistHandler struct {
+	ListWatchlistsUseCase    *watchlistapp.ListWatchlistsUseCase
+	CreateWatchlistUseCase   *watchlistapp.CreateWatchlistUseCase
+	GetWatchlistUseCase      *watchlistapp.GetWatchlistUseCase
+	UpdateWatchlistUseCase   *watchlistapp.UpdateWatchlistUseCase
+	DeleteWatchlistUseCase   *watchlistapp.DeleteWatchlistUseCase
+	ReorderWatchlistsUseCase *watchlistapp.ReorderWatchlistsUseCase
+	AddItemUseCase           *watchlistapp.AddItemUseCase
+	RemoveItemUseCase        *watchlistapp.RemoveItemUseCase
+	ReorderItemsUseCase      *watchlistapp.ReorderItemsUseCase
+	QuoteWatchlistUseCase    *watchlistapp.QuoteWatchlistUseCase
+	Logger                   zerolog.Logger
+}
+
+func NewWatchlistHandler(
+	listWatchlistsUseCase *watchlistapp.ListWatchlistsUseCase,
+	createWatchlistUseCase *watchlistapp.CreateWatchlistUseCase,
+	getWatchlistUseCase *watchlistapp.GetWatchlistUseCase,
+	updateWatchlistUseCase *watchlistapp.UpdateWatchlistUseCase,
+	deleteWatchlistUseCase *watchlistapp.DeleteWatchlistUseCase,
+	reorderWatchlistsUseCase *watchlistapp.ReorderWatchlistsUseCase,
+	addItemUseCase *watchlistapp.AddItemUseCase,
+	removeItemUseCase *watchlistapp.RemoveItemUseCase,
+	reorderItemsUseCase *watchlistapp.ReorderItemsUseCase,
+	quoteWatchlistUseCase *watchlistapp.QuoteWatchlistUseCase,
+	logger zerolog.Logger,
+) *WatchlistHandler {
+	return &WatchlistHandler{
+		ListWatchlistsUseCase:    listWatchlistsUseCase,
+		CreateWatchlistUseCase:   createWatchlistUseCase,
+		GetWatchlistUseCase:      getWatchlistUseCase,
+		UpdateWatchlistUseCase:   updateWatchlistUseCase,
+		DeleteWatchlistUseCase:   deleteWatchlistUseCase,
+		ReorderWatchlistsUseCase: reorderWatchlistsUseCase,
+		AddItemUseCase:           addItemUseCase,
+		RemoveItemUseCase:        removeItemUseCase,
+		ReorderItemsUseCase:      reorderItemsUseCase,
+		QuoteWatchlistUseCase:    quoteWatchlistUseCase,
+		Logger:                   logger.With().Str("component", "WatchlistHandler").Logger(),
+	}
+}
+
+// ListWatchlists godoc
+// @Summary List watchlists
+// @Description List the caller's watchlists in the active organization, in order, with their items
+// @Tags Watchlists
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Success 200 {object} watchlistapp.ListWatchlistsSuccessResponseDoc "Watchlists retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/watchlists [get]
+func (h *WatchlistHandler) ListWatchlists(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	lists, err := h.ListWatchlistsUseCase.Execute(c.Request().Context(), orgID, userID)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to list watchlists")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, lists, "Watchlists retrieved successfully")
+}
+
+// CreateWatchlist godoc
+// @Summary Create a watchlist
+// @Description Create an empty watchlist after the existing ones
+// @Tags Watchlists
+// @Accept json
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param body body watchlistapp.CreateWatchlistRequest true "Watchlist name"
+// @Success 200 {object} watchlistapp.WatchlistSuccessResponseDoc "Watchlist created successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/watchlists [post]
+func (h *WatchlistHandler) CreateWatchlist(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	var in watchlistapp.CreateWatchlistRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	created, err := h.CreateWatchlistUseCase.Execute(c.Request().Context(), orgID, userID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to create watchlist")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, created, "Watchlist created successfully")
+}
+
+// ReorderWatchlists godoc
+// @Summary Reorder watchlists
+// @Description Set the order of the watchlists. ids must list every watchlist exactly once
+// @Tags Watchlists
+// @Accept json
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param body body watchlistapp.ReorderRequest true "Watchlist IDs in the new order"
+// @Success 200 {object} watchlistapp.ListWatchlistsSuccessResponseDoc "Watchlists reordered successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/watchlists/order [put]
+func (h *WatchlistHandler) ReorderWatchlists(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	var in watchlistapp.ReorderRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	lists, err := h.ReorderWatchlistsUseCase.Execute(c.Request().Context(), orgID, userID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to reorder watchlists")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, lists, "Watchlists reordered successfully")
+}
+
+// GetWatchlist godoc
+// @Summary Get a watchlist
+// @Description Get one watchlist with its items in order
+// @Tags Watchlists
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Watchlist ID"
+// @Success 200 {object} watchlistapp.WatchlistSuccessResponseDoc "Watchlist retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/watchlists/{id} [get]
+func (h *WatchlistHandler) GetWatchlist(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid watchlist ID")
+	}
+	result, err := h.GetWatchlistUseCase.Execute(c.Request().Context(), orgID, userID, id)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to get watchlist")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Watchlist retrieved successfully")
+}
+
+// UpdateWatchlist godoc
+// @Summary Rename a watchlist
+// @Description Change the name of a watchlist
+// @Tags Watchlists
+// @Accept json
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Watchlist ID"
+// @Param body body watchlistapp.UpdateWatchlistRequest true "New name"
+// @Success 200 {object} watchlistapp.WatchlistSuccessResponseDoc "Watchlist updated successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/watchlists/{id} [patch]
+func (h *WatchlistHandler) UpdateWatchlist(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid watchlist ID")
+	}
+	var in watchlistapp.UpdateWatchlistRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	result, err := h.UpdateWatchlistUseCase.Execute(c.Request().Context(), orgID, userID, id, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to update watchlist")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Watchlist updated successfully")
+}
+
+// DeleteWatchlist godoc
+// @Summary Delete a watchlist
+// @Description Delete a watchlist and its items
+// @Tags Watchlists
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Watchlist ID"
+// @Success 200 {object} response.SuccessBaseDoc "Watchlist deleted successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/watchlists/{id} [delete]
+func (h *WatchlistHandler) DeleteWatchlist(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid watchlist ID")
+	}
+	if err := h.DeleteWatchlistUseCase.Execute(c.Request().Context(), orgID, userID, id); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to delete watchlist")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Watchlist deleted successfully")
+}
+
+// AddWatchlistItem godoc
+// @Summary Add a symbol to a watchlist
+// @Description Append a symbol to the end of a watchlist. market is coin (default) or stock; coin intervals must be Binance kline intervals
+// @Tags Watchlists
+// @Accept json
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Watchlist ID"
+// @Param body body watchlistapp.AddItemRequest true "Symbol, market and interval"
+// @Success 200 {object} watchlistapp.WatchlistSuccessResponseDoc "Watchlist item added successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/watchlists/{id}/items [post]
+func (h *WatchlistHandler) AddItem(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid watchlist ID")
+	}
+	var in watchlistapp.AddItemRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	result, err := h.AddItemUseCase.Execute(c.Request().Context(), orgID, userID, id, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to add watchlist item")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Watchlist item added successfully")
+}
+
+// ReorderWatchlistItems godoc
+// @Summary Reorder watchlist items
+// @Description Set the order of the items of a watchlist. ids must list every item exactly once
+// @Tags Watchlists
+// @Accept json
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Watchlist ID"
+// @Param body body watchlistapp.ReorderRequest true "Item IDs in the new order"
+// @Success 200 {object} watchlistapp.WatchlistSuccessResponseDoc "Watchlist items reordered successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/watchlists/{id}/items/order [put]
+func (h *WatchlistHandler) ReorderItems(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid watchlist ID")
+	}
+	var in watchlistapp.ReorderRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	result, err := h.ReorderItemsUseCase.Execute(c.Request().Context(), orgID, userID, id, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to reorder watchlist items")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Watchlist items reordered successfully")
+}
+
+// RemoveWatchlistItem godoc
+// @Summary Remove a symbol from a watchlist
+// @Description Remove one item from a watchlist
+// @Tags Watchlists
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Watchlist ID"
+// @Param item_id path string true "Item ID"
+// @Success 200 {object} response.SuccessBaseDoc "Watchlist item removed successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/watchlists/{id}/items/{item_id} [delete]
+func (h *WatchlistHandler) RemoveItem(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid watchlist ID")
+	}
+	itemID, err := uuid.Parse(c.Param("item_id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid item ID")
+	}
+	if err := h.RemoveItemUseCase.Execute(c.Request().Context(), orgID, userID, id, itemID); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to remove watchlist item")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Watchlist item removed successfully")
+}
+
+// QuoteWatchlist godoc
+// @Summary Quote a watchlist
+// @Description Return every item with its latest price and the signal of the organization's production model for that symbol/interval. Items that cannot be quoted carry an error instead of failing the request
+// @Tags Watchlists
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Watchlist ID"
+// @Success 200 {object} watchlistapp.WatchlistQuotesSuccessResponseDoc "Watchlist quoted successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/watchlists/{id}/quotes [get]
+func (h *WatchlistHandler) QuoteWatchlist(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid watchlist ID")
+	}
+	result, err := h.QuoteWatchlistUseCase.Execute(c.Request().Context(), orgID, userID, id)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to quote watchlist")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Watchlist quoted successfully")
+}
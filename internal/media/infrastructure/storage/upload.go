@@ -29,12 +29,19 @@ func (m *MinioClient) uploadObject(ctx context.Context, prefix string, file mult
 	return m.PublicURL(objectName), nil
 }
 
-func (m *MinioClient) UploadFile(ctx context.Context, file multipart.File, header *multipart.FileHeader) (string, error) {
-	return m.uploadObject(ctx, "file", file, header)
+// uploaderFolder holds what userID uploaded for the organization.
+func uploaderFolder(orgID, userID uuid.UUID) string {
+	return orgID.String() + "/" + userID.String() + "/"
 }
 
-func (m *MinioClient) UploadLogo(ctx context.Context, file multipart.File, header *multipart.FileHeader) (string, error) {
-	return m.uploadObject(ctx, "logo", file, header)
+// UploadFile stores the file under the organization's folder.
+func (m *MinioClient) UploadFile(ctx context.Context, orgID, userID uuid.UUID, file multipart.File, header *multipart.FileHeader) (string, error) {
+	return m.uploadObject(ctx, uploaderFolder(orgID, userID)+"file", file, header)
+}
+
+// UploadLogo stores the logo under the organization's folder.
+func (m *MinioClient) UploadLogo(ctx context.Context, orgID, userID uuid.UUID, file multipart.File, header *multipart.FileHeader) (string, error) {
+	return m.uploadObject(ctx, uploaderFolder(orgID, userID)+"logo", file, header)
 }
 
 func (m *MinioClient) buildObjectName(prefix, originalName string) string {
@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+)
+
+type UploadInfo struct {
+	OrganizationID uuid.UUID `json:"organization_id"`
+	Name           string    `json:"name"`
+	Url            string    `json:"url"`
+	Size           int64     `json:"size"`
+	UploadedAt     time.Time `json:"uploaded_at"`
+}
+
+// ExportUserData lists what the user uploaded to each organization.
+func (m *MinioClient) ExportUserData(ctx context.Context, userID uuid.UUID, orgIDs []uuid.UUID) (any, error) {
+	uploads := []UploadInfo{}
+	for _, orgID := range orgIDs {
+		objects := m.Client.ListObjects(ctx, m.Bucket, minio.ListObjectsOptions{
+			Prefix:    uploaderFolder(orgID, userID),
+			Recursive: true,
+		})
+		for obj := range objects {
+			if obj.Err != nil {
+				return nil, obj.Err
+			}
+			uploads = append(uploads, UploadInfo{
+				OrganizationID: orgID,
+				Name:           obj.Key,
+				Url:            m.PublicURL(obj.Key),
+				Size:           obj.Size,
+				UploadedAt:     obj.LastModified,
+			})
+		}
+	}
+	return uploads, nil
+}
+
+// PurgeUserData removes every file of the organizations deleted along with
+// the account. Uploads to organizations that live on belong to them.
+func (m *MinioClient) PurgeUserData(ctx context.Context, userID uuid.UUID, orgIDs []uuid.UUID) error {
+	var firstErr error
+	for _, orgID := range orgIDs {
+		objects := m.Client.ListObjects(ctx, m.Bucket, minio.ListObjectsOptions{
+			Prefix:    orgID.String() + "/",
+			Recursive: true,
+		})
+		// Drain the results so the removal goroutine can finish.
+		for rerr := range m.Client.RemoveObjects(ctx, m.Bucket, objects, minio.RemoveObjectsOptions{}) {
+			if rerr.Err != nil && firstErr == nil {
+				firstErr = rerr.Err
+			}
+		}
+	}
+	return firstErr
+}
@@ -8,5 +8,5 @@ import (
 
 func RegisterMediaRoutes(api *echo.Group, h *UpLoadHandler, auth *middlewares.IdentityMiddleware) {
 	r := api.Group("/upload")
-	r.POST("/logo", h.UploadLogoHandler(), auth.Handler)
+	r.POST("/logo", h.UploadLogoHandler(), auth.SessionOnly, auth.Organization)
 }
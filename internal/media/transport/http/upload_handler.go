@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v5"
 	"github.com/rs/zerolog"
 )
@@ -30,16 +31,25 @@ func NewUploadHandler(mc *storage.MinioClient, logger zerolog.Logger) *UpLoadHan
 
 // UploadLogoHandler godoc
 // @Summary Upload logo file
-// @Description Upload a logo image to storage and return the public URL
+// @Description Upload a logo image to the active organization's storage folder and return the public URL
 // @Tags Upload
 // @Accept multipart/form-data
 // @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
 // @Param logo formData file true "Logo file (png, jpg, jpeg)"
 // @Success 200 {object} app.UploadLogoSuccessResponseDoc "Upload logo success"
 // @Failure default {object} response.ErrorDoc "Errors"
 // @Router /api/upload/logo [post]
 func (h *UpLoadHandler) UploadLogoHandler() echo.HandlerFunc {
 	return func(c *echo.Context) error {
+		userID, ok := c.Get("user_id").(uuid.UUID)
+		if !ok {
+			return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+		}
+		orgID, ok := c.Get("org_id").(uuid.UUID)
+		if !ok {
+			return response.Error(c, http.StatusForbidden, "No active organization")
+		}
 		fileHeader, err := c.FormFile("logo")
 		if err != nil {
 			h.Logger.Error().Err(err).Msg("Upload logo: missing file")
@@ -76,7 +86,7 @@ func (h *UpLoadHandler) UploadLogoHandler() echo.HandlerFunc {
 		}
 		defer file.Close()
 
-		url, err := h.MC.UploadLogo(c.Request().Context(), file, fileHeader)
+		url, err := h.MC.UploadLogo(c.Request().Context(), orgID, userID, file, fileHeader)
 		if err != nil {
 			h.Logger.Error().Err(err).Msg("Upload logo: MinIO upload error")
 			return response.Error(c, http.StatusBadRequest, "Upload to storage failed")
@@ -0,0 +1,28 @@
+package registryapp
+
+import (
+	"go-ai/pkg/response"
+)
+
+type ModelVersionSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *ModelVersionResponse `json:"data,omitempty"`
+}
+
+type ModelVersionDetailSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *ModelVersionDetailResponse `json:"data,omitempty"`
+}
+
+type ListModelVersionsSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *struct {
+		response.PaginatedResponseDoc
+		Items []ModelVersionResponse `json:"items"`
+	} `json:"data,omitempty"`
+}
+
+type PredictionSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *PredictionResponse `json:"data,omitempty"`
+}
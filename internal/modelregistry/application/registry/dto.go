@@ -0,0 +1,59 @@
+package registryapp
+
+import (
+	"go-ai/internal/coinai"
+	"go-ai/internal/modelregistry/domain/registry"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ModelVersionResponse struct {
+	ID             uuid.UUID                `json:"id"`
+	Symbol         string                   `json:"symbol"`
+	Interval       string                   `json:"interval"`
+	Version        int                      `json:"version"`
+	Stage          string                   `json:"stage"`
+	LineageHash    string                   `json:"lineage_hash"`
+	ParentID       *uuid.UUID               `json:"parent_id,omitempty"`
+	Metrics        coinai.ValidationMetrics `json:"metrics"`
+	FeatureNames   []string                 `json:"feature_names"`
+	TrainedAt      time.Time                `json:"trained_at"`
+	CreatedAt      time.Time                `json:"created_at"`
+	StageChangedAt time.Time                `json:"stage_changed_at"`
+	ProductionAt   *time.Time               `json:"production_at,omitempty"`
+	RolledBackAt   *time.Time               `json:"rolled_back_at,omitempty"`
+}
+
+type ModelVersionDetailResponse struct {
+	ModelVersionResponse
+	Scaler coinai.StandardScaler `json:"scaler"`
+	Model  coinai.LinearModel    `json:"model"`
+}
+
+func toModelVersionResponse(v registry.ModelVersion) ModelVersionResponse {
+	return ModelVersionResponse{
+		ID:             v.ID,
+		Symbol:         v.Symbol,
+		Interval:       v.Interval,
+		Version:        v.Version,
+		Stage:          string(v.Stage),
+		LineageHash:    v.LineageHash,
+		ParentID:       v.ParentID,
+		Metrics:        v.Metrics,
+		FeatureNames:   v.Artifact.FeatureNames,
+		TrainedAt:      v.Artifact.TrainedAt,
+		CreatedAt:      v.CreatedAt,
+		StageChangedAt: v.StageChangedAt,
+		ProductionAt:   v.ProductionAt,
+		RolledBackAt:   v.RolledBackAt,
+	}
+}
+
+func toModelVersionDetailResponse(v registry.ModelVersion) ModelVersionDetailResponse {
+	return ModelVersionDetailResponse{
+		ModelVersionResponse: toModelVersionResponse(v),
+		Scaler:               v.Artifact.Scaler,
+		Model:                v.Artifact.Model,
+	}
+}
@@ -0,0 +1,51 @@
+package registryapp
+
+import (
+	"context"
+	"go-ai/internal/modelregistry/domain/registry"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// Reasons a version reached production, sent with model.promoted.
+const (
+	PromotedByRetrain  = "retrain"
+	PromotedByRequest  = "manual"
+	PromotedByRollback = "rollback"
+)
+
+// EventPublisher announces production changes to the organization's
+// webhooks.
+type EventPublisher interface {
+	Publish(ctx context.Context, orgID uuid.UUID, eventType string, data any) error
+}
+
+// Announcer publishes model.promoted whenever a version enters production.
+// Failures are only logged: the promotion has happened either way.
+type Announcer struct {
+	Events EventPublisher
+	Logger zerolog.Logger
+}
+
+func NewAnnouncer(events EventPublisher, logger zerolog.Logger) *Announcer {
+	return &Announcer{
+		Events: events,
+		Logger: logger.With().Str("component", "ModelAnnouncer").Logger(),
+	}
+}
+
+func (a *Announcer) Promoted(ctx context.Context, v registry.ModelVersion, reason string) {
+	err := a.Events.Publish(ctx, v.OrganizationID, "model.promoted", map[string]any{
+		"model_id":     v.ID,
+		"symbol":       v.Symbol,
+		"interval":     v.Interval,
+		"version":      v.Version,
+		"lineage_hash": v.LineageHash,
+		"metrics":      v.Metrics,
+		"reason":       reason,
+	})
+	if err != nil {
+		a.Logger.Warn().Err(err).Str("model_id", v.ID.String()).Msg("failed to publish model.promoted")
+	}
+}
@@ -0,0 +1,27 @@
+package registryapp
+
+import (
+	"context"
+	"go-ai/internal/modelregistry/domain/registry"
+
+	"github.com/google/uuid"
+)
+
+type GetModelVersionUseCase struct {
+	Repo registry.Repository
+}
+
+func NewGetModelVersionUseCase(repo registry.Repository) *GetModelVersionUseCase {
+	return &GetModelVersionUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *GetModelVersionUseCase) Execute(ctx context.Context, orgID, id uuid.UUID) (*ModelVersionDetailResponse, error) {
+	v, err := uc.Repo.GetByID(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := toModelVersionDetailResponse(*v)
+	return &resp, nil
+}
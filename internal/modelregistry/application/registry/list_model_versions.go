@@ -0,0 +1,61 @@
+package registryapp
+
+import (
+	"context"
+	"go-ai/internal/modelregistry/domain/registry"
+	"go-ai/pkg/response"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type ListModelVersionsRequest struct {
+	Symbol   string `query:"symbol"`
+	Interval string `query:"interval"`
+	Stage    string `query:"stage"`
+	Page     *int32 `query:"page"`
+	Limit    *int32 `query:"limit"`
+}
+
+type ListModelVersionsUseCase struct {
+	Repo registry.Repository
+}
+
+func NewListModelVersionsUseCase(repo registry.Repository) *ListModelVersionsUseCase {
+	return &ListModelVersionsUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *ListModelVersionsUseCase) Execute(ctx context.Context, orgID uuid.UUID, req ListModelVersionsRequest) (*response.PaginatedResponse[[]ModelVersionResponse], error) {
+	filter := registry.ListFilter{
+		OrganizationID: orgID,
+		Symbol:         normalizeSymbol(req.Symbol),
+		Interval:       strings.TrimSpace(req.Interval),
+	}
+	if req.Stage != "" {
+		stage, err := registry.ParseStage(req.Stage)
+		if err != nil {
+			return nil, err
+		}
+		filter.Stage = stage
+	}
+
+	page, limit, offset := response.ApplyDefaultPaginated(req.Page, req.Limit)
+	versions, total, err := uc.Repo.List(ctx, filter, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]ModelVersionResponse, 0, len(versions))
+	for _, v := range versions {
+		items = append(items, toModelVersionResponse(v))
+	}
+	return &response.PaginatedResponse[[]ModelVersionResponse]{
+		Page:       page,
+		Limit:      limit,
+		TotalItems: total,
+		TotalPages: response.CalculateTotalPages(total, int64(limit)),
+		Items:      items,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package registryapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/coinai"
+	"go-ai/internal/modelregistry/domain/registry"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ModelRegistry is the store background jobs use to register trained models.
+// It serves each organization's production version of a symbol/interval.
+type ModelRegistry struct {
+	Repo      registry.Repository
+	Announcer *Announcer
+}
+
+func NewModelRegistry(repo registry.Repository, announcer *Announcer) *ModelRegistry {
+	return &ModelRegistry{
+		Repo:      repo,
+		Announcer: announcer,
+	}
+}
+
+// Current returns the production model, or (nil, nil) when there is none.
+func (r *ModelRegistry) Current(ctx context.Context, orgID uuid.UUID, symbol, interval string) (*coinai.SavedModel, error) {
+	v, err := r.Repo.GetProduction(ctx, orgID, normalizeSymbol(symbol), strings.TrimSpace(interval))
+	if err != nil {
+		if errors.Is(err, registry.ErrNoProductionModel) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &v.Artifact, nil
+}
+
+// Register stores model as a new candidate version whose parent is the
+// current production version, and promotes it straight to production when
+// asked to. It returns the assigned version number.
+func (r *ModelRegistry) Register(ctx context.Context, orgID uuid.UUID, model *coinai.SavedModel, metrics coinai.ValidationMetrics, promote bool) (int, error) {
+	if model == nil {
+		return 0, registry.ErrInvalidArtifact
+	}
+	parent, err := r.Repo.GetProduction(ctx, orgID, normalizeSymbol(model.Symbol), strings.TrimSpace(model.Interval))
+	if err != nil && !errors.Is(err, registry.ErrNoProductionModel) {
+		return 0, err
+	}
+
+	var parentID *uuid.UUID
+	if parent != nil {
+		parentID = &parent.ID
+	}
+	v, err := registry.NewModelVersion(orgID, model, metrics, parentID)
+	if err != nil {
+		return 0, err
+	}
+
+	created, err := r.Repo.Create(ctx, v)
+	if err != nil {
+		return 0, err
+	}
+	if promote {
+		if err := r.Repo.Promote(ctx, orgID, created.ID, created.Symbol, created.Interval, false); err != nil {
+			return 0, err
+		}
+		r.Announcer.Promoted(ctx, *created, PromotedByRetrain)
+	}
+	return created.Version, nil
+}
+
+func normalizeSymbol(symbol string) string {
+	return strings.ToUpper(strings.TrimSpace(symbol))
+}
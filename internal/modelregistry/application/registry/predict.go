@@ -0,0 +1,87 @@
+package registryapp
+
+import (
+	"context"
+	"fmt"
+	"go-ai/internal/coinai"
+	"go-ai/internal/modelregistry/domain/registry"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// predictCandles is enough history for the feature window plus the
+// still-open candle that gets dropped.
+const predictCandles = 50
+
+type PredictRequest struct {
+	Symbol   string `query:"symbol"`
+	Interval string `query:"interval"`
+}
+
+type PredictionResponse struct {
+	ModelID         uuid.UUID     `json:"model_id"`
+	Symbol          string        `json:"symbol"`
+	Interval        string        `json:"interval"`
+	Version         int           `json:"version"`
+	CandleCloseTime time.Time     `json:"candle_close_time"`
+	PredictedReturn float64       `json:"predicted_return"`
+	Signal          coinai.Signal `json:"signal"`
+}
+
+type PredictUseCase struct {
+	Repo           registry.Repository
+	Fetcher        coinai.CandleFetcher
+	LongThreshold  float64
+	ShortThreshold float64
+}
+
+func NewPredictUseCase(repo registry.Repository, fetcher coinai.CandleFetcher) *PredictUseCase {
+	return &PredictUseCase{
+		Repo:           repo,
+		Fetcher:        fetcher,
+		LongThreshold:  coinai.DefaultLongThreshold,
+		ShortThreshold: coinai.DefaultShortThreshold,
+	}
+}
+
+// Execute predicts the next-candle return with the organization's version
+// that is in production at the time of the request. The production row is read on every
+// call so a promotion or rollback takes effect immediately.
+func (uc *PredictUseCase) Execute(ctx context.Context, orgID uuid.UUID, req PredictRequest) (*PredictionResponse, error) {
+	symbol := normalizeSymbol(req.Symbol)
+	interval := strings.TrimSpace(req.Interval)
+	if symbol == "" || interval == "" {
+		return nil, registry.ErrSymbolIntervalRequired
+	}
+
+	v, err := uc.Repo.GetProduction(ctx, orgID, symbol, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	candles, err := uc.Fetcher.FetchKlines(ctx, symbol, interval, predictCandles)
+	if err != nil {
+		return nil, fmt.Errorf("fetch candles: %w", err)
+	}
+	candles = coinai.ClosedCandles(candles, time.Now())
+	features, err := coinai.BuildLatestFeatures(candles)
+	if err != nil {
+		return nil, fmt.Errorf("build features: %w", err)
+	}
+	pred, err := v.Artifact.Predict(features)
+	if err != nil {
+		return nil, fmt.Errorf("predict: %w", err)
+	}
+
+	return &PredictionResponse{
+		ModelID:         v.ID,
+		Symbol:          v.Symbol,
+		Interval:        v.Interval,
+		Version:         v.Version,
+		CandleCloseTime: candles[len(candles)-1].CloseTime,
+		PredictedReturn: pred,
+		Signal:          coinai.SignalFromPrediction(pred, uc.LongThreshold, uc.ShortThreshold),
+	}, nil
+}
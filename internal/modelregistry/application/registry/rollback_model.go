@@ -0,0 +1,55 @@
+package registryapp
+
+import (
+	"context"
+	"go-ai/internal/modelregistry/domain/registry"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type RollbackModelRequest struct {
+	Symbol   string `json:"symbol"`
+	Interval string `json:"interval"`
+}
+
+type RollbackModelUseCase struct {
+	Repo      registry.Repository
+	Announcer *Announcer
+}
+
+func NewRollbackModelUseCase(repo registry.Repository, announcer *Announcer) *RollbackModelUseCase {
+	return &RollbackModelUseCase{
+		Repo:      repo,
+		Announcer: announcer,
+	}
+}
+
+// Execute restores the previous production version. The replaced version is
+// marked as rolled back so repeated rollbacks walk further back in history.
+func (uc *RollbackModelUseCase) Execute(ctx context.Context, orgID uuid.UUID, req RollbackModelRequest) (*ModelVersionResponse, error) {
+	symbol := normalizeSymbol(req.Symbol)
+	interval := strings.TrimSpace(req.Interval)
+	if symbol == "" || interval == "" {
+		return nil, registry.ErrSymbolIntervalRequired
+	}
+
+	if _, err := uc.Repo.GetProduction(ctx, orgID, symbol, interval); err != nil {
+		return nil, err
+	}
+	target, err := uc.Repo.GetRollbackTarget(ctx, orgID, symbol, interval)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.Promote(ctx, orgID, target.ID, symbol, interval, true); err != nil {
+		return nil, err
+	}
+
+	restored, err := uc.Repo.GetByID(ctx, orgID, target.ID)
+	if err != nil {
+		return nil, err
+	}
+	uc.Announcer.Promoted(ctx, *restored, PromotedByRollback)
+	resp := toModelVersionResponse(*restored)
+	return &resp, nil
+}
@@ -0,0 +1,59 @@
+package registryapp
+
+import (
+	"context"
+	"go-ai/internal/modelregistry/domain/registry"
+
+	"github.com/google/uuid"
+)
+
+type SetModelStageRequest struct {
+	Stage string `json:"stage"`
+}
+
+type SetModelStageUseCase struct {
+	Repo      registry.Repository
+	Announcer *Announcer
+}
+
+func NewSetModelStageUseCase(repo registry.Repository, announcer *Announcer) *SetModelStageUseCase {
+	return &SetModelStageUseCase{
+		Repo:      repo,
+		Announcer: announcer,
+	}
+}
+
+// Execute moves a version to another stage. Promoting to production archives
+// the version currently serving the same symbol/interval in one transaction.
+func (uc *SetModelStageUseCase) Execute(ctx context.Context, orgID, id uuid.UUID, req SetModelStageRequest) (*ModelVersionResponse, error) {
+	stage, err := registry.ParseStage(req.Stage)
+	if err != nil {
+		return nil, err
+	}
+	v, err := uc.Repo.GetByID(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !registry.CanTransition(v.Stage, stage) {
+		return nil, registry.ErrInvalidStageTransition
+	}
+
+	if stage == registry.StageProduction {
+		err = uc.Repo.Promote(ctx, orgID, v.ID, v.Symbol, v.Interval, false)
+	} else {
+		err = uc.Repo.SetStage(ctx, v.ID, stage)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.Repo.GetByID(ctx, orgID, id)
+	if err != nil {
+		return nil, err
+	}
+	if stage == registry.StageProduction {
+		uc.Announcer.Promoted(ctx, *updated, PromotedByRequest)
+	}
+	resp := toModelVersionResponse(*updated)
+	return &resp, nil
+}
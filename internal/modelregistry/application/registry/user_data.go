@@ -0,0 +1,63 @@
+package registryapp
+
+import (
+	"context"
+	"go-ai/internal/modelregistry/domain/registry"
+
+	"github.com/google/uuid"
+)
+
+const exportPageSize = 200
+
+type OrganizationModels struct {
+	OrganizationID uuid.UUID                    `json:"organization_id"`
+	Versions       []ModelVersionDetailResponse `json:"versions"`
+}
+
+// UserData exports and purges the model versions of a user's organizations.
+type UserData struct {
+	Repo registry.Repository
+}
+
+func NewUserData(repo registry.Repository) *UserData {
+	return &UserData{
+		Repo: repo,
+	}
+}
+
+// ExportUserData returns every model version, weights included, of the
+// organizations the user belongs to.
+func (d *UserData) ExportUserData(ctx context.Context, userID uuid.UUID, orgIDs []uuid.UUID) (any, error) {
+	out := make([]OrganizationModels, 0, len(orgIDs))
+	for _, orgID := range orgIDs {
+		models := OrganizationModels{
+			OrganizationID: orgID,
+			Versions:       []ModelVersionDetailResponse{},
+		}
+		for offset := int32(0); ; offset += exportPageSize {
+			versions, total, err := d.Repo.List(ctx, registry.ListFilter{OrganizationID: orgID}, exportPageSize, offset)
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range versions {
+				models.Versions = append(models.Versions, toModelVersionDetailResponse(v))
+			}
+			if len(versions) == 0 || int64(offset)+exportPageSize >= total {
+				break
+			}
+		}
+		out = append(out, models)
+	}
+	return out, nil
+}
+
+// PurgeUserData deletes the model versions of organizations removed along
+// with the account.
+func (d *UserData) PurgeUserData(ctx context.Context, userID uuid.UUID, orgIDs []uuid.UUID) error {
+	for _, orgID := range orgIDs {
+		if _, err := d.Repo.DeleteByOrganization(ctx, orgID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
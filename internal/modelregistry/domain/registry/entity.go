@@ -0,0 +1,107 @@
+package registry
+
+import (
+	"go-ai/internal/coinai"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Stage string
+
+const (
+	StageCandidate  Stage = "candidate"
+	StageStaging    Stage = "staging"
+	StageProduction Stage = "production"
+	StageArchived   Stage = "archived"
+)
+
+// ModelVersion is an immutable trained model owned by one organization. Only
+// its stage changes after it has been registered.
+type ModelVersion struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	Symbol         string
+	Interval       string
+	Version        int
+	Stage          Stage
+	LineageHash    string
+	ParentID       *uuid.UUID
+	Metrics        coinai.ValidationMetrics
+	Artifact       coinai.SavedModel
+	CreatedAt      time.Time
+	StageChangedAt time.Time
+	ProductionAt   *time.Time
+	RolledBackAt   *time.Time
+}
+
+type ListFilter struct {
+	OrganizationID uuid.UUID
+	Symbol         string
+	Interval       string
+	Stage          Stage
+}
+
+func ParseStage(s string) (Stage, error) {
+	stage := Stage(strings.ToLower(strings.TrimSpace(s)))
+	switch stage {
+	case StageCandidate, StageStaging, StageProduction, StageArchived:
+		return stage, nil
+	}
+	return "", ErrInvalidStage
+}
+
+// NewModelVersion validates a trained model before registration. The version
+// number is assigned by the repository.
+func NewModelVersion(orgID uuid.UUID, artifact *coinai.SavedModel, metrics coinai.ValidationMetrics, parentID *uuid.UUID) (*ModelVersion, error) {
+	if orgID == uuid.Nil {
+		return nil, ErrOrganizationRequired
+	}
+	if artifact == nil || len(artifact.Model.Weights) == 0 {
+		return nil, ErrInvalidArtifact
+	}
+	if len(artifact.Scaler.Means) != len(artifact.Model.Weights) || len(artifact.Scaler.Stds) != len(artifact.Model.Weights) {
+		return nil, ErrInvalidArtifact
+	}
+	symbol := strings.ToUpper(strings.TrimSpace(artifact.Symbol))
+	interval := strings.TrimSpace(artifact.Interval)
+	if symbol == "" || interval == "" {
+		return nil, ErrSymbolIntervalRequired
+	}
+	if artifact.LineageHash == "" {
+		return nil, ErrLineageRequired
+	}
+
+	saved := *artifact
+	saved.Symbol = symbol
+	saved.Interval = interval
+	return &ModelVersion{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		Symbol:         symbol,
+		Interval:       interval,
+		Stage:          StageCandidate,
+		LineageHash:    artifact.LineageHash,
+		ParentID:       parentID,
+		Metrics:        metrics,
+		Artifact:       saved,
+	}, nil
+}
+
+// CanTransition reports whether a version may move from one stage to another.
+// Leaving production only happens by archiving; nothing goes back to candidate.
+func CanTransition(from, to Stage) bool {
+	if from == to {
+		return false
+	}
+	switch to {
+	case StageStaging:
+		return from == StageCandidate || from == StageArchived
+	case StageProduction:
+		return from == StageCandidate || from == StageStaging || from == StageArchived
+	case StageArchived:
+		return from == StageCandidate || from == StageStaging || from == StageProduction
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package registry
+
+import (
+	"errors"
+	"go-ai/internal/coinai"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		from, to Stage
+		want     bool
+	}{
+		{StageCandidate, StageStaging, true},
+		{StageCandidate, StageProduction, true},
+		{StageStaging, StageProduction, true},
+		{StageArchived, StageProduction, true},
+		{StageProduction, StageArchived, true},
+		{StageProduction, StageStaging, false},
+		{StageProduction, StageCandidate, false},
+		{StageStaging, StageCandidate, false},
+		{StageProduction, StageProduction, false},
+	}
+	for _, tt := range tests {
+		if got := CanTransition(tt.from, tt.to); got != tt.want {
+			t.Fatalf("CanTransition(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestNewModelVersion(t *testing.T) {
+	artifact := &coinai.SavedModel{
+		LineageHash: "abc",
+		Symbol:      " btcusdt ",
+		Interval:    "1h",
+		Scaler:      coinai.StandardScaler{Means: []float64{0, 0}, Stds: []float64{1, 1}},
+		Model:       coinai.LinearModel{Weights: []float64{0.1, 0.2}},
+	}
+
+	orgID := uuid.New()
+	v, err := NewModelVersion(orgID, artifact, coinai.ValidationMetrics{MSE: 0.01}, nil)
+	if err != nil {
+		t.Fatalf("NewModelVersion error: %v", err)
+	}
+	if v.Symbol != "BTCUSDT" || v.Artifact.Symbol != "BTCUSDT" {
+		t.Fatalf("symbol = %q / %q, want BTCUSDT", v.Symbol, v.Artifact.Symbol)
+	}
+	if v.Stage != StageCandidate {
+		t.Fatalf("stage = %s, want candidate", v.Stage)
+	}
+	if v.OrganizationID != orgID {
+		t.Fatalf("organization = %s, want %s", v.OrganizationID, orgID)
+	}
+	if _, err := NewModelVersion(uuid.Nil, artifact, coinai.ValidationMetrics{}, nil); !errors.Is(err, ErrOrganizationRequired) {
+		t.Fatalf("error = %v, want ErrOrganizationRequired", err)
+	}
+
+	noLineage := *artifact
+	noLineage.LineageHash = ""
+	if _, err := NewModelVersion(orgID, &noLineage, coinai.ValidationMetrics{}, nil); !errors.Is(err, ErrLineageRequired) {
+		t.Fatalf("error = %v, want ErrLineageRequired", err)
+	}
+
+	mismatched := *artifact
+	mismatched.Scaler = coinai.StandardScaler{Means: []float64{0}, Stds: []float64{1}}
+	if _, err := NewModelVersion(orgID, &mismatched, coinai.ValidationMetrics{}, nil); !errors.Is(err, ErrInvalidArtifact) {
+		t.Fatalf("error = %v, want ErrInvalidArtifact", err)
+	}
+}
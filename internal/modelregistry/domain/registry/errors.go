@@ -0,0 +1,18 @@
+package registry
+
+import (
+	domainerr "go-ai/pkg/domain_err"
+	"net/http"
+)
+
+var (
+	ErrModelVersionNotFound   = domainerr.New(http.StatusNotFound, "Model version not found")
+	ErrNoProductionModel      = domainerr.New(http.StatusNotFound, "No production model for this symbol and interval")
+	ErrNoRollbackTarget       = domainerr.New(http.StatusConflict, "No previous production model to roll back to")
+	ErrInvalidStage           = domainerr.New(http.StatusBadRequest, "Stage must be one of candidate, staging, production, archived")
+	ErrInvalidStageTransition = domainerr.New(http.StatusConflict, "Model version cannot move to this stage")
+	ErrInvalidArtifact        = domainerr.New(http.StatusBadRequest, "Model artifact is invalid")
+	ErrSymbolIntervalRequired = domainerr.New(http.StatusBadRequest, "Symbol and interval are required")
+	ErrLineageRequired        = domainerr.New(http.StatusBadRequest, "Model lineage hash is required")
+	ErrOrganizationRequired   = domainerr.New(http.StatusBadRequest, "Model version must belong to an organization")
+)
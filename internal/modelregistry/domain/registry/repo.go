@@ -0,0 +1,27 @@
+package registry
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// Create assigns the next version number for the organization's
+	// symbol/interval.
+	Create(ctx context.Context, v *ModelVersion) (*ModelVersion, error)
+	// GetByID returns ErrModelVersionNotFound for versions of other
+	// organizations.
+	GetByID(ctx context.Context, orgID, id uuid.UUID) (*ModelVersion, error)
+	GetProduction(ctx context.Context, orgID uuid.UUID, symbol, interval string) (*ModelVersion, error)
+	// GetRollbackTarget returns the most recent former production version
+	// that has not itself been rolled back.
+	GetRollbackTarget(ctx context.Context, orgID uuid.UUID, symbol, interval string) (*ModelVersion, error)
+	List(ctx context.Context, filter ListFilter, limit, offset int32) ([]ModelVersion, int64, error)
+	SetStage(ctx context.Context, id uuid.UUID, stage Stage) error
+	// Promote atomically archives the organization's current production
+	// version of the symbol/interval and moves id into production. When
+	// rollback is true the replaced version is marked as rolled back.
+	Promote(ctx context.Context, orgID, id uuid.UUID, symbol, interval string, rollback bool) error
+	DeleteByOrganization(ctx context.Context, orgID uuid.UUID) (int64, error)
+}
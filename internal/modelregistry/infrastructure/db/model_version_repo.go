@@ -0,0 +1,226 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go-ai/internal/modelregistry/domain/registry"
+	sqlc "go-ai/internal/modelregistry/infrastructure/sqlc/registry"
+	"go-ai/pkg/pgerr"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// createRetries bounds how often Create retries when a concurrent
+// registration took the same version number.
+const createRetries = 3
+
+type ModelVersionRepo struct {
+	pool    *pgxpool.Pool
+	queries *sqlc.Queries
+}
+
+func NewModelVersionRepo(pool *pgxpool.Pool) *ModelVersionRepo {
+	return &ModelVersionRepo{
+		pool:    pool,
+		queries: sqlc.New(pool),
+	}
+}
+
+func (r *ModelVersionRepo) Create(ctx context.Context, v *registry.ModelVersion) (*registry.ModelVersion, error) {
+	metrics, err := json.Marshal(v.Metrics)
+	if err != nil {
+		return nil, fmt.Errorf("marshal metrics: %w", err)
+	}
+	// The version number lives in its own column and is filled in on read.
+	artifact := v.Artifact
+	artifact.Version = 0
+	raw, err := json.Marshal(artifact)
+	if err != nil {
+		return nil, fmt.Errorf("marshal artifact: %w", err)
+	}
+
+	for attempt := 1; ; attempt++ {
+		row, err := r.queries.CreateModelVersion(ctx, sqlc.CreateModelVersionParams{
+			ID:             v.ID,
+			OrganizationID: v.OrganizationID,
+			Symbol:         v.Symbol,
+			KlineInterval:  v.Interval,
+			Stage:          string(v.Stage),
+			LineageHash:    v.LineageHash,
+			ParentID:       v.ParentID,
+			Metrics:        metrics,
+			Artifact:       raw,
+		})
+		if err != nil {
+			if pgerr.IsUniqueViolation(err, "uq_model_versions_version") && attempt < createRetries {
+				continue
+			}
+			return nil, err
+		}
+		return toModelVersion(row)
+	}
+}
+
+func (r *ModelVersionRepo) GetByID(ctx context.Context, orgID, id uuid.UUID) (*registry.ModelVersion, error) {
+	row, err := r.queries.GetModelVersionByID(ctx, sqlc.GetModelVersionByIDParams{
+		ID:             id,
+		OrganizationID: orgID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, registry.ErrModelVersionNotFound
+		}
+		return nil, err
+	}
+	return toModelVersion(row)
+}
+
+func (r *ModelVersionRepo) GetProduction(ctx context.Context, orgID uuid.UUID, symbol, interval string) (*registry.ModelVersion, error) {
+	row, err := r.queries.GetProductionModelVersion(ctx, sqlc.GetProductionModelVersionParams{
+		OrganizationID: orgID,
+		Symbol:         symbol,
+		KlineInterval:  interval,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, registry.ErrNoProductionModel
+		}
+		return nil, err
+	}
+	return toModelVersion(row)
+}
+
+func (r *ModelVersionRepo) GetRollbackTarget(ctx context.Context, orgID uuid.UUID, symbol, interval string) (*registry.ModelVersion, error) {
+	row, err := r.queries.GetRollbackModelVersion(ctx, sqlc.GetRollbackModelVersionParams{
+		OrganizationID: orgID,
+		Symbol:         symbol,
+		KlineInterval:  interval,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, registry.ErrNoRollbackTarget
+		}
+		return nil, err
+	}
+	return toModelVersion(row)
+}
+
+func (r *ModelVersionRepo) List(ctx context.Context, filter registry.ListFilter, limit, offset int32) ([]registry.ModelVersion, int64, error) {
+	symbol := optional(filter.Symbol)
+	interval := optional(filter.Interval)
+	stage := optional(string(filter.Stage))
+
+	total, err := r.queries.CountModelVersions(ctx, sqlc.CountModelVersionsParams{
+		OrganizationID: filter.OrganizationID,
+		Symbol:         symbol,
+		KlineInterval:  interval,
+		Stage:          stage,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, err := r.queries.ListModelVersions(ctx, sqlc.ListModelVersionsParams{
+		OrganizationID: filter.OrganizationID,
+		Symbol:         symbol,
+		KlineInterval:  interval,
+		Stage:          stage,
+		LimitRows:      limit,
+		OffsetRows:     offset,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	versions := make([]registry.ModelVersion, 0, len(rows))
+	for _, row := range rows {
+		v, err := toModelVersion(row)
+		if err != nil {
+			return nil, 0, err
+		}
+		versions = append(versions, *v)
+	}
+	return versions, total, nil
+}
+
+func (r *ModelVersionRepo) DeleteByOrganization(ctx context.Context, orgID uuid.UUID) (int64, error) {
+	return r.queries.DeleteOrganizationModelVersions(ctx, orgID)
+}
+
+func (r *ModelVersionRepo) SetStage(ctx context.Context, id uuid.UUID, stage registry.Stage) error {
+	affected, err := r.queries.SetModelVersionStage(ctx, sqlc.SetModelVersionStageParams{
+		Stage: string(stage),
+		ID:    id,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return registry.ErrModelVersionNotFound
+	}
+	return nil
+}
+
+func (r *ModelVersionRepo) Promote(ctx context.Context, orgID, id uuid.UUID, symbol, interval string, rollback bool) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	q := r.queries.WithTx(tx)
+	if _, err := q.ArchiveProductionModelVersion(ctx, sqlc.ArchiveProductionModelVersionParams{
+		Rollback:       rollback,
+		OrganizationID: orgID,
+		Symbol:         symbol,
+		KlineInterval:  interval,
+	}); err != nil {
+		return err
+	}
+	affected, err := q.SetModelVersionStage(ctx, sqlc.SetModelVersionStageParams{
+		Stage: string(registry.StageProduction),
+		ID:    id,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return registry.ErrModelVersionNotFound
+	}
+	return tx.Commit(ctx)
+}
+
+func toModelVersion(row sqlc.ModelVersion) (*registry.ModelVersion, error) {
+	v := &registry.ModelVersion{
+		ID:             row.ID,
+		OrganizationID: row.OrganizationID,
+		Symbol:         row.Symbol,
+		Interval:       row.KlineInterval,
+		Version:        int(row.Version),
+		Stage:          registry.Stage(row.Stage),
+		LineageHash:    row.LineageHash,
+		ParentID:       row.ParentID,
+		CreatedAt:      row.CreatedAt,
+		StageChangedAt: row.StageChangedAt,
+		ProductionAt:   row.ProductionAt,
+		RolledBackAt:   row.RolledBackAt,
+	}
+	if err := json.Unmarshal(row.Metrics, &v.Metrics); err != nil {
+		return nil, fmt.Errorf("decode metrics of model version %s: %w", row.ID, err)
+	}
+	if err := json.Unmarshal(row.Artifact, &v.Artifact); err != nil {
+		return nil, fmt.Errorf("decode artifact of model version %s: %w", row.ID, err)
+	}
+	v.Artifact.Version = v.Version
+	return v, nil
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,201 @@
+package registryhttp
+
+import (
+	registryapp "go-ai/internal/modelregistry/application/registry"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/rs/zerolog"
+)
+
+type ModelHandler struct {
+	ListModelVersionsUseCase *registryapp.ListModelVersionsUseCase
+	GetModelVersionUseCase   *registryapp.GetModelVersionUseCase
+	SetModelStageUseCase     *registryapp.SetModelStageUseCase
+	RollbackModelUseCase     *registryapp.RollbackModelUseCase
+	PredictUseCase           *registryapp.PredictUseCase
+	Logger                   zerolog.Logger
+}
+
+func NewModelHandler(
+	listModelVersionsUseCase *registryapp.ListModelVersionsUseCase,
+	getModelVersionUseCase *registryapp.GetModelVersionUseCase,
+	setModelStageUseCase *registryapp.SetModelStageUseCase,
+	rollbackModelUseCase *registryapp.RollbackModelUseCase,
+	predictUseCase *registryapp.PredictUseCase,
+	logger zerolog.Logger,
+) *ModelHandler {
+	return &ModelHandler{
+		ListModelVersionsUseCase: listModelVersionsUseCase,
+		GetModelVersionUseCase:   getModelVersionUseCase,
+		SetModelStageUseCase:     setModelStageUseCase,
+		RollbackModelUseCase:     rollbackModelUseCase,
+		PredictUseCase:           predictUseCase,
+		Logger:                   logger.With().Str("component", "ModelHandler").Logger(),
+	}
+}
+
+// ListModelVersions godoc
+// @Summary List model versions
+// @Description List registered model versions, newest first per symbol/interval
+// @Tags Models
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param symbol query string false "Symbol, e.g. BTCUSDT"
+// @Param interval query string false "Kline interval, e.g. 1h"
+// @Param stage query string false "candidate, staging, production or archived"
+// @Param page query int false "Page number"
+// @Param limit query int false "Page size (max 100)"
+// @Success 200 {object} registryapp.ListModelVersionsSuccessResponseDoc "Model versions retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/models [get]
+func (h *ModelHandler) ListModelVersions(c *echo.Context) error {
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	var in registryapp.ListModelVersionsRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid query parameters")
+	}
+	versions, err := h.ListModelVersionsUseCase.Execute(c.Request().Context(), orgID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to list model versions")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, versions, "Model versions retrieved successfully")
+}
+
+// GetModelVersion godoc
+// @Summary Get a model version
+// @Description Get a model version with its lineage, metrics and weights
+// @Tags Models
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Model version ID"
+// @Success 200 {object} registryapp.ModelVersionDetailSuccessResponseDoc "Model version retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/models/{id} [get]
+func (h *ModelHandler) GetModelVersion(c *echo.Context) error {
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid model version ID")
+	}
+	version, err := h.GetModelVersionUseCase.Execute(c.Request().Context(), orgID, id)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to get model version")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, version, "Model version retrieved successfully")
+}
+
+// SetModelStage godoc
+// @Summary Move a model version to another stage
+// @Description Promote to staging or production, or archive. Promoting to production archives the current production version.
+// @Tags Models
+// @Accept json
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Model version ID"
+// @Param body body registryapp.SetModelStageRequest true "Target stage"
+// @Success 200 {object} registryapp.ModelVersionSuccessResponseDoc "Model stage updated successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/models/{id}/stage [post]
+func (h *ModelHandler) SetModelStage(c *echo.Context) error {
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid model version ID")
+	}
+	var in registryapp.SetModelStageRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	version, err := h.SetModelStageUseCase.Execute(c.Request().Context(), orgID, id, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to set model stage")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, version, "Model stage updated successfully")
+}
+
+// RollbackModel godoc
+// @Summary Roll back the production model
+// @Description Restore the previous production version of a symbol/interval
+// @Tags Models
+// @Accept json
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param body body registryapp.RollbackModelRequest true "Symbol and interval"
+// @Success 200 {object} registryapp.ModelVersionSuccessResponseDoc "Model rolled back successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/models/rollback [post]
+func (h *ModelHandler) RollbackModel(c *echo.Context) error {
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	var in registryapp.RollbackModelRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	version, err := h.RollbackModelUseCase.Execute(c.Request().Context(), orgID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to roll back model")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, version, "Model rolled back successfully")
+}
+
+// Predict godoc
+// @Summary Predict the next candle return
+// @Description Predict with the production model of a symbol/interval on the latest closed candles
+// @Tags Models
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param symbol query string true "Symbol, e.g. BTCUSDT"
+// @Param interval query string true "Kline interval, e.g. 1h"
+// @Success 200 {object} registryapp.PredictionSuccessResponseDoc "Prediction generated successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/models/predict [get]
+func (h *ModelHandler) Predict(c *echo.Context) error {
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	var in registryapp.PredictRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid query parameters")
+	}
+	prediction, err := h.PredictUseCase.Execute(c.Request().Context(), orgID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to predict")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, prediction, "Prediction generated successfully")
+}
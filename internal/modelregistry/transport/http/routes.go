@@ -0,0 +1,22 @@
+package registryhttp
+
+import (
+	"go-ai/internal/identity/domain/rbac"
+	middlewares "go-ai/internal/identity/transport/middlewares"
+
+	"github.com/labstack/echo/v5"
+)
+
+func RegisterModelRoutes(api *echo.Group, h *ModelHandler, m *middlewares.IdentityMiddleware, rbacService rbac.Service) {
+	models := api.Group("/models", m.Handler, m.Organization)
+	read := middlewares.RequirePermission(rbacService, rbac.ModelsRead)
+	predict := middlewares.RequirePermission(rbacService, rbac.ModelsPredict)
+	promote := middlewares.RequirePermission(rbacService, rbac.ModelsPromote)
+
+	models.GET("", h.ListModelVersions, read)
+	models.GET("/predict", h.Predict, predict)
+	models.GET("/:id", h.GetModelVersion, read)
+
+	models.POST("/rollback", h.RollbackModel, promote)
+	models.POST("/:id/stage", h.SetModelStage, promote)
+}
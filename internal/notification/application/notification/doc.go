@@ -0,0 +1,19 @@
+package notificationapp
+
+import (
+	"go-ai/pkg/response"
+)
+
+type ListNotificationsSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *struct {
+		response.PaginatedResponseDoc
+		Items       []NotificationResponse `json:"items"`
+		UnreadCount int64                  `json:"unread_count"`
+	} `json:"data,omitempty"`
+}
+
+type MarkAllReadSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *MarkAllReadResponse `json:"data,omitempty"`
+}
@@ -0,0 +1,33 @@
+package notificationapp
+
+import (
+	"encoding/json"
+	"go-ai/internal/notification/domain/notification"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationResponse struct {
+	ID             uuid.UUID       `json:"id"`
+	OrganizationID *uuid.UUID      `json:"organization_id,omitempty"`
+	Kind           string          `json:"kind"`
+	Title          string          `json:"title"`
+	Body           string          `json:"body"`
+	Data           json.RawMessage `json:"data,omitempty" swaggertype:"object"`
+	ReadAt         *time.Time      `json:"read_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+func toNotificationResponse(n notification.Entity) NotificationResponse {
+	return NotificationResponse{
+		ID:             n.ID,
+		OrganizationID: n.OrganizationID,
+		Kind:           n.Kind,
+		Title:          n.Title,
+		Body:           n.Body,
+		Data:           n.Data,
+		ReadAt:         n.ReadAt,
+		CreatedAt:      n.CreatedAt,
+	}
+}
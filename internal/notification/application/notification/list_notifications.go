@@ -0,0 +1,58 @@
+package notificationapp
+
+import (
+	"context"
+	"go-ai/internal/notification/domain/notification"
+	"go-ai/pkg/response"
+
+	"github.com/google/uuid"
+)
+
+type ListNotificationsRequest struct {
+	Page   *int32 `query:"page"`
+	Limit  *int32 `query:"limit"`
+	Unread bool   `query:"unread"`
+}
+
+type ListNotificationsResponse struct {
+	response.PaginatedResponse[[]NotificationResponse]
+	UnreadCount int64 `json:"unread_count"`
+}
+
+type ListNotificationsUseCase struct {
+	Repo notification.Repository
+}
+
+func NewListNotificationsUseCase(repo notification.Repository) *ListNotificationsUseCase {
+	return &ListNotificationsUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute returns the user's feed, newest first, with the unread count.
+func (uc *ListNotificationsUseCase) Execute(ctx context.Context, userID uuid.UUID, req ListNotificationsRequest) (*ListNotificationsResponse, error) {
+	page, limit, offset := response.ApplyDefaultPaginated(req.Page, req.Limit)
+	list, total, err := uc.Repo.List(ctx, userID, req.Unread, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	unread, err := uc.Repo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]NotificationResponse, 0, len(list))
+	for _, n := range list {
+		items = append(items, toNotificationResponse(n))
+	}
+	return &ListNotificationsResponse{
+		PaginatedResponse: response.PaginatedResponse[[]NotificationResponse]{
+			Page:       page,
+			Limit:      limit,
+			TotalItems: total,
+			TotalPages: response.CalculateTotalPages(total, int64(limit)),
+			Items:      items,
+		},
+		UnreadCount: unread,
+	}, nil
+}
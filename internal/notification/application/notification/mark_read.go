@@ -0,0 +1,44 @@
+package notificationapp
+
+import (
+	"context"
+	"go-ai/internal/notification/domain/notification"
+
+	"github.com/google/uuid"
+)
+
+type MarkAllReadResponse struct {
+	Updated int64 `json:"updated"`
+}
+
+type MarkReadUseCase struct {
+	Repo notification.Repository
+}
+
+func NewMarkReadUseCase(repo notification.Repository) *MarkReadUseCase {
+	return &MarkReadUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *MarkReadUseCase) Execute(ctx context.Context, userID, id uuid.UUID) error {
+	return uc.Repo.MarkRead(ctx, userID, id)
+}
+
+type MarkAllReadUseCase struct {
+	Repo notification.Repository
+}
+
+func NewMarkAllReadUseCase(repo notification.Repository) *MarkAllReadUseCase {
+	return &MarkAllReadUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *MarkAllReadUseCase) Execute(ctx context.Context, userID uuid.UUID) (*MarkAllReadResponse, error) {
+	updated, err := uc.Repo.MarkAllRead(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &MarkAllReadResponse{Updated: updated}, nil
+}
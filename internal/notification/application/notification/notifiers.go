@@ -0,0 +1,67 @@
+package notificationapp
+
+import (
+	"context"
+	"encoding/json"
+	"go-ai/internal/notification/domain/notification"
+	"go-ai/pkg/mailer"
+	"go-ai/pkg/notify"
+
+	"github.com/google/uuid"
+)
+
+// FeedNotifier stores messages in the recipient's in-app feed.
+type FeedNotifier struct {
+	Repo notification.Repository
+}
+
+func NewFeedNotifier(repo notification.Repository) *FeedNotifier {
+	return &FeedNotifier{
+		Repo: repo,
+	}
+}
+
+func (n *FeedNotifier) Notify(ctx context.Context, msg notify.Message) error {
+	data, err := json.Marshal(msg.Data)
+	if err != nil {
+		return err
+	}
+	var orgID *uuid.UUID
+	if msg.OrganizationID != uuid.Nil {
+		orgID = &msg.OrganizationID
+	}
+	return n.Repo.Create(ctx, &notification.Entity{
+		ID:             uuid.New(),
+		UserID:         msg.UserID,
+		OrganizationID: orgID,
+		Kind:           msg.Kind,
+		Title:          msg.Title,
+		Body:           msg.Body,
+		Data:           data,
+	})
+}
+
+// EmailNotifier mails messages to the recipient's account address.
+type EmailNotifier struct {
+	Repo   notification.Repository
+	Mailer mailer.Mailer
+}
+
+func NewEmailNotifier(repo notification.Repository, mail mailer.Mailer) *EmailNotifier {
+	return &EmailNotifier{
+		Repo:   repo,
+		Mailer: mail,
+	}
+}
+
+func (n *EmailNotifier) Notify(ctx context.Context, msg notify.Message) error {
+	to, err := n.Repo.GetUserEmail(ctx, msg.UserID)
+	if err != nil {
+		return err
+	}
+	return n.Mailer.Send(ctx, mailer.Message{
+		To:      to,
+		Subject: msg.Title,
+		Body:    msg.Body + "\n",
+	})
+}
@@ -0,0 +1,52 @@
+package notificationapp
+
+import (
+	"context"
+	"go-ai/internal/notification/domain/notification"
+
+	"github.com/google/uuid"
+)
+
+const exportPageSize = 200
+
+// UserData exports and purges a user's in-app feed.
+type UserData struct {
+	Repo notification.Repository
+}
+
+func NewUserData(repo notification.Repository) *UserData {
+	return &UserData{
+		Repo: repo,
+	}
+}
+
+func (d *UserData) ExportUserData(ctx context.Context, userID uuid.UUID, orgIDs []uuid.UUID) (any, error) {
+	out := []NotificationResponse{}
+	for offset := int32(0); ; offset += exportPageSize {
+		list, total, err := d.Repo.List(ctx, userID, false, exportPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range list {
+			out = append(out, toNotificationResponse(n))
+		}
+		if len(list) == 0 || int64(offset)+exportPageSize >= total {
+			break
+		}
+	}
+	return out, nil
+}
+
+// PurgeUserData deletes the user's feed and every notification of the
+// organizations removed along with the account.
+func (d *UserData) PurgeUserData(ctx context.Context, userID uuid.UUID, orgIDs []uuid.UUID) error {
+	if _, err := d.Repo.DeleteByUser(ctx, userID); err != nil {
+		return err
+	}
+	for _, orgID := range orgIDs {
+		if _, err := d.Repo.DeleteByOrganization(ctx, orgID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
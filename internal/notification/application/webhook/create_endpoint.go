@@ -0,0 +1,44 @@
+package webhookapp
+
+import (
+	"context"
+	"go-ai/internal/notification/domain/webhook"
+
+	"github.com/google/uuid"
+)
+
+type CreateEndpointRequest struct {
+	URL         string   `json:"url"`
+	Description string   `json:"description"`
+	Events      []string `json:"events"`
+}
+
+type CreateEndpointUseCase struct {
+	Repo webhook.Repository
+}
+
+func NewCreateEndpointUseCase(repo webhook.Repository) *CreateEndpointUseCase {
+	return &CreateEndpointUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute registers an enabled endpoint and returns its signing secret.
+func (uc *CreateEndpointUseCase) Execute(ctx context.Context, orgID, userID uuid.UUID, req CreateEndpointRequest) (*EndpointSecretResponse, error) {
+	e, err := webhook.NewEndpoint(orgID, userID, req.URL, req.Description, req.Events)
+	if err != nil {
+		return nil, err
+	}
+	count, err := uc.Repo.CountByOwner(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= webhook.MaxPerOwner {
+		return nil, webhook.ErrTooManyEndpoints
+	}
+	if err := uc.Repo.Create(ctx, e); err != nil {
+		return nil, err
+	}
+	resp := toEndpointSecretResponse(*e)
+	return &resp, nil
+}
@@ -0,0 +1,53 @@
+package webhookapp
+
+import (
+	"context"
+	"go-ai/internal/notification/domain/webhook"
+	"go-ai/pkg/notify"
+	"time"
+)
+
+// Sender posts one signed webhook request.
+type Sender interface {
+	Send(ctx context.Context, w notify.SignedWebhook) (notify.WebhookResponse, error)
+}
+
+// Deliverer makes single delivery attempts for the worker and for the
+// endpoints that send on request.
+type Deliverer struct {
+	Repo   webhook.Repository
+	Sender Sender
+	Policy webhook.RetryPolicy
+}
+
+func NewDeliverer(repo webhook.Repository, sender Sender, policy webhook.RetryPolicy) *Deliverer {
+	return &Deliverer{
+		Repo:   repo,
+		Sender: sender,
+		Policy: policy,
+	}
+}
+
+// Attempt sends d to e once, then logs the attempt and saves the new state.
+// A failed send is not an error: it is recorded on d and retried while retry
+// is set. An attempt cut short by ctx is not recorded, so the delivery goes
+// back to the queue when its lease runs out.
+func (dl *Deliverer) Attempt(ctx context.Context, e webhook.Endpoint, d *webhook.Delivery, retry bool) error {
+	resp, err := dl.Sender.Send(ctx, notify.SignedWebhook{
+		URL:    e.URL,
+		Secret: e.Secret,
+		ID:     d.EventID.String(),
+		Event:  d.EventType,
+		Body:   d.Payload,
+	})
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	a := d.Record(webhook.Result{
+		StatusCode: resp.StatusCode,
+		Body:       resp.Body,
+		Duration:   resp.Duration,
+		Err:        err,
+	}, time.Now().UTC(), dl.Policy, retry)
+	return dl.Repo.RecordAttempt(ctx, d, &a)
+}
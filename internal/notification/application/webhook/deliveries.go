@@ -0,0 +1,118 @@
+package webhookapp
+
+import (
+	"context"
+	"go-ai/internal/notification/domain/webhook"
+	"go-ai/pkg/response"
+
+	"github.com/google/uuid"
+)
+
+type ListDeliveriesRequest struct {
+	Page   *int32 `query:"page"`
+	Limit  *int32 `query:"limit"`
+	Status string `query:"status"`
+}
+
+type ListDeliveriesUseCase struct {
+	Repo webhook.Repository
+}
+
+func NewListDeliveriesUseCase(repo webhook.Repository) *ListDeliveriesUseCase {
+	return &ListDeliveriesUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute returns the endpoint's deliveries, newest first, optionally only
+// those in one status.
+func (uc *ListDeliveriesUseCase) Execute(ctx context.Context, orgID, userID, endpointID uuid.UUID, req ListDeliveriesRequest) (*response.PaginatedResponse[[]DeliveryResponse], error) {
+	status, err := webhook.ParseStatus(req.Status)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.Repo.GetByID(ctx, orgID, userID, endpointID); err != nil {
+		return nil, err
+	}
+	page, limit, offset := response.ApplyDefaultPaginated(req.Page, req.Limit)
+	list, total, err := uc.Repo.ListDeliveries(ctx, endpointID, status, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]DeliveryResponse, 0, len(list))
+	for _, d := range list {
+		items = append(items, toDeliveryResponse(d))
+	}
+	return &response.PaginatedResponse[[]DeliveryResponse]{
+		Page:       page,
+		Limit:      limit,
+		TotalItems: total,
+		TotalPages: response.CalculateTotalPages(total, int64(limit)),
+		Items:      items,
+	}, nil
+}
+
+type GetDeliveryUseCase struct {
+	Repo webhook.Repository
+}
+
+func NewGetDeliveryUseCase(repo webhook.Repository) *GetDeliveryUseCase {
+	return &GetDeliveryUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute returns a delivery with every attempt made so far.
+func (uc *GetDeliveryUseCase) Execute(ctx context.Context, orgID, userID, endpointID, id uuid.UUID) (*DeliveryDetailResponse, error) {
+	if _, err := uc.Repo.GetByID(ctx, orgID, userID, endpointID); err != nil {
+		return nil, err
+	}
+	return deliveryDetail(ctx, uc.Repo, endpointID, id)
+}
+
+type RedeliverUseCase struct {
+	Repo      webhook.Repository
+	Deliverer *Deliverer
+}
+
+func NewRedeliverUseCase(repo webhook.Repository, deliverer *Deliverer) *RedeliverUseCase {
+	return &RedeliverUseCase{
+		Repo:      repo,
+		Deliverer: deliverer,
+	}
+}
+
+// Execute sends a dead or already delivered event again, once and right
+// away. The delivery ends up succeeded or dead depending on this attempt;
+// deliveries still queued are left to the worker.
+func (uc *RedeliverUseCase) Execute(ctx context.Context, orgID, userID, endpointID, id uuid.UUID) (*DeliveryDetailResponse, error) {
+	e, err := uc.Repo.GetByID(ctx, orgID, userID, endpointID)
+	if err != nil {
+		return nil, err
+	}
+	d, err := uc.Repo.GetDelivery(ctx, endpointID, id)
+	if err != nil {
+		return nil, err
+	}
+	if d.Status == webhook.StatusPending {
+		return nil, webhook.ErrDeliveryQueued
+	}
+	if err := uc.Deliverer.Attempt(ctx, *e, d, false); err != nil {
+		return nil, err
+	}
+	return deliveryDetail(ctx, uc.Repo, endpointID, id)
+}
+
+func deliveryDetail(ctx context.Context, repo webhook.Repository, endpointID, id uuid.UUID) (*DeliveryDetailResponse, error) {
+	d, err := repo.GetDelivery(ctx, endpointID, id)
+	if err != nil {
+		return nil, err
+	}
+	attempts, err := repo.ListAttempts(ctx, d.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp := toDeliveryDetailResponse(*d, attempts)
+	return &resp, nil
+}
@@ -0,0 +1,33 @@
+package webhookapp
+
+import (
+	"go-ai/pkg/response"
+)
+
+type EndpointSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *EndpointResponse `json:"data,omitempty"`
+}
+
+type EndpointSecretSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *EndpointSecretResponse `json:"data,omitempty"`
+}
+
+type ListEndpointsSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data []EndpointResponse `json:"data,omitempty"`
+}
+
+type DeliverySuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *DeliveryDetailResponse `json:"data,omitempty"`
+}
+
+type ListDeliveriesSuccessResponseDoc struct {
+	response.SuccessBaseDoc
+	Data *struct {
+		response.PaginatedResponseDoc
+		Items []DeliveryResponse `json:"items"`
+	} `json:"data,omitempty"`
+}
@@ -0,0 +1,118 @@
+package webhookapp
+
+import (
+	"encoding/json"
+	"go-ai/internal/notification/domain/webhook"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EndpointResponse struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	URL            string    `json:"url"`
+	Description    string    `json:"description"`
+	Events         []string  `json:"events"`
+	Enabled        bool      `json:"enabled"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// EndpointSecretResponse is returned on create and rotate, the only times
+// the signing secret is shown.
+type EndpointSecretResponse struct {
+	EndpointResponse
+	Secret string `json:"secret"`
+}
+
+type DeliveryResponse struct {
+	ID             uuid.UUID       `json:"id"`
+	EndpointID     uuid.UUID       `json:"endpoint_id"`
+	EventID        uuid.UUID       `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"`
+	LastAttemptAt  *time.Time      `json:"last_attempt_at,omitempty"`
+	LastStatusCode *int            `json:"last_status_code,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
+
+type AttemptResponse struct {
+	Attempt      int       `json:"attempt"`
+	StatusCode   *int      `json:"status_code,omitempty"`
+	Error        string    `json:"error,omitempty"`
+	ResponseBody string    `json:"response_body,omitempty"`
+	DurationMS   int64     `json:"duration_ms"`
+	AttemptedAt  time.Time `json:"attempted_at"`
+}
+
+// DeliveryDetailResponse is a delivery with its attempt log.
+type DeliveryDetailResponse struct {
+	DeliveryResponse
+	AttemptLog []AttemptResponse `json:"attempt_log"`
+}
+
+func toEndpointResponse(e webhook.Endpoint) EndpointResponse {
+	return EndpointResponse{
+		ID:             e.ID,
+		OrganizationID: e.OrganizationID,
+		URL:            e.URL,
+		Description:    e.Description,
+		Events:         e.Events,
+		Enabled:        e.Enabled,
+		CreatedAt:      e.CreatedAt,
+		UpdatedAt:      e.UpdatedAt,
+	}
+}
+
+func toEndpointSecretResponse(e webhook.Endpoint) EndpointSecretResponse {
+	return EndpointSecretResponse{
+		EndpointResponse: toEndpointResponse(e),
+		Secret:           e.Secret,
+	}
+}
+
+func toDeliveryResponse(d webhook.Delivery) DeliveryResponse {
+	resp := DeliveryResponse{
+		ID:             d.ID,
+		EndpointID:     d.EndpointID,
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		LastAttemptAt:  d.LastAttemptAt,
+		LastStatusCode: d.LastStatusCode,
+		LastError:      d.LastError,
+		DeliveredAt:    d.DeliveredAt,
+		Payload:        d.Payload,
+		CreatedAt:      d.CreatedAt,
+	}
+	if d.Status == webhook.StatusPending {
+		next := d.NextAttemptAt
+		resp.NextAttemptAt = &next
+	}
+	return resp
+}
+
+func toDeliveryDetailResponse(d webhook.Delivery, attempts []webhook.Attempt) DeliveryDetailResponse {
+	log := make([]AttemptResponse, 0, len(attempts))
+	for _, a := range attempts {
+		log = append(log, AttemptResponse{
+			Attempt:      a.Attempt,
+			StatusCode:   a.StatusCode,
+			Error:        a.Error,
+			ResponseBody: a.ResponseBody,
+			DurationMS:   a.Duration.Milliseconds(),
+			AttemptedAt:  a.AttemptedAt,
+		})
+	}
+	return DeliveryDetailResponse{
+		DeliveryResponse: toDeliveryResponse(d),
+		AttemptLog:       log,
+	}
+}
@@ -0,0 +1,51 @@
+package webhookapp
+
+import (
+	"context"
+	"go-ai/internal/notification/domain/webhook"
+
+	"github.com/google/uuid"
+)
+
+type ListEndpointsUseCase struct {
+	Repo webhook.Repository
+}
+
+func NewListEndpointsUseCase(repo webhook.Repository) *ListEndpointsUseCase {
+	return &ListEndpointsUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute returns the caller's endpoints in the active organization, oldest
+// first.
+func (uc *ListEndpointsUseCase) Execute(ctx context.Context, orgID, userID uuid.UUID) ([]EndpointResponse, error) {
+	list, err := uc.Repo.List(ctx, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]EndpointResponse, 0, len(list))
+	for _, e := range list {
+		out = append(out, toEndpointResponse(e))
+	}
+	return out, nil
+}
+
+type GetEndpointUseCase struct {
+	Repo webhook.Repository
+}
+
+func NewGetEndpointUseCase(repo webhook.Repository) *GetEndpointUseCase {
+	return &GetEndpointUseCase{
+		Repo: repo,
+	}
+}
+
+func (uc *GetEndpointUseCase) Execute(ctx context.Context, orgID, userID, id uuid.UUID) (*EndpointResponse, error) {
+	e, err := uc.Repo.GetByID(ctx, orgID, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := toEndpointResponse(*e)
+	return &resp, nil
+}
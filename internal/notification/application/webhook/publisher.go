@@ -0,0 +1,65 @@
+package webhookapp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"go-ai/internal/notification/domain/webhook"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Publisher queues an event for every endpoint subscribed to it. The
+// delivery worker sends it from there, so publishing never waits on a
+// receiver.
+type Publisher struct {
+	Repo webhook.Repository
+}
+
+func NewPublisher(repo webhook.Repository) *Publisher {
+	return &Publisher{
+		Repo: repo,
+	}
+}
+
+// Publish queues an organization-wide event for every member's endpoints.
+func (p *Publisher) Publish(ctx context.Context, orgID uuid.UUID, eventType string, data any) error {
+	return p.publish(ctx, orgID, nil, eventType, data)
+}
+
+// PublishToUser queues an event that concerns one member, such as their
+// alert firing, for that member's endpoints only.
+func (p *Publisher) PublishToUser(ctx context.Context, orgID, userID uuid.UUID, eventType string, data any) error {
+	return p.publish(ctx, orgID, &userID, eventType, data)
+}
+
+func (p *Publisher) publish(ctx context.Context, orgID uuid.UUID, userID *uuid.UUID, eventType string, data any) error {
+	endpoints, err := p.Repo.ListSubscribed(ctx, orgID, userID, eventType)
+	if err != nil {
+		return err
+	}
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	eventID := uuid.New()
+	payload, err := json.Marshal(webhook.Envelope{
+		ID:             eventID,
+		Type:           eventType,
+		OrganizationID: orgID,
+		CreatedAt:      now,
+		Data:           data,
+	})
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, e := range endpoints {
+		if err := p.Repo.CreateDelivery(ctx, webhook.NewDelivery(e, eventID, eventType, payload, now)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,60 @@
+package webhookapp
+
+import (
+	"context"
+	"encoding/json"
+	"go-ai/internal/notification/domain/webhook"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// testLease keeps the worker off a test delivery while it is sent inline.
+const testLease = 5 * time.Minute
+
+type SendTestEventUseCase struct {
+	Repo      webhook.Repository
+	Deliverer *Deliverer
+}
+
+func NewSendTestEventUseCase(repo webhook.Repository, deliverer *Deliverer) *SendTestEventUseCase {
+	return &SendTestEventUseCase{
+		Repo:      repo,
+		Deliverer: deliverer,
+	}
+}
+
+// Execute sends a webhook.test event to the endpoint right away, whatever
+// it subscribes to and even when it is disabled, and returns the logged
+// attempt. Test events are not retried.
+func (uc *SendTestEventUseCase) Execute(ctx context.Context, orgID, userID, id uuid.UUID) (*DeliveryDetailResponse, error) {
+	e, err := uc.Repo.GetByID(ctx, orgID, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	eventID := uuid.New()
+	payload, err := json.Marshal(webhook.Envelope{
+		ID:             eventID,
+		Type:           webhook.EventTest,
+		OrganizationID: orgID,
+		CreatedAt:      now,
+		Data: map[string]any{
+			"endpoint_id": e.ID,
+			"message":     "Test event from go-market-ai",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	d := webhook.NewDelivery(*e, eventID, webhook.EventTest, payload, now)
+	d.NextAttemptAt = now.Add(testLease)
+	if err := uc.Repo.CreateDelivery(ctx, d); err != nil {
+		return nil, err
+	}
+	if err := uc.Deliverer.Attempt(ctx, *e, d, false); err != nil {
+		return nil, err
+	}
+	return deliveryDetail(ctx, uc.Repo, e.ID, d.ID)
+}
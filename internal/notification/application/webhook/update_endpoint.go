@@ -0,0 +1,104 @@
+package webhookapp
+
+import (
+	"context"
+	"go-ai/internal/notification/domain/webhook"
+
+	"github.com/google/uuid"
+)
+
+// UpdateEndpointRequest changes only the fields that are set.
+type UpdateEndpointRequest struct {
+	URL         *string   `json:"url"`
+	Description *string   `json:"description"`
+	Events      *[]string `json:"events"`
+	Enabled     *bool     `json:"enabled"`
+}
+
+type UpdateEndpointUseCase struct {
+	Repo webhook.Repository
+}
+
+func NewUpdateEndpointUseCase(repo webhook.Repository) *UpdateEndpointUseCase {
+	return &UpdateEndpointUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute saves the changes. Disabling an endpoint holds its queued
+// deliveries until it is enabled again.
+func (uc *UpdateEndpointUseCase) Execute(ctx context.Context, orgID, userID, id uuid.UUID, req UpdateEndpointRequest) (*EndpointResponse, error) {
+	e, err := uc.Repo.GetByID(ctx, orgID, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if req.URL != nil {
+		if err := e.SetURL(*req.URL); err != nil {
+			return nil, err
+		}
+	}
+	if req.Description != nil {
+		if err := e.SetDescription(*req.Description); err != nil {
+			return nil, err
+		}
+	}
+	if req.Events != nil {
+		if err := e.SetEvents(*req.Events); err != nil {
+			return nil, err
+		}
+	}
+	if req.Enabled != nil {
+		e.Enabled = *req.Enabled
+	}
+	if err := uc.Repo.Update(ctx, e); err != nil {
+		return nil, err
+	}
+	updated, err := uc.Repo.GetByID(ctx, orgID, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	resp := toEndpointResponse(*updated)
+	return &resp, nil
+}
+
+type RotateSecretUseCase struct {
+	Repo webhook.Repository
+}
+
+func NewRotateSecretUseCase(repo webhook.Repository) *RotateSecretUseCase {
+	return &RotateSecretUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute replaces the signing secret and returns the new one. The old
+// secret stops working immediately, including for queued retries.
+func (uc *RotateSecretUseCase) Execute(ctx context.Context, orgID, userID, id uuid.UUID) (*EndpointSecretResponse, error) {
+	e, err := uc.Repo.GetByID(ctx, orgID, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.RotateSecret(); err != nil {
+		return nil, err
+	}
+	if err := uc.Repo.Update(ctx, e); err != nil {
+		return nil, err
+	}
+	resp := toEndpointSecretResponse(*e)
+	return &resp, nil
+}
+
+type DeleteEndpointUseCase struct {
+	Repo webhook.Repository
+}
+
+func NewDeleteEndpointUseCase(repo webhook.Repository) *DeleteEndpointUseCase {
+	return &DeleteEndpointUseCase{
+		Repo: repo,
+	}
+}
+
+// Execute deletes an endpoint with its queue and delivery log.
+func (uc *DeleteEndpointUseCase) Execute(ctx context.Context, orgID, userID, id uuid.UUID) error {
+	return uc.Repo.Delete(ctx, orgID, userID, id)
+}
@@ -0,0 +1,46 @@
+package webhookapp
+
+import (
+	"context"
+	"go-ai/internal/notification/domain/webhook"
+
+	"github.com/google/uuid"
+)
+
+// UserData exports and purges a user's webhook endpoints. Secrets are left
+// out of the export; deliveries go with their endpoints.
+type UserData struct {
+	Repo webhook.Repository
+}
+
+func NewUserData(repo webhook.Repository) *UserData {
+	return &UserData{
+		Repo: repo,
+	}
+}
+
+func (d *UserData) ExportUserData(ctx context.Context, userID uuid.UUID, orgIDs []uuid.UUID) (any, error) {
+	list, err := d.Repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]EndpointResponse, 0, len(list))
+	for _, e := range list {
+		out = append(out, toEndpointResponse(e))
+	}
+	return out, nil
+}
+
+// PurgeUserData deletes the user's endpoints and every endpoint of the
+// organizations removed along with the account.
+func (d *UserData) PurgeUserData(ctx context.Context, userID uuid.UUID, orgIDs []uuid.UUID) error {
+	if _, err := d.Repo.DeleteByUser(ctx, userID); err != nil {
+		return err
+	}
+	for _, orgID := range orgIDs {
+		if _, err := d.Repo.DeleteByOrganization(ctx, orgID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
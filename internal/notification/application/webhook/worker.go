@@ -0,0 +1,169 @@
+package webhookapp
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/notification/domain/webhook"
+	"go-ai/pkg/worker"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// cleanupEvery is how often finished deliveries past retention are dropped.
+const cleanupEvery = time.Hour
+
+// DeliveryWorkerConfig holds delivery worker settings
+type DeliveryWorkerConfig struct {
+	Workers      int
+	PollInterval time.Duration
+	// Timeout bounds one request; claimed deliveries are leased for a bit
+	// longer than that.
+	Timeout time.Duration
+	// Retention is how long succeeded and dead deliveries are kept. Zero
+	// keeps them forever.
+	Retention time.Duration
+}
+
+// DefaultDeliveryWorkerConfig returns default delivery worker configuration
+func DefaultDeliveryWorkerConfig() DeliveryWorkerConfig {
+	return DeliveryWorkerConfig{
+		Workers:      4,
+		PollInterval: 5 * time.Second,
+		Timeout:      10 * time.Second,
+		Retention:    30 * 24 * time.Hour,
+	}
+}
+
+// DeliveryWorker sends queued deliveries that are due. Deliveries are
+// claimed with a lease in Postgres, so any number of replicas can run it.
+type DeliveryWorker struct {
+	repo      webhook.Repository
+	deliverer *Deliverer
+	cfg       DeliveryWorkerConfig
+	logger    zerolog.Logger
+
+	lastCleanup time.Time
+	group       *worker.Group
+}
+
+func NewDeliveryWorker(repo webhook.Repository, deliverer *Deliverer, cfg DeliveryWorkerConfig, logger zerolog.Logger) *DeliveryWorker {
+	defaults := DefaultDeliveryWorkerConfig()
+	if cfg.Workers <= 0 {
+		cfg.Workers = defaults.Workers
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaults.PollInterval
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaults.Timeout
+	}
+	if cfg.Retention < 0 {
+		cfg.Retention = 0
+	}
+	logger = logger.With().Str("component", "WebhookDeliveryWorker").Logger()
+	return &DeliveryWorker{
+		repo:      repo,
+		deliverer: deliverer,
+		cfg:       cfg,
+		logger:    logger,
+		group:     worker.New("webhook delivery worker", logger),
+	}
+}
+
+// Start launches the polling loop. It returns immediately.
+func (w *DeliveryWorker) Start(ctx context.Context) {
+	w.group.Start(ctx, w.loop)
+	w.logger.Info().Int("workers", w.cfg.Workers).Dur("poll_interval", w.cfg.PollInterval).Msg("webhook delivery worker started")
+}
+
+// Shutdown stops polling and waits for requests in flight, giving up when
+// ctx expires. Interrupted deliveries are retried once their lease ends.
+func (w *DeliveryWorker) Shutdown(ctx context.Context) error {
+	return w.group.Shutdown(ctx)
+}
+
+func (w *DeliveryWorker) loop(ctx context.Context) {
+	worker.Every(ctx, w.cfg.PollInterval, func(ctx context.Context) {
+		w.drain(ctx)
+		w.cleanup(ctx)
+	})
+}
+
+// drain polls until a batch comes back short, so a backlog does not wait a
+// poll interval per batch.
+func (w *DeliveryWorker) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		if w.poll(ctx) < w.cfg.Workers {
+			return
+		}
+	}
+}
+
+// poll claims up to one delivery per worker, sends them concurrently and
+// returns how many it claimed.
+func (w *DeliveryWorker) poll(ctx context.Context) int {
+	now := time.Now().UTC()
+	lease := now.Add(w.cfg.Timeout + 30*time.Second)
+	due, err := w.repo.ClaimDue(ctx, now, lease, int32(w.cfg.Workers))
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.Error().Err(err).Msg("claim due webhook deliveries")
+		}
+		return 0
+	}
+
+	var wg sync.WaitGroup
+	for i := range due {
+		wg.Add(1)
+		go func(d *webhook.Delivery) {
+			defer wg.Done()
+			w.deliver(ctx, d)
+		}(&due[i])
+	}
+	wg.Wait()
+	return len(due)
+}
+
+func (w *DeliveryWorker) deliver(ctx context.Context, d *webhook.Delivery) {
+	logger := w.logger.With().Str("delivery_id", d.ID.String()).Str("endpoint_id", d.EndpointID.String()).Str("event", d.EventType).Logger()
+
+	e, err := w.repo.GetEndpoint(ctx, d.EndpointID)
+	if err != nil {
+		// A deleted endpoint takes its deliveries with it.
+		if !errors.Is(err, webhook.ErrEndpointNotFound) && ctx.Err() == nil {
+			logger.Error().Err(err).Msg("load webhook endpoint")
+		}
+		return
+	}
+	if err := w.deliverer.Attempt(ctx, *e, d, true); err != nil {
+		if ctx.Err() == nil {
+			logger.Error().Err(err).Msg("record webhook attempt")
+		}
+		return
+	}
+	switch d.Status {
+	case webhook.StatusDead:
+		logger.Warn().Int("attempts", d.Attempts).Str("error", d.LastError).Msg("webhook delivery gave up")
+	case webhook.StatusPending:
+		logger.Debug().Int("attempts", d.Attempts).Time("next_attempt_at", d.NextAttemptAt).Str("error", d.LastError).Msg("webhook delivery failed, retrying")
+	}
+}
+
+func (w *DeliveryWorker) cleanup(ctx context.Context) {
+	if w.cfg.Retention == 0 || time.Since(w.lastCleanup) < cleanupEvery {
+		return
+	}
+	w.lastCleanup = time.Now()
+	deleted, err := w.repo.DeleteFinishedBefore(ctx, time.Now().UTC().Add(-w.cfg.Retention))
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.Error().Err(err).Msg("delete old webhook deliveries")
+		}
+		return
+	}
+	if deleted > 0 {
+		w.logger.Info().Int64("deleted", deleted).Msg("deleted old webhook deliveries")
+	}
+}
@@ -0,0 +1,21 @@
+package notification
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Entity is one entry of a user's in-app feed.
+type Entity struct {
+	ID             uuid.UUID
+	UserID         uuid.UUID
+	OrganizationID *uuid.UUID
+	Kind           string
+	Title          string
+	Body           string
+	Data           json.RawMessage
+	ReadAt         *time.Time
+	CreatedAt      time.Time
+}
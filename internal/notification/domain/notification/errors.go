@@ -0,0 +1,11 @@
+package notification
+
+import (
+	domainerr "go-ai/pkg/domain_err"
+	"net/http"
+)
+
+var (
+	ErrNotificationNotFound = domainerr.New(http.StatusNotFound, "Notification not found")
+	ErrRecipientNotFound    = domainerr.New(http.StatusNotFound, "Recipient has no email address")
+)
@@ -0,0 +1,21 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, n *Entity) error
+	// List returns the user's feed, newest first, and the total count.
+	List(ctx context.Context, userID uuid.UUID, unreadOnly bool, limit, offset int32) ([]Entity, int64, error)
+	CountUnread(ctx context.Context, userID uuid.UUID) (int64, error)
+	MarkRead(ctx context.Context, userID, id uuid.UUID) error
+	MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error)
+	DeleteByUser(ctx context.Context, userID uuid.UUID) (int64, error)
+	DeleteByOrganization(ctx context.Context, orgID uuid.UUID) (int64, error)
+	// GetUserEmail returns ErrRecipientNotFound for unknown users and users
+	// without an email address.
+	GetUserEmail(ctx context.Context, userID uuid.UUID) (string, error)
+}
@@ -0,0 +1,286 @@
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"go-ai/pkg/notify"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Event types an endpoint can subscribe to. EventAll subscribes to every
+// type, including ones added later.
+const (
+	EventModelTrained   = "model.trained"
+	EventModelPromoted  = "model.promoted"
+	EventSignalChanged  = "signal.changed"
+	EventAlertTriggered = "alert.triggered"
+	EventAll            = "*"
+	// EventTest is only sent on request and cannot be subscribed to.
+	EventTest = "webhook.test"
+)
+
+// Events lists the subscribable event types.
+var Events = []string{EventModelTrained, EventModelPromoted, EventSignalChanged, EventAlertTriggered}
+
+const (
+	StatusPending   = "pending"
+	StatusSucceeded = "succeeded"
+	StatusDead      = "dead"
+)
+
+const (
+	MaxPerOwner    = 10
+	maxDescription = 200
+	secretPrefix   = "whsec_"
+)
+
+// Endpoint is a URL a user registered to receive their organization's
+// events. Secret signs every request and is only shown when it is created
+// or rotated.
+type Endpoint struct {
+	ID             uuid.UUID
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	URL            string
+	Description    string
+	Events         []string
+	Secret         string
+	Enabled        bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func NewEndpoint(orgID, userID uuid.UUID, rawURL, description string, events []string) (*Endpoint, error) {
+	if orgID == uuid.Nil {
+		return nil, ErrOrganizationRequired
+	}
+	e := &Endpoint{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		UserID:         userID,
+		Enabled:        true,
+	}
+	if err := e.SetURL(rawURL); err != nil {
+		return nil, err
+	}
+	if err := e.SetDescription(description); err != nil {
+		return nil, err
+	}
+	if err := e.SetEvents(events); err != nil {
+		return nil, err
+	}
+	if err := e.RotateSecret(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// SetURL accepts absolute http(s) URLs outside the server's own network.
+// Host names are checked again when requests are made.
+func (e *Endpoint) SetURL(rawURL string) error {
+	rawURL = strings.TrimSpace(rawURL)
+	if err := notify.CheckWebhookURL(rawURL); err != nil {
+		if errors.Is(err, notify.ErrWebhookURLPrivate) {
+			return ErrPrivateURL
+		}
+		return ErrInvalidURL
+	}
+	e.URL = rawURL
+	return nil
+}
+
+func (e *Endpoint) SetDescription(description string) error {
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > maxDescription {
+		return ErrDescriptionTooLong
+	}
+	e.Description = description
+	return nil
+}
+
+// SetEvents validates and de-duplicates the subscription. A "*" anywhere
+// collapses it to every event.
+func (e *Endpoint) SetEvents(events []string) error {
+	out := make([]string, 0, len(events))
+	for _, ev := range events {
+		ev = strings.ToLower(strings.TrimSpace(ev))
+		if ev == EventAll {
+			e.Events = []string{EventAll}
+			return nil
+		}
+		if !slices.Contains(Events, ev) {
+			return ErrInvalidEvent
+		}
+		if !slices.Contains(out, ev) {
+			out = append(out, ev)
+		}
+	}
+	if len(out) == 0 {
+		return ErrEventsRequired
+	}
+	e.Events = out
+	return nil
+}
+
+// RotateSecret replaces the signing secret. Requests already queued are
+// signed with the new one.
+func (e *Endpoint) RotateSecret() error {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return err
+	}
+	e.Secret = secretPrefix + hex.EncodeToString(buf)
+	return nil
+}
+
+// Subscribes reports whether the endpoint wants events of type event.
+func (e *Endpoint) Subscribes(event string) bool {
+	return slices.Contains(e.Events, EventAll) || slices.Contains(e.Events, event)
+}
+
+// Envelope is the JSON body of every webhook request. ID is shared by the
+// deliveries of one event to different endpoints and by retries.
+type Envelope struct {
+	ID             uuid.UUID `json:"id"`
+	Type           string    `json:"type"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	CreatedAt      time.Time `json:"created_at"`
+	Data           any       `json:"data"`
+}
+
+// Delivery is one event queued for one endpoint.
+type Delivery struct {
+	ID             uuid.UUID
+	EndpointID     uuid.UUID
+	OrganizationID uuid.UUID
+	EventID        uuid.UUID
+	EventType      string
+	Payload        json.RawMessage
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastAttemptAt  *time.Time
+	LastStatusCode *int
+	LastError      string
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+}
+
+// NewDelivery queues payload for endpoint, due at now.
+func NewDelivery(endpoint Endpoint, eventID uuid.UUID, eventType string, payload json.RawMessage, now time.Time) *Delivery {
+	return &Delivery{
+		ID:             uuid.New(),
+		EndpointID:     endpoint.ID,
+		OrganizationID: endpoint.OrganizationID,
+		EventID:        eventID,
+		EventType:      eventType,
+		Payload:        payload,
+		Status:         StatusPending,
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+	}
+}
+
+// Attempt is one logged HTTP request of a delivery. StatusCode is nil when
+// the receiver did not answer.
+type Attempt struct {
+	ID           uuid.UUID
+	DeliveryID   uuid.UUID
+	Attempt      int
+	StatusCode   *int
+	Error        string
+	ResponseBody string
+	Duration     time.Duration
+	AttemptedAt  time.Time
+}
+
+// Result is the outcome of sending a delivery once.
+type Result struct {
+	StatusCode int
+	Body       string
+	Duration   time.Duration
+	Err        error
+}
+
+// RetryPolicy spaces out attempts with exponential backoff and gives up
+// after MaxAttempts.
+type RetryPolicy struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 8,
+		Backoff:     30 * time.Second,
+		MaxBackoff:  time.Hour,
+	}
+}
+
+// Delay returns how long to wait after the given failed attempt (1-based):
+// Backoff doubled per attempt, capped at MaxBackoff.
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+	d := p.Backoff
+	for i := 1; i < attempt && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	return d
+}
+
+// Record applies the result of an attempt made at now and returns its log
+// entry. A failure is retried while retry is set and attempts remain;
+// otherwise the delivery is dead.
+func (d *Delivery) Record(r Result, now time.Time, policy RetryPolicy, retry bool) Attempt {
+	d.Attempts++
+	a := Attempt{
+		ID:           uuid.New(),
+		DeliveryID:   d.ID,
+		Attempt:      d.Attempts,
+		ResponseBody: r.Body,
+		Duration:     r.Duration,
+		AttemptedAt:  now,
+	}
+	if r.StatusCode != 0 {
+		code := r.StatusCode
+		a.StatusCode = &code
+	}
+	d.LastAttemptAt = &now
+	d.LastStatusCode = a.StatusCode
+
+	if r.Err == nil {
+		d.Status = StatusSucceeded
+		d.DeliveredAt = &now
+		d.LastError = ""
+		return a
+	}
+	a.Error = r.Err.Error()
+	d.LastError = a.Error
+	if retry && d.Attempts < policy.MaxAttempts {
+		d.Status = StatusPending
+		d.NextAttemptAt = now.Add(policy.Delay(d.Attempts))
+		return a
+	}
+	d.Status = StatusDead
+	return a
+}
+
+// ParseStatus validates a delivery status filter; "" means any status.
+func ParseStatus(s string) (string, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	switch s {
+	case "", StatusPending, StatusSucceeded, StatusDead:
+		return s, nil
+	}
+	return "", ErrInvalidStatus
+}
@@ -0,0 +1,140 @@
+package webhook
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var t0 = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestNewEndpoint(t *testing.T) {
+	e, err := NewEndpoint(uuid.New(), uuid.New(), " https://example.com/hook ", "bot", []string{"Model.Trained", "model.trained", "signal.changed"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.URL != "https://example.com/hook" || !e.Enabled {
+		t.Fatalf("endpoint = %+v", e)
+	}
+	if len(e.Events) != 2 || e.Events[0] != EventModelTrained || e.Events[1] != EventSignalChanged {
+		t.Fatalf("Events = %v", e.Events)
+	}
+	if !strings.HasPrefix(e.Secret, secretPrefix) || len(e.Secret) != len(secretPrefix)+48 {
+		t.Fatalf("Secret = %q", e.Secret)
+	}
+	if !e.Subscribes(EventModelTrained) || e.Subscribes(EventAlertTriggered) {
+		t.Fatal("Subscribes does not follow Events")
+	}
+
+	old := e.Secret
+	if err := e.RotateSecret(); err != nil || e.Secret == old {
+		t.Fatalf("RotateSecret kept %q (err %v)", e.Secret, err)
+	}
+}
+
+func TestNewEndpointValidation(t *testing.T) {
+	org, user := uuid.New(), uuid.New()
+	cases := []struct {
+		name   string
+		org    uuid.UUID
+		url    string
+		desc   string
+		events []string
+		want   error
+	}{
+		{"organization", uuid.Nil, "https://x.io", "", []string{"*"}, ErrOrganizationRequired},
+		{"scheme", org, "ftp://x.io", "", []string{"*"}, ErrInvalidURL},
+		{"relative", org, "/hook", "", []string{"*"}, ErrInvalidURL},
+		{"loopback", org, "http://127.0.0.1:8080/hook", "", []string{"*"}, ErrPrivateURL},
+		{"metadata", org, "http://169.254.169.254/latest/meta-data/", "", []string{"*"}, ErrPrivateURL},
+		{"localhost", org, "http://localhost/hook", "", []string{"*"}, ErrPrivateURL},
+		{"description", org, "https://x.io", strings.Repeat("a", maxDescription+1), []string{"*"}, ErrDescriptionTooLong},
+		{"no events", org, "https://x.io", "", nil, ErrEventsRequired},
+		{"unknown event", org, "https://x.io", "", []string{"model.deleted"}, ErrInvalidEvent},
+		{"test event", org, "https://x.io", "", []string{EventTest}, ErrInvalidEvent},
+	}
+	for _, tc := range cases {
+		if _, err := NewEndpoint(tc.org, user, tc.url, tc.desc, tc.events); !errors.Is(err, tc.want) {
+			t.Errorf("%s: err = %v, want %v", tc.name, err, tc.want)
+		}
+	}
+}
+
+func TestWildcardSubscribesToEverything(t *testing.T) {
+	e, err := NewEndpoint(uuid.New(), uuid.New(), "https://hooks.example.com", "", []string{EventModelTrained, "*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(e.Events) != 1 || e.Events[0] != EventAll {
+		t.Fatalf("Events = %v", e.Events)
+	}
+	for _, ev := range Events {
+		if !e.Subscribes(ev) {
+			t.Fatalf("wildcard does not subscribe to %s", ev)
+		}
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 10, Backoff: 30 * time.Second, MaxBackoff: 5 * time.Minute}
+	want := []time.Duration{30 * time.Second, time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute, 5 * time.Minute}
+	for i, w := range want {
+		if got := p.Delay(i + 1); got != w {
+			t.Errorf("Delay(%d) = %v, want %v", i+1, got, w)
+		}
+	}
+}
+
+func TestRecordRetriesThenDies(t *testing.T) {
+	e, _ := NewEndpoint(uuid.New(), uuid.New(), "https://x.io", "", []string{"*"})
+	d := NewDelivery(*e, uuid.New(), EventModelTrained, []byte(`{}`), t0)
+	p := RetryPolicy{MaxAttempts: 3, Backoff: time.Minute, MaxBackoff: time.Hour}
+	fail := Result{StatusCode: 500, Body: "oops", Err: errors.New("webhook returned 500")}
+
+	a := d.Record(fail, t0, p, true)
+	if d.Status != StatusPending || d.Attempts != 1 || !d.NextAttemptAt.Equal(t0.Add(time.Minute)) {
+		t.Fatalf("after first failure: %+v", d)
+	}
+	if a.Attempt != 1 || a.StatusCode == nil || *a.StatusCode != 500 || a.Error == "" || a.ResponseBody != "oops" {
+		t.Fatalf("attempt = %+v", a)
+	}
+
+	d.Record(Result{Err: errors.New("connection refused")}, t0.Add(time.Minute), p, true)
+	if d.Status != StatusPending || !d.NextAttemptAt.Equal(t0.Add(3*time.Minute)) || d.LastStatusCode != nil {
+		t.Fatalf("after second failure: %+v", d)
+	}
+
+	d.Record(fail, t0.Add(3*time.Minute), p, true)
+	if d.Status != StatusDead || d.Attempts != 3 {
+		t.Fatalf("after last failure: %+v", d)
+	}
+
+	// A manual redelivery that works brings a dead delivery back.
+	d.Record(Result{StatusCode: 204}, t0.Add(time.Hour), p, false)
+	if d.Status != StatusSucceeded || d.DeliveredAt == nil || d.LastError != "" || d.Attempts != 4 {
+		t.Fatalf("after redelivery: %+v", d)
+	}
+}
+
+func TestRecordWithoutRetryDiesOnFailure(t *testing.T) {
+	e, _ := NewEndpoint(uuid.New(), uuid.New(), "https://x.io", "", []string{"*"})
+	d := NewDelivery(*e, uuid.New(), EventTest, []byte(`{}`), t0)
+	d.Record(Result{StatusCode: 404, Err: errors.New("webhook returned 404")}, t0, DefaultRetryPolicy(), false)
+	if d.Status != StatusDead {
+		t.Fatalf("status = %s, want dead", d.Status)
+	}
+}
+
+func TestParseStatus(t *testing.T) {
+	for _, s := range []string{"", "pending", " DEAD ", "succeeded"} {
+		if _, err := ParseStatus(s); err != nil {
+			t.Errorf("ParseStatus(%q) = %v", s, err)
+		}
+	}
+	if _, err := ParseStatus("failed"); !errors.Is(err, ErrInvalidStatus) {
+		t.Fatalf("err = %v, want ErrInvalidStatus", err)
+	}
+}
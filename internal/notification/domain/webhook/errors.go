@@ -0,0 +1,20 @@
+package webhook
+
+import (
+	domainerr "go-ai/pkg/domain_err"
+	"net/http"
+)
+
+var (
+	ErrEndpointNotFound     = domainerr.New(http.StatusNotFound, "Webhook endpoint not found")
+	ErrDeliveryNotFound     = domainerr.New(http.StatusNotFound, "Webhook delivery not found")
+	ErrOrganizationRequired = domainerr.New(http.StatusBadRequest, "Webhook endpoint must belong to an organization")
+	ErrInvalidURL           = domainerr.New(http.StatusBadRequest, "Webhook URL must be an absolute http or https URL")
+	ErrPrivateURL           = domainerr.New(http.StatusBadRequest, "Webhook URL must point to a public host")
+	ErrDescriptionTooLong   = domainerr.New(http.StatusBadRequest, "Webhook description is too long")
+	ErrEventsRequired       = domainerr.New(http.StatusBadRequest, "Subscribe to at least one event")
+	ErrInvalidEvent         = domainerr.New(http.StatusBadRequest, "Events must be model.trained, model.promoted, signal.changed, alert.triggered or *")
+	ErrInvalidStatus        = domainerr.New(http.StatusBadRequest, "Status must be pending, succeeded or dead")
+	ErrTooManyEndpoints     = domainerr.New(http.StatusBadRequest, "Webhook endpoint limit reached")
+	ErrDeliveryQueued       = domainerr.New(http.StatusConflict, "Delivery is still queued for retry")
+)
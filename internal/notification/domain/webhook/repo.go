@@ -0,0 +1,46 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository methods that take an organization and user return
+// ErrEndpointNotFound for endpoints owned by anyone else. Secrets are
+// stored sealed and returned in the clear.
+type Repository interface {
+	Create(ctx context.Context, e *Endpoint) error
+	CountByOwner(ctx context.Context, orgID, userID uuid.UUID) (int64, error)
+	GetByID(ctx context.Context, orgID, userID, id uuid.UUID) (*Endpoint, error)
+	// GetEndpoint loads an endpoint of any owner, for the delivery worker.
+	GetEndpoint(ctx context.Context, id uuid.UUID) (*Endpoint, error)
+	List(ctx context.Context, orgID, userID uuid.UUID) ([]Endpoint, error)
+	// ListByUser returns the user's endpoints across every organization.
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]Endpoint, error)
+	// ListSubscribed returns the organization's enabled endpoints that want
+	// event, limited to one user's endpoints when userID is set.
+	ListSubscribed(ctx context.Context, orgID uuid.UUID, userID *uuid.UUID, event string) ([]Endpoint, error)
+	// Update saves the URL, description, events, secret and enabled flag.
+	Update(ctx context.Context, e *Endpoint) error
+	Delete(ctx context.Context, orgID, userID, id uuid.UUID) error
+	DeleteByOrganization(ctx context.Context, orgID uuid.UUID) (int64, error)
+	DeleteByUser(ctx context.Context, userID uuid.UUID) (int64, error)
+
+	CreateDelivery(ctx context.Context, d *Delivery) error
+	// ClaimDue returns up to limit pending deliveries due at now whose
+	// endpoint is enabled, and pushes them to leaseUntil so no other worker
+	// picks them up meanwhile.
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int32) ([]Delivery, error)
+	GetDelivery(ctx context.Context, endpointID, id uuid.UUID) (*Delivery, error)
+	// ListDeliveries returns an endpoint's deliveries, newest first, and the
+	// total count. An empty status matches every status.
+	ListDeliveries(ctx context.Context, endpointID uuid.UUID, status string, limit, offset int32) ([]Delivery, int64, error)
+	// RecordAttempt logs a and saves the delivery state in one transaction.
+	RecordAttempt(ctx context.Context, d *Delivery, a *Attempt) error
+	ListAttempts(ctx context.Context, deliveryID uuid.UUID) ([]Attempt, error)
+	// DeleteFinishedBefore drops succeeded and dead deliveries created
+	// before the cutoff, with their attempts.
+	DeleteFinishedBefore(ctx context.Context, before time.Time) (int64, error)
+}
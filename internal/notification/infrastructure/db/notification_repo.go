@@ -0,0 +1,125 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"go-ai/internal/notification/domain/notification"
+	sqlc "go-ai/internal/notification/infrastructure/sqlc/notification"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type NotificationRepo struct {
+	queries *sqlc.Queries
+}
+
+func NewNotificationRepo(pool *pgxpool.Pool) *NotificationRepo {
+	return &NotificationRepo{
+		queries: sqlc.New(pool),
+	}
+}
+
+func (r *NotificationRepo) Create(ctx context.Context, n *notification.Entity) error {
+	data := []byte(n.Data)
+	if len(data) == 0 {
+		data = []byte("{}")
+	}
+	row, err := r.queries.CreateNotification(ctx, sqlc.CreateNotificationParams{
+		ID:             n.ID,
+		UserID:         n.UserID,
+		OrganizationID: n.OrganizationID,
+		Kind:           n.Kind,
+		Title:          n.Title,
+		Body:           n.Body,
+		Data:           data,
+	})
+	if err != nil {
+		return err
+	}
+	n.CreatedAt = row.CreatedAt
+	return nil
+}
+
+func (r *NotificationRepo) List(ctx context.Context, userID uuid.UUID, unreadOnly bool, limit, offset int32) ([]notification.Entity, int64, error) {
+	total, err := r.queries.CountNotifications(ctx, sqlc.CountNotificationsParams{
+		UserID:     userID,
+		UnreadOnly: unreadOnly,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, err := r.queries.ListNotifications(ctx, sqlc.ListNotificationsParams{
+		UserID:     userID,
+		UnreadOnly: unreadOnly,
+		LimitRows:  limit,
+		OffsetRows: offset,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	out := make([]notification.Entity, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, toEntity(row))
+	}
+	return out, total, nil
+}
+
+func (r *NotificationRepo) CountUnread(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return r.queries.CountUnreadNotifications(ctx, userID)
+}
+
+func (r *NotificationRepo) MarkRead(ctx context.Context, userID, id uuid.UUID) error {
+	affected, err := r.queries.MarkNotificationRead(ctx, sqlc.MarkNotificationReadParams{
+		ID:     id,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return notification.ErrNotificationNotFound
+	}
+	return nil
+}
+
+func (r *NotificationRepo) MarkAllRead(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return r.queries.MarkAllNotificationsRead(ctx, userID)
+}
+
+func (r *NotificationRepo) DeleteByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return r.queries.DeleteUserNotifications(ctx, userID)
+}
+
+func (r *NotificationRepo) DeleteByOrganization(ctx context.Context, orgID uuid.UUID) (int64, error) {
+	return r.queries.DeleteOrganizationNotifications(ctx, orgID)
+}
+
+func (r *NotificationRepo) GetUserEmail(ctx context.Context, userID uuid.UUID) (string, error) {
+	email, err := r.queries.GetUserEmail(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", notification.ErrRecipientNotFound
+		}
+		return "", err
+	}
+	if email == nil || *email == "" {
+		return "", notification.ErrRecipientNotFound
+	}
+	return *email, nil
+}
+
+func toEntity(row sqlc.Notification) notification.Entity {
+	return notification.Entity{
+		ID:             row.ID,
+		UserID:         row.UserID,
+		OrganizationID: row.OrganizationID,
+		Kind:           row.Kind,
+		Title:          row.Title,
+		Body:           row.Body,
+		Data:           row.Data,
+		ReadAt:         row.ReadAt,
+		CreatedAt:      row.CreatedAt,
+	}
+}
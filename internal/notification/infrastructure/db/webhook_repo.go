@@ -0,0 +1,384 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-ai/internal/notification/domain/webhook"
+	sqlc "go-ai/internal/notification/infrastructure/sqlc/webhook"
+	"go-ai/pkg/helpers"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// WebhookRepo seals endpoint secrets with secretKey before they reach the
+// database.
+type WebhookRepo struct {
+	pool      *pgxpool.Pool
+	queries   *sqlc.Queries
+	secretKey string
+}
+
+func NewWebhookRepo(pool *pgxpool.Pool, secretKey string) *WebhookRepo {
+	return &WebhookRepo{
+		pool:      pool,
+		queries:   sqlc.New(pool),
+		secretKey: secretKey,
+	}
+}
+
+func (r *WebhookRepo) Create(ctx context.Context, e *webhook.Endpoint) error {
+	secret, err := helpers.EncryptString(r.secretKey, e.Secret)
+	if err != nil {
+		return err
+	}
+	row, err := r.queries.CreateWebhookEndpoint(ctx, sqlc.CreateWebhookEndpointParams{
+		ID:             e.ID,
+		OrganizationID: e.OrganizationID,
+		UserID:         e.UserID,
+		Url:            e.URL,
+		Description:    e.Description,
+		Events:         e.Events,
+		Secret:         secret,
+		Enabled:        e.Enabled,
+	})
+	if err != nil {
+		return err
+	}
+	e.CreatedAt = row.CreatedAt
+	e.UpdatedAt = row.UpdatedAt
+	return nil
+}
+
+func (r *WebhookRepo) CountByOwner(ctx context.Context, orgID, userID uuid.UUID) (int64, error) {
+	return r.queries.CountOwnerWebhookEndpoints(ctx, sqlc.CountOwnerWebhookEndpointsParams{
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+}
+
+func (r *WebhookRepo) GetByID(ctx context.Context, orgID, userID, id uuid.UUID) (*webhook.Endpoint, error) {
+	row, err := r.queries.GetWebhookEndpoint(ctx, sqlc.GetWebhookEndpointParams{
+		ID:             id,
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, webhook.ErrEndpointNotFound
+		}
+		return nil, err
+	}
+	e, err := r.toEndpoint(row)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (r *WebhookRepo) GetEndpoint(ctx context.Context, id uuid.UUID) (*webhook.Endpoint, error) {
+	row, err := r.queries.GetWebhookEndpointByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, webhook.ErrEndpointNotFound
+		}
+		return nil, err
+	}
+	e, err := r.toEndpoint(row)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (r *WebhookRepo) List(ctx context.Context, orgID, userID uuid.UUID) ([]webhook.Endpoint, error) {
+	rows, err := r.queries.ListWebhookEndpoints(ctx, sqlc.ListWebhookEndpointsParams{
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.toEndpoints(rows)
+}
+
+func (r *WebhookRepo) ListByUser(ctx context.Context, userID uuid.UUID) ([]webhook.Endpoint, error) {
+	rows, err := r.queries.ListUserWebhookEndpoints(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return r.toEndpoints(rows)
+}
+
+func (r *WebhookRepo) ListSubscribed(ctx context.Context, orgID uuid.UUID, userID *uuid.UUID, event string) ([]webhook.Endpoint, error) {
+	rows, err := r.queries.ListSubscribedWebhookEndpoints(ctx, sqlc.ListSubscribedWebhookEndpointsParams{
+		OrganizationID: orgID,
+		EventType:      event,
+		UserID:         userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.toEndpoints(rows)
+}
+
+func (r *WebhookRepo) Update(ctx context.Context, e *webhook.Endpoint) error {
+	secret, err := helpers.EncryptString(r.secretKey, e.Secret)
+	if err != nil {
+		return err
+	}
+	affected, err := r.queries.UpdateWebhookEndpoint(ctx, sqlc.UpdateWebhookEndpointParams{
+		Url:            e.URL,
+		Description:    e.Description,
+		Events:         e.Events,
+		Secret:         secret,
+		Enabled:        e.Enabled,
+		ID:             e.ID,
+		OrganizationID: e.OrganizationID,
+		UserID:         e.UserID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return webhook.ErrEndpointNotFound
+	}
+	return nil
+}
+
+func (r *WebhookRepo) Delete(ctx context.Context, orgID, userID, id uuid.UUID) error {
+	affected, err := r.queries.DeleteWebhookEndpoint(ctx, sqlc.DeleteWebhookEndpointParams{
+		ID:             id,
+		OrganizationID: orgID,
+		UserID:         userID,
+	})
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return webhook.ErrEndpointNotFound
+	}
+	return nil
+}
+
+func (r *WebhookRepo) DeleteByOrganization(ctx context.Context, orgID uuid.UUID) (int64, error) {
+	return r.queries.DeleteOrganizationWebhookEndpoints(ctx, orgID)
+}
+
+func (r *WebhookRepo) DeleteByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return r.queries.DeleteUserWebhookEndpoints(ctx, userID)
+}
+
+func (r *WebhookRepo) CreateDelivery(ctx context.Context, d *webhook.Delivery) error {
+	row, err := r.queries.CreateWebhookDelivery(ctx, sqlc.CreateWebhookDeliveryParams{
+		ID:             d.ID,
+		EndpointID:     d.EndpointID,
+		OrganizationID: d.OrganizationID,
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Payload:        d.Payload,
+		NextAttemptAt:  d.NextAttemptAt,
+	})
+	if err != nil {
+		return err
+	}
+	*d = toDelivery(row)
+	return nil
+}
+
+func (r *WebhookRepo) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int32) ([]webhook.Delivery, error) {
+	rows, err := r.queries.ClaimDueWebhookDeliveries(ctx, sqlc.ClaimDueWebhookDeliveriesParams{
+		LeaseUntil: leaseUntil,
+		Now:        now,
+		LimitRows:  limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDeliveries(rows), nil
+}
+
+func (r *WebhookRepo) GetDelivery(ctx context.Context, endpointID, id uuid.UUID) (*webhook.Delivery, error) {
+	row, err := r.queries.GetWebhookDelivery(ctx, sqlc.GetWebhookDeliveryParams{
+		ID:         id,
+		EndpointID: endpointID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, webhook.ErrDeliveryNotFound
+		}
+		return nil, err
+	}
+	d := toDelivery(row)
+	return &d, nil
+}
+
+func (r *WebhookRepo) ListDeliveries(ctx context.Context, endpointID uuid.UUID, status string, limit, offset int32) ([]webhook.Delivery, int64, error) {
+	total, err := r.queries.CountWebhookDeliveries(ctx, sqlc.CountWebhookDeliveriesParams{
+		EndpointID: endpointID,
+		Status:     status,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, err := r.queries.ListWebhookDeliveries(ctx, sqlc.ListWebhookDeliveriesParams{
+		EndpointID: endpointID,
+		Status:     status,
+		LimitRows:  limit,
+		OffsetRows: offset,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return toDeliveries(rows), total, nil
+}
+
+func (r *WebhookRepo) RecordAttempt(ctx context.Context, d *webhook.Delivery, a *webhook.Attempt) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	q := r.queries.WithTx(tx)
+
+	if err := q.CreateWebhookDeliveryAttempt(ctx, sqlc.CreateWebhookDeliveryAttemptParams{
+		ID:           a.ID,
+		DeliveryID:   a.DeliveryID,
+		Attempt:      int32(a.Attempt),
+		StatusCode:   optionalInt32(a.StatusCode),
+		Error:        optionalString(a.Error),
+		ResponseBody: a.ResponseBody,
+		DurationMs:   int32(a.Duration / time.Millisecond),
+		AttemptedAt:  a.AttemptedAt,
+	}); err != nil {
+		return err
+	}
+	if err := q.UpdateWebhookDeliveryState(ctx, sqlc.UpdateWebhookDeliveryStateParams{
+		Status:         d.Status,
+		Attempts:       int32(d.Attempts),
+		NextAttemptAt:  d.NextAttemptAt,
+		LastAttemptAt:  d.LastAttemptAt,
+		LastStatusCode: optionalInt32(d.LastStatusCode),
+		LastError:      optionalString(d.LastError),
+		DeliveredAt:    d.DeliveredAt,
+		ID:             d.ID,
+	}); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func (r *WebhookRepo) ListAttempts(ctx context.Context, deliveryID uuid.UUID) ([]webhook.Attempt, error) {
+	rows, err := r.queries.ListWebhookDeliveryAttempts(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]webhook.Attempt, 0, len(rows))
+	for _, row := range rows {
+		a := webhook.Attempt{
+			ID:           row.ID,
+			DeliveryID:   row.DeliveryID,
+			Attempt:      int(row.Attempt),
+			StatusCode:   intPtr(row.StatusCode),
+			ResponseBody: row.ResponseBody,
+			Duration:     time.Duration(row.DurationMs) * time.Millisecond,
+			AttemptedAt:  row.AttemptedAt,
+		}
+		if row.Error != nil {
+			a.Error = *row.Error
+		}
+		out = append(out, a)
+	}
+	return out, nil
+}
+
+func (r *WebhookRepo) DeleteFinishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	return r.queries.DeleteFinishedWebhookDeliveries(ctx, before)
+}
+
+func (r *WebhookRepo) toEndpoint(row sqlc.WebhookEndpoint) (webhook.Endpoint, error) {
+	secret, err := helpers.DecryptString(r.secretKey, row.Secret)
+	if err != nil {
+		return webhook.Endpoint{}, fmt.Errorf("decrypt webhook secret %s: %w", row.ID, err)
+	}
+	return webhook.Endpoint{
+		ID:             row.ID,
+		OrganizationID: row.OrganizationID,
+		UserID:         row.UserID,
+		URL:            row.Url,
+		Description:    row.Description,
+		Events:         row.Events,
+		Secret:         secret,
+		Enabled:        row.Enabled,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}, nil
+}
+
+func (r *WebhookRepo) toEndpoints(rows []sqlc.WebhookEndpoint) ([]webhook.Endpoint, error) {
+	out := make([]webhook.Endpoint, 0, len(rows))
+	for _, row := range rows {
+		e, err := r.toEndpoint(row)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, e)
+	}
+	return out, nil
+}
+
+func toDelivery(row sqlc.WebhookDelivery) webhook.Delivery {
+	d := webhook.Delivery{
+		ID:             row.ID,
+		EndpointID:     row.EndpointID,
+		OrganizationID: row.OrganizationID,
+		EventID:        row.EventID,
+		EventType:      row.EventType,
+		Payload:        row.Payload,
+		Status:         row.Status,
+		Attempts:       int(row.Attempts),
+		NextAttemptAt:  row.NextAttemptAt,
+		LastAttemptAt:  row.LastAttemptAt,
+		LastStatusCode: intPtr(row.LastStatusCode),
+		DeliveredAt:    row.DeliveredAt,
+		CreatedAt:      row.CreatedAt,
+	}
+	if row.LastError != nil {
+		d.LastError = *row.LastError
+	}
+	return d
+}
+
+func toDeliveries(rows []sqlc.WebhookDelivery) []webhook.Delivery {
+	out := make([]webhook.Delivery, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, toDelivery(row))
+	}
+	return out
+}
+
+func optionalString(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+func optionalInt32(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	n := int32(*v)
+	return &n
+}
+
+func intPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	n := int(*v)
+	return &n
+}
@@ -0,0 +1,110 @@
+package notificationhttp
+
+import (
+	notificationapp "go-ai/internal/notification/application/notification"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/rs/zerolog"
+)
+
+type NotificationHandler struct {
+	ListNotificationsUseCase *notificationapp.ListNotificationsUseCase
+	MarkReadUseCase          *notificationapp.MarkReadUseCase
+	MarkAllReadUseCase       *notificationapp.MarkAllReadUseCase
+	Logger                   zerolog.Logger
+}
+
+func NewNotificationHandler(
+	listNotificationsUseCase *notificationapp.ListNotificationsUseCase,
+	markReadUseCase *notificationapp.MarkReadUseCase,
+	markAllReadUseCase *notificationapp.MarkAllReadUseCase,
+	logger zerolog.Logger,
+) *NotificationHandler {
+	return &NotificationHandler{
+		ListNotificationsUseCase: listNotificationsUseCase,
+		MarkReadUseCase:          markReadUseCase,
+		MarkAllReadUseCase:       markAllReadUseCase,
+		Logger:                   logger.With().Str("component", "NotificationHandler").Logger(),
+	}
+}
+
+// ListNotifications godoc
+// @Summary List notifications
+// @Description List the caller's in-app notifications, newest first, with the unread count
+// @Tags Notifications
+// @Produce json
+// @Param page query int false "Page number"
+// @Param limit query int false "Page size"
+// @Param unread query bool false "Only unread notifications"
+// @Success 200 {object} notificationapp.ListNotificationsSuccessResponseDoc "Notifications retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/notifications [get]
+func (h *NotificationHandler) ListNotifications(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	var in notificationapp.ListNotificationsRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid query parameters")
+	}
+	result, err := h.ListNotificationsUseCase.Execute(c.Request().Context(), userID, in)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to list notifications")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Notifications retrieved successfully")
+}
+
+// MarkNotificationRead godoc
+// @Summary Mark a notification as read
+// @Description Mark one of the caller's notifications as read. Marking it again keeps the first read time
+// @Tags Notifications
+// @Produce json
+// @Param id path string true "Notification ID"
+// @Success 200 {object} response.SuccessBaseDoc "Notification marked as read"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/notifications/{id}/read [post]
+func (h *NotificationHandler) MarkRead(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid notification ID")
+	}
+	if err := h.MarkReadUseCase.Execute(c.Request().Context(), userID, id); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to mark notification as read")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Notification marked as read")
+}
+
+// MarkAllNotificationsRead godoc
+// @Summary Mark every notification as read
+// @Description Mark all of the caller's unread notifications as read
+// @Tags Notifications
+// @Produce json
+// @Success 200 {object} notificationapp.MarkAllReadSuccessResponseDoc "Notifications marked as read"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/notifications/read-all [post]
+func (h *NotificationHandler) MarkAllRead(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	result, err := h.MarkAllReadUseCase.Execute(c.Request().Context(), userID)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to mark notifications as read")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Notifications marked as read")
+}
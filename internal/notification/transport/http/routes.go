@@ -0,0 +1,33 @@
+package notificationhttp
+
+import (
+	"go-ai/internal/identity/domain/rbac"
+	middlewares "go-ai/internal/identity/transport/middlewares"
+
+	"github.com/labstack/echo/v5"
+)
+
+func RegisterNotificationRoutes(api *echo.Group, h *NotificationHandler, m *middlewares.IdentityMiddleware) {
+	notifications := api.Group("/notifications", m.SessionOnly)
+	notifications.GET("", h.ListNotifications)
+	notifications.POST("/read-all", h.MarkAllRead)
+	notifications.POST("/:id/read", h.MarkRead)
+}
+
+func RegisterWebhookRoutes(api *echo.Group, h *WebhookHandler, m *middlewares.IdentityMiddleware, rbacService rbac.Service) {
+	// Delivery logs keep what receivers answered, so webhooks need a
+	// permission on top of a session.
+	manage := middlewares.RequirePermission(rbacService, rbac.WebhooksManage)
+	webhooks := api.Group("/webhooks", m.SessionOnly, m.Organization, manage)
+
+	webhooks.GET("", h.ListWebhooks)
+	webhooks.POST("", h.CreateWebhook)
+	webhooks.GET("/:id", h.GetWebhook)
+	webhooks.PATCH("/:id", h.UpdateWebhook)
+	webhooks.DELETE("/:id", h.DeleteWebhook)
+	webhooks.POST("/:id/rotate-secret", h.RotateSecret)
+	webhooks.POST("/:id/test", h.SendTestEvent)
+	webhooks.GET("/:id/deliveries", h.ListDeliveries)
+	webhooks.GET("/:id/deliveries/:delivery_id", h.GetDelivery)
+	webhooks.POST("/:id/deliveries/:delivery_id/redeliver", h.Redeliver)
+}
@@ -0,0 +1,409 @@
+package notificationhttp
+
+import (
+	webhookapp "go-ai/internal/notification/application/webhook"
+	domainerr "go-ai/pkg/domain_err"
+	"go-ai/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/rs/zerolog"
+)
+
+type WebhookHandler struct {
+	ListEndpointsUseCase  *webhookapp.ListEndpointsUseCase
+	CreateEndpointUseCase *webhookapp.CreateEndpointUseCase
+	GetEndpointUseCase    *webhookapp.GetEndpointUseCase
+	UpdateEndpointUseCase *webhookapp.UpdateEndpointUseCase
+	DeleteEndpointUseCase *webhookapp.DeleteEndpointUseCase
+	RotateSecretUseCase   *webhookapp.RotateSecretUseCase
+	SendTestEventUseCase  *webhookapp.SendTestEventUseCase
+	ListDeliveriesUseCase *webhookapp.ListDeliveriesUseCase
+	GetDeliveryUseCase    *webhookapp.GetDeliveryUseCase
+	RedeliverUseCase      *webhookapp.RedeliverUseCase
+	Logger                zerolog.Logger
+}
+
+func NewWebhookHandler(
+	listEndpointsUseCase *webhookapp.ListEndpointsUseCase,
+	createEndpointUseCase *webhookapp.CreateEndpointUseCase,
+	getEndpointUseCase *webhookapp.GetEndpointUseCase,
+	updateEndpointUseCase *webhookapp.UpdateEndpointUseCase,
+	deleteEndpointUseCase *webhookapp.DeleteEndpointUseCase,
+	rotateSecretUseCase *webhookapp.RotateSecretUseCase,
+	sendTestEventUseCase *webhookapp.SendTestEventUseCase,
+	listDeliveriesUseCase *webhookapp.ListDeliveriesUseCase,
+	getDeliveryUseCase *webhookapp.GetDeliveryUseCase,
+	redeliverUseCase *webhookapp.RedeliverUseCase,
+	logger zerolog.Logger,
+) *WebhookHandler {
+	return &WebhookHandler{
+		ListEndpointsUseCase:  listEndpointsUseCase,
+		CreateEndpointUseCase: createEndpointUseCase,
+		GetEndpointUseCase:    getEndpointUseCase,
+		UpdateEndpointUseCase: updateEndpointUseCase,
+		DeleteEndpointUseCase: deleteEndpointUseCase,
+		RotateSecretUseCase:   rotateSecretUseCase,
+		SendTestEventUseCase:  sendTestEventUseCase,
+		ListDeliveriesUseCase: listDeliveriesUseCase,
+		GetDeliveryUseCase:    getDeliveryUseCase,
+		RedeliverUseCase:      redeliverUseCase,
+		Logger:                logger.With().Str("component", "WebhookHandler").Logger(),
+	}
+}
+
+// ListWebhooks godoc
+// @Summary List webhook endpoints
+// @Description List the caller's webhook endpoints in the active organization. Secrets are not included
+// @Tags Webhooks
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Success 200 {object} webhookapp.ListEndpointsSuccessResponseDoc "Webhooks retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/webhooks [get]
+func (h *WebhookHandler) ListWebhooks(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	endpoints, err := h.ListEndpointsUseCase.Execute(c.Request().Context(), orgID, userID)
+	if err != nil {
+		h.Logger.Error().Err(err).Msg("failed to list webhooks")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, endpoints, "Webhooks retrieved successfully")
+}
+
+// CreateWebhook godoc
+// @Summary Register a webhook endpoint
+// @Description Register a URL to receive the organization's model.trained, model.promoted and signal.changed events and the caller's alert.triggered events. The response carries the signing secret, which is not shown again
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param body body webhookapp.CreateEndpointRequest true "Endpoint definition"
+// @Success 200 {object} webhookapp.EndpointSecretSuccessResponseDoc "Webhook created successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/webhooks [post]
+func (h *WebhookHandler) CreateWebhook(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	var in webhookapp.CreateEndpointRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	created, err := h.CreateEndpointUseCase.Execute(c.Request().Context(), orgID, userID, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to create webhook")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, created, "Webhook created successfully")
+}
+
+// GetWebhook godoc
+// @Summary Get a webhook endpoint
+// @Description Get one webhook endpoint without its secret
+// @Tags Webhooks
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Webhook ID"
+// @Success 200 {object} webhookapp.EndpointSuccessResponseDoc "Webhook retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/webhooks/{id} [get]
+func (h *WebhookHandler) GetWebhook(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid webhook ID")
+	}
+	result, err := h.GetEndpointUseCase.Execute(c.Request().Context(), orgID, userID, id)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to get webhook")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Webhook retrieved successfully")
+}
+
+// UpdateWebhook godoc
+// @Summary Update a webhook endpoint
+// @Description Change the URL, description, events or enabled flag. Deliveries queued for a disabled endpoint wait until it is enabled again
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Webhook ID"
+// @Param body body webhookapp.UpdateEndpointRequest true "Fields to change"
+// @Success 200 {object} webhookapp.EndpointSuccessResponseDoc "Webhook updated successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/webhooks/{id} [patch]
+func (h *WebhookHandler) UpdateWebhook(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid webhook ID")
+	}
+	var in webhookapp.UpdateEndpointRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid request payload")
+	}
+	updated, err := h.UpdateEndpointUseCase.Execute(c.Request().Context(), orgID, userID, id, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to update webhook")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, updated, "Webhook updated successfully")
+}
+
+// DeleteWebhook godoc
+// @Summary Delete a webhook endpoint
+// @Description Delete a webhook endpoint with its queued deliveries and delivery log
+// @Tags Webhooks
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Webhook ID"
+// @Success 200 {object} response.SuccessBaseDoc "Webhook deleted successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/webhooks/{id} [delete]
+func (h *WebhookHandler) DeleteWebhook(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid webhook ID")
+	}
+	if err := h.DeleteEndpointUseCase.Execute(c.Request().Context(), orgID, userID, id); err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to delete webhook")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success[any](c, nil, "Webhook deleted successfully")
+}
+
+// RotateWebhookSecret godoc
+// @Summary Rotate a webhook signing secret
+// @Description Replace the signing secret and return the new one. The old secret stops working immediately, including for queued retries
+// @Tags Webhooks
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Webhook ID"
+// @Success 200 {object} webhookapp.EndpointSecretSuccessResponseDoc "Webhook secret rotated successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/webhooks/{id}/rotate-secret [post]
+func (h *WebhookHandler) RotateSecret(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid webhook ID")
+	}
+	result, err := h.RotateSecretUseCase.Execute(c.Request().Context(), orgID, userID, id)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to rotate webhook secret")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Webhook secret rotated successfully")
+}
+
+// SendWebhookTestEvent godoc
+// @Summary Send a test event
+// @Description Send a signed webhook.test event to the endpoint right away and return the logged attempt. Test events are not retried
+// @Tags Webhooks
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Webhook ID"
+// @Success 200 {object} webhookapp.DeliverySuccessResponseDoc "Test event sent"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/webhooks/{id}/test [post]
+func (h *WebhookHandler) SendTestEvent(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid webhook ID")
+	}
+	result, err := h.SendTestEventUseCase.Execute(c.Request().Context(), orgID, userID, id)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to send webhook test event")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Test event sent")
+}
+
+// ListWebhookDeliveries godoc
+// @Summary List webhook deliveries
+// @Description List the endpoint's deliveries, newest first
+// @Tags Webhooks
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Webhook ID"
+// @Param page query int false "Page number"
+// @Param limit query int false "Page size"
+// @Param status query string false "pending, succeeded or dead"
+// @Success 200 {object} webhookapp.ListDeliveriesSuccessResponseDoc "Deliveries retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) ListDeliveries(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid webhook ID")
+	}
+	var in webhookapp.ListDeliveriesRequest
+	if err := c.Bind(&in); err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid query parameters")
+	}
+	result, err := h.ListDeliveriesUseCase.Execute(c.Request().Context(), orgID, userID, id, in)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to list webhook deliveries")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Deliveries retrieved successfully")
+}
+
+// GetWebhookDelivery godoc
+// @Summary Get a webhook delivery
+// @Description Get one delivery with its payload and the log of every attempt
+// @Tags Webhooks
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Webhook ID"
+// @Param delivery_id path string true "Delivery ID"
+// @Success 200 {object} webhookapp.DeliverySuccessResponseDoc "Delivery retrieved successfully"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/webhooks/{id}/deliveries/{delivery_id} [get]
+func (h *WebhookHandler) GetDelivery(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid webhook ID")
+	}
+	deliveryID, err := uuid.Parse(c.Param("delivery_id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid delivery ID")
+	}
+	result, err := h.GetDeliveryUseCase.Execute(c.Request().Context(), orgID, userID, id, deliveryID)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to get webhook delivery")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Delivery retrieved successfully")
+}
+
+// RedeliverWebhook godoc
+// @Summary Redeliver a webhook event
+// @Description Send a dead or already delivered event again, once and right away. Deliveries still queued for retry are rejected
+// @Tags Webhooks
+// @Produce json
+// @Param X-Organization-ID header string false "Organization ID, defaults to the active organization"
+// @Param id path string true "Webhook ID"
+// @Param delivery_id path string true "Delivery ID"
+// @Success 200 {object} webhookapp.DeliverySuccessResponseDoc "Delivery sent"
+// @Failure default {object} response.ErrorDoc "Errors"
+// @Router /api/webhooks/{id}/deliveries/{delivery_id}/redeliver [post]
+func (h *WebhookHandler) Redeliver(c *echo.Context) error {
+	userID, ok := c.Get("user_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusUnauthorized, "Unauthorized")
+	}
+	orgID, ok := c.Get("org_id").(uuid.UUID)
+	if !ok {
+		return response.Error(c, http.StatusForbidden, "No active organization")
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid webhook ID")
+	}
+	deliveryID, err := uuid.Parse(c.Param("delivery_id"))
+	if err != nil {
+		return response.Error(c, http.StatusBadRequest, "Invalid delivery ID")
+	}
+	result, err := h.RedeliverUseCase.Execute(c.Request().Context(), orgID, userID, id, deliveryID)
+	if err != nil {
+		if ae, ok := err.(domainerr.AppError); ok {
+			return response.Error(c, ae.Status, ae.Msg)
+		}
+		h.Logger.Error().Err(err).Msg("failed to redeliver webhook")
+		return response.Error(c, http.StatusInternalServerError, "Internal server error")
+	}
+	return response.Success(c, result, "Delivery sent")
+}
@@ -11,6 +11,8 @@ import (
 const (
 	defaultAccessSecret  = "your-access-secret-key"
 	defaultRefreshSecret = "your-refresh-secret-key"
+	defaultTOTPKey       = "your-totp-encryption-key"
+	defaultWebhookKey    = "your-webhook-encryption-key"
 )
 
 type Config struct {
@@ -18,6 +20,9 @@ type Config struct {
 	JwtRefreshSecret    string `mapstructure:"JWT_REFRESH_SECRET"`
 	JwtExpiresIn        int    `mapstructure:"JWT_EXPIRES_IN"`
 	JwtRefreshExpiresIn int    `mapstructure:"JWT_REFRESH_EXPIRES_IN"`
+	JwtAlgorithm        string `mapstructure:"JWT_ALGORITHM"`  // HS256, RS256 or EdDSA
+	JwtKeysDir          string `mapstructure:"JWT_KEYS_DIR"`   // PEM keys for RS256/EdDSA
+	JwtActiveKID        string `mapstructure:"JWT_ACTIVE_KID"` // key that signs new tokens
 	RedisHost           string `mapstructure:"REDIS_HOST"`
 	RedisPassword       string `mapstructure:"REDIS_PASSWORD"`
 	RedisPort           int    `mapstructure:"REDIS_PORT"`
@@ -56,6 +61,119 @@ type Config struct {
 	// Timeout Settings
 	RequestTimeout  int `mapstructure:"REQUEST_TIMEOUT"`  // seconds
 	ShutdownTimeout int `mapstructure:"SHUTDOWN_TIMEOUT"` // seconds
+
+	// Proxy Settings
+	TrustedProxies string `mapstructure:"TRUSTED_PROXIES"` // comma separated IPs or CIDRs
+
+	// Background Job Settings
+	JobsEnabled     bool   `mapstructure:"JOBS_ENABLED"`
+	JobWorkers      int    `mapstructure:"JOB_WORKERS"`
+	JobPollInterval int    `mapstructure:"JOB_POLL_INTERVAL"` // seconds
+	JobLockTTL      int    `mapstructure:"JOB_LOCK_TTL"`      // seconds
+	JobRetryBackoff int    `mapstructure:"JOB_RETRY_BACKOFF"` // seconds
+	JobMaxBackoff   int    `mapstructure:"JOB_MAX_BACKOFF"`   // seconds
+	BinanceBaseURL  string `mapstructure:"BINANCE_BASE_URL"`
+	BinanceTimeout  int    `mapstructure:"BINANCE_TIMEOUT"` // seconds
+
+	// Mail Settings
+	MailDriver    string `mapstructure:"MAIL_DRIVER"` // smtp, file or memory
+	MailFrom      string `mapstructure:"MAIL_FROM"`
+	MailOutboxDir string `mapstructure:"MAIL_OUTBOX_DIR"`
+	SMTPHost      string `mapstructure:"SMTP_HOST"`
+	SMTPPort      int    `mapstructure:"SMTP_PORT"`
+	SMTPUsername  string `mapstructure:"SMTP_USERNAME"`
+	SMTPPassword  string `mapstructure:"SMTP_PASSWORD"`
+	AppBaseURL    string `mapstructure:"APP_BASE_URL"`
+
+	// Account Settings
+	PasswordResetTTL         int  `mapstructure:"PASSWORD_RESET_TTL"`      // seconds
+	PasswordResetCooldown    int  `mapstructure:"PASSWORD_RESET_COOLDOWN"` // seconds
+	PasswordResetIPMax       int  `mapstructure:"PASSWORD_RESET_IP_MAX"`
+	PasswordResetIPWindow    int  `mapstructure:"PASSWORD_RESET_IP_WINDOW"` // seconds
+	EmailVerificationTTL     int  `mapstructure:"EMAIL_VERIFICATION_TTL"`   // seconds
+	RequireEmailVerification bool `mapstructure:"REQUIRE_EMAIL_VERIFICATION"`
+
+	// Two-Factor Settings
+	TOTPIssuer        string `mapstructure:"TOTP_ISSUER"`
+	TOTPEncryptionKey string `mapstructure:"TOTP_ENCRYPTION_KEY"`
+	MFAChallengeTTL   int    `mapstructure:"MFA_CHALLENGE_TTL"` // seconds
+
+	// Login Lockout Settings
+	LoginMaxAttempts     int `mapstructure:"LOGIN_MAX_ATTEMPTS"`
+	LoginIPMaxAttempts   int `mapstructure:"LOGIN_IP_MAX_ATTEMPTS"`
+	LoginAttemptWindow   int `mapstructure:"LOGIN_ATTEMPT_WINDOW"`   // seconds
+	LoginLockoutDuration int `mapstructure:"LOGIN_LOCKOUT_DURATION"` // seconds
+	LoginDelayBase       int `mapstructure:"LOGIN_DELAY_BASE"`       // milliseconds
+	LoginDelayMax        int `mapstructure:"LOGIN_DELAY_MAX"`        // milliseconds
+
+	// Social Login Settings
+	OAuthProviders string `mapstructure:"OAUTH_PROVIDERS"` // comma separated names
+	OAuthStateTTL  int    `mapstructure:"OAUTH_STATE_TTL"` // seconds
+
+	// Organization Settings
+	OrgInvitationTTL int `mapstructure:"ORG_INVITATION_TTL"` // seconds
+
+	// Account Deletion Settings
+	AccountDeletionGrace int `mapstructure:"ACCOUNT_DELETION_GRACE"` // seconds
+	AccountPurgeInterval int `mapstructure:"ACCOUNT_PURGE_INTERVAL"` // seconds
+
+	// Password Settings
+	PasswordArgon2Memory      int    `mapstructure:"PASSWORD_ARGON2_MEMORY"` // KiB
+	PasswordArgon2Iterations  int    `mapstructure:"PASSWORD_ARGON2_ITERATIONS"`
+	PasswordArgon2Parallelism int    `mapstructure:"PASSWORD_ARGON2_PARALLELISM"`
+	PasswordPepper            string `mapstructure:"PASSWORD_PEPPER"`
+	PasswordMinLength         int    `mapstructure:"PASSWORD_MIN_LENGTH"`
+	PasswordMaxLength         int    `mapstructure:"PASSWORD_MAX_LENGTH"`
+	PasswordRequireLower      bool   `mapstructure:"PASSWORD_REQUIRE_LOWER"`
+	PasswordRequireUpper      bool   `mapstructure:"PASSWORD_REQUIRE_UPPER"`
+	PasswordRequireDigit      bool   `mapstructure:"PASSWORD_REQUIRE_DIGIT"`
+	PasswordRequireSpecial    bool   `mapstructure:"PASSWORD_REQUIRE_SPECIAL"`
+	PasswordBlocklistFile     string `mapstructure:"PASSWORD_BLOCKLIST_FILE"`
+
+	// Phone Settings
+	SMSDriver               string `mapstructure:"SMS_DRIVER"`
+	PhoneDefaultCountryCode string `mapstructure:"PHONE_DEFAULT_COUNTRY_CODE"`
+	PhoneCodeTTL            int    `mapstructure:"PHONE_CODE_TTL"`      // seconds
+	PhoneCodeCooldown       int    `mapstructure:"PHONE_CODE_COOLDOWN"` // seconds
+	PhoneCodeMaxSends       int    `mapstructure:"PHONE_CODE_MAX_SENDS"`
+	PhoneCodeWindow         int    `mapstructure:"PHONE_CODE_WINDOW"` // seconds
+	PhoneCodeMaxAttempts    int    `mapstructure:"PHONE_CODE_MAX_ATTEMPTS"`
+
+	// Alert Settings
+	AlertsEnabled        bool `mapstructure:"ALERTS_ENABLED"`
+	AlertEvalInterval    int  `mapstructure:"ALERT_EVAL_INTERVAL"`    // seconds
+	AlertDefaultCooldown int  `mapstructure:"ALERT_DEFAULT_COOLDOWN"` // seconds
+	NotifyWebhookTimeout int  `mapstructure:"NOTIFY_WEBHOOK_TIMEOUT"` // seconds
+
+	// Webhook Settings
+	WebhooksEnabled      bool   `mapstructure:"WEBHOOKS_ENABLED"`
+	WebhookEncryptionKey string `mapstructure:"WEBHOOK_ENCRYPTION_KEY"`
+	WebhookWorkers       int    `mapstructure:"WEBHOOK_WORKERS"`
+	WebhookPollInterval  int    `mapstructure:"WEBHOOK_POLL_INTERVAL"` // seconds
+	WebhookTimeout       int    `mapstructure:"WEBHOOK_TIMEOUT"`       // seconds
+	WebhookMaxAttempts   int    `mapstructure:"WEBHOOK_MAX_ATTEMPTS"`
+	WebhookRetryBackoff  int    `mapstructure:"WEBHOOK_RETRY_BACKOFF"` // seconds
+	WebhookMaxBackoff    int    `mapstructure:"WEBHOOK_MAX_BACKOFF"`   // seconds
+	WebhookRetention     int    `mapstructure:"WEBHOOK_RETENTION"`     // days
+
+	// Stream Settings
+	StreamsEnabled       bool `mapstructure:"STREAMS_ENABLED"`
+	StreamHeartbeat      int  `mapstructure:"STREAM_HEARTBEAT"`     // seconds
+	StreamPollInterval   int  `mapstructure:"STREAM_POLL_INTERVAL"` // seconds
+	StreamMaxConnections int  `mapstructure:"STREAM_MAX_CONNECTIONS"`
+	StreamLogSize        int  `mapstructure:"STREAM_LOG_SIZE"`
+	StreamReplayLimit    int  `mapstructure:"STREAM_REPLAY_LIMIT"`
+	StreamTicketTTL      int  `mapstructure:"STREAM_TICKET_TTL"` // seconds
+}
+
+// OAuthProviderConfig holds the OAUTH_<NAME>_* settings of one provider.
+type OAuthProviderConfig struct {
+	Kind         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Scopes       []string
 }
 
 func LoadConfig() (*Config, error) {
@@ -91,10 +209,24 @@ func LoadConfig() (*Config, error) {
 		logger.Error().Err(err).Msg("unable to decode config")
 		return nil, err
 	}
-	if cfg.JwtAccessSecret == defaultAccessSecret || cfg.JwtRefreshSecret == defaultRefreshSecret {
+	// Default secrets are only tolerated in development. The HMAC secrets
+	// are unused once tokens are signed with a key pair.
+	if cfg.JwtAlgorithm == "HS256" && (cfg.JwtAccessSecret == defaultAccessSecret || cfg.JwtRefreshSecret == defaultRefreshSecret) {
 		logger.Warn().Msg("JWT secrets are using default values; set JWT_SECRET and JWT_REFRESH_SECRET")
-		if cfg.IsProduction() {
-			return nil, fmt.Errorf("jwt secrets must be set for production")
+		if !cfg.IsDevelopment() {
+			return nil, fmt.Errorf("jwt secrets must be set outside development")
+		}
+	}
+	if cfg.TOTPEncryptionKey == defaultTOTPKey {
+		logger.Warn().Msg("TOTP encryption key is using the default value; set TOTP_ENCRYPTION_KEY")
+		if !cfg.IsDevelopment() {
+			return nil, fmt.Errorf("totp encryption key must be set outside development")
+		}
+	}
+	if cfg.WebhookEncryptionKey == defaultWebhookKey {
+		logger.Warn().Msg("webhook encryption key is using the default value; set WEBHOOK_ENCRYPTION_KEY")
+		if !cfg.IsDevelopment() {
+			return nil, fmt.Errorf("webhook encryption key must be set outside development")
 		}
 	}
 
@@ -107,6 +239,9 @@ func setDefaults() {
 	viper.SetDefault("JWT_REFRESH_SECRET", defaultRefreshSecret)
 	viper.SetDefault("JWT_EXPIRES_IN", 3000)
 	viper.SetDefault("JWT_REFRESH_EXPIRES_IN", 6480000)
+	viper.SetDefault("JWT_ALGORITHM", "HS256")
+	viper.SetDefault("JWT_KEYS_DIR", "keys/jwt")
+	viper.SetDefault("JWT_ACTIVE_KID", "")
 
 	// Redis defaults
 	viper.SetDefault("REDIS_HOST", "localhost")
@@ -155,6 +290,122 @@ func setDefaults() {
 	// Timeout defaults
 	viper.SetDefault("REQUEST_TIMEOUT", 30)
 	viper.SetDefault("SHUTDOWN_TIMEOUT", 10)
+
+	// Proxy defaults
+	viper.SetDefault("TRUSTED_PROXIES", "")
+
+	// Background job defaults
+	viper.SetDefault("JOBS_ENABLED", true)
+	viper.SetDefault("JOB_WORKERS", 2)
+	viper.SetDefault("JOB_POLL_INTERVAL", 15)
+	viper.SetDefault("JOB_LOCK_TTL", 60)
+	viper.SetDefault("JOB_RETRY_BACKOFF", 30)
+	viper.SetDefault("JOB_MAX_BACKOFF", 600)
+	viper.SetDefault("BINANCE_BASE_URL", "")
+	viper.SetDefault("BINANCE_TIMEOUT", 15)
+
+	// Mail defaults
+	viper.SetDefault("MAIL_DRIVER", "file")
+	viper.SetDefault("MAIL_FROM", "no-reply@localhost")
+	viper.SetDefault("MAIL_OUTBOX_DIR", "outbox")
+	viper.SetDefault("SMTP_HOST", "")
+	viper.SetDefault("SMTP_PORT", 587)
+	viper.SetDefault("SMTP_USERNAME", "")
+	viper.SetDefault("SMTP_PASSWORD", "")
+	viper.SetDefault("APP_BASE_URL", "http://localhost:8080")
+
+	// Account defaults
+	viper.SetDefault("PASSWORD_RESET_TTL", 3600)
+	viper.SetDefault("PASSWORD_RESET_COOLDOWN", 60)
+	viper.SetDefault("PASSWORD_RESET_IP_MAX", 20)
+	viper.SetDefault("PASSWORD_RESET_IP_WINDOW", 3600)
+	viper.SetDefault("EMAIL_VERIFICATION_TTL", 86400)
+	viper.SetDefault("REQUIRE_EMAIL_VERIFICATION", false)
+
+	// Two-factor defaults
+	viper.SetDefault("TOTP_ISSUER", "go-market-ai")
+	viper.SetDefault("TOTP_ENCRYPTION_KEY", defaultTOTPKey)
+	viper.SetDefault("MFA_CHALLENGE_TTL", 300)
+
+	// Login lockout defaults
+	viper.SetDefault("LOGIN_MAX_ATTEMPTS", 5)
+	viper.SetDefault("LOGIN_IP_MAX_ATTEMPTS", 50)
+	viper.SetDefault("LOGIN_ATTEMPT_WINDOW", 900)
+	viper.SetDefault("LOGIN_LOCKOUT_DURATION", 900)
+	viper.SetDefault("LOGIN_DELAY_BASE", 1000)
+	viper.SetDefault("LOGIN_DELAY_MAX", 30000)
+
+	// Social login defaults
+	viper.SetDefault("OAUTH_PROVIDERS", "")
+	viper.SetDefault("OAUTH_STATE_TTL", 600)
+
+	// Organization defaults
+	viper.SetDefault("ORG_INVITATION_TTL", 604800)
+
+	// Account deletion defaults
+	viper.SetDefault("ACCOUNT_DELETION_GRACE", 2592000)
+	viper.SetDefault("ACCOUNT_PURGE_INTERVAL", 3600)
+
+	// Password defaults
+	viper.SetDefault("PASSWORD_ARGON2_MEMORY", 19456)
+	viper.SetDefault("PASSWORD_ARGON2_ITERATIONS", 2)
+	viper.SetDefault("PASSWORD_ARGON2_PARALLELISM", 1)
+	viper.SetDefault("PASSWORD_PEPPER", "")
+	viper.SetDefault("PASSWORD_MIN_LENGTH", 6)
+	viper.SetDefault("PASSWORD_MAX_LENGTH", 128)
+	viper.SetDefault("PASSWORD_REQUIRE_LOWER", true)
+	viper.SetDefault("PASSWORD_REQUIRE_UPPER", true)
+	viper.SetDefault("PASSWORD_REQUIRE_DIGIT", true)
+	viper.SetDefault("PASSWORD_REQUIRE_SPECIAL", true)
+	viper.SetDefault("PASSWORD_BLOCKLIST_FILE", "")
+
+	// Phone defaults
+	viper.SetDefault("SMS_DRIVER", "log")
+	viper.SetDefault("PHONE_DEFAULT_COUNTRY_CODE", "")
+	viper.SetDefault("PHONE_CODE_TTL", 300)
+	viper.SetDefault("PHONE_CODE_COOLDOWN", 60)
+	viper.SetDefault("PHONE_CODE_MAX_SENDS", 5)
+	viper.SetDefault("PHONE_CODE_WINDOW", 3600)
+	viper.SetDefault("PHONE_CODE_MAX_ATTEMPTS", 5)
+
+	// Alert defaults
+	viper.SetDefault("ALERTS_ENABLED", true)
+	viper.SetDefault("ALERT_EVAL_INTERVAL", 60)
+	viper.SetDefault("ALERT_DEFAULT_COOLDOWN", 3600)
+	viper.SetDefault("NOTIFY_WEBHOOK_TIMEOUT", 10)
+
+	// Webhook defaults
+	viper.SetDefault("WEBHOOKS_ENABLED", true)
+	viper.SetDefault("WEBHOOK_ENCRYPTION_KEY", defaultWebhookKey)
+	viper.SetDefault("WEBHOOK_WORKERS", 4)
+	viper.SetDefault("WEBHOOK_POLL_INTERVAL", 5)
+	viper.SetDefault("WEBHOOK_TIMEOUT", 10)
+	viper.SetDefault("WEBHOOK_MAX_ATTEMPTS", 8)
+	viper.SetDefault("WEBHOOK_RETRY_BACKOFF", 30)
+	viper.SetDefault("WEBHOOK_MAX_BACKOFF", 3600)
+	viper.SetDefault("WEBHOOK_RETENTION", 30)
+
+	// Stream defaults
+	viper.SetDefault("STREAMS_ENABLED", true)
+	viper.SetDefault("STREAM_HEARTBEAT", 15)
+	viper.SetDefault("STREAM_POLL_INTERVAL", 5)
+	viper.SetDefault("STREAM_MAX_CONNECTIONS", 5)
+	viper.SetDefault("STREAM_LOG_SIZE", 10000)
+	viper.SetDefault("STREAM_REPLAY_LIMIT", 500)
+	viper.SetDefault("STREAM_TICKET_TTL", 30)
+}
+
+// OAuthProvider reads the settings of the named social login provider.
+func (c *Config) OAuthProvider(name string) OAuthProviderConfig {
+	prefix := "OAUTH_" + strings.ToUpper(name) + "_"
+	return OAuthProviderConfig{
+		Kind:         viper.GetString(prefix + "KIND"),
+		Issuer:       viper.GetString(prefix + "ISSUER"),
+		ClientID:     viper.GetString(prefix + "CLIENT_ID"),
+		ClientSecret: viper.GetString(prefix + "CLIENT_SECRET"),
+		RedirectURL:  viper.GetString(prefix + "REDIRECT_URL"),
+		Scopes:       strings.Fields(strings.ReplaceAll(viper.GetString(prefix+"SCOPES"), ",", " ")),
+	}
 }
 
 // GetString returns a string value from config
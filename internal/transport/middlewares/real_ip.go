@@ -0,0 +1,46 @@
+package middlewares
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/labstack/echo/v5"
+)
+
+// TrustedProxyIPExtractor returns how c.RealIP() finds the client address.
+// proxies is a comma separated list of IPs or CIDRs, such as the nginx in
+// front of the API. X-Forwarded-For is only read past a peer in that list,
+// and the nearest address not in it wins, so a client cannot pick its own IP
+// by sending the header. With no proxies the header is ignored.
+func TrustedProxyIPExtractor(proxies string) (echo.IPExtractor, error) {
+	var ranges []echo.TrustOption
+	for _, entry := range strings.Split(proxies, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			if ip := net.ParseIP(entry); ip != nil && ip.To4() != nil {
+				entry += "/32"
+			} else {
+				entry += "/128"
+			}
+		}
+		_, ipNet, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy %q: %w", entry, err)
+		}
+		ranges = append(ranges, echo.TrustIPRange(ipNet))
+	}
+	if len(ranges) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+	// Echo trusts loopback and private networks unless told otherwise.
+	opts := append([]echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}, ranges...)
+	return echo.ExtractIPFromXFFHeader(opts...), nil
+}
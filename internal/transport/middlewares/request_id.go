@@ -1,6 +1,8 @@
 package middlewares
 
 import (
+	"go-ai/pkg/requestmeta"
+
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v5"
 	"github.com/rs/zerolog"
@@ -24,6 +26,11 @@ func RequestIDMiddleware(baseLogger zerolog.Logger) echo.MiddlewareFunc {
 				Logger()
 
 			ctx := reqLogger.WithContext(req.Context())
+			ctx = requestmeta.With(ctx, requestmeta.Meta{
+				RequestID: requestID,
+				IP:        c.RealIP(),
+				UserAgent: req.UserAgent(),
+			})
 			c.SetRequest(req.WithContext(ctx))
 
 			return next(c)
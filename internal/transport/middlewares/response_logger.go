@@ -7,12 +7,16 @@ import (
 	"time"
 
 	"github.com/labstack/echo/v5"
+	"github.com/labstack/echo/v5/middleware"
 	"github.com/rs/zerolog"
 )
 
 const maxLoggedBodyBytes = 2048
 
-func ResponseLoggerMiddleware() echo.MiddlewareFunc {
+// ResponseLoggerMiddleware logs every response with the start of its body.
+// Requests matched by skipBody are logged without the body, which suits
+// streams that write for as long as the client stays connected.
+func ResponseLoggerMiddleware(skipBody middleware.Skipper) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c *echo.Context) error {
 			start := time.Now()
@@ -26,6 +30,15 @@ func ResponseLoggerMiddleware() echo.MiddlewareFunc {
 
 			logger := zerolog.Ctx(req.Context())
 
+			if skipBody != nil && skipBody(c) {
+				err := next(c)
+				logger.Debug().
+					Int("status", res.Status).
+					Int64("latency_ms", time.Since(start).Milliseconds()).
+					Int64("size", res.Size).Msg("response sent")
+				return err
+			}
+
 			var responseBuf bytes.Buffer
 			origWriter := res.ResponseWriter
 			respLogger := &responseCaptureWriter{
@@ -84,6 +97,11 @@ func (w *responseCaptureWriter) Write(b []byte) (int, error) {
 	return w.ResponseWriter.Write(b)
 }
 
+// Unwrap lets http.ResponseController reach the connection.
+func (w *responseCaptureWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 func (w *responseCaptureWriter) Flush() {
 	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
 		flusher.Flush()
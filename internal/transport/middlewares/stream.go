@@ -0,0 +1,18 @@
+package middlewares
+
+import (
+	"slices"
+
+	"github.com/labstack/echo/v5"
+	"github.com/labstack/echo/v5/middleware"
+)
+
+// StreamSkipper matches requests to the server-sent event routes registered
+// under one of paths. Streams stay open as long as the client does, so they
+// skip the request timeout and response body capture. Only the route counts:
+// an Accept header is up to the client and must not lift the timeout.
+func StreamSkipper(paths ...string) middleware.Skipper {
+	return func(c *echo.Context) bool {
+		return slices.Contains(paths, c.Path())
+	}
+}
@@ -11,4 +11,5 @@ var (
 	ErrInvalidField        = New(http.StatusBadRequest, "Invalid field")
 	ErrInvalidUrl          = New(http.StatusBadRequest, "Invalid url")
 	ErrInvalidPrice        = errors.New("Menu: price must be >= 0")
+	ErrInvalidDecimal      = New(http.StatusBadRequest, "Invalid decimal number")
 )
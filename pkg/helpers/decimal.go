@@ -0,0 +1,208 @@
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	domainerr "go-ai/pkg/domain_err"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// DecimalPlaces is the precision of Decimal, enough for exchange quantities
+// and prices such as 0.00000001 BTC.
+const DecimalPlaces = 8
+
+var decimalScale = big.NewInt(100_000_000)
+
+// Decimal is an exact fixed-point number with DecimalPlaces digits after the
+// point, for amounts Money can't hold: fractions and negative values. The
+// zero value is 0. It is stored as NUMERIC and encoded in JSON as a string
+// so no precision is lost on either side.
+type Decimal struct {
+	units *big.Int // value * 10^DecimalPlaces
+}
+
+func NewDecimal(v int64) Decimal {
+	return Decimal{units: new(big.Int).Mul(big.NewInt(v), decimalScale)}
+}
+
+// ParseDecimal reads a plain decimal such as "-12.5". More than
+// DecimalPlaces digits after the point is an error.
+func ParseDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" && frac == "" || len(frac) > DecimalPlaces {
+		return Decimal{}, domainerr.ErrInvalidDecimal
+	}
+	digits := whole + frac + strings.Repeat("0", DecimalPlaces-len(frac))
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return Decimal{}, domainerr.ErrInvalidDecimal
+		}
+	}
+	units, _ := new(big.Int).SetString(digits, 10)
+	if neg {
+		units.Neg(units)
+	}
+	return Decimal{units: units}, nil
+}
+
+// DecimalFromFloat rounds f to DecimalPlaces, e.g. for candle prices.
+func DecimalFromFloat(f float64) Decimal {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Decimal{}
+	}
+	d, err := ParseDecimal(big.NewFloat(f).Text('f', DecimalPlaces))
+	if err != nil {
+		return Decimal{}
+	}
+	return d
+}
+
+func NumericToDecimal(n pgtype.Numeric) (Decimal, error) {
+	if !n.Valid {
+		return Decimal{}, nil
+	}
+	if n.NaN || n.InfinityModifier != pgtype.Finite {
+		return Decimal{}, fmt.Errorf("numeric is not a finite number")
+	}
+	if n.Int == nil {
+		return Decimal{}, fmt.Errorf("numeric has no value (nil Int)")
+	}
+	units := new(big.Int).Set(n.Int)
+	shift := int64(n.Exp) + DecimalPlaces
+	if shift >= 0 {
+		units.Mul(units, pow10(shift))
+		return Decimal{units: units}, nil
+	}
+	scale := pow10(-shift)
+	if new(big.Int).Rem(units, scale).Sign() != 0 {
+		return Decimal{}, fmt.Errorf("numeric has more than %d decimal places", DecimalPlaces)
+	}
+	return Decimal{units: units.Quo(units, scale)}, nil
+}
+
+func NumericFromDecimal(d Decimal) pgtype.Numeric {
+	return d.Numeric()
+}
+
+func (d Decimal) Numeric() pgtype.Numeric {
+	return pgtype.Numeric{
+		Int:   new(big.Int).Set(d.int()),
+		Exp:   -DecimalPlaces,
+		Valid: true,
+	}
+}
+
+func (d Decimal) Add(e Decimal) Decimal {
+	return Decimal{units: new(big.Int).Add(d.int(), e.int())}
+}
+
+func (d Decimal) Sub(e Decimal) Decimal {
+	return Decimal{units: new(big.Int).Sub(d.int(), e.int())}
+}
+
+// Mul returns d*e rounded half away from zero.
+func (d Decimal) Mul(e Decimal) Decimal {
+	product := new(big.Int).Mul(d.int(), e.int())
+	return Decimal{units: divRound(product, decimalScale)}
+}
+
+// Div returns d/e rounded half away from zero, or 0 when e is 0.
+func (d Decimal) Div(e Decimal) Decimal {
+	if e.IsZero() {
+		return Decimal{}
+	}
+	scaled := new(big.Int).Mul(d.int(), decimalScale)
+	return Decimal{units: divRound(scaled, e.int())}
+}
+
+func (d Decimal) Neg() Decimal {
+	return Decimal{units: new(big.Int).Neg(d.int())}
+}
+
+func (d Decimal) Cmp(e Decimal) int {
+	return d.int().Cmp(e.int())
+}
+
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+func (d Decimal) Float64() float64 {
+	f, _ := new(big.Rat).SetFrac(d.int(), decimalScale).Float64()
+	return f
+}
+
+// String formats d without trailing zeros, e.g. "0.5" or "-12".
+func (d Decimal) String() string {
+	units := d.int()
+	abs := new(big.Int).Abs(units).String()
+	if len(abs) <= DecimalPlaces {
+		abs = strings.Repeat("0", DecimalPlaces-len(abs)+1) + abs
+	}
+	whole, frac := abs[:len(abs)-DecimalPlaces], strings.TrimRight(abs[len(abs)-DecimalPlaces:], "0")
+	s := whole
+	if frac != "" {
+		s += "." + frac
+	}
+	if units.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON accepts a string or a plain number.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(data, &s); err != nil {
+		s = string(data)
+	}
+	v, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+func (d Decimal) int() *big.Int {
+	if d.units == nil {
+		return new(big.Int)
+	}
+	return d.units
+}
+
+func divRound(n, d *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	// Round half away from zero: |2r| >= |d|.
+	if new(big.Int).Abs(new(big.Int).Lsh(r, 1)).Cmp(new(big.Int).Abs(d)) >= 0 {
+		if (n.Sign() < 0) != (d.Sign() < 0) {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+func pow10(n int64) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(n), nil)
+}
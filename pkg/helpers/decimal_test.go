@@ -0,0 +1,106 @@
+package helpers
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func mustDecimal(t *testing.T, s string) Decimal {
+	t.Helper()
+	d, err := ParseDecimal(s)
+	if err != nil {
+		t.Fatalf("ParseDecimal(%q): %v", s, err)
+	}
+	return d
+}
+
+func TestParseDecimal(t *testing.T) {
+	cases := map[string]string{
+		"0":           "0",
+		"1.50":        "1.5",
+		"-12.5":       "-12.5",
+		".25":         "0.25",
+		"0.00000001":  "0.00000001",
+		"65000.12345": "65000.12345",
+	}
+	for in, want := range cases {
+		if got := mustDecimal(t, in).String(); got != want {
+			t.Errorf("ParseDecimal(%q) = %s, want %s", in, got, want)
+		}
+	}
+	for _, in := range []string{"", "-", "1.123456789", "1e5", "abc", "1.2.3"} {
+		if _, err := ParseDecimal(in); err == nil {
+			t.Errorf("ParseDecimal(%q) should fail", in)
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a := mustDecimal(t, "0.3")
+	b := mustDecimal(t, "65000.5")
+	if got := a.Mul(b).String(); got != "19500.15" {
+		t.Errorf("Mul = %s, want 19500.15", got)
+	}
+	if got := NewDecimal(1).Div(NewDecimal(3)).String(); got != "0.33333333" {
+		t.Errorf("Div = %s, want 0.33333333", got)
+	}
+	if got := NewDecimal(2).Div(NewDecimal(3)).String(); got != "0.66666667" {
+		t.Errorf("Div rounds half away from zero: got %s", got)
+	}
+	if got := NewDecimal(-2).Div(NewDecimal(3)).String(); got != "-0.66666667" {
+		t.Errorf("negative Div = %s, want -0.66666667", got)
+	}
+	if got := a.Sub(b).Add(b).String(); got != "0.3" {
+		t.Errorf("Sub/Add = %s, want 0.3", got)
+	}
+	if !NewDecimal(5).Div(Decimal{}).IsZero() {
+		t.Error("division by zero should return 0")
+	}
+	var zero Decimal
+	if zero.String() != "0" || zero.Sign() != 0 {
+		t.Errorf("zero value = %s", zero.String())
+	}
+}
+
+func TestDecimalNumericRoundTrip(t *testing.T) {
+	d := mustDecimal(t, "-1234.56789")
+	got, err := NumericToDecimal(d.Numeric())
+	if err != nil || got.Cmp(d) != 0 {
+		t.Fatalf("round trip = %s, %v", got, err)
+	}
+
+	n := pgtype.Numeric{Int: big.NewInt(15), Exp: 3, Valid: true}
+	if got, err := NumericToDecimal(n); err != nil || got.String() != "15000" {
+		t.Errorf("positive exponent = %s, %v", got, err)
+	}
+	n = pgtype.Numeric{Int: big.NewInt(1), Exp: -9, Valid: true}
+	if _, err := NumericToDecimal(n); err == nil {
+		t.Error("more than 8 decimal places should fail")
+	}
+	if got, err := NumericToDecimal(pgtype.Numeric{}); err != nil || !got.IsZero() {
+		t.Errorf("NULL = %s, %v", got, err)
+	}
+}
+
+func TestDecimalJSON(t *testing.T) {
+	var v struct {
+		A Decimal `json:"a"`
+		B Decimal `json:"b"`
+	}
+	if err := json.Unmarshal([]byte(`{"a":"0.1","b":2.25}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != `{"a":"0.1","b":"2.25"}` {
+		t.Errorf("json = %s", out)
+	}
+	if got := DecimalFromFloat(0.1 + 0.2).String(); got != "0.3" {
+		t.Errorf("DecimalFromFloat = %s, want 0.3", got)
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 type JWTClaims struct {
 	Sid string `json:"sid"`
+	Use string `json:"use,omitempty"`
 	jwt.RegisteredClaims
 }
 
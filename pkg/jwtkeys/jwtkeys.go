@@ -0,0 +1,62 @@
+// Package jwtkeys signs and verifies the service's JWTs, either with the
+// shared HS256 secrets or with RS256/EdDSA key pairs loaded from files.
+package jwtkeys
+
+import (
+	"go-ai/pkg/helpers"
+)
+
+const (
+	AlgHS256 = "HS256"
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+// Use tells access and refresh tokens apart when both are signed with the
+// same key.
+type Use string
+
+const (
+	UseAccess  Use = "access"
+	UseRefresh Use = "refresh"
+)
+
+// Issuer signs and verifies access and refresh tokens.
+type Issuer interface {
+	Issue(sid string, use Use, ttlSeconds int) (string, error)
+	Verify(token string, use Use) (*helpers.JWTClaims, error)
+	// JWKS lists the public verification keys; it is empty for HS256.
+	JWKS() JWKS
+}
+
+// HMACIssuer keeps the original HS256 tokens with one secret per token use.
+type HMACIssuer struct {
+	AccessSecret  string
+	RefreshSecret string
+}
+
+func NewHMACIssuer(accessSecret, refreshSecret string) *HMACIssuer {
+	return &HMACIssuer{
+		AccessSecret:  accessSecret,
+		RefreshSecret: refreshSecret,
+	}
+}
+
+func (h *HMACIssuer) Issue(sid string, use Use, ttlSeconds int) (string, error) {
+	return helpers.GenerateToken(sid, h.secret(use), ttlSeconds)
+}
+
+func (h *HMACIssuer) Verify(token string, use Use) (*helpers.JWTClaims, error) {
+	return helpers.VerifyToken(token, h.secret(use))
+}
+
+func (h *HMACIssuer) JWKS() JWKS {
+	return JWKS{Keys: []JWK{}}
+}
+
+func (h *HMACIssuer) secret(use Use) string {
+	if use == UseRefresh {
+		return h.RefreshSecret
+	}
+	return h.AccessSecret
+}
@@ -0,0 +1,199 @@
+package jwtkeys
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"go-ai/pkg/helpers"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const minRSABits = 2048
+
+// Key is one key pair. Retired keys have no private half and only verify.
+type Key struct {
+	ID      string
+	Alg     string
+	Private any
+	Public  any
+}
+
+// KeySet signs with its active key and verifies with any key it holds,
+// selected by the token's kid header.
+type KeySet struct {
+	active *Key
+	keys   map[string]*Key
+}
+
+// NewKeySet builds a key set from keys; activeID names the signing key.
+func NewKeySet(activeID string, keys ...*Key) (*KeySet, error) {
+	ks := &KeySet{keys: make(map[string]*Key, len(keys))}
+	for _, k := range keys {
+		if _, dup := ks.keys[k.ID]; dup {
+			return nil, fmt.Errorf("jwtkeys: duplicate kid %q", k.ID)
+		}
+		ks.keys[k.ID] = k
+	}
+	active, ok := ks.keys[activeID]
+	if !ok {
+		return nil, fmt.Errorf("jwtkeys: active kid %q not found", activeID)
+	}
+	if active.Private == nil {
+		return nil, fmt.Errorf("jwtkeys: active kid %q has no private key", activeID)
+	}
+	ks.active = active
+	return ks, nil
+}
+
+// LoadDir reads every key in dir. <kid>.pem holds a private key and
+// <kid>.pub.pem a public key kept only to verify tokens signed before a
+// rotation. alg, when set, must match the active key.
+func LoadDir(dir, activeID, alg string) (*KeySet, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("jwtkeys: read %s: %w", dir, err)
+	}
+	var keys []*Key
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".pem") {
+			continue
+		}
+		kid := strings.TrimSuffix(strings.TrimSuffix(name, ".pem"), ".pub")
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, fmt.Errorf("jwtkeys: read %s: %w", name, err)
+		}
+		k, err := ParsePEM(kid, data)
+		if err != nil {
+			return nil, fmt.Errorf("jwtkeys: %s: %w", name, err)
+		}
+		keys = append(keys, k)
+	}
+	ks, err := NewKeySet(activeID, keys...)
+	if err != nil {
+		return nil, err
+	}
+	if alg != "" && ks.active.Alg != alg {
+		return nil, fmt.Errorf("jwtkeys: active kid %q is %s, want %s", activeID, ks.active.Alg, alg)
+	}
+	return ks, nil
+}
+
+// ParsePEM reads an RSA or Ed25519 key, private (PKCS#8 or PKCS#1) or public.
+func ParsePEM(kid string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block")
+	}
+	var parsed any
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < minRSABits {
+			return nil, fmt.Errorf("RSA key must be at least %d bits", minRSABits)
+		}
+		return &Key{ID: kid, Alg: AlgRS256, Private: k, Public: &k.PublicKey}, nil
+	case *rsa.PublicKey:
+		if k.N.BitLen() < minRSABits {
+			return nil, fmt.Errorf("RSA key must be at least %d bits", minRSABits)
+		}
+		return &Key{ID: kid, Alg: AlgRS256, Public: k}, nil
+	case ed25519.PrivateKey:
+		return &Key{ID: kid, Alg: AlgEdDSA, Private: k, Public: k.Public()}, nil
+	case ed25519.PublicKey:
+		return &Key{ID: kid, Alg: AlgEdDSA, Public: k}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", parsed)
+	}
+}
+
+// ActiveKID returns the kid new tokens are signed with.
+func (ks *KeySet) ActiveKID() string {
+	return ks.active.ID
+}
+
+func (ks *KeySet) Issue(sid string, use Use, ttlSeconds int) (string, error) {
+	if ttlSeconds <= 0 {
+		ttlSeconds = 60
+	}
+	now := time.Now()
+	claims := helpers.JWTClaims{
+		Sid: sid,
+		Use: string(use),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    "go-ai",
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Duration(ttlSeconds) * time.Second)),
+		},
+	}
+	token := jwt.NewWithClaims(signingMethod(ks.active.Alg), claims)
+	token.Header["kid"] = ks.active.ID
+	return token.SignedString(ks.active.Private)
+}
+
+func (ks *KeySet) Verify(tokenString string, use Use) (*helpers.JWTClaims, error) {
+	claims := &helpers.JWTClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		k, ok := ks.keys[kid]
+		if !ok {
+			return nil, jwt.ErrTokenUnverifiable
+		}
+		// A kid only ever verifies with its own algorithm.
+		if token.Method.Alg() != k.Alg {
+			return nil, jwt.ErrTokenSignatureInvalid
+		}
+		return k.Public, nil
+	}, jwt.WithValidMethods([]string{AlgRS256, AlgEdDSA}))
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid || claims.Use != string(use) {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+	return claims, nil
+}
+
+func (ks *KeySet) JWKS() JWKS {
+	ids := make([]string, 0, len(ks.keys))
+	for id := range ks.keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	set := JWKS{Keys: make([]JWK, 0, len(ids))}
+	for _, id := range ids {
+		set.Keys = append(set.Keys, toJWK(ks.keys[id]))
+	}
+	return set
+}
+
+func signingMethod(alg string) jwt.SigningMethod {
+	if alg == AlgEdDSA {
+		return jwt.SigningMethodEdDSA
+	}
+	return jwt.SigningMethodRS256
+}
@@ -0,0 +1,165 @@
+package jwtkeys
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func writePrivate(t *testing.T, dir, kid string, key any) {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	if err := os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writePublic(t *testing.T, dir, kid string, key any) {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	if err := os.WriteFile(filepath.Join(dir, kid+".pub.pem"), data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestKeySetRoundTrip(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		alg string
+		key any
+	}{
+		{AlgRS256, rsaKey},
+		{AlgEdDSA, edKey},
+	}
+	for _, tc := range cases {
+		t.Run(tc.alg, func(t *testing.T) {
+			dir := t.TempDir()
+			writePrivate(t, dir, "k1", tc.key)
+			ks, err := LoadDir(dir, "k1", tc.alg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			token, err := ks.Issue("sid-1", UseAccess, 60)
+			if err != nil {
+				t.Fatal(err)
+			}
+			claims, err := ks.Verify(token, UseAccess)
+			if err != nil {
+				t.Fatalf("verify: %v", err)
+			}
+			if claims.Sid != "sid-1" {
+				t.Fatalf("sid = %q", claims.Sid)
+			}
+			if _, err := ks.Verify(token, UseRefresh); err == nil {
+				t.Fatal("access token accepted as refresh token")
+			}
+		})
+	}
+}
+
+func TestKeySetRotation(t *testing.T) {
+	_, oldKey, _ := ed25519.GenerateKey(rand.Reader)
+	_, newKey, _ := ed25519.GenerateKey(rand.Reader)
+
+	dir := t.TempDir()
+	writePrivate(t, dir, "2026-01", oldKey)
+	before, err := LoadDir(dir, "2026-01", AlgEdDSA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldToken, _ := before.Issue("sid-old", UseRefresh, 60)
+
+	// Rotate: the old private key is replaced by its public half.
+	os.Remove(filepath.Join(dir, "2026-01.pem"))
+	writePublic(t, dir, "2026-01", oldKey.Public())
+	writePrivate(t, dir, "2026-02", newKey)
+	after, err := LoadDir(dir, "2026-02", AlgEdDSA)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := after.Verify(oldToken, UseRefresh); err != nil {
+		t.Fatalf("token signed before rotation rejected: %v", err)
+	}
+	newToken, _ := after.Issue("sid-new", UseAccess, 60)
+	parsed, _, err := jwt.NewParser().ParseUnverified(newToken, &jwt.RegisteredClaims{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Header["kid"] != "2026-02" {
+		t.Fatalf("kid = %v", parsed.Header["kid"])
+	}
+	if _, err := before.Verify(newToken, UseAccess); err == nil {
+		t.Fatal("unknown kid accepted")
+	}
+	if got := len(after.JWKS().Keys); got != 2 {
+		t.Fatalf("jwks has %d keys, want 2", got)
+	}
+}
+
+func TestKeySetRejects(t *testing.T) {
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	ks, err := NewKeySet("k1", &Key{ID: "k1", Alg: AlgEdDSA, Private: edKey, Public: edKey.Public()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// An HS256 token using the kid must not verify, whatever the secret.
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sid": "x", "use": "access"})
+	forged.Header["kid"] = "k1"
+	raw, _ := forged.SignedString([]byte("secret"))
+	if _, err := ks.Verify(raw, UseAccess); err == nil {
+		t.Fatal("HS256 token accepted")
+	}
+
+	if _, err := NewKeySet("k1", &Key{ID: "k1", Alg: AlgEdDSA, Public: edKey.Public()}); err == nil {
+		t.Fatal("verify-only active key accepted")
+	}
+
+	dir := t.TempDir()
+	writePrivate(t, dir, "k1", edKey)
+	if _, err := LoadDir(dir, "k1", AlgRS256); err == nil {
+		t.Fatal("algorithm mismatch accepted")
+	}
+}
+
+func TestJWKSFormat(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	ks, err := NewKeySet("a",
+		&Key{ID: "a", Alg: AlgRS256, Private: rsaKey, Public: &rsaKey.PublicKey},
+		&Key{ID: "b", Alg: AlgEdDSA, Public: edKey.Public()},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := ks.JWKS().Keys
+	if keys[0].Kty != "RSA" || keys[0].E != "AQAB" || keys[0].N == "" {
+		t.Fatalf("rsa jwk = %+v", keys[0])
+	}
+	if keys[1].Kty != "OKP" || keys[1].Crv != "Ed25519" || keys[1].X == "" {
+		t.Fatalf("ed25519 jwk = %+v", keys[1])
+	}
+}
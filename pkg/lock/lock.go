@@ -0,0 +1,126 @@
+package lock
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrNotHeld is returned when refreshing or releasing a lock whose key has
+// expired or been taken over by another holder.
+var ErrNotHeld = errors.New("lock: not held")
+
+var (
+	releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+	refreshScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+)
+
+// Locker hands out Redis-backed mutual exclusion locks shared by all replicas.
+type Locker struct {
+	client *redis.Client
+	prefix string
+}
+
+// Lock is a held lock. The token guarantees only the holder can refresh or release it.
+type Lock struct {
+	client *redis.Client
+	key    string
+	token  string
+	ttl    time.Duration
+}
+
+// New creates a Locker whose keys are namespaced with prefix.
+func New(client *redis.Client, prefix string) *Locker {
+	return &Locker{client: client, prefix: prefix}
+}
+
+// TryAcquire attempts to take the lock once. It returns (nil, nil) when the
+// lock is currently held by someone else.
+func (l *Locker) TryAcquire(ctx context.Context, key string, ttl time.Duration) (*Lock, error) {
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+	fullKey := l.prefix + key
+	ok, err := l.client.SetNX(ctx, fullKey, token, ttl).Result()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &Lock{client: l.client, key: fullKey, token: token, ttl: ttl}, nil
+}
+
+// Refresh extends the lock TTL.
+func (lk *Lock) Refresh(ctx context.Context) error {
+	res, err := refreshScript.Run(ctx, lk.client, []string{lk.key}, lk.token, lk.ttl.Milliseconds()).Int()
+	if err != nil {
+		return err
+	}
+	if res == 0 {
+		return ErrNotHeld
+	}
+	return nil
+}
+
+// Release deletes the lock if it is still held by this holder.
+func (lk *Lock) Release(ctx context.Context) error {
+	res, err := releaseScript.Run(ctx, lk.client, []string{lk.key}, lk.token).Int()
+	if err != nil {
+		return err
+	}
+	if res == 0 {
+		return ErrNotHeld
+	}
+	return nil
+}
+
+// KeepAlive refreshes the lock every ttl/3 until ctx is done. It calls
+// onLost and returns if the lock cannot be refreshed.
+func (lk *Lock) KeepAlive(ctx context.Context, onLost func(error)) {
+	interval := lk.ttl / 3
+	if interval <= 0 {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := lk.Refresh(ctx); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				if onLost != nil {
+					onLost(err)
+				}
+				return
+			}
+		}
+	}
+}
+
+func newToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
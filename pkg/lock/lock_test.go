@@ -0,0 +1,127 @@
+package lock
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// testLocker connects to TEST_REDIS_ADDR, default localhost:6379, and skips
+// the test when no Redis answers there. Keys are namespaced per test and
+// removed afterwards.
+func testLocker(t *testing.T) (*Locker, *redis.Client) {
+	t.Helper()
+	addr := os.Getenv("TEST_REDIS_ADDR")
+	if addr == "" {
+		addr = "localhost:6379"
+	}
+	client := redis.NewClient(&redis.Options{Addr: addr, MaxRetries: -1})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		client.Close()
+		t.Skipf("redis not reachable at %s: %v", addr, err)
+	}
+	token, err := newToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	prefix := "lock_test_" + token + "_"
+	t.Cleanup(func() {
+		ctx := context.Background()
+		if keys, err := client.Keys(ctx, prefix+"*").Result(); err == nil && len(keys) > 0 {
+			client.Del(ctx, keys...)
+		}
+		client.Close()
+	})
+	return New(client, prefix), client
+}
+
+func TestTryAcquireIsExclusive(t *testing.T) {
+	locker, _ := testLocker(t)
+	ctx := context.Background()
+
+	lk, err := locker.TryAcquire(ctx, "job", time.Minute)
+	if err != nil || lk == nil {
+		t.Fatalf("first acquire = %v, %v", lk, err)
+	}
+	other, err := locker.TryAcquire(ctx, "job", time.Minute)
+	if err != nil || other != nil {
+		t.Fatalf("second acquire = %v, %v, want nil, nil", other, err)
+	}
+	if err := lk.Release(ctx); err != nil {
+		t.Fatal(err)
+	}
+	again, err := locker.TryAcquire(ctx, "job", time.Minute)
+	if err != nil || again == nil {
+		t.Fatalf("acquire after release = %v, %v", again, err)
+	}
+}
+
+func TestRefreshExtendsTTL(t *testing.T) {
+	locker, client := testLocker(t)
+	ctx := context.Background()
+
+	lk, err := locker.TryAcquire(ctx, "job", time.Minute)
+	if err != nil || lk == nil {
+		t.Fatalf("acquire = %v, %v", lk, err)
+	}
+	client.PExpire(ctx, lk.key, 100*time.Millisecond)
+	if err := lk.Refresh(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if ttl := client.PTTL(ctx, lk.key).Val(); ttl < 30*time.Second {
+		t.Fatalf("ttl after refresh = %v, want about a minute", ttl)
+	}
+}
+
+// A holder whose lock expired and was taken over must neither extend nor
+// delete the new holder's lock.
+func TestRefreshAndReleaseCheckTheToken(t *testing.T) {
+	locker, client := testLocker(t)
+	ctx := context.Background()
+
+	stale, err := locker.TryAcquire(ctx, "job", time.Minute)
+	if err != nil || stale == nil {
+		t.Fatalf("acquire = %v, %v", stale, err)
+	}
+	client.Del(ctx, stale.key)
+	holder, err := locker.TryAcquire(ctx, "job", time.Minute)
+	if err != nil || holder == nil {
+		t.Fatalf("takeover = %v, %v", holder, err)
+	}
+
+	if err := stale.Refresh(ctx); !errors.Is(err, ErrNotHeld) {
+		t.Fatalf("stale refresh = %v, want ErrNotHeld", err)
+	}
+	if err := stale.Release(ctx); !errors.Is(err, ErrNotHeld) {
+		t.Fatalf("stale release = %v, want ErrNotHeld", err)
+	}
+	if got := client.Get(ctx, holder.key).Val(); got != holder.token {
+		t.Fatalf("lock value = %q, want the new holder's token", got)
+	}
+	if err := holder.Release(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRefreshAndReleaseAfterExpiry(t *testing.T) {
+	locker, client := testLocker(t)
+	ctx := context.Background()
+
+	lk, err := locker.TryAcquire(ctx, "job", time.Minute)
+	if err != nil || lk == nil {
+		t.Fatalf("acquire = %v, %v", lk, err)
+	}
+	client.Del(ctx, lk.key)
+	if err := lk.Refresh(ctx); !errors.Is(err, ErrNotHeld) {
+		t.Fatalf("refresh = %v, want ErrNotHeld", err)
+	}
+	if err := lk.Release(ctx); !errors.Is(err, ErrNotHeld) {
+		t.Fatalf("release = %v, want ErrNotHeld", err)
+	}
+}
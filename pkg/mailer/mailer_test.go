@@ -0,0 +1,61 @@
+package mailer
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatStripsHeaderInjection(t *testing.T) {
+	raw := string(Format("app@example.com", Message{
+		To:      "user@example.com\r\nBcc: evil@example.com",
+		Subject: "Hi",
+		Body:    "line 1\nline 2",
+	}, time.Unix(0, 0).UTC()))
+
+	if strings.Contains(raw, "\r\nBcc:") {
+		t.Fatalf("header injection not stripped:\n%s", raw)
+	}
+	if !strings.HasSuffix(raw, "\r\n\r\nline 1\r\nline 2") {
+		t.Fatalf("body not CRLF-normalised:\n%q", raw)
+	}
+}
+
+func TestFileMailerWritesOutbox(t *testing.T) {
+	dir := t.TempDir()
+	m, err := New(Config{Driver: DriverFile, OutboxDir: dir, From: "app@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Send(context.Background(), Message{To: "user@example.com", Subject: "Reset", Body: "token"}); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || !strings.HasSuffix(entries[0].Name(), ".eml") {
+		t.Fatalf("expected one .eml file, got %v", entries)
+	}
+}
+
+func TestMemoryMailerLast(t *testing.T) {
+	m := NewMemoryMailer()
+	ctx := context.Background()
+	_ = m.Send(ctx, Message{To: "a@example.com", Subject: "first"})
+	_ = m.Send(ctx, Message{To: "b@example.com", Subject: "other"})
+	_ = m.Send(ctx, Message{To: "a@example.com", Subject: "second"})
+
+	msg, ok := m.Last("a@example.com")
+	if !ok || msg.Subject != "second" {
+		t.Fatalf("unexpected last message %+v", msg)
+	}
+	if len(m.Messages()) != 3 {
+		t.Fatalf("expected 3 messages, got %d", len(m.Messages()))
+	}
+	if _, err := New(Config{Driver: "carrier-pigeon"}); err == nil {
+		t.Fatal("expected error for unknown driver")
+	}
+}
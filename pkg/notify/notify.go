@@ -0,0 +1,90 @@
+// Package notify delivers notifications to users over several channels: an
+// in-app feed, email and webhooks. Callers pick the channels per message and
+// get one Delivery per channel back.
+package notify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ChannelInApp   = "in_app"
+	ChannelEmail   = "email"
+	ChannelWebhook = "webhook"
+)
+
+const (
+	StatusSent   = "sent"
+	StatusFailed = "failed"
+)
+
+// Message is one notification for one user.
+type Message struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	// Kind names the event, e.g. "alert.triggered".
+	Kind  string
+	Title string
+	Body  string
+	Data  map[string]any
+	// WebhookURL is where the webhook channel posts the message.
+	WebhookURL string
+	CreatedAt  time.Time
+}
+
+// Notifier delivers a message over one channel.
+type Notifier interface {
+	Notify(ctx context.Context, msg Message) error
+}
+
+// Delivery is the outcome of sending a message over one channel.
+type Delivery struct {
+	Channel string `json:"channel"`
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Dispatcher routes messages to the notifier registered for each channel.
+type Dispatcher struct {
+	notifiers map[string]Notifier
+}
+
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{notifiers: map[string]Notifier{}}
+}
+
+func (d *Dispatcher) Register(channel string, n Notifier) {
+	d.notifiers[channel] = n
+}
+
+// Has reports whether a notifier is registered for channel.
+func (d *Dispatcher) Has(channel string) bool {
+	_, ok := d.notifiers[channel]
+	return ok
+}
+
+// Dispatch sends msg over every channel in turn. A failing channel does not
+// stop the others.
+func (d *Dispatcher) Dispatch(ctx context.Context, channels []string, msg Message) []Delivery {
+	if msg.CreatedAt.IsZero() {
+		msg.CreatedAt = time.Now().UTC()
+	}
+	out := make([]Delivery, 0, len(channels))
+	for _, ch := range channels {
+		n, ok := d.notifiers[ch]
+		if !ok {
+			out = append(out, Delivery{Channel: ch, Status: StatusFailed, Error: fmt.Sprintf("no notifier for channel %q", ch)})
+			continue
+		}
+		if err := n.Notify(ctx, msg); err != nil {
+			out = append(out, Delivery{Channel: ch, Status: StatusFailed, Error: err.Error()})
+			continue
+		}
+		out = append(out, Delivery{Channel: ch, Status: StatusSent})
+	}
+	return out
+}
@@ -0,0 +1,102 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type recordingNotifier struct {
+	got []Message
+	err error
+}
+
+func (r *recordingNotifier) Notify(ctx context.Context, msg Message) error {
+	r.got = append(r.got, msg)
+	return r.err
+}
+
+func TestDispatchReportsEveryChannel(t *testing.T) {
+	feed := &recordingNotifier{}
+	email := &recordingNotifier{err: errors.New("smtp down")}
+	d := NewDispatcher()
+	d.Register(ChannelInApp, feed)
+	d.Register(ChannelEmail, email)
+
+	got := d.Dispatch(context.Background(), []string{ChannelInApp, ChannelEmail, ChannelWebhook}, Message{Title: "hi"})
+	if len(got) != 3 {
+		t.Fatalf("got %d deliveries, want 3", len(got))
+	}
+	if got[0].Status != StatusSent {
+		t.Fatalf("in_app = %+v", got[0])
+	}
+	if got[1].Status != StatusFailed || got[1].Error != "smtp down" {
+		t.Fatalf("email = %+v", got[1])
+	}
+	if got[2].Status != StatusFailed {
+		t.Fatalf("webhook without notifier = %+v", got[2])
+	}
+	if len(feed.got) != 1 || feed.got[0].CreatedAt.IsZero() {
+		t.Fatalf("feed got %+v", feed.got)
+	}
+}
+
+func TestWebhookNotifierPostsJSON(t *testing.T) {
+	var payload WebhookPayload
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("content type %q", r.Header.Get("Content-Type"))
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Error(err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	n := newWebhookNotifier(srv.Client())
+	err := n.Notify(context.Background(), Message{Kind: "alert.triggered", Title: "BTC", WebhookURL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payload.Kind != "alert.triggered" || payload.Title != "BTC" {
+		t.Fatalf("payload = %+v", payload)
+	}
+}
+
+func TestWebhookNotifierFailsOnErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	n := newWebhookNotifier(srv.Client())
+	if err := n.Notify(context.Background(), Message{WebhookURL: srv.URL}); err == nil {
+		t.Fatal("expected an error for 502")
+	}
+	if err := n.Notify(context.Background(), Message{}); !errors.Is(err, ErrWebhookURLMissing) {
+		t.Fatalf("err = %v, want ErrWebhookURLMissing", err)
+	}
+}
+
+func TestWebhookNotifierRefusesPrivateAddresses(t *testing.T) {
+	var hit bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hit = true
+	}))
+	defer srv.Close()
+
+	n := NewWebhookNotifier(time.Second)
+	for _, url := range []string{srv.URL, "http://169.254.169.254/latest/meta-data/"} {
+		if err := n.Notify(context.Background(), Message{WebhookURL: url}); !errors.Is(err, ErrWebhookURLPrivate) {
+			t.Fatalf("Notify(%s) err = %v, want ErrWebhookURLPrivate", url, err)
+		}
+	}
+	if hit {
+		t.Fatal("request reached a loopback server")
+	}
+}
@@ -0,0 +1,96 @@
+package notify
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+var (
+	ErrWebhookURLInvalid = errors.New("notify: webhook url must be an absolute http or https url")
+	// ErrWebhookURLPrivate is returned for URLs, and dialled addresses,
+	// inside the server's own network.
+	ErrWebhookURLPrivate = errors.New("notify: webhook url points to a private address")
+)
+
+// blockedPrefixes are ranges net/netip does not classify as private but
+// that still reach infrastructure rather than the internet.
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// PublicAddr reports whether ip may receive webhooks: not loopback,
+// private, link-local, multicast, unspecified or otherwise reserved.
+func PublicAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsValid() || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, p := range blockedPrefixes {
+		if p.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckWebhookURL validates a webhook URL when it is saved. Literal
+// addresses and localhost names must be public; other host names are
+// checked again on every request by the client from NewWebhookClient,
+// since what they resolve to can change.
+func CheckWebhookURL(raw string) error {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return ErrWebhookURLInvalid
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return ErrWebhookURLPrivate
+	}
+	if ip, err := netip.ParseAddr(host); err == nil && !PublicAddr(ip) {
+		return ErrWebhookURLPrivate
+	}
+	return nil
+}
+
+// NewWebhookClient returns an HTTP client for user-supplied URLs. It only
+// connects to public addresses, checked after DNS resolution, ignores proxy
+// settings and does not follow redirects.
+func NewWebhookClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip, err := netip.ParseAddr(host)
+			if err != nil || !PublicAddr(ip) {
+				return ErrWebhookURLPrivate
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		// A redirect comes back as a 3xx, which counts as a failure.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
@@ -0,0 +1,88 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestPublicAddr(t *testing.T) {
+	blocked := []string{
+		"127.0.0.1", "10.1.2.3", "172.16.0.1", "192.168.1.1", "169.254.169.254",
+		"0.0.0.0", "100.64.0.1", "224.0.0.1", "::1", "fe80::1", "fd00::1", "::ffff:127.0.0.1",
+	}
+	for _, s := range blocked {
+		if PublicAddr(netip.MustParseAddr(s)) {
+			t.Errorf("PublicAddr(%s) = true", s)
+		}
+	}
+	for _, s := range []string{"8.8.8.8", "1.1.1.1", "2606:4700:4700::1111"} {
+		if !PublicAddr(netip.MustParseAddr(s)) {
+			t.Errorf("PublicAddr(%s) = false", s)
+		}
+	}
+}
+
+func TestCheckWebhookURL(t *testing.T) {
+	cases := []struct {
+		url  string
+		want error
+	}{
+		{"https://hooks.example.com/x", nil},
+		{"http://8.8.8.8:8080/x", nil},
+		{"ftp://example.com", ErrWebhookURLInvalid},
+		{"/relative", ErrWebhookURLInvalid},
+		{"http://localhost:8080", ErrWebhookURLPrivate},
+		{"http://api.localhost.", ErrWebhookURLPrivate},
+		{"http://169.254.169.254/latest/meta-data/", ErrWebhookURLPrivate},
+		{"http://[::1]:9000", ErrWebhookURLPrivate},
+		{"http://10.0.0.5", ErrWebhookURLPrivate},
+	}
+	for _, tc := range cases {
+		if err := CheckWebhookURL(tc.url); !errors.Is(err, tc.want) {
+			t.Errorf("CheckWebhookURL(%q) = %v, want %v", tc.url, err, tc.want)
+		}
+	}
+}
+
+func TestWebhookClientRefusesPrivateAddresses(t *testing.T) {
+	var hit bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hit = true
+	}))
+	defer srv.Close()
+
+	_, err := NewWebhookSender(time.Second).Send(context.Background(), SignedWebhook{URL: srv.URL, Secret: "s", Body: []byte("{}")})
+	if !errors.Is(err, ErrWebhookURLPrivate) {
+		t.Fatalf("err = %v, want ErrWebhookURLPrivate", err)
+	}
+	if hit {
+		t.Fatal("request reached a loopback server")
+	}
+}
+
+func TestWebhookClientDoesNotFollowRedirects(t *testing.T) {
+	var followed bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/internal" {
+			followed = true
+			return
+		}
+		http.Redirect(w, r, "/internal", http.StatusFound)
+	}))
+	defer srv.Close()
+
+	client := NewWebhookClient(time.Second)
+	client.Transport = srv.Client().Transport
+	resp, err := newWebhookSender(client).Send(context.Background(), SignedWebhook{URL: srv.URL, Secret: "s", Body: []byte("{}")})
+	if err == nil || resp.StatusCode != http.StatusFound {
+		t.Fatalf("resp = %+v, err = %v; want a failed 302", resp, err)
+	}
+	if followed {
+		t.Fatal("redirect was followed")
+	}
+}
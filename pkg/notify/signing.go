@@ -0,0 +1,144 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers sent with every signed webhook. The signature is
+// "v1=" + hex(HMAC-SHA256(secret, timestamp + "." + body)), so receivers can
+// reject replays by checking the timestamp as well as the body.
+const (
+	HeaderWebhookID        = "X-Webhook-Id"
+	HeaderWebhookEvent     = "X-Webhook-Event"
+	HeaderWebhookTimestamp = "X-Webhook-Timestamp"
+	HeaderWebhookSignature = "X-Webhook-Signature"
+)
+
+const signatureVersion = "v1="
+
+// maxResponseBody caps how much of a receiver's reply is kept for the log.
+const maxResponseBody = 1 << 10
+
+var (
+	ErrSignatureMissing  = errors.New("notify: webhook signature is missing")
+	ErrSignatureMismatch = errors.New("notify: webhook signature does not match")
+	ErrTimestampInvalid  = errors.New("notify: webhook timestamp is invalid")
+	ErrTimestampExpired  = errors.New("notify: webhook timestamp is outside the tolerance")
+)
+
+// SignWebhook returns the signature header value for body sent at timestamp
+// (unix seconds).
+func SignWebhook(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signatureVersion + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhook checks the signature headers of a received webhook. Requests
+// signed more than tolerance away from now are rejected.
+func VerifyWebhook(secret string, header http.Header, body []byte, tolerance time.Duration, now time.Time) error {
+	sig := header.Get(HeaderWebhookSignature)
+	if sig == "" {
+		return ErrSignatureMissing
+	}
+	ts, err := strconv.ParseInt(header.Get(HeaderWebhookTimestamp), 10, 64)
+	if err != nil {
+		return ErrTimestampInvalid
+	}
+	if d := now.Sub(time.Unix(ts, 0)); d > tolerance || d < -tolerance {
+		return ErrTimestampExpired
+	}
+	if !hmac.Equal([]byte(sig), []byte(SignWebhook(secret, ts, body))) {
+		return ErrSignatureMismatch
+	}
+	return nil
+}
+
+// SignedWebhook is one request to a registered webhook endpoint.
+type SignedWebhook struct {
+	URL    string
+	Secret string
+	// ID identifies the event; it stays the same across retries so
+	// receivers can drop duplicates.
+	ID    string
+	Event string
+	Body  []byte
+}
+
+// WebhookResponse is what the receiver answered. StatusCode is 0 when no
+// response arrived.
+type WebhookResponse struct {
+	StatusCode int
+	Body       string
+	Duration   time.Duration
+}
+
+// WebhookSender posts signed JSON bodies to webhook endpoints.
+type WebhookSender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewWebhookSender sends through NewWebhookClient, so endpoints can only
+// reach public addresses.
+func NewWebhookSender(timeout time.Duration) *WebhookSender {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return newWebhookSender(NewWebhookClient(timeout))
+}
+
+func newWebhookSender(client *http.Client) *WebhookSender {
+	return &WebhookSender{client: client, now: time.Now}
+}
+
+// Send signs and posts w. Any status other than 2xx is an error; the
+// response is returned either way so the attempt can be logged.
+func (s *WebhookSender) Send(ctx context.Context, w SignedWebhook) (WebhookResponse, error) {
+	if w.URL == "" {
+		return WebhookResponse{}, ErrWebhookURLMissing
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(w.Body))
+	if err != nil {
+		return WebhookResponse{}, err
+	}
+	ts := s.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "go-market-ai-webhook")
+	req.Header.Set(HeaderWebhookID, w.ID)
+	req.Header.Set(HeaderWebhookEvent, w.Event)
+	req.Header.Set(HeaderWebhookTimestamp, strconv.FormatInt(ts, 10))
+	req.Header.Set(HeaderWebhookSignature, SignWebhook(w.Secret, ts, w.Body))
+
+	start := time.Now()
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return WebhookResponse{Duration: time.Since(start)}, err
+	}
+	defer resp.Body.Close()
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	out := WebhookResponse{
+		StatusCode: resp.StatusCode,
+		Body:       strings.ToValidUTF8(string(raw), ""),
+		Duration:   time.Since(start),
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return out, fmt.Errorf("notify: webhook returned %d", resp.StatusCode)
+	}
+	return out, nil
+}
@@ -0,0 +1,96 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestWebhookSenderSignsRequests(t *testing.T) {
+	const secret = "whsec_test"
+	var verifyErr error
+	var gotID, gotEvent string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		verifyErr = VerifyWebhook(secret, r.Header, body, 5*time.Minute, time.Now())
+		gotID = r.Header.Get(HeaderWebhookID)
+		gotEvent = r.Header.Get(HeaderWebhookEvent)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	s := newWebhookSender(srv.Client())
+	resp, err := s.Send(context.Background(), SignedWebhook{
+		URL:    srv.URL,
+		Secret: secret,
+		ID:     "evt_1",
+		Event:  "model.trained",
+		Body:   []byte(`{"type":"model.trained"}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verifyErr != nil {
+		t.Fatalf("receiver could not verify: %v", verifyErr)
+	}
+	if gotID != "evt_1" || gotEvent != "model.trained" {
+		t.Fatalf("headers id=%q event=%q", gotID, gotEvent)
+	}
+	if resp.StatusCode != http.StatusOK || resp.Body != "ok" {
+		t.Fatalf("resp = %+v", resp)
+	}
+}
+
+func TestWebhookSenderReportsErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write([]byte("busy"))
+	}))
+	defer srv.Close()
+
+	s := newWebhookSender(srv.Client())
+	resp, err := s.Send(context.Background(), SignedWebhook{URL: srv.URL, Secret: "s", Body: []byte("{}")})
+	if err == nil {
+		t.Fatal("expected an error for 503")
+	}
+	if resp.StatusCode != http.StatusServiceUnavailable || resp.Body != "busy" {
+		t.Fatalf("resp = %+v", resp)
+	}
+	if _, err := s.Send(context.Background(), SignedWebhook{}); !errors.Is(err, ErrWebhookURLMissing) {
+		t.Fatalf("err = %v, want ErrWebhookURLMissing", err)
+	}
+}
+
+func TestVerifyWebhookRejectsTamperingAndReplays(t *testing.T) {
+	now := time.Unix(1_800_000_000, 0)
+	body := []byte(`{"a":1}`)
+	header := func(ts int64, sig string) http.Header {
+		h := http.Header{}
+		h.Set(HeaderWebhookTimestamp, strconv.FormatInt(ts, 10))
+		h.Set(HeaderWebhookSignature, sig)
+		return h
+	}
+	ts := now.Unix()
+
+	if err := VerifyWebhook("s", header(ts, SignWebhook("s", ts, body)), body, time.Minute, now); err != nil {
+		t.Fatalf("valid signature: %v", err)
+	}
+	if err := VerifyWebhook("s", header(ts, SignWebhook("s", ts, body)), []byte(`{"a":2}`), time.Minute, now); !errors.Is(err, ErrSignatureMismatch) {
+		t.Fatalf("tampered body: %v", err)
+	}
+	if err := VerifyWebhook("other", header(ts, SignWebhook("s", ts, body)), body, time.Minute, now); !errors.Is(err, ErrSignatureMismatch) {
+		t.Fatalf("wrong secret: %v", err)
+	}
+	old := ts - 600
+	if err := VerifyWebhook("s", header(old, SignWebhook("s", old, body)), body, time.Minute, now); !errors.Is(err, ErrTimestampExpired) {
+		t.Fatalf("replayed request: %v", err)
+	}
+	if err := VerifyWebhook("s", http.Header{}, body, time.Minute, now); !errors.Is(err, ErrSignatureMissing) {
+		t.Fatalf("missing signature: %v", err)
+	}
+}
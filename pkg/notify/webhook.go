@@ -0,0 +1,80 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrWebhookURLMissing = errors.New("notify: webhook url is missing")
+
+// WebhookPayload is the JSON body posted to webhook URLs.
+type WebhookPayload struct {
+	Kind           string         `json:"kind"`
+	OrganizationID uuid.UUID      `json:"organization_id"`
+	UserID         uuid.UUID      `json:"user_id"`
+	Title          string         `json:"title"`
+	Body           string         `json:"body"`
+	Data           map[string]any `json:"data,omitempty"`
+	CreatedAt      time.Time      `json:"created_at"`
+}
+
+// WebhookNotifier posts messages as JSON to msg.WebhookURL. Any status other
+// than 2xx is an error. URLs come from users, so only public addresses are
+// reached.
+type WebhookNotifier struct {
+	client *http.Client
+}
+
+func NewWebhookNotifier(timeout time.Duration) *WebhookNotifier {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	return newWebhookNotifier(NewWebhookClient(timeout))
+}
+
+func newWebhookNotifier(client *http.Client) *WebhookNotifier {
+	return &WebhookNotifier{client: client}
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, msg Message) error {
+	if msg.WebhookURL == "" {
+		return ErrWebhookURLMissing
+	}
+	body, err := json.Marshal(WebhookPayload{
+		Kind:           msg.Kind,
+		OrganizationID: msg.OrganizationID,
+		UserID:         msg.UserID,
+		Title:          msg.Title,
+		Body:           msg.Body,
+		Data:           msg.Data,
+		CreatedAt:      msg.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, msg.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "go-market-ai-webhook")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("notify: webhook returned %d", resp.StatusCode)
+	}
+	return nil
+}
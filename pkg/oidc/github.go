@@ -0,0 +1,90 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+const (
+	githubAuthURL  = "https://github.com/login/oauth/authorize"
+	githubTokenURL = "https://github.com/login/oauth/access_token"
+	githubAPIURL   = "https://api.github.com"
+)
+
+// githubProvider adapts GitHub's OAuth2 flow, which has no id_token: the
+// identity comes from the user API and the email from /user/emails.
+type githubProvider struct {
+	cfg      Config
+	client   *http.Client
+	authURL  string
+	tokenURL string
+	apiURL   string
+}
+
+func newGitHubProvider(cfg Config, client *http.Client) *githubProvider {
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"read:user", "user:email"}
+	}
+	p := &githubProvider{cfg: cfg, client: client, authURL: githubAuthURL, tokenURL: githubTokenURL, apiURL: githubAPIURL}
+	if cfg.AuthURL != "" {
+		p.authURL = cfg.AuthURL
+	}
+	if cfg.TokenURL != "" {
+		p.tokenURL = cfg.TokenURL
+	}
+	if cfg.UserInfoURL != "" {
+		p.apiURL = cfg.UserInfoURL
+	}
+	return p
+}
+
+func (p *githubProvider) Name() string {
+	return p.cfg.Name
+}
+
+func (p *githubProvider) AuthCodeURL(_ context.Context, state, _, verifier string) (string, error) {
+	return authCodeURL(p.authURL, p.cfg, p.cfg.Scopes, state, verifier, nil)
+}
+
+func (p *githubProvider) Exchange(ctx context.Context, code, verifier, _ string) (*UserInfo, error) {
+	tok, err := exchangeCode(ctx, p.client, p.tokenURL, p.cfg, code, verifier)
+	if err != nil {
+		return nil, err
+	}
+	var user struct {
+		ID        int64  `json:"id"`
+		Login     string `json:"login"`
+		Name      string `json:"name"`
+		AvatarURL string `json:"avatar_url"`
+	}
+	if err := getJSON(ctx, p.client, p.apiURL+"/user", tok.AccessToken, &user); err != nil {
+		return nil, err
+	}
+	if user.ID == 0 {
+		return nil, errors.New("oidc: github user has no id")
+	}
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := getJSON(ctx, p.client, p.apiURL+"/user/emails", tok.AccessToken, &emails); err != nil {
+		return nil, err
+	}
+	info := &UserInfo{
+		Subject: strconv.FormatInt(user.ID, 10),
+		Name:    user.Name,
+		Picture: user.AvatarURL,
+	}
+	if info.Name == "" {
+		info.Name = user.Login
+	}
+	for _, e := range emails {
+		if e.Primary {
+			info.Email, info.EmailVerified = e.Email, e.Verified
+		}
+	}
+	return info, nil
+}
@@ -0,0 +1,119 @@
+package oidc_test
+
+import (
+	"context"
+	"go-ai/pkg/oidc"
+	"go-ai/pkg/oidc/oidctest"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+const redirectURL = "http://app.local/oauth/callback/mock"
+
+func start(t *testing.T, p oidc.Provider, srv *oidctest.Server) (code, verifier, nonce string) {
+	t.Helper()
+	state, nonce, verifier := oidc.NewState(), oidc.NewState(), oidc.NewVerifier()
+	authURL, err := p.AuthCodeURL(context.Background(), state, nonce, verifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, gotState, err := srv.Authorize(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotState != state {
+		t.Fatalf("state = %q, want %q", gotState, state)
+	}
+	return code, verifier, nonce
+}
+
+func TestAuthorizationCodeFlow(t *testing.T) {
+	srv := oidctest.NewServer("client-1")
+	defer srv.Close()
+	p, err := oidc.New(srv.Config("mock", redirectURL), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code, verifier, nonce := start(t, p, srv)
+	info, err := p.Exchange(context.Background(), code, verifier, nonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Subject != srv.User.Subject || info.Email != srv.User.Email || !info.EmailVerified {
+		t.Fatalf("info = %+v", info)
+	}
+
+	// Codes are single use.
+	if _, err := p.Exchange(context.Background(), code, verifier, nonce); err == nil {
+		t.Fatal("code redeemed twice")
+	}
+}
+
+func TestExchangeRejects(t *testing.T) {
+	srv := oidctest.NewServer("client-1")
+	defer srv.Close()
+	p, _ := oidc.New(srv.Config("mock", redirectURL), nil)
+
+	code, _, nonce := start(t, p, srv)
+	if _, err := p.Exchange(context.Background(), code, oidc.NewVerifier(), nonce); err == nil {
+		t.Fatal("wrong PKCE verifier accepted")
+	}
+
+	code, verifier, _ := start(t, p, srv)
+	if _, err := p.Exchange(context.Background(), code, verifier, "other-nonce"); err == nil {
+		t.Fatal("wrong nonce accepted")
+	}
+
+	other, _ := oidc.New(oidc.Config{Name: "mock", Issuer: srv.URL, ClientID: "client-2", RedirectURL: redirectURL}, nil)
+	code, verifier, nonce = start(t, p, srv)
+	if _, err := other.Exchange(context.Background(), code, verifier, nonce); err == nil {
+		t.Fatal("code accepted for another client")
+	}
+}
+
+func TestGitHubPicksPrimaryEmail(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"access_token":"gho_test","token_type":"bearer"}`))
+	})
+	mux.HandleFunc("GET /user", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer gho_test" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"id":42,"login":"octo","name":""}`))
+	})
+	mux.HandleFunc("GET /user/emails", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"email":"old@example.com","primary":false,"verified":true},{"email":"octo@example.com","primary":true,"verified":true}]`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	p, err := oidc.New(oidc.Config{
+		Name:        "github",
+		Kind:        oidc.KindGitHub,
+		ClientID:    "gh",
+		RedirectURL: redirectURL,
+		AuthURL:     srv.URL + "/authorize",
+		TokenURL:    srv.URL + "/token",
+		UserInfoURL: srv.URL,
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	authURL, _ := p.AuthCodeURL(context.Background(), "s", "", "v")
+	u, _ := url.Parse(authURL)
+	if u.Query().Get("code_challenge") != oidc.Challenge("v") {
+		t.Fatalf("missing PKCE challenge in %s", authURL)
+	}
+	info, err := p.Exchange(context.Background(), "code", "v", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Subject != "42" || info.Email != "octo@example.com" || !info.EmailVerified || info.Name != "octo" {
+		t.Fatalf("info = %+v", info)
+	}
+}
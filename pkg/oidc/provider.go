@@ -0,0 +1,204 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-ai/pkg/jwtkeys"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	metadataTTL = time.Hour
+	// jwksMinRefresh limits refetches triggered by unknown kids.
+	jwksMinRefresh = time.Minute
+)
+
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+}
+
+type oidcProvider struct {
+	cfg    Config
+	client *http.Client
+
+	mu       sync.Mutex
+	meta     *metadata
+	metaAt   time.Time
+	jwks     jwtkeys.JWKS
+	jwksAt   time.Time
+	jwksFrom string
+}
+
+func newOIDCProvider(cfg Config, client *http.Client) *oidcProvider {
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"openid", "email", "profile"}
+	}
+	return &oidcProvider{cfg: cfg, client: client}
+}
+
+func (p *oidcProvider) Name() string {
+	return p.cfg.Name
+}
+
+func (p *oidcProvider) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	return authCodeURL(meta.AuthorizationEndpoint, p.cfg, p.cfg.Scopes, state, verifier, url.Values{"nonce": {nonce}})
+}
+
+func (p *oidcProvider) Exchange(ctx context.Context, code, verifier, nonce string) (*UserInfo, error) {
+	meta, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tok, err := exchangeCode(ctx, p.client, meta.TokenEndpoint, p.cfg, code, verifier)
+	if err != nil {
+		return nil, err
+	}
+	if tok.IDToken == "" {
+		return nil, errors.New("oidc: token response has no id_token")
+	}
+	claims, err := p.verifyIDToken(ctx, meta, tok.IDToken, nonce)
+	if err != nil {
+		return nil, err
+	}
+	info := &UserInfo{
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+		Name:          claims.Name,
+		Picture:       claims.Picture,
+	}
+	// Some providers only put the email in the userinfo response.
+	if info.Email == "" && meta.UserinfoEndpoint != "" && tok.AccessToken != "" {
+		var extra idClaims
+		if err := getJSON(ctx, p.client, meta.UserinfoEndpoint, tok.AccessToken, &extra); err == nil && extra.Subject == info.Subject {
+			info.Email = extra.Email
+			info.EmailVerified = bool(extra.EmailVerified)
+			if info.Name == "" {
+				info.Name = extra.Name
+			}
+		}
+	}
+	return info, nil
+}
+
+// flexBool accepts both true and "true"; some providers send the string.
+type flexBool bool
+
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	*b = flexBool(strings.Trim(string(data), `"`) == "true")
+	return nil
+}
+
+type idClaims struct {
+	Nonce         string   `json:"nonce"`
+	Email         string   `json:"email"`
+	EmailVerified flexBool `json:"email_verified"`
+	Name          string   `json:"name"`
+	Picture       string   `json:"picture"`
+	AuthorizedBy  string   `json:"azp"`
+	jwt.RegisteredClaims
+}
+
+func (p *oidcProvider) verifyIDToken(ctx context.Context, meta *metadata, raw, nonce string) (*idClaims, error) {
+	claims := &idClaims{}
+	_, err := jwt.ParseWithClaims(raw, claims, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.key(ctx, meta.JWKSURI, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "ES256", "EdDSA"}),
+		jwt.WithIssuer(meta.Issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("oidc: id_token: %w", err)
+	}
+	if claims.Nonce != nonce {
+		return nil, errors.New("oidc: id_token nonce mismatch")
+	}
+	if len(claims.Audience) > 1 && claims.AuthorizedBy != p.cfg.ClientID {
+		return nil, errors.New("oidc: id_token azp mismatch")
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("oidc: id_token has no subject")
+	}
+	return claims, nil
+}
+
+func (p *oidcProvider) discover(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.meta != nil && time.Since(p.metaAt) < metadataTTL {
+		return p.meta, nil
+	}
+	issuer := strings.TrimRight(p.cfg.Issuer, "/")
+	var meta metadata
+	if err := getJSON(ctx, p.client, issuer+"/.well-known/openid-configuration", "", &meta); err != nil {
+		return nil, fmt.Errorf("oidc: discovery: %w", err)
+	}
+	if strings.TrimRight(meta.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("oidc: discovery issuer %q does not match %q", meta.Issuer, p.cfg.Issuer)
+	}
+	if p.cfg.AuthURL != "" {
+		meta.AuthorizationEndpoint = p.cfg.AuthURL
+	}
+	if p.cfg.TokenURL != "" {
+		meta.TokenEndpoint = p.cfg.TokenURL
+	}
+	if p.cfg.UserInfoURL != "" {
+		meta.UserinfoEndpoint = p.cfg.UserInfoURL
+	}
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return nil, errors.New("oidc: discovery document is incomplete")
+	}
+	p.meta, p.metaAt = &meta, time.Now()
+	return p.meta, nil
+}
+
+// key finds the signing key by kid, refetching the set once when the
+// provider has rotated to a key we have not seen.
+func (p *oidcProvider) key(ctx context.Context, jwksURI, kid string) (any, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if k, ok := p.lookup(jwksURI, kid); ok {
+		return k.PublicKey()
+	}
+	if p.jwksFrom == jwksURI && time.Since(p.jwksAt) < jwksMinRefresh {
+		return nil, fmt.Errorf("oidc: unknown signing key %q", kid)
+	}
+	var set jwtkeys.JWKS
+	if err := getJSON(ctx, p.client, jwksURI, "", &set); err != nil {
+		return nil, fmt.Errorf("oidc: jwks: %w", err)
+	}
+	p.jwks, p.jwksAt, p.jwksFrom = set, time.Now(), jwksURI
+	if k, ok := p.lookup(jwksURI, kid); ok {
+		return k.PublicKey()
+	}
+	return nil, fmt.Errorf("oidc: unknown signing key %q", kid)
+}
+
+func (p *oidcProvider) lookup(jwksURI, kid string) (jwtkeys.JWK, bool) {
+	if p.jwksFrom != jwksURI {
+		return jwtkeys.JWK{}, false
+	}
+	if kid == "" && len(p.jwks.Keys) == 1 {
+		return p.jwks.Keys[0], true
+	}
+	return p.jwks.Key(kid)
+}
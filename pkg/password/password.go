@@ -0,0 +1,158 @@
+// Package password hashes passwords with argon2id and stores them in the PHC
+// string format, so every hash records the parameters it was made with.
+// bcrypt hashes from before the switch still verify and are flagged for a
+// rehash.
+package password
+
+import (
+	"bufio"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Params are the argon2id cost parameters. Memory is in KiB.
+type Params struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultParams follow the OWASP recommendation for argon2id.
+var DefaultParams = Params{
+	Memory:      19 * 1024,
+	Iterations:  2,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+var (
+	ErrMalformedHash = errors.New("password: malformed hash")
+	b64              = base64.RawStdEncoding
+)
+
+// Hasher hashes new passwords with its params and verifies stored hashes made
+// with any params. A non-empty pepper is mixed into argon2id hashes only, so
+// changing it makes every argon2id hash unverifiable.
+type Hasher struct {
+	params Params
+	pepper []byte
+}
+
+func New(params Params, pepper string) *Hasher {
+	if params.SaltLength == 0 {
+		params.SaltLength = DefaultParams.SaltLength
+	}
+	if params.KeyLength == 0 {
+		params.KeyLength = DefaultParams.KeyLength
+	}
+	return &Hasher{params: params, pepper: []byte(pepper)}
+}
+
+// Hash returns the encoded argon2id hash of password.
+func (h *Hasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := h.derive(password, salt, h.params)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, h.params.Memory, h.params.Iterations, h.params.Parallelism,
+		b64.EncodeToString(salt), b64.EncodeToString(key),
+	), nil
+}
+
+// Verify reports whether password matches encoded, and whether the hash
+// should be replaced because it uses bcrypt or outdated parameters.
+func (h *Hasher) Verify(password, encoded string) (ok, rehash bool) {
+	if strings.HasPrefix(encoded, "$2") {
+		if bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password)) != nil {
+			return false, false
+		}
+		return true, true
+	}
+	params, salt, key, err := decode(encoded)
+	if err != nil {
+		return false, false
+	}
+	got := h.derive(password, salt, params)
+	if subtle.ConstantTimeCompare(got, key) != 1 {
+		return false, false
+	}
+	return true, params != h.params
+}
+
+func (h *Hasher) derive(password string, salt []byte, p Params) []byte {
+	input := []byte(password)
+	if len(h.pepper) > 0 {
+		mac := hmac.New(sha256.New, h.pepper)
+		mac.Write(input)
+		input = mac.Sum(nil)
+	}
+	return argon2.IDKey(input, salt, p.Iterations, p.Memory, p.Parallelism, p.KeyLength)
+}
+
+func decode(encoded string) (Params, []byte, []byte, error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return Params{}, nil, nil, ErrMalformedHash
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return Params{}, nil, nil, ErrMalformedHash
+	}
+	var p Params
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Iterations, &p.Parallelism); err != nil {
+		return Params{}, nil, nil, ErrMalformedHash
+	}
+	if p.Memory == 0 || p.Iterations == 0 || p.Parallelism == 0 {
+		return Params{}, nil, nil, ErrMalformedHash
+	}
+	salt, err := b64.DecodeString(parts[4])
+	if err != nil || len(salt) == 0 {
+		return Params{}, nil, nil, ErrMalformedHash
+	}
+	key, err := b64.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return Params{}, nil, nil, ErrMalformedHash
+	}
+	p.SaltLength = uint32(len(salt))
+	p.KeyLength = uint32(len(key))
+	return p, salt, key, nil
+}
+
+// LoadBlocklist reads a file with one password per line. Entries are compared
+// case-insensitively; blank lines and lines starting with # are skipped.
+func LoadBlocklist(path string) (map[string]struct{}, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	list := map[string]struct{}{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		list[strings.ToLower(line)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
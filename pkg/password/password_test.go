@@ -0,0 +1,115 @@
+package password
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Cheap params keep the tests fast.
+var testParams = Params{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+func TestHashRoundTrip(t *testing.T) {
+	h := New(testParams, "")
+	encoded, err := h.Hash("Secret1!")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(encoded, "$argon2id$v=19$m=64,t=1,p=1$") {
+		t.Fatalf("unexpected encoding %q", encoded)
+	}
+	if ok, rehash := h.Verify("Secret1!", encoded); !ok || rehash {
+		t.Fatalf("Verify = %v, %v; want true, false", ok, rehash)
+	}
+	if ok, _ := h.Verify("secret1!", encoded); ok {
+		t.Fatal("wrong password verified")
+	}
+}
+
+func TestHashUsesRandomSalt(t *testing.T) {
+	h := New(testParams, "")
+	a, _ := h.Hash("Secret1!")
+	b, _ := h.Hash("Secret1!")
+	if a == b {
+		t.Fatal("two hashes of the same password are equal")
+	}
+}
+
+func TestVerifyFlagsOutdatedParams(t *testing.T) {
+	old := New(testParams, "")
+	encoded, _ := old.Hash("Secret1!")
+
+	upgraded := testParams
+	upgraded.Iterations = 2
+	ok, rehash := New(upgraded, "").Verify("Secret1!", encoded)
+	if !ok || !rehash {
+		t.Fatalf("Verify = %v, %v; want true, true", ok, rehash)
+	}
+}
+
+func TestVerifyBcryptAlwaysRehashes(t *testing.T) {
+	legacy, err := bcrypt.GenerateFromPassword([]byte("Secret1!"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := New(testParams, "pepper")
+	if ok, rehash := h.Verify("Secret1!", string(legacy)); !ok || !rehash {
+		t.Fatalf("Verify = %v, %v; want true, true", ok, rehash)
+	}
+	if ok, _ := h.Verify("other", string(legacy)); ok {
+		t.Fatal("wrong password verified")
+	}
+}
+
+func TestPepperIsRequired(t *testing.T) {
+	encoded, _ := New(testParams, "one").Hash("Secret1!")
+	if ok, _ := New(testParams, "one").Verify("Secret1!", encoded); !ok {
+		t.Fatal("same pepper did not verify")
+	}
+	if ok, _ := New(testParams, "two").Verify("Secret1!", encoded); ok {
+		t.Fatal("different pepper verified")
+	}
+	if ok, _ := New(testParams, "").Verify("Secret1!", encoded); ok {
+		t.Fatal("missing pepper verified")
+	}
+}
+
+func TestVerifyRejectsMalformedHashes(t *testing.T) {
+	h := New(testParams, "")
+	for _, encoded := range []string{
+		"",
+		"!",
+		"$argon2id$v=19$m=64,t=1,p=1$c2FsdA",
+		"$argon2i$v=19$m=64,t=1,p=1$c2FsdHNhbHQ$a2V5",
+		"$argon2id$v=18$m=64,t=1,p=1$c2FsdHNhbHQ$a2V5",
+		"$argon2id$v=19$m=0,t=1,p=1$c2FsdHNhbHQ$a2V5",
+		"$argon2id$v=19$m=64,t=1,p=1$***$a2V5",
+	} {
+		if ok, _ := h.Verify("Secret1!", encoded); ok {
+			t.Fatalf("Verify(%q) = true", encoded)
+		}
+	}
+}
+
+func TestLoadBlocklist(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "common.txt")
+	body := "# top passwords\nPassword1\n\n  letmein  \n"
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	list, err := LoadBlocklist(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 {
+		t.Fatalf("got %d entries, want 2", len(list))
+	}
+	for _, want := range []string{"password1", "letmein"} {
+		if _, ok := list[want]; !ok {
+			t.Fatalf("missing %q", want)
+		}
+	}
+}
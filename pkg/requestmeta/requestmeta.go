@@ -0,0 +1,23 @@
+// Package requestmeta carries facts about the HTTP request in the context,
+// so code below the transport layer can record where a call came from.
+package requestmeta
+
+import "context"
+
+type Meta struct {
+	RequestID string
+	IP        string
+	UserAgent string
+}
+
+type ctxKey struct{}
+
+func With(ctx context.Context, m Meta) context.Context {
+	return context.WithValue(ctx, ctxKey{}, m)
+}
+
+// From returns the request metadata, or the zero Meta outside a request.
+func From(ctx context.Context) Meta {
+	m, _ := ctx.Value(ctxKey{}).(Meta)
+	return m
+}
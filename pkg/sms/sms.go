@@ -0,0 +1,83 @@
+// Package sms sends text messages. Only development drivers ship here; a
+// provider integration implements Sender.
+package sms
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+const (
+	DriverLog    = "log"
+	DriverMemory = "memory"
+)
+
+// Message is a text message to a phone number in E.164 form.
+type Message struct {
+	To   string
+	Body string
+}
+
+// Sender delivers text messages.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// New returns the Sender selected by driver.
+func New(driver string, log zerolog.Logger) (Sender, error) {
+	switch driver {
+	case DriverLog, "":
+		return NewLogSender(log), nil
+	case DriverMemory:
+		return NewMemorySender(), nil
+	default:
+		return nil, fmt.Errorf("sms: unknown driver %q", driver)
+	}
+}
+
+// LogSender writes every message to the log instead of sending it. It is
+// meant for local development and prints codes in clear text.
+type LogSender struct {
+	log zerolog.Logger
+}
+
+func NewLogSender(log zerolog.Logger) *LogSender {
+	return &LogSender{log: log.With().Str("component", "sms").Logger()}
+}
+
+func (s *LogSender) Send(_ context.Context, msg Message) error {
+	s.log.Info().Str("to", msg.To).Str("body", msg.Body).Msg("sms not sent (log driver)")
+	return nil
+}
+
+// MemorySender keeps sent messages in memory. It is used by tests.
+type MemorySender struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func NewMemorySender() *MemorySender {
+	return &MemorySender{}
+}
+
+func (s *MemorySender) Send(_ context.Context, msg Message) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = append(s.messages, msg)
+	return nil
+}
+
+// Last returns the most recent message sent to number.
+func (s *MemorySender) Last(number string) (Message, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := len(s.messages) - 1; i >= 0; i-- {
+		if s.messages[i].To == number {
+			return s.messages[i], true
+		}
+	}
+	return Message{}, false
+}
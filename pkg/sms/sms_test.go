@@ -0,0 +1,46 @@
+package sms
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+func TestLogSenderWritesMessage(t *testing.T) {
+	var buf bytes.Buffer
+	s, err := New(DriverLog, zerolog.New(&buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Send(context.Background(), Message{To: "+84912345678", Body: "code 123456"}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "+84912345678") || !strings.Contains(buf.String(), "code 123456") {
+		t.Fatalf("message not logged: %s", buf.String())
+	}
+}
+
+func TestMemorySenderLast(t *testing.T) {
+	s := NewMemorySender()
+	ctx := context.Background()
+	_ = s.Send(ctx, Message{To: "+1", Body: "first"})
+	_ = s.Send(ctx, Message{To: "+2", Body: "other"})
+	_ = s.Send(ctx, Message{To: "+1", Body: "second"})
+
+	msg, ok := s.Last("+1")
+	if !ok || msg.Body != "second" {
+		t.Fatalf("Last = %+v, %v", msg, ok)
+	}
+	if _, ok := s.Last("+3"); ok {
+		t.Fatal("found a message that was never sent")
+	}
+}
+
+func TestNewRejectsUnknownDriver(t *testing.T) {
+	if _, err := New("carrier-pigeon", zerolog.Nop()); err == nil {
+		t.Fatal("expected an error")
+	}
+}
@@ -0,0 +1,67 @@
+package sse
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ContentType is the media type of an event stream.
+const ContentType = "text/event-stream"
+
+// LastEventIDHeader carries the ID of the last event a reconnecting client
+// saw.
+const LastEventIDHeader = "Last-Event-ID"
+
+// Event is one server-sent event. Empty fields are left out; an event
+// without an ID keeps the client's last event ID as it was.
+type Event struct {
+	ID    string
+	Type  string
+	Data  []byte
+	Retry time.Duration
+}
+
+// Write encodes e in the text/event-stream format. Data spanning several
+// lines is sent as one data field per line.
+func Write(w io.Writer, e Event) error {
+	var buf bytes.Buffer
+	if e.ID != "" {
+		writeField(&buf, "id", e.ID)
+	}
+	if e.Type != "" {
+		writeField(&buf, "event", e.Type)
+	}
+	if e.Retry > 0 {
+		writeField(&buf, "retry", strconv.FormatInt(e.Retry.Milliseconds(), 10))
+	}
+	if e.Data != nil {
+		for _, line := range strings.Split(strings.ReplaceAll(string(e.Data), "\r\n", "\n"), "\n") {
+			writeField(&buf, "data", line)
+		}
+	}
+	buf.WriteByte('\n')
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// WriteComment sends a comment line, which clients ignore. It keeps
+// proxies from closing an idle connection.
+func WriteComment(w io.Writer, text string) error {
+	_, err := io.WriteString(w, ": "+strings.ReplaceAll(text, "\n", " ")+"\n\n")
+	return err
+}
+
+// writeField drops line breaks from values other than data, which would
+// otherwise start a new field.
+func writeField(buf *bytes.Buffer, name, value string) {
+	if name != "data" {
+		value = strings.NewReplacer("\r", "", "\n", "").Replace(value)
+	}
+	buf.WriteString(name)
+	buf.WriteString(": ")
+	buf.WriteString(value)
+	buf.WriteByte('\n')
+}
@@ -0,0 +1,40 @@
+package sse
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWrite(t *testing.T) {
+	var b strings.Builder
+	err := Write(&b, Event{ID: "1700000000000-0", Type: "candle", Data: []byte(`{"close":1}`), Retry: 5 * time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "id: 1700000000000-0\nevent: candle\nretry: 5000\ndata: {\"close\":1}\n\n"
+	if b.String() != want {
+		t.Fatalf("got %q, want %q", b.String(), want)
+	}
+}
+
+func TestWriteMultilineData(t *testing.T) {
+	var b strings.Builder
+	if err := Write(&b, Event{Type: "bad\ntype", Data: []byte("a\r\nb\nc")}); err != nil {
+		t.Fatal(err)
+	}
+	want := "event: badtype\ndata: a\ndata: b\ndata: c\n\n"
+	if b.String() != want {
+		t.Fatalf("got %q, want %q", b.String(), want)
+	}
+}
+
+func TestWriteComment(t *testing.T) {
+	var b strings.Builder
+	if err := WriteComment(&b, "connected\nok"); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != ": connected ok\n\n" {
+		t.Fatalf("got %q", b.String())
+	}
+}
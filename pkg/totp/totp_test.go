@@ -0,0 +1,74 @@
+package totp
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+// RFC 6238 appendix B uses the ASCII secret "12345678901234567890".
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestHOTPMatchesRFCVectors(t *testing.T) {
+	key := []byte("12345678901234567890")
+	cases := []struct {
+		unix int64
+		want string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+	}
+	for _, tc := range cases {
+		got := hotp(key, uint64(Step(time.Unix(tc.unix, 0))), 8)
+		if got != tc.want {
+			t.Fatalf("t=%d: got %s want %s", tc.unix, got, tc.want)
+		}
+	}
+}
+
+func TestValidateAllowsSkewAndReturnsStep(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	prev := Step(now) - 1
+	code, err := Code(rfcSecret, prev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	step, ok := Validate(rfcSecret, code, now, 1)
+	if !ok || step != prev {
+		t.Fatalf("expected previous step to validate, got step=%d ok=%v", step, ok)
+	}
+	if _, ok := Validate(rfcSecret, code, now, 0); ok {
+		t.Fatal("expected previous step to fail without skew")
+	}
+	if _, ok := Validate(rfcSecret, "12345", now, 1); ok {
+		t.Fatal("expected short code to fail")
+	}
+}
+
+func TestGenerateSecretRoundTrips(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err := Code(strings.ToLower(secret), Step(time.Now()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := Validate(secret, code, time.Now(), 1); !ok {
+		t.Fatal("generated secret did not validate its own code")
+	}
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("Go Market", "alice@example.com", "ABC")
+	if !strings.HasPrefix(uri, "otpauth://totp/Go%20Market:alice@example.com?") {
+		t.Fatalf("unexpected label: %s", uri)
+	}
+	if !strings.Contains(uri, "secret=ABC") || !strings.Contains(uri, "issuer=Go+Market") {
+		t.Fatalf("missing query params: %s", uri)
+	}
+}
@@ -0,0 +1,102 @@
+// Package worker holds the lifecycle shared by background workers: a loop
+// started alongside the HTTP server and stopped, together with anything it
+// spawned, during graceful shutdown.
+package worker
+
+import (
+	"context"
+	"fmt"
+	"go-ai/pkg/lock"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Group runs the goroutines of one worker between Start and Shutdown.
+type Group struct {
+	name   string
+	logger zerolog.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New creates a Group. name appears in its log lines and errors, e.g.
+// "alert evaluator".
+func New(name string, logger zerolog.Logger) *Group {
+	return &Group{name: name, logger: logger}
+}
+
+// Start runs run in a goroutine under a context that Shutdown cancels. It
+// returns immediately.
+func (g *Group) Start(ctx context.Context, run func(ctx context.Context)) {
+	ctx, cancel := context.WithCancel(ctx)
+	g.cancel = cancel
+	g.Go(func() { run(ctx) })
+}
+
+// Go runs fn in a goroutine that Shutdown waits for.
+func (g *Group) Go(fn func()) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		fn()
+	}()
+}
+
+// Shutdown cancels the context given to Start and waits for every goroutine
+// of the group to return, giving up when ctx expires.
+func (g *Group) Shutdown(ctx context.Context) error {
+	if g.cancel == nil {
+		return nil
+	}
+	g.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		g.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		g.logger.Info().Msg(g.name + " stopped")
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("%s shutdown: %w", g.name, ctx.Err())
+	}
+}
+
+// Every calls fn at once and then every interval until ctx is done. A call
+// running longer than interval delays the next one rather than overlapping.
+func Every(ctx context.Context, interval time.Duration, fn func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		fn(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Exclusive calls fn only if it takes the lock at key. The lock is never
+// released, only left to expire after interval, so replicas running the
+// same pass on that interval skip it instead of repeating it.
+func Exclusive(ctx context.Context, locker *lock.Locker, key string, interval time.Duration, logger zerolog.Logger, fn func(ctx context.Context)) {
+	lk, err := locker.TryAcquire(ctx, key, interval)
+	if err != nil {
+		if ctx.Err() == nil {
+			logger.Error().Err(err).Str("lock", key).Msg("acquire pass lock")
+		}
+		return
+	}
+	if lk == nil {
+		return
+	}
+	fn(ctx)
+}
@@ -0,0 +1,61 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+func TestShutdownWaitsForSpawnedGoroutines(t *testing.T) {
+	g := New("test worker", zerolog.Nop())
+	finished := make(chan struct{})
+	g.Start(context.Background(), func(ctx context.Context) {
+		g.Go(func() {
+			<-ctx.Done()
+			close(finished)
+		})
+	})
+
+	if err := g.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-finished:
+	default:
+		t.Fatal("Shutdown returned before the spawned goroutine")
+	}
+}
+
+func TestShutdownGivesUpWhenCtxExpires(t *testing.T) {
+	g := New("test worker", zerolog.Nop())
+	release := make(chan struct{})
+	defer close(release)
+	g.Start(context.Background(), func(context.Context) { <-release })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := g.Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want DeadlineExceeded", err)
+	}
+}
+
+func TestShutdownBeforeStart(t *testing.T) {
+	if err := New("test worker", zerolog.Nop()).Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEveryRunsAtOnceAndStopsWithCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	Every(ctx, time.Hour, func(context.Context) {
+		calls++
+		cancel()
+	})
+	if calls != 1 {
+		t.Fatalf("calls = %d, want 1", calls)
+	}
+}